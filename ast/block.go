@@ -228,6 +228,37 @@ func (b *FunctionBlock) HasConditions() bool {
 		(!b.PreConditions.IsEmpty() || !b.PostConditions.IsEmpty())
 }
 
+// ReturnsValue returns true if the function block contains a return statement with a value.
+// Return statements of nested functions are not considered
+func (b *FunctionBlock) ReturnsValue() bool {
+	if b == nil || b.Block == nil {
+		return false
+	}
+
+	var returnsValue bool
+
+	Inspect(b.Block, func(element Element) bool {
+		if returnsValue {
+			return false
+		}
+
+		switch element := element.(type) {
+		case *ReturnStatement:
+			if element.Expression != nil {
+				returnsValue = true
+				return false
+			}
+
+		case *FunctionExpression, *FunctionDeclaration:
+			return false
+		}
+
+		return true
+	})
+
+	return returnsValue
+}
+
 // Condition
 
 type Condition interface {
@@ -50,13 +50,22 @@ func IsResourceDestructionDefaultEvent(identifier string) bool {
 }
 
 type CompositeDeclaration struct {
-	Members      *Members
-	DocString    string
-	Conformances []*NominalType
-	Identifier   Identifier
+	Members *Members
+	// UnderlyingType is the type a distinct type is derived from,
+	// and is nil for all other composite declarations
+	UnderlyingType *TypeAnnotation `json:",omitempty"`
+	// TypeParameterList is the list of type parameters of a generic composite,
+	// and is nil for all other composite declarations
+	TypeParameterList *TypeParameterList `json:",omitempty"`
+	DocString         string
+	Conformances      []*NominalType
+	Identifier        Identifier
 	Range
 	Access        Access
 	CompositeKind common.CompositeKind
+	// InheritsOperations is true if a distinct type
+	// opted in to the operations of its underlying type
+	InheritsOperations bool `json:",omitempty"`
 }
 
 var _ Element = &CompositeDeclaration{}
@@ -69,6 +78,7 @@ func NewCompositeDeclaration(
 	access Access,
 	compositeKind common.CompositeKind,
 	identifier Identifier,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 	docString string,
@@ -77,16 +87,202 @@ func NewCompositeDeclaration(
 	common.UseMemory(memoryGauge, common.CompositeDeclarationMemoryUsage)
 
 	return &CompositeDeclaration{
-		Access:        access,
-		CompositeKind: compositeKind,
-		Identifier:    identifier,
-		Conformances:  conformances,
-		Members:       members,
-		DocString:     docString,
-		Range:         declarationRange,
+		Access:            access,
+		CompositeKind:     compositeKind,
+		Identifier:        identifier,
+		TypeParameterList: typeParameterList,
+		Conformances:      conformances,
+		Members:           members,
+		DocString:         docString,
+		Range:             declarationRange,
 	}
 }
 
+const DistinctTypeUnderlyingValueFieldName = "underlyingValue"
+const DistinctTypeToUnderlyingFunctionName = "toUnderlying"
+
+// NewDistinctTypeDeclaration returns the declaration of a distinct type,
+// e.g. `type PersonID = distinct String`.
+//
+// A distinct type is declared as a structure, which wraps a value of the underlying type.
+// The members are synthesized: a private field holding the underlying value,
+// an initializer converting from the underlying type,
+// and a function converting back to the underlying type.
+func NewDistinctTypeDeclaration(
+	memoryGauge common.MemoryGauge,
+	access Access,
+	identifier Identifier,
+	underlyingType *TypeAnnotation,
+	inheritsOperations bool,
+	docString string,
+	declarationRange Range,
+) *CompositeDeclaration {
+
+	members := distinctTypeMembers(
+		memoryGauge,
+		underlyingType,
+		declarationRange,
+	)
+
+	declaration := NewCompositeDeclaration(
+		memoryGauge,
+		access,
+		common.CompositeKindStructure,
+		identifier,
+		nil,
+		nil,
+		members,
+		docString,
+		declarationRange,
+	)
+	declaration.UnderlyingType = underlyingType
+	declaration.InheritsOperations = inheritsOperations
+
+	return declaration
+}
+
+func distinctTypeMembers(
+	memoryGauge common.MemoryGauge,
+	underlyingType *TypeAnnotation,
+	declarationRange Range,
+) *Members {
+
+	startPos := declarationRange.StartPos
+
+	newIdentifier := func(identifier string) Identifier {
+		return NewIdentifier(memoryGauge, identifier, startPos)
+	}
+
+	newSelfMemberExpression := func() *MemberExpression {
+		return NewMemberExpression(
+			memoryGauge,
+			NewIdentifierExpression(memoryGauge, newIdentifier("self")),
+			false,
+			startPos,
+			newIdentifier(DistinctTypeUnderlyingValueFieldName),
+		)
+	}
+
+	// access(self) let underlyingValue: T
+
+	field := NewFieldDeclaration(
+		memoryGauge,
+		AccessSelf,
+		false,
+		false,
+		VariableKindConstant,
+		newIdentifier(DistinctTypeUnderlyingValueFieldName),
+		underlyingType,
+		"",
+		declarationRange,
+	)
+
+	// init(_ value: T) { self.underlyingValue = value }
+
+	const parameterName = "value"
+
+	initializerParameterList := NewParameterList(
+		memoryGauge,
+		[]*Parameter{
+			NewParameter(
+				memoryGauge,
+				"_",
+				newIdentifier(parameterName),
+				underlyingType,
+				nil,
+				startPos,
+			),
+		},
+		declarationRange,
+	)
+
+	initializerBlock := NewFunctionBlock(
+		memoryGauge,
+		NewBlock(
+			memoryGauge,
+			[]Statement{
+				NewAssignmentStatement(
+					memoryGauge,
+					newSelfMemberExpression(),
+					NewTransfer(memoryGauge, TransferOperationCopy, startPos),
+					NewIdentifierExpression(memoryGauge, newIdentifier(parameterName)),
+				),
+			},
+			declarationRange,
+		),
+		nil,
+		nil,
+	)
+
+	initializer := NewSpecialFunctionDeclaration(
+		memoryGauge,
+		common.DeclarationKindInitializer,
+		NewFunctionDeclaration(
+			memoryGauge,
+			AccessNotSpecified,
+			FunctionPurityView,
+			false,
+			false,
+			newIdentifier("init"),
+			nil,
+			initializerParameterList,
+			nil,
+			initializerBlock,
+			startPos,
+			"",
+		),
+	)
+
+	// access(all) view fun toUnderlying(): T { return self.underlyingValue }
+
+	conversionBlock := NewFunctionBlock(
+		memoryGauge,
+		NewBlock(
+			memoryGauge,
+			[]Statement{
+				NewReturnStatement(
+					memoryGauge,
+					newSelfMemberExpression(),
+					declarationRange,
+				),
+			},
+			declarationRange,
+		),
+		nil,
+		nil,
+	)
+
+	conversionFunction := NewFunctionDeclaration(
+		memoryGauge,
+		AccessAll,
+		FunctionPurityView,
+		false,
+		false,
+		newIdentifier(DistinctTypeToUnderlyingFunctionName),
+		nil,
+		NewParameterList(memoryGauge, nil, declarationRange),
+		underlyingType,
+		conversionBlock,
+		startPos,
+		"",
+	)
+
+	return NewMembers(
+		memoryGauge,
+		[]Declaration{
+			field,
+			initializer,
+			conversionFunction,
+		},
+	)
+}
+
+// IsDistinctType returns true if the declaration declares a distinct type,
+// e.g. `type PersonID = distinct String`
+func (d *CompositeDeclaration) IsDistinctType() bool {
+	return d.UnderlyingType != nil
+}
+
 func (*CompositeDeclaration) ElementType() ElementType {
 	return ElementTypeCompositeDeclaration
 }
@@ -140,11 +336,16 @@ func (d *CompositeDeclaration) Doc() prettier.Doc {
 		return d.EventDoc()
 	}
 
+	if d.IsDistinctType() {
+		return d.DistinctTypeDoc()
+	}
+
 	return CompositeDocument(
 		d.Access,
 		d.CompositeKind,
 		false,
 		d.Identifier.Identifier,
+		d.TypeParameterList,
 		d.Conformances,
 		d.Members,
 	)
@@ -179,6 +380,36 @@ func (d *CompositeDeclaration) EventDoc() prettier.Doc {
 	return append(doc, paramsDoc)
 }
 
+var distinctTypeKeywordSpaceDoc = prettier.Text("type ")
+var distinctTypeEqualDistinctSpaceDoc = prettier.Text(" = distinct ")
+var distinctTypeWithOperationsDoc = prettier.Text(" with operations")
+
+func (d *CompositeDeclaration) DistinctTypeDoc() prettier.Doc {
+	var doc prettier.Concat
+
+	if d.Access != AccessNotSpecified {
+		doc = append(
+			doc,
+			prettier.Text(d.Access.Keyword()),
+			prettier.Space,
+		)
+	}
+
+	doc = append(
+		doc,
+		distinctTypeKeywordSpaceDoc,
+		prettier.Text(d.Identifier.Identifier),
+		distinctTypeEqualDistinctSpaceDoc,
+		d.UnderlyingType.Doc(),
+	)
+
+	if d.InheritsOperations {
+		doc = append(doc, distinctTypeWithOperationsDoc)
+	}
+
+	return doc
+}
+
 func (d *CompositeDeclaration) String() string {
 	return Prettier(d)
 }
@@ -195,6 +426,7 @@ func CompositeDocument(
 	kind common.CompositeKind,
 	isInterface bool,
 	identifier string,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 ) prettier.Doc {
@@ -227,6 +459,13 @@ func CompositeDocument(
 		prettier.Text(identifier),
 	)
 
+	if typeParameterList != nil && !typeParameterList.IsEmpty() {
+		doc = append(
+			doc,
+			typeParameterList.Doc(),
+		)
+	}
+
 	if len(conformances) > 0 {
 
 		conformancesDoc := prettier.Concat{
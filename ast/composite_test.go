@@ -934,3 +934,104 @@ func TestEnumCaseDeclaration_String(t *testing.T) {
 		decl.String(),
 	)
 }
+
+func TestDistinctTypeDeclaration_String(t *testing.T) {
+
+	t.Parallel()
+
+	newDecl := func(access Access, inheritsOperations bool) *CompositeDeclaration {
+		return NewDistinctTypeDeclaration(
+			nil,
+			access,
+			Identifier{
+				Identifier: "AB",
+			},
+			&TypeAnnotation{
+				Type: &NominalType{
+					Identifier: Identifier{
+						Identifier: "CD",
+					},
+				},
+			},
+			inheritsOperations,
+			"",
+			EmptyRange,
+		)
+	}
+
+	t.Run("access", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(
+			t,
+			"access(all) type AB = distinct CD",
+			newDecl(AccessAll, false).String(),
+		)
+	})
+
+	t.Run("with operations", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(
+			t,
+			"type AB = distinct CD with operations",
+			newDecl(AccessNotSpecified, true).String(),
+		)
+	})
+}
+
+func TestDistinctTypeDeclaration_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	decl := NewDistinctTypeDeclaration(
+		nil,
+		AccessAll,
+		Identifier{
+			Identifier: "AB",
+			Pos:        Position{Offset: 1, Line: 2, Column: 3},
+		},
+		&TypeAnnotation{
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "CD",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+			StartPos: Position{Offset: 4, Line: 5, Column: 6},
+		},
+		true,
+		"test",
+		Range{
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+		},
+	)
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(actual, &result))
+
+	assert.Equal(t, "CompositeDeclaration", result["Type"])
+	assert.Equal(t, "CompositeKindStructure", result["CompositeKind"])
+	assert.Equal(t, true, result["InheritsOperations"])
+	assert.Contains(t, result, "UnderlyingType")
+
+	// Other composite declarations do not include the distinct type fields
+
+	decl.UnderlyingType = nil
+	decl.InheritsOperations = false
+
+	actual, err = json.Marshal(decl)
+	require.NoError(t, err)
+
+	result = nil
+	require.NoError(t, json.Unmarshal(actual, &result))
+
+	assert.NotContains(t, result, "UnderlyingType")
+	assert.NotContains(t, result, "InheritsOperations")
+}
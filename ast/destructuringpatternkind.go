@@ -0,0 +1,57 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/cadence/errors"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=DestructuringPatternKind
+
+type DestructuringPatternKind uint
+
+const (
+	DestructuringPatternKindUnknown DestructuringPatternKind = iota
+	DestructuringPatternKindArray
+	DestructuringPatternKindDictionary
+	DestructuringPatternKindComposite
+)
+
+func DestructuringPatternKindCount() int {
+	return len(_DestructuringPatternKind_index) - 1
+}
+
+func (k DestructuringPatternKind) Name() string {
+	switch k {
+	case DestructuringPatternKindArray:
+		return "array"
+	case DestructuringPatternKindDictionary:
+		return "dictionary"
+	case DestructuringPatternKindComposite:
+		return "composite"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (k DestructuringPatternKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
@@ -43,6 +43,7 @@ const (
 	ElementTypePragmaDeclaration
 	ElementTypeImportDeclaration
 	ElementTypeTransactionDeclaration
+	ElementTypeTypeAliasDeclaration
 
 	// Statements
 
@@ -59,6 +60,7 @@ const (
 	ElementTypeSwapStatement
 	ElementTypeExpressionStatement
 	ElementTypeRemoveStatement
+	ElementTypeTryStatement
 
 	// Expressions
 
@@ -85,4 +87,5 @@ const (
 	ElementTypeForceExpression
 	ElementTypePathExpression
 	ElementTypeAttachExpression
+	ElementTypeStringTemplateExpression
 )
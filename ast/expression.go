@@ -220,6 +220,93 @@ func (*StringExpression) precedence() precedence {
 	return precedenceLiteral
 }
 
+// StringTemplateExpression
+
+// StringTemplateExpression is a string literal with interpolated expressions,
+// e.g. "balance: \(vault.balance)".
+// Values contains the literal parts of the string, and surrounds the expressions,
+// i.e. it always has one more element than Expressions.
+type StringTemplateExpression struct {
+	Values      []string
+	Expressions []Expression
+	Range
+}
+
+var _ Element = &StringTemplateExpression{}
+var _ Expression = &StringTemplateExpression{}
+
+func NewStringTemplateExpression(
+	gauge common.MemoryGauge,
+	values []string,
+	expressions []Expression,
+	exprRange Range,
+) *StringTemplateExpression {
+	common.UseMemory(gauge, common.StringTemplateExpressionMemoryUsage)
+	return &StringTemplateExpression{
+		Values:      values,
+		Expressions: expressions,
+		Range:       exprRange,
+	}
+}
+
+func (*StringTemplateExpression) ElementType() ElementType {
+	return ElementTypeStringTemplateExpression
+}
+
+func (*StringTemplateExpression) isExpression() {}
+
+func (*StringTemplateExpression) isIfStatementTest() {}
+
+func (e *StringTemplateExpression) Walk(walkChild func(Element)) {
+	walkExpressions(walkChild, e.Expressions)
+}
+
+func (e *StringTemplateExpression) String() string {
+	return Prettier(e)
+}
+
+var stringTemplateExpressionQuoteDoc prettier.Doc = prettier.Text(`"`)
+var stringTemplateExpressionStartDoc prettier.Doc = prettier.Text(`\(`)
+var stringTemplateExpressionEndDoc prettier.Doc = prettier.Text(")")
+
+func (e *StringTemplateExpression) Doc() prettier.Doc {
+	doc := prettier.Concat{
+		stringTemplateExpressionQuoteDoc,
+	}
+
+	for i, value := range e.Values {
+		var b strings.Builder
+		writeEscapedString(&b, value)
+		doc = append(doc, prettier.Text(b.String()))
+
+		if i < len(e.Expressions) {
+			doc = append(
+				doc,
+				stringTemplateExpressionStartDoc,
+				e.Expressions[i].Doc(),
+				stringTemplateExpressionEndDoc,
+			)
+		}
+	}
+
+	return append(doc, stringTemplateExpressionQuoteDoc)
+}
+
+func (e *StringTemplateExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringTemplateExpression
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+	}{
+		Type:  "StringTemplateExpression",
+		Alias: (*Alias)(e),
+	})
+}
+
+func (*StringTemplateExpression) precedence() precedence {
+	return precedenceLiteral
+}
+
 // IntegerExpression
 
 type IntegerExpression struct {
@@ -1945,10 +2032,16 @@ func (*ForceExpression) precedence() precedence {
 
 // PathExpression
 
+// PathExpression is a path literal, e.g. `/storage/vault`.
+// Extended paths additionally embed component values,
+// e.g. `/storage/vault/\(tokenType)`.
 type PathExpression struct {
 	Domain     Identifier
 	Identifier Identifier
-	StartPos   Position `json:"-"`
+	Components []Expression `json:",omitempty"`
+	StartPos   Position     `json:"-"`
+	// EndPos is the end position of the last component, if any
+	EndPos Position `json:"-"`
 }
 
 var _ Element = &PathExpression{}
@@ -1977,8 +2070,8 @@ func (*PathExpression) isExpression() {}
 
 func (*PathExpression) isIfStatementTest() {}
 
-func (*PathExpression) Walk(_ func(Element)) {
-	// NO-OP
+func (e *PathExpression) Walk(walkChild func(Element)) {
+	walkExpressions(walkChild, e.Components)
 }
 
 func (e *PathExpression) String() string {
@@ -1986,14 +2079,27 @@ func (e *PathExpression) String() string {
 }
 
 var pathSeparatorDoc = prettier.Text("/")
+var pathComponentStartDoc = prettier.Text(`/\(`)
+var pathComponentEndDoc = prettier.Text(")")
 
 func (e *PathExpression) Doc() prettier.Doc {
-	return prettier.Concat{
+	doc := prettier.Concat{
 		pathSeparatorDoc,
 		prettier.Text(e.Domain.String()),
 		pathSeparatorDoc,
 		prettier.Text(e.Identifier.String()),
 	}
+
+	for _, component := range e.Components {
+		doc = append(
+			doc,
+			pathComponentStartDoc,
+			component.Doc(),
+			pathComponentEndDoc,
+		)
+	}
+
+	return doc
 }
 
 func (e *PathExpression) StartPosition() Position {
@@ -2001,6 +2107,9 @@ func (e *PathExpression) StartPosition() Position {
 }
 
 func (e *PathExpression) EndPosition(memoryGauge common.MemoryGauge) Position {
+	if len(e.Components) > 0 {
+		return e.EndPos
+	}
 	return e.Identifier.EndPosition(memoryGauge)
 }
 
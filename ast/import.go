@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/turbolent/prettier"
@@ -31,6 +32,8 @@ import (
 type ImportDeclaration struct {
 	Location    common.Location
 	Identifiers []Identifier
+	// Hash is the expected hash of the imported code, if the import is pinned
+	Hash []byte
 	Range
 	LocationPos Position
 }
@@ -42,6 +45,7 @@ func NewImportDeclaration(
 	gauge common.MemoryGauge,
 	identifiers []Identifier,
 	location common.Location,
+	hash []byte,
 	declRange Range,
 	locationPos Position,
 ) *ImportDeclaration {
@@ -50,6 +54,7 @@ func NewImportDeclaration(
 	return &ImportDeclaration{
 		Identifiers: identifiers,
 		Location:    location,
+		Hash:        hash,
 		Range:       declRange,
 		LocationPos: locationPos,
 	}
@@ -89,17 +94,24 @@ func (d *ImportDeclaration) DeclarationDocString() string {
 
 func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 	type Alias ImportDeclaration
+	var hash string
+	if d.Hash != nil {
+		hash = hex.EncodeToString(d.Hash)
+	}
 	return json.Marshal(&struct {
 		*Alias
 		Type string
+		Hash string `json:",omitempty"`
 	}{
 		Type:  "ImportDeclaration",
 		Alias: (*Alias)(d),
+		Hash:  hash,
 	})
 }
 
 const importDeclarationImportKeywordDoc = prettier.Text("import")
 const importDeclarationFromKeywordDoc = prettier.Text("from ")
+const importDeclarationHashKeywordDoc = prettier.Text(" hash ")
 
 var importDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
@@ -152,10 +164,20 @@ func (d *ImportDeclaration) Doc() prettier.Doc {
 		)
 	}
 
-	return append(
+	doc = append(
 		doc,
 		LocationDoc(d.Location),
 	)
+
+	if d.Hash != nil {
+		doc = append(
+			doc,
+			importDeclarationHashKeywordDoc,
+			prettier.Text(QuoteString(hex.EncodeToString(d.Hash))),
+		)
+	}
+
+	return doc
 }
 
 func (d *ImportDeclaration) String() string {
@@ -76,6 +76,25 @@ func TestImportDeclaration_MarshalJSON(t *testing.T) {
 	)
 }
 
+func TestImportDeclaration_MarshalJSON_Hash(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &ImportDeclaration{
+		Location: common.StringLocation("test"),
+		Hash:     []byte{0xab, 0xcd},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	var result map[string]any
+	err = json.Unmarshal(actual, &result)
+	require.NoError(t, err)
+
+	assert.Equal(t, "abcd", result["Hash"])
+}
+
 func TestImportDeclaration_Doc(t *testing.T) {
 
 	t.Parallel()
@@ -239,4 +258,26 @@ func TestImportDeclaration_String(t *testing.T) {
 			decl.String(),
 		)
 	})
+	t.Run("hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Identifiers: []Identifier{
+				{
+					Identifier: "foo",
+				},
+			},
+			Location: common.AddressLocation{
+				Address: common.MustBytesToAddress([]byte{0x1}),
+			},
+			Hash: []byte{0xab, 0xcd},
+		}
+
+		require.Equal(
+			t,
+			`import foo from 0x1 hash "abcd"`,
+			decl.String(),
+		)
+	})
 }
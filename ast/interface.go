@@ -116,6 +116,7 @@ func (d *InterfaceDeclaration) Doc() prettier.Doc {
 		d.CompositeKind,
 		true,
 		d.Identifier.Identifier,
+		nil,
 		d.Conformances,
 		d.Members,
 	)
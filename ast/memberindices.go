@@ -65,6 +65,8 @@ type memberIndices struct {
 	_enumCases []*EnumCaseDeclaration
 	// Use `Pragmas()` instead
 	_pragmas []*PragmaDeclaration
+	// Use `TypeAliases()` instead
+	_typeAliases []*TypeAliasDeclaration
 }
 
 func (i *memberIndices) FieldsByIdentifier(declarations []Declaration) map[string]*FieldDeclaration {
@@ -157,6 +159,11 @@ func (i *memberIndices) Pragmas(declarations []Declaration) []*PragmaDeclaration
 	return i._pragmas
 }
 
+func (i *memberIndices) TypeAliases(declarations []Declaration) []*TypeAliasDeclaration {
+	i.once.Do(i.initializer(declarations))
+	return i._typeAliases
+}
+
 func (i *memberIndices) initializer(declarations []Declaration) func() {
 	return func() {
 		i.init(declarations)
@@ -192,6 +199,7 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 	i._enumCases = make([]*EnumCaseDeclaration, 0)
 	i._pragmas = make([]*PragmaDeclaration, 0)
+	i._typeAliases = make([]*TypeAliasDeclaration, 0)
 
 	for _, declaration := range declarations {
 		switch declaration := declaration.(type) {
@@ -236,6 +244,9 @@ func (i *memberIndices) init(declarations []Declaration) {
 
 		case *PragmaDeclaration:
 			i._pragmas = append(i._pragmas, declaration)
+
+		case *TypeAliasDeclaration:
+			i._typeAliases = append(i._typeAliases, declaration)
 		}
 	}
 }
@@ -88,6 +88,10 @@ func (m *Members) Pragmas() []*PragmaDeclaration {
 	return m.indices.Pragmas(m.declarations)
 }
 
+func (m *Members) TypeAliases() []*TypeAliasDeclaration {
+	return m.indices.TypeAliases(m.declarations)
+}
+
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
 	return m.indices.FieldsByIdentifier(m.declarations)
 }
@@ -27,6 +27,8 @@ import (
 )
 
 type Parameter struct {
+	// TypeAnnotation is nil if the type annotation is omitted,
+	// which is only allowed in the loose mode
 	TypeAnnotation  *TypeAnnotation
 	DefaultArgument Expression
 	Label           string
@@ -73,6 +75,9 @@ func (p *Parameter) EndPosition(memoryGauge common.MemoryGauge) Position {
 	if p.HasDefaultArgument() {
 		return p.DefaultArgument.EndPosition(memoryGauge)
 	}
+	if p.TypeAnnotation == nil {
+		return p.Identifier.EndPosition(memoryGauge)
+	}
 	return p.TypeAnnotation.EndPosition(memoryGauge)
 }
 
@@ -107,10 +112,16 @@ func (p *Parameter) Doc() prettier.Doc {
 	parameterDoc = append(
 		parameterDoc,
 		prettier.Text(p.Identifier.Identifier),
-		typeSeparatorSpaceDoc,
-		p.TypeAnnotation.Doc(),
 	)
 
+	if p.TypeAnnotation != nil {
+		parameterDoc = append(
+			parameterDoc,
+			typeSeparatorSpaceDoc,
+			p.TypeAnnotation.Doc(),
+		)
+	}
+
 	if p.DefaultArgument != nil {
 		parameterDoc = append(parameterDoc,
 			prettier.Space,
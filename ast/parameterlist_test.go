@@ -208,3 +208,25 @@ func TestParameterList_String(t *testing.T) {
 		params.String(),
 	)
 }
+
+func TestParameterList_StringWithoutTypeAnnotations(t *testing.T) {
+
+	t.Parallel()
+
+	params := &ParameterList{
+		Parameters: []*Parameter{
+			{
+				Identifier: Identifier{Identifier: "a"},
+			},
+			{
+				Label:      "b",
+				Identifier: Identifier{Identifier: "c"},
+			},
+		},
+	}
+
+	require.Equal(t,
+		"(a, b c)",
+		params.String(),
+	)
+}
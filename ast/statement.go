@@ -25,6 +25,7 @@ import (
 	"github.com/turbolent/prettier"
 
 	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
 )
 
 type Statement interface {
@@ -382,6 +383,7 @@ func (s *WhileStatement) MarshalJSON() ([]byte, error) {
 type ForStatement struct {
 	Value      Expression
 	Index      *Identifier
+	Pattern    *DestructuringPattern
 	Block      *Block
 	Identifier Identifier
 	StartPos   Position `json:"-"`
@@ -393,6 +395,7 @@ var _ Statement = &ForStatement{}
 func NewForStatement(
 	gauge common.MemoryGauge,
 	identifier Identifier,
+	pattern *DestructuringPattern,
 	index *Identifier,
 	block *Block,
 	expression Expression,
@@ -402,6 +405,7 @@ func NewForStatement(
 
 	return &ForStatement{
 		Identifier: identifier,
+		Pattern:    pattern,
 		Index:      index,
 		Block:      block,
 		Value:      expression,
@@ -416,6 +420,9 @@ func (*ForStatement) ElementType() ElementType {
 func (*ForStatement) isStatement() {}
 
 func (s *ForStatement) Walk(walkChild func(Element)) {
+	if s.Pattern != nil {
+		s.Pattern.Walk(walkChild)
+	}
 	walkChild(s.Value)
 	walkChild(s.Block)
 }
@@ -444,9 +451,16 @@ func (s *ForStatement) Doc() prettier.Doc {
 		)
 	}
 
+	var identifierDoc prettier.Doc
+	if s.Pattern != nil {
+		identifierDoc = s.Pattern.Doc()
+	} else {
+		identifierDoc = prettier.Text(s.Identifier.Identifier)
+	}
+
 	doc = append(
 		doc,
-		prettier.Text(s.Identifier.Identifier),
+		identifierDoc,
 		forStatementSpaceInKeywordSpaceDoc,
 		s.Value.Doc(),
 		prettier.Space,
@@ -475,6 +489,82 @@ func (s *ForStatement) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// TryStatement
+
+type TryStatement struct {
+	Block      *Block
+	CatchBlock *Block
+	StartPos   Position `json:"-"`
+}
+
+var _ Element = &TryStatement{}
+var _ Statement = &TryStatement{}
+
+func NewTryStatement(
+	gauge common.MemoryGauge,
+	block *Block,
+	catchBlock *Block,
+	startPos Position,
+) *TryStatement {
+	common.UseMemory(gauge, common.TryStatementMemoryUsage)
+
+	return &TryStatement{
+		Block:      block,
+		CatchBlock: catchBlock,
+		StartPos:   startPos,
+	}
+}
+
+func (*TryStatement) ElementType() ElementType {
+	return ElementTypeTryStatement
+}
+
+func (*TryStatement) isStatement() {}
+
+func (s *TryStatement) Walk(walkChild func(Element)) {
+	walkChild(s.Block)
+	walkChild(s.CatchBlock)
+}
+
+func (s *TryStatement) StartPosition() Position {
+	return s.StartPos
+}
+
+func (s *TryStatement) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return s.CatchBlock.EndPosition(memoryGauge)
+}
+
+const tryStatementTryKeywordSpaceDoc = prettier.Text("try ")
+const tryStatementSpaceCatchKeywordSpaceDoc = prettier.Text(" catch ")
+
+func (s *TryStatement) Doc() prettier.Doc {
+	return prettier.Group{
+		Doc: prettier.Concat{
+			tryStatementTryKeywordSpaceDoc,
+			s.Block.Doc(),
+			tryStatementSpaceCatchKeywordSpaceDoc,
+			s.CatchBlock.Doc(),
+		},
+	}
+}
+
+func (s *TryStatement) String() string {
+	return Prettier(s)
+}
+
+func (s *TryStatement) MarshalJSON() ([]byte, error) {
+	type Alias TryStatement
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+		Range
+	}{
+		Type:  "TryStatement",
+		Range: NewUnmeteredRangeFromPositioned(s),
+		Alias: (*Alias)(s),
+	})
+}
+
 // EmitStatement
 
 type EmitStatement struct {
@@ -547,6 +637,9 @@ type AssignmentStatement struct {
 	Target   Expression
 	Transfer *Transfer
 	Value    Expression
+	// Operation is the binary operation of a compound assignment (e.g. `x += 1`),
+	// or OperationUnknown for a simple assignment
+	Operation Operation `json:",omitempty"`
 }
 
 var _ Element = &AssignmentStatement{}
@@ -567,6 +660,25 @@ func NewAssignmentStatement(
 	}
 }
 
+func NewCompoundAssignmentStatement(
+	gauge common.MemoryGauge,
+	expression Expression,
+	transfer *Transfer,
+	operation Operation,
+	value Expression,
+) *AssignmentStatement {
+	statement := NewAssignmentStatement(gauge, expression, transfer, value)
+	statement.Operation = operation
+	return statement
+}
+
+// IsCompound returns true if the assignment is a compound assignment,
+// e.g. `x += 1`, which is equivalent to `x = x + 1`,
+// but only evaluates the target once
+func (s *AssignmentStatement) IsCompound() bool {
+	return s.Operation != OperationUnknown
+}
+
 func (*AssignmentStatement) ElementType() ElementType {
 	return ElementTypeAssignmentStatement
 }
@@ -587,11 +699,18 @@ func (s *AssignmentStatement) Walk(walkChild func(Element)) {
 }
 
 func (s *AssignmentStatement) Doc() prettier.Doc {
+	var transferDoc prettier.Doc
+	if s.IsCompound() {
+		transferDoc = prettier.Text(s.Operation.Symbol() + "=")
+	} else {
+		transferDoc = s.Transfer.Doc()
+	}
+
 	return prettier.Group{
 		Doc: prettier.Concat{
 			s.Target.Doc(),
 			prettier.Space,
-			s.Transfer.Doc(),
+			transferDoc,
 			prettier.Space,
 			prettier.Group{
 				Doc: prettier.Indent{
@@ -779,6 +898,14 @@ func (s *SwitchStatement) Walk(walkChild func(Element)) {
 		if expression != nil {
 			walkChild(expression)
 		}
+		pattern := switchCase.Pattern
+		if pattern != nil && pattern.Expression != nil {
+			walkChild(pattern.Expression)
+		}
+		guard := switchCase.Guard
+		if guard != nil {
+			walkChild(guard)
+		}
 		walkStatements(walkChild, switchCase.Statements)
 	}
 }
@@ -837,11 +964,22 @@ func (s *SwitchStatement) MarshalJSON() ([]byte, error) {
 // SwitchCase
 
 type SwitchCase struct {
+	// Expression is the expression of an equality case, e.g. `case 1:`
 	Expression Expression
+	// Pattern is the pattern of a pattern case, e.g. `case let x as T:`
+	Pattern *SwitchPattern
+	// Guard is the optional guard of the case, e.g. `case let x? where x > 1:`
+	Guard      Expression
 	Statements []Statement
 	Range
 }
 
+// IsDefault returns true if the case is the default case,
+// i.e. it has neither an expression nor a pattern
+func (s *SwitchCase) IsDefault() bool {
+	return s.Expression == nil && s.Pattern == nil
+}
+
 func (s *SwitchCase) MarshalJSON() ([]byte, error) {
 	type Alias SwitchCase
 	return json.Marshal(&struct {
@@ -862,17 +1000,118 @@ func (s *SwitchCase) Doc() prettier.Doc {
 		Doc: StatementsDoc(s.Statements),
 	}
 
-	if s.Expression == nil {
+	if s.IsDefault() {
 		return prettier.Concat{
 			switchCaseDefaultKeywordSpaceDoc,
 			statementsDoc,
 		}
 	}
 
-	return prettier.Concat{
+	var patternDoc prettier.Doc
+	if s.Pattern != nil {
+		patternDoc = s.Pattern.Doc()
+	} else {
+		patternDoc = s.Expression.Doc()
+	}
+
+	doc := prettier.Concat{
 		switchCaseKeywordSpaceDoc,
-		s.Expression.Doc(),
+		patternDoc,
+	}
+
+	if s.Guard != nil {
+		doc = append(
+			doc,
+			switchCaseWhereKeywordSpaceDoc,
+			s.Guard.Doc(),
+		)
+	}
+
+	return append(
+		doc,
 		switchCaseColonSymbolDoc,
 		statementsDoc,
+	)
+}
+
+// SwitchPattern is the pattern of a switch case:
+// a type pattern, e.g. `let x as T`,
+// an optional pattern, e.g. `let x?`,
+// or a range pattern, e.g. `in InclusiveRange(1, 10)`
+type SwitchPattern struct {
+	// Identifier is the identifier bound by type and optional patterns
+	Identifier Identifier
+	// TypeAnnotation is the type of type patterns
+	TypeAnnotation *TypeAnnotation
+	// Expression is the range expression of range patterns
+	Expression Expression
+	Range
+	Kind SwitchPatternKind
+}
+
+func NewSwitchPattern(
+	gauge common.MemoryGauge,
+	kind SwitchPatternKind,
+	identifier Identifier,
+	typeAnnotation *TypeAnnotation,
+	expression Expression,
+	astRange Range,
+) *SwitchPattern {
+	common.UseMemory(gauge, common.SwitchPatternMemoryUsage)
+
+	return &SwitchPattern{
+		Kind:           kind,
+		Identifier:     identifier,
+		TypeAnnotation: typeAnnotation,
+		Expression:     expression,
+		Range:          astRange,
+	}
+}
+
+const switchCaseWhereKeywordSpaceDoc = prettier.Text(" where ")
+const switchPatternLetKeywordSpaceDoc = prettier.Text("let ")
+const switchPatternAsKeywordSpaceDoc = prettier.Text(" as ")
+const switchPatternOptionalSymbolDoc = prettier.Text("?")
+const switchPatternInKeywordSpaceDoc = prettier.Text("in ")
+
+func (p *SwitchPattern) Doc() prettier.Doc {
+	switch p.Kind {
+	case SwitchPatternKindType:
+		return prettier.Concat{
+			switchPatternLetKeywordSpaceDoc,
+			prettier.Text(p.Identifier.Identifier),
+			switchPatternAsKeywordSpaceDoc,
+			p.TypeAnnotation.Doc(),
+		}
+
+	case SwitchPatternKindOptional:
+		return prettier.Concat{
+			switchPatternLetKeywordSpaceDoc,
+			prettier.Text(p.Identifier.Identifier),
+			switchPatternOptionalSymbolDoc,
+		}
+
+	case SwitchPatternKindRange:
+		return prettier.Concat{
+			switchPatternInKeywordSpaceDoc,
+			p.Expression.Doc(),
+		}
 	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (p *SwitchPattern) MarshalJSON() ([]byte, error) {
+	type Alias SwitchPattern
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+	}{
+		Type:  "SwitchPattern",
+		Alias: (*Alias)(p),
+	})
+}
+
+func (p *SwitchPattern) String() string {
+	return Prettier(p)
 }
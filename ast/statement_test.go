@@ -20,6 +20,7 @@ package ast
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -597,6 +598,101 @@ func TestWhileStatement_String(t *testing.T) {
 	)
 }
 
+func TestTryStatement_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &TryStatement{
+		Block: &Block{
+			Statements: []Statement{},
+			Range: Range{
+				StartPos: Position{Offset: 1, Line: 2, Column: 3},
+				EndPos:   Position{Offset: 4, Line: 5, Column: 6},
+			},
+		},
+		CatchBlock: &Block{
+			Statements: []Statement{},
+			Range: Range{
+				StartPos: Position{Offset: 7, Line: 8, Column: 9},
+				EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+			},
+		},
+		StartPos: Position{Offset: 13, Line: 14, Column: 15},
+	}
+
+	actual, err := json.Marshal(stmt)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "TryStatement",
+            "Block": {
+                "Type": "Block",
+                "Statements": [],
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+            },
+            "CatchBlock": {
+                "Type": "Block",
+                "Statements": [],
+                "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+            },
+            "StartPos": {"Offset": 13, "Line": 14, "Column": 15},
+            "EndPos":   {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTryStatement_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &TryStatement{
+		Block: &Block{
+			Statements: []Statement{},
+		},
+		CatchBlock: &Block{
+			Statements: []Statement{},
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Group{
+			Doc: prettier.Concat{
+				prettier.Text("try "),
+				prettier.Text("{}"),
+				prettier.Text(" catch "),
+				prettier.Text("{}"),
+			},
+		},
+		stmt.Doc(),
+	)
+}
+
+func TestTryStatement_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &TryStatement{
+		Block: &Block{
+			Statements: []Statement{},
+		},
+		CatchBlock: &Block{
+			Statements: []Statement{},
+		},
+	}
+
+	assert.Equal(t,
+		"try {} catch {}",
+		stmt.String(),
+	)
+}
+
 func TestForStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -641,6 +737,7 @@ func TestForStatement_MarshalJSON(t *testing.T) {
                     "EndPos": {"Offset": 6, "Line": 2, "Column": 8}
                 },
 		    	"Index": null,
+		    	"Pattern": null,
                 "Value": {
                     "Type": "BoolExpression",
                     "Value": false,
@@ -704,6 +801,7 @@ func TestForStatement_MarshalJSON(t *testing.T) {
                     "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
                     "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
                 },
+                "Pattern": null,
 		    	"Identifier": {
                     "Identifier": "foobar",
                     "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
@@ -977,6 +1075,101 @@ func TestAssignmentStatement_String(t *testing.T) {
 	)
 }
 
+func TestCompoundAssignmentStatement_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &AssignmentStatement{
+		Target: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foobar",
+				Pos:        Position{Offset: 1, Line: 2, Column: 3},
+			},
+		},
+		Transfer: &Transfer{
+			Operation: TransferOperationCopy,
+			Pos:       Position{Offset: 4, Line: 5, Column: 6},
+		},
+		Operation: OperationPlus,
+		Value: &IntegerExpression{
+			PositiveLiteral: []byte("1"),
+			Value:           big.NewInt(1),
+			Base:            10,
+			Range: Range{
+				StartPos: Position{Offset: 7, Line: 8, Column: 9},
+				EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+			},
+		},
+	}
+
+	actual, err := json.Marshal(stmt)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "AssignmentStatement",
+            "Target": {
+                "Type": "IdentifierExpression",
+                "Identifier": {
+                    "Identifier": "foobar",
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 6, "Line": 2, "Column": 8}
+                },
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 6, "Line": 2, "Column": 8}
+            },
+            "Transfer": {
+                "Type": "Transfer",
+                "Operation": "TransferOperationCopy",
+                "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+            },
+            "Operation": "OperationPlus",
+            "Value": {
+                "Type": "IntegerExpression",
+                "PositiveLiteral": "1",
+                "Value": "1",
+                "Base": 10,
+                "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+            },
+            "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+            "EndPos":  {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestCompoundAssignmentStatement_String(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &AssignmentStatement{
+		Target: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foobar",
+			},
+		},
+		Transfer: &Transfer{
+			Operation: TransferOperationCopy,
+		},
+		Operation: OperationBitwiseLeftShift,
+		Value: &IntegerExpression{
+			PositiveLiteral: []byte("2"),
+			Value:           big.NewInt(2),
+			Base:            10,
+		},
+	}
+
+	require.Equal(t,
+		"foobar <<= 2",
+		stmt.String(),
+	)
+}
+
 func TestSwapStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -1318,6 +1511,8 @@ func TestSwitchStatement_MarshalJSON(t *testing.T) {
                         "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
                         "EndPos": {"Offset": 7, "Line": 8, "Column": 9}
                     },
+                    "Pattern": null,
+                    "Guard": null,
                     "Statements": [
                         {
                             "Type": "ExpressionStatement",
@@ -1341,6 +1536,8 @@ func TestSwitchStatement_MarshalJSON(t *testing.T) {
                 {
                     "Type": "SwitchCase",
                     "Expression": null,
+                    "Pattern": null,
+                    "Guard": null,
                     "Statements": [
                         {
                             "Type": "ExpressionStatement",
@@ -1506,3 +1703,158 @@ func TestSwitchStatement_Doc(t *testing.T) {
 		stmt.String(),
 	)
 }
+
+func TestSwitchStatement_Doc_Patterns(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &SwitchStatement{
+		Expression: &IdentifierExpression{
+			Identifier: Identifier{
+				Identifier: "foo",
+			},
+		},
+		Cases: []*SwitchCase{
+			{
+				Pattern: &SwitchPattern{
+					Kind: SwitchPatternKindType,
+					Identifier: Identifier{
+						Identifier: "v",
+					},
+					TypeAnnotation: &TypeAnnotation{
+						IsResource: true,
+						Type: &NominalType{
+							Identifier: Identifier{
+								Identifier: "Vault",
+							},
+						},
+					},
+				},
+				Statements: []Statement{
+					&ExpressionStatement{
+						Expression: &IdentifierExpression{
+							Identifier: Identifier{
+								Identifier: "a",
+							},
+						},
+					},
+				},
+			},
+			{
+				Pattern: &SwitchPattern{
+					Kind: SwitchPatternKindOptional,
+					Identifier: Identifier{
+						Identifier: "x",
+					},
+				},
+				Guard: &BoolExpression{
+					Value: true,
+				},
+				Statements: []Statement{
+					&ExpressionStatement{
+						Expression: &IdentifierExpression{
+							Identifier: Identifier{
+								Identifier: "b",
+							},
+						},
+					},
+				},
+			},
+			{
+				Pattern: &SwitchPattern{
+					Kind: SwitchPatternKindRange,
+					Expression: &IdentifierExpression{
+						Identifier: Identifier{
+							Identifier: "range",
+						},
+					},
+				},
+				Statements: []Statement{
+					&ExpressionStatement{
+						Expression: &IdentifierExpression{
+							Identifier: Identifier{
+								Identifier: "c",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"switch foo {\n"+
+			"    case let v as @Vault:\n"+
+			"        a\n"+
+			"    case let x? where true:\n"+
+			"        b\n"+
+			"    case in range:\n"+
+			"        c\n"+
+			"}",
+		stmt.String(),
+	)
+}
+
+func TestSwitchPattern_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	pattern := &SwitchPattern{
+		Kind: SwitchPatternKindType,
+		Identifier: Identifier{
+			Identifier: "v",
+			Pos:        Position{Offset: 1, Line: 2, Column: 3},
+		},
+		TypeAnnotation: &TypeAnnotation{
+			IsResource: false,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "T",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+			StartPos: Position{Offset: 4, Line: 5, Column: 6},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+		},
+	}
+
+	actual, err := json.Marshal(pattern)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "SwitchPattern",
+            "Kind": "SwitchPatternKindType",
+            "Identifier": {
+                "Identifier": "v",
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+            },
+            "TypeAnnotation": {
+                "IsResource": false,
+                "AnnotatedType": {
+                    "Type": "NominalType",
+                    "Identifier": {
+                        "Identifier": "T",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                    },
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                },
+                "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+            },
+            "Expression": null,
+            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+            "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
+}
@@ -0,0 +1,57 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/cadence/errors"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=SwitchPatternKind
+
+type SwitchPatternKind uint
+
+const (
+	SwitchPatternKindUnknown SwitchPatternKind = iota
+	SwitchPatternKindType
+	SwitchPatternKindOptional
+	SwitchPatternKindRange
+)
+
+func SwitchPatternKindCount() int {
+	return len(_SwitchPatternKind_index) - 1
+}
+
+func (k SwitchPatternKind) Name() string {
+	switch k {
+	case SwitchPatternKindType:
+		return "type"
+	case SwitchPatternKindOptional:
+		return "optional"
+	case SwitchPatternKindRange:
+		return "range"
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (k SwitchPatternKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
@@ -0,0 +1,187 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turbolent/prettier"
+)
+
+func TestTypeAliasDeclaration_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Access: AccessAll,
+		Identifier: Identifier{
+			Identifier: "AB",
+			Pos:        Position{Offset: 1, Line: 2, Column: 3},
+		},
+		TypeAnnotation: &TypeAnnotation{
+			IsResource: true,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "CD",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+			StartPos: Position{Offset: 3, Line: 5, Column: 5},
+		},
+		DocString: "test",
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+		},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "TypeAliasDeclaration",
+            "Access": "AccessAll",
+            "Identifier": {
+                "Identifier": "AB",
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 2, "Line": 2, "Column": 4}
+            },
+            "TypeAnnotation": {
+                "StartPos": {"Offset": 3, "Line": 5, "Column": 5},
+                "EndPos": {"Offset": 5, "Line": 5, "Column": 7},
+                "IsResource": true,
+                "AnnotatedType": {
+                    "Type": "NominalType",
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 5, "Line": 5, "Column": 7},
+                    "Identifier": {
+                        "Identifier": "CD",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 5, "Line": 5, "Column": 7}
+                    }
+                }
+            },
+            "DocString": "test",
+            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+            "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTypeAliasDeclaration_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &TypeAliasDeclaration{
+		Access: AccessAll,
+		Identifier: Identifier{
+			Identifier: "AB",
+		},
+		TypeAnnotation: &TypeAnnotation{
+			IsResource: true,
+			Type: &NominalType{
+				Identifier: Identifier{
+					Identifier: "CD",
+				},
+			},
+		},
+	}
+
+	require.Equal(
+		t,
+		prettier.Concat{
+			prettier.Text("access(all)"),
+			prettier.Space,
+			prettier.Text("typealias "),
+			prettier.Text("AB"),
+			prettier.Text(" = "),
+			prettier.Concat{
+				prettier.Text("@"),
+				prettier.Text("CD"),
+			},
+		},
+		decl.Doc(),
+	)
+}
+
+func TestTypeAliasDeclaration_String(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("access", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &TypeAliasDeclaration{
+			Access: AccessAll,
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+			TypeAnnotation: &TypeAnnotation{
+				Type: &OptionalType{
+					Type: &NominalType{
+						Identifier: Identifier{
+							Identifier: "CD",
+						},
+					},
+				},
+			},
+		}
+
+		require.Equal(
+			t,
+			"access(all) typealias AB = CD?",
+			decl.String(),
+		)
+	})
+
+	t.Run("no access", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &TypeAliasDeclaration{
+			Access: AccessNotSpecified,
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+			TypeAnnotation: &TypeAnnotation{
+				IsResource: true,
+				Type: &NominalType{
+					Identifier: Identifier{
+						Identifier: "CD",
+					},
+				},
+			},
+		}
+
+		require.Equal(
+			t,
+			"typealias AB = @CD",
+			decl.String(),
+		)
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/turbolent/prettier"
 
 	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
 )
 
 type VariableDeclaration struct {
@@ -32,6 +33,7 @@ type VariableDeclaration struct {
 	TypeAnnotation    *TypeAnnotation
 	Transfer          *Transfer
 	SecondTransfer    *Transfer
+	Pattern           *DestructuringPattern
 	ParentIfStatement *IfStatement `json:"-"`
 	DocString         string
 	Identifier        Identifier
@@ -49,6 +51,7 @@ func NewVariableDeclaration(
 	access Access,
 	isLet bool,
 	identifier Identifier,
+	pattern *DestructuringPattern,
 	typeAnnotation *TypeAnnotation,
 	value Expression,
 	transfer *Transfer,
@@ -63,6 +66,7 @@ func NewVariableDeclaration(
 		Access:         access,
 		IsConstant:     isLet,
 		Identifier:     identifier,
+		Pattern:        pattern,
 		TypeAnnotation: typeAnnotation,
 		Value:          value,
 		Transfer:       transfer,
@@ -101,6 +105,9 @@ func (*VariableDeclaration) isIfStatementTest() {}
 
 func (d *VariableDeclaration) Walk(walkChild func(Element)) {
 	// TODO: walk type
+	if d.Pattern != nil {
+		d.Pattern.Walk(walkChild)
+	}
 	walkChild(d.Value)
 	if d.SecondValue != nil {
 		walkChild(d.SecondValue)
@@ -139,8 +146,15 @@ func (d *VariableDeclaration) Doc() prettier.Doc {
 		keywordDoc = letKeywordDoc
 	}
 
-	identifierTypeDoc := prettier.Concat{
-		prettier.Text(d.Identifier.Identifier),
+	var identifierTypeDoc prettier.Concat
+	if d.Pattern != nil {
+		identifierTypeDoc = prettier.Concat{
+			d.Pattern.Doc(),
+		}
+	} else {
+		identifierTypeDoc = prettier.Concat{
+			prettier.Text(d.Identifier.Identifier),
+		}
 	}
 
 	if d.TypeAnnotation != nil {
@@ -240,3 +254,158 @@ func (d *VariableDeclaration) MarshalJSON() ([]byte, error) {
 func (d *VariableDeclaration) String() string {
 	return Prettier(d)
 }
+
+// DestructuringPattern is the pattern of a destructuring declaration,
+// e.g. `[a, b]` for arrays, `{"a": a, "b": b}` for dictionaries,
+// and `(a, b: c)` for composites.
+type DestructuringPattern struct {
+	Elements []*DestructuringElement
+	Range
+	Kind DestructuringPatternKind
+}
+
+func NewDestructuringPattern(
+	gauge common.MemoryGauge,
+	kind DestructuringPatternKind,
+	elements []*DestructuringElement,
+	astRange Range,
+) *DestructuringPattern {
+	common.UseMemory(gauge, common.DestructuringPatternMemoryUsage)
+
+	return &DestructuringPattern{
+		Kind:     kind,
+		Elements: elements,
+		Range:    astRange,
+	}
+}
+
+func (p *DestructuringPattern) Walk(walkChild func(Element)) {
+	for _, element := range p.Elements {
+		if element.Key != nil {
+			walkChild(element.Key)
+		}
+	}
+}
+
+var destructuringPatternSeparatorDoc prettier.Doc = prettier.Concat{
+	prettier.Text(","),
+	prettier.Line{},
+}
+
+func (p *DestructuringPattern) Doc() prettier.Doc {
+	elementDocs := make([]prettier.Doc, len(p.Elements))
+	for i, element := range p.Elements {
+		elementDocs[i] = element.Doc()
+	}
+
+	elementsDoc := prettier.Join(destructuringPatternSeparatorDoc, elementDocs...)
+
+	switch p.Kind {
+	case DestructuringPatternKindArray:
+		return prettier.WrapBrackets(elementsDoc, prettier.SoftLine{})
+	case DestructuringPatternKindDictionary:
+		return prettier.WrapBraces(elementsDoc, prettier.SoftLine{})
+	case DestructuringPatternKindComposite:
+		return prettier.WrapParentheses(elementsDoc, prettier.SoftLine{})
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (p *DestructuringPattern) MarshalJSON() ([]byte, error) {
+	type Alias DestructuringPattern
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+	}{
+		Type:  "DestructuringPattern",
+		Alias: (*Alias)(p),
+	})
+}
+
+func (p *DestructuringPattern) String() string {
+	return Prettier(p)
+}
+
+// DestructuringElement is an element of a destructuring pattern.
+// The key is only set for dictionary patterns,
+// and the field is only set for composite patterns.
+type DestructuringElement struct {
+	Key        Expression
+	Field      *Identifier
+	Identifier Identifier
+}
+
+const DestructuringDiscardIdentifier = "_"
+
+func NewDestructuringElement(
+	gauge common.MemoryGauge,
+	key Expression,
+	field *Identifier,
+	identifier Identifier,
+) *DestructuringElement {
+	common.UseMemory(gauge, common.DestructuringElementMemoryUsage)
+
+	return &DestructuringElement{
+		Key:        key,
+		Field:      field,
+		Identifier: identifier,
+	}
+}
+
+// IsDiscard returns true if the element does not declare a variable,
+// i.e. it is the identifier `_`.
+func (e *DestructuringElement) IsDiscard() bool {
+	return e.Identifier.Identifier == DestructuringDiscardIdentifier
+}
+
+func (e *DestructuringElement) StartPosition() Position {
+	switch {
+	case e.Key != nil:
+		return e.Key.StartPosition()
+	case e.Field != nil:
+		return e.Field.StartPosition()
+	default:
+		return e.Identifier.StartPosition()
+	}
+}
+
+func (e *DestructuringElement) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return e.Identifier.EndPosition(memoryGauge)
+}
+
+func (e *DestructuringElement) Doc() prettier.Doc {
+	identifierDoc := prettier.Text(e.Identifier.Identifier)
+
+	switch {
+	case e.Key != nil:
+		return prettier.Concat{
+			e.Key.Doc(),
+			prettier.Text(": "),
+			identifierDoc,
+		}
+
+	case e.Field != nil && e.Field.Identifier != e.Identifier.Identifier:
+		return prettier.Concat{
+			prettier.Text(e.Field.Identifier),
+			prettier.Text(": "),
+			identifierDoc,
+		}
+
+	default:
+		return identifierDoc
+	}
+}
+
+func (e *DestructuringElement) MarshalJSON() ([]byte, error) {
+	type Alias DestructuringElement
+	return json.Marshal(&struct {
+		*Alias
+		Type string
+		Range
+	}{
+		Type:  "DestructuringElement",
+		Range: NewUnmeteredRangeFromPositioned(e),
+		Alias: (*Alias)(e),
+	})
+}
@@ -128,6 +128,7 @@ func TestVariableDeclaration_MarshalJSON(t *testing.T) {
                 "StartPos": {"Offset": 25, "Line": 26, "Column": 27},
                 "EndPos": {"Offset": 28, "Line": 29, "Column": 30}
             },
+            "Pattern": null,
             "DocString": "test",
             "StartPos": {"Offset": 19, "Line": 20, "Column": 21},
             "EndPos": {"Offset": 28, "Line": 29, "Column": 30}
@@ -353,4 +354,159 @@ let foo: @AB <- true <- false`,
 			decl.String(),
 		)
 	})
+
+	t.Run("with array pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &VariableDeclaration{
+			Access:     AccessNotSpecified,
+			IsConstant: true,
+			Pattern: &DestructuringPattern{
+				Kind: DestructuringPatternKindArray,
+				Elements: []*DestructuringElement{
+					{Identifier: Identifier{Identifier: "a"}},
+					{Identifier: Identifier{Identifier: "_"}},
+				},
+			},
+			Value: &IdentifierExpression{
+				Identifier: Identifier{Identifier: "values"},
+			},
+			Transfer: &Transfer{
+				Operation: TransferOperationCopy,
+			},
+		}
+
+		require.Equal(t,
+			`let [a, _] = values`,
+			decl.String(),
+		)
+	})
+
+	t.Run("with dictionary pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &VariableDeclaration{
+			Access:     AccessNotSpecified,
+			IsConstant: true,
+			Pattern: &DestructuringPattern{
+				Kind: DestructuringPatternKindDictionary,
+				Elements: []*DestructuringElement{
+					{
+						Key:        &StringExpression{Value: "a"},
+						Identifier: Identifier{Identifier: "a"},
+					},
+					{
+						Key:        &StringExpression{Value: "b"},
+						Identifier: Identifier{Identifier: "c"},
+					},
+				},
+			},
+			Value: &IdentifierExpression{
+				Identifier: Identifier{Identifier: "values"},
+			},
+			Transfer: &Transfer{
+				Operation: TransferOperationCopy,
+			},
+		}
+
+		require.Equal(t,
+			`let {"a": a, "b": c} = values`,
+			decl.String(),
+		)
+	})
+
+	t.Run("with composite pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &VariableDeclaration{
+			Access:     AccessNotSpecified,
+			IsConstant: false,
+			Pattern: &DestructuringPattern{
+				Kind: DestructuringPatternKindComposite,
+				Elements: []*DestructuringElement{
+					{
+						Field:      &Identifier{Identifier: "a"},
+						Identifier: Identifier{Identifier: "a"},
+					},
+					{
+						Field:      &Identifier{Identifier: "b"},
+						Identifier: Identifier{Identifier: "c"},
+					},
+				},
+			},
+			Value: &IdentifierExpression{
+				Identifier: Identifier{Identifier: "pair"},
+			},
+			Transfer: &Transfer{
+				Operation: TransferOperationCopy,
+			},
+		}
+
+		require.Equal(t,
+			`var (a, b: c) = pair`,
+			decl.String(),
+		)
+	})
+}
+
+func TestDestructuringPattern_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	pattern := &DestructuringPattern{
+		Kind: DestructuringPatternKindComposite,
+		Elements: []*DestructuringElement{
+			{
+				Field: &Identifier{
+					Identifier: "a",
+					Pos:        Position{Offset: 1, Line: 2, Column: 3},
+				},
+				Identifier: Identifier{
+					Identifier: "b",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 7, Line: 8, Column: 9},
+			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
+		},
+	}
+
+	actual, err := json.Marshal(pattern)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		// language=json
+		`
+        {
+            "Type": "DestructuringPattern",
+            "Kind": "DestructuringPatternKindComposite",
+            "Elements": [
+                {
+                    "Type": "DestructuringElement",
+                    "Key": null,
+                    "Field": {
+                        "Identifier": "a",
+                        "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                        "EndPos": {"Offset": 1, "Line": 2, "Column": 3}
+                    },
+                    "Identifier": {
+                        "Identifier": "b",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                    },
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+                }
+            ],
+            "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+            "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
+        }
+        `,
+		string(actual),
+	)
 }
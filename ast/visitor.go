@@ -38,6 +38,7 @@ type StatementDeclarationVisitor[T any] interface {
 	VisitEntitlementDeclaration(*EntitlementDeclaration) T
 	VisitEntitlementMappingDeclaration(*EntitlementMappingDeclaration) T
 	VisitTransactionDeclaration(*TransactionDeclaration) T
+	VisitTypeAliasDeclaration(*TypeAliasDeclaration) T
 }
 
 type DeclarationVisitor[T any] interface {
@@ -90,6 +91,9 @@ func AcceptDeclaration[T any](declaration Declaration, visitor DeclarationVisito
 
 	case ElementTypeEntitlementMappingDeclaration:
 		return visitor.VisitEntitlementMappingDeclaration(declaration.(*EntitlementMappingDeclaration))
+
+	case ElementTypeTypeAliasDeclaration:
+		return visitor.VisitTypeAliasDeclaration(declaration.(*TypeAliasDeclaration))
 	}
 
 	panic(errors.NewUnreachableError())
@@ -109,6 +113,7 @@ type StatementVisitor[T any] interface {
 	VisitEmitStatement(*EmitStatement) T
 	VisitExpressionStatement(*ExpressionStatement) T
 	VisitRemoveStatement(*RemoveStatement) T
+	VisitTryStatement(*TryStatement) T
 }
 
 func AcceptStatement[T any](statement Statement, visitor StatementVisitor[T]) (_ T) {
@@ -171,8 +176,14 @@ func AcceptStatement[T any](statement Statement, visitor StatementVisitor[T]) (_
 	case ElementTypeEntitlementDeclaration:
 		return visitor.VisitEntitlementDeclaration(statement.(*EntitlementDeclaration))
 
+	case ElementTypeTypeAliasDeclaration:
+		return visitor.VisitTypeAliasDeclaration(statement.(*TypeAliasDeclaration))
+
 	case ElementTypeRemoveStatement:
 		return visitor.VisitRemoveStatement(statement.(*RemoveStatement))
+
+	case ElementTypeTryStatement:
+		return visitor.VisitTryStatement(statement.(*TryStatement))
 	}
 
 	panic(errors.NewUnreachableError())
@@ -183,6 +194,7 @@ type ExpressionVisitor[T any] interface {
 	VisitNilExpression(*NilExpression) T
 	VisitBoolExpression(*BoolExpression) T
 	VisitStringExpression(*StringExpression) T
+	VisitStringTemplateExpression(*StringTemplateExpression) T
 	VisitIntegerExpression(*IntegerExpression) T
 	VisitFixedPointExpression(*FixedPointExpression) T
 	VisitDictionaryExpression(*DictionaryExpression) T
@@ -219,6 +231,9 @@ func AcceptExpression[T any](expression Expression, visitor ExpressionVisitor[T]
 	case ElementTypeStringExpression:
 		return visitor.VisitStringExpression(expression.(*StringExpression))
 
+	case ElementTypeStringTemplateExpression:
+		return visitor.VisitStringTemplateExpression(expression.(*StringTemplateExpression))
+
 	case ElementTypeIntegerExpression:
 		return visitor.VisitIntegerExpression(expression.(*IntegerExpression))
 
@@ -0,0 +1,172 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// A utility program that checks the health of the account storage of a state dump in JSON Lines format,
+// and optionally writes a repaired state dump, with all orphaned slabs removed
+
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/tools/registers"
+	"github.com/onflow/cadence/tools/storagehealth"
+)
+
+type stringSlice []string
+
+func (s stringSlice) String() string {
+	return strings.Join(s, ", ")
+}
+
+func (s *stringSlice) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+var addressesFlag stringSlice
+
+func init() {
+	flag.Var(&addressesFlag, "addresses", "only check the storage of the given addresses")
+}
+
+var gzipFlag = flag.Bool("gzip", false, "set true if input and output files are gzipped")
+var repairFlag = flag.String("repair", "", "write the state dump with all orphaned slabs removed to the given file")
+
+func main() {
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 1 {
+		log.Fatal("usage: check-storage [flags] <state dump>")
+	}
+
+	ledger := read(args[0])
+
+	var addresses []common.Address
+
+	if len(addressesFlag) > 0 {
+		for _, hexAddress := range addressesFlag {
+			address, err := common.HexToAddress(hexAddress)
+			if err != nil {
+				log.Fatalf("Invalid address: %s", hexAddress)
+			}
+			addresses = append(addresses, address)
+		}
+	} else {
+		addresses = ledger.Owners()
+	}
+
+	log.Printf("Checking storage of %d accounts ...", len(addresses))
+
+	problems, err := storagehealth.Check(ledger, addresses)
+	if err != nil {
+		log.Fatalf("Failed to check storage: %s", err)
+	}
+
+	counts := map[storagehealth.ProblemKind]int{}
+
+	for _, problem := range problems {
+		fmt.Println(problem)
+		counts[problem.Kind]++
+	}
+
+	log.Printf("Found %d problems", len(problems))
+
+	for kind := storagehealth.ProblemKindOrphanedSlab; kind <= storagehealth.ProblemKindInvalidValue; kind++ {
+		if counts[kind] == 0 {
+			continue
+		}
+		log.Printf("- %s: %d", kind, counts[kind])
+	}
+
+	if *repairFlag == "" {
+		return
+	}
+
+	removed, err := storagehealth.RemoveOrphanedSlabs(ledger, problems)
+	if err != nil {
+		log.Fatalf("Failed to remove orphaned slabs: %s", err)
+	}
+
+	log.Printf("Removed %d orphaned slabs", removed)
+
+	write(*repairFlag, ledger)
+}
+
+func read(path string) *registers.Registers {
+
+	log.Printf("Reading %s ...", path)
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if *gzipFlag {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	result, err := registers.Read(reader)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %s", path, err)
+	}
+
+	log.Printf("Read %d registers", result.Count())
+
+	return result
+}
+
+func write(path string, ledger *registers.Registers) {
+
+	log.Printf("Writing %s ...", path)
+
+	file, err := os.Create(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var writer io.Writer = file
+	if *gzipFlag {
+		gzipWriter := gzip.NewWriter(writer)
+		defer gzipWriter.Close()
+		writer = gzipWriter
+	}
+
+	err = ledger.Write(writer)
+	if err != nil {
+		log.Fatalf("Failed to write %s: %s", path, err)
+	}
+
+	log.Printf("Wrote %d registers", ledger.Count())
+}
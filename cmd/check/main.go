@@ -103,6 +103,7 @@ type result struct {
 	Bench    *benchResult `json:"bench,omitempty"`
 	BenchStr string       `json:"-"`
 	Error    string       `json:"error,omitempty"`
+	Warnings string       `json:"warnings,omitempty"`
 }
 
 type output interface {
@@ -161,6 +162,13 @@ func (s stdoutOutput) Append(r result) {
 		}
 	}
 
+	if len(r.Warnings) > 0 {
+		_, err = fmt.Fprintf(s.writer, "warnings:\t%s\n", r.Warnings)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	err = s.writer.Flush()
 	if err != nil {
 		panic(err)
@@ -268,6 +276,23 @@ func runPath(
 			}
 			res.Error = builder.String()
 		}
+
+		// Warnings do not cause checking to fail
+		warnings := checker.Warnings()
+		if len(warnings) > 0 {
+			var builder strings.Builder
+			printer := pretty.NewErrorPrettyPrinter(&builder, useColor)
+			for i, warning := range warnings {
+				if i > 0 {
+					builder.WriteString("\n")
+				}
+				printErr := printer.PrettyPrintError(warning, location, codes)
+				if printErr != nil {
+					panic(printErr)
+				}
+			}
+			res.Warnings = builder.String()
+		}
 	}()
 
 	if err != nil {
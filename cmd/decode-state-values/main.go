@@ -65,6 +65,8 @@ var printFlag = flag.Bool("print", false, "print parsed data (filtered, if addre
 var loadFlag = flag.Bool("load", false, "load the parsed data")
 var checkSlabsFlag = flag.Bool("check-slabs", false, "check slabs")
 var checkValuesFlag = flag.Bool("check-values", false, "check values")
+var reportFlag = flag.String("report", "", "write an inventory of all stored values per type to the given file")
+var reportFormatFlag = flag.String("report-format", reportFormatCSV, "format of the inventory report (csv or json)")
 
 const keyPartCount = 3
 
@@ -460,6 +462,10 @@ func main() {
 		load()
 	}
 
+	if *reportFlag != "" {
+		report(*reportFlag, *reportFormatFlag)
+	}
+
 	if *printFlag {
 		for key, value := range storage { //nolint:maprange
 			var keyParts []encodedKeyPart
@@ -486,6 +492,27 @@ func main() {
 	}
 }
 
+func report(path string, format string) {
+	if format != reportFormatCSV && format != reportFormatJSON {
+		log.Fatalf("Invalid report format: %s", format)
+	}
+
+	inventories := inventory()
+
+	log.Printf("Writing inventory of %d types to %s ...", len(inventories), path)
+
+	file, err := os.Create(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	err = writeInventory(file, inventories, format)
+	if err != nil {
+		log.Fatalf("Failed to write inventory: %s", err)
+	}
+}
+
 func read(file *os.File, addresses []common.Address) {
 
 	log.Println("Reading file ...")
@@ -0,0 +1,362 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/onflow/atree"
+	"github.com/schollz/progressbar/v3"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
+)
+
+const (
+	reportFormatCSV  = "csv"
+	reportFormatJSON = "json"
+)
+
+// typeInventory is the inventory of all stored values of a type
+type typeInventory struct {
+	Type      string   `json:"type"`
+	Count     uint64   `json:"count"`
+	TotalSize uint64   `json:"totalSize"`
+	MaxSize   uint64   `json:"maxSize"`
+	MinDepth  int      `json:"minDepth"`
+	MaxDepth  int      `json:"maxDepth"`
+	Owners    []string `json:"owners"`
+
+	owners map[common.Address]struct{}
+}
+
+func (i *typeInventory) add(owner common.Address, size uint64, depth int) {
+	if i.Count == 0 || depth < i.MinDepth {
+		i.MinDepth = depth
+	}
+	if depth > i.MaxDepth {
+		i.MaxDepth = depth
+	}
+
+	i.Count++
+	i.TotalSize += size
+	if size > i.MaxSize {
+		i.MaxSize = size
+	}
+
+	i.owners[owner] = struct{}{}
+}
+
+// inventory walks all storage maps of all accounts,
+// and returns the inventory of all stored values, including nested values, per type.
+//
+// The depth of a value is the number of containers it is nested in,
+// i.e. values stored directly in a storage map have depth 0.
+// Optionals are transparent, i.e. they are not reported and do not increase the depth.
+func inventory() []*typeInventory {
+
+	log.Println("Creating inventory ...")
+
+	slabStorage := &slabStorage{}
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		nil,
+		&interpreter.Config{
+			Storage: &interpreterStorage{
+				slabStorage: slabStorage,
+			},
+		},
+	)
+	if err != nil {
+		log.Fatalf("Failed to create interpreter: %s", err)
+	}
+
+	inventories := map[interpreter.TypeID]*typeInventory{}
+
+	owners := storageOwners()
+
+	bar := progressbar.Default(int64(len(owners)))
+
+	for _, owner := range owners {
+		_ = bar.Add(1)
+
+		for _, domain := range runtime.StorageDomains {
+			inventoryStorageMap(inter, slabStorage, owner, domain, inventories)
+		}
+	}
+
+	_ = bar.Close()
+
+	result := make([]*typeInventory, 0, len(inventories))
+
+	for _, inventory := range inventories { //nolint:maprange
+
+		inventory.Owners = make([]string, 0, len(inventory.owners))
+		for owner := range inventory.owners { //nolint:maprange
+			inventory.Owners = append(inventory.Owners, owner.HexWithPrefix())
+		}
+		sort.Strings(inventory.Owners)
+
+		result = append(result, inventory)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Type < result[j].Type
+	})
+
+	return result
+}
+
+// storageOwners returns the owners of all registers, in ascending order
+func storageOwners() []common.Address {
+	ownerSet := map[common.Address]struct{}{}
+
+	for key := range storage { //nolint:maprange
+		if len(key[0]) != common.AddressLength {
+			continue
+		}
+		ownerSet[common.MustBytesToAddress([]byte(key[0]))] = struct{}{}
+	}
+
+	owners := make([]common.Address, 0, len(ownerSet))
+	for owner := range ownerSet { //nolint:maprange
+		owners = append(owners, owner)
+	}
+
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i][:], owners[j][:]) < 0
+	})
+
+	return owners
+}
+
+func inventoryStorageMap(
+	inter *interpreter.Interpreter,
+	slabStorage *slabStorage,
+	owner common.Address,
+	domain string,
+	inventories map[interpreter.TypeID]*typeInventory,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("failed to create inventory of storage map @ %s %s: %v", owner, domain, r)
+		}
+	}()
+
+	// The register of the domain contains the index of the root slab of the storage map
+
+	data, ok := storage[storageKey{string(owner[:]), "", domain}]
+	if !ok {
+		return
+	}
+
+	var slabIndex atree.SlabIndex
+	if len(data) != len(slabIndex) {
+		log.Printf("Invalid storage map index @ %s %s: %x", owner, domain, data)
+		return
+	}
+	copy(slabIndex[:], data)
+
+	storageMap := interpreter.NewStorageMapWithRootID(
+		slabStorage,
+		atree.NewSlabID(atree.Address(owner), slabIndex),
+	)
+
+	iterator := storageMap.Iterator(nil)
+	for {
+		value := iterator.NextValue()
+		if value == nil {
+			break
+		}
+
+		inventoryValue(inter, slabStorage, owner, value, 0, inventories)
+	}
+}
+
+func inventoryValue(
+	inter *interpreter.Interpreter,
+	slabStorage *slabStorage,
+	owner common.Address,
+	value interpreter.Value,
+	depth int,
+	inventories map[interpreter.TypeID]*typeInventory,
+) {
+	if someValue, ok := value.(*interpreter.SomeValue); ok {
+		innerValue := someValue.InnerValue(inter, interpreter.EmptyLocationRange)
+		inventoryValue(inter, slabStorage, owner, innerValue, depth, inventories)
+		return
+	}
+
+	typeID := value.StaticType(inter).ID()
+
+	inventory, ok := inventories[typeID]
+	if !ok {
+		inventory = &typeInventory{
+			Type:   string(typeID),
+			owners: map[common.Address]struct{}{},
+		}
+		inventories[typeID] = inventory
+	}
+
+	size, err := encodedSize(slabStorage, owner, value)
+	if err != nil {
+		log.Printf("Failed to get encoded size of %s @ %s: %s", typeID, owner, err)
+	}
+
+	inventory.add(owner, size, depth)
+
+	value.Walk(
+		inter,
+		func(child interpreter.Value) {
+			inventoryValue(inter, slabStorage, owner, child, depth+1, inventories)
+		},
+		interpreter.EmptyLocationRange,
+	)
+}
+
+// encodedSize returns the size of the encoding of the given value.
+//
+// The size of a container (composite, array, or dictionary) which is stored in separate slabs
+// is the total size of all its slabs, which includes the encoding of all inlined nested values.
+// The size of an inlined container is the size of its inlined encoding.
+// The size of an optional is the size of its inner value.
+func encodedSize(slabStorage *slabStorage, owner common.Address, value interpreter.Value) (uint64, error) {
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		return encodedSize(
+			slabStorage,
+			owner,
+			value.InnerValue(nil, interpreter.EmptyLocationRange),
+		)
+
+	case interface{ SlabID() atree.SlabID }:
+		slab, found, err := slabStorage.Retrieve(value.SlabID())
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			return slabTreeSize(slabStorage, slab)
+		}
+	}
+
+	// NOTE: the maximum inline size ensures that the storable is not stored in a separate slab
+	storable, err := value.Storable(slabStorage, atree.Address(owner), math.MaxUint64)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := interpreter.StorableSize(storable)
+	return uint64(size), err
+}
+
+// slabTreeSize returns the size of the given slab,
+// and the sizes of all child slabs, if the slab is a metadata slab.
+func slabTreeSize(slabStorage *slabStorage, slab atree.Slab) (uint64, error) {
+	size, err := interpreter.StorableSize(slab)
+	if err != nil {
+		return 0, err
+	}
+
+	result := uint64(size)
+
+	dataSlab, ok := slab.(interface{ IsData() bool })
+	if !ok || dataSlab.IsData() {
+		return result, nil
+	}
+
+	for _, child := range slab.ChildStorables() {
+		slabIDStorable, ok := child.(atree.SlabIDStorable)
+		if !ok {
+			continue
+		}
+
+		childSlab, found, err := slabStorage.Retrieve(atree.SlabID(slabIDStorable))
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			return 0, fmt.Errorf("missing child slab %s", atree.SlabID(slabIDStorable))
+		}
+
+		childSize, err := slabTreeSize(slabStorage, childSlab)
+		if err != nil {
+			return 0, err
+		}
+
+		result += childSize
+	}
+
+	return result, nil
+}
+
+func writeInventory(w io.Writer, inventories []*typeInventory, format string) error {
+	switch format {
+	case reportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(inventories)
+
+	case reportFormatCSV:
+		writer := csv.NewWriter(w)
+
+		err := writer.Write([]string{
+			"type",
+			"count",
+			"total_size",
+			"max_size",
+			"min_depth",
+			"max_depth",
+			"owner_count",
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, inventory := range inventories {
+			err := writer.Write([]string{
+				inventory.Type,
+				strconv.FormatUint(inventory.Count, 10),
+				strconv.FormatUint(inventory.TotalSize, 10),
+				strconv.FormatUint(inventory.MaxSize, 10),
+				strconv.Itoa(inventory.MinDepth),
+				strconv.Itoa(inventory.MaxDepth),
+				strconv.Itoa(len(inventory.Owners)),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		writer.Flush()
+		return writer.Error()
+
+	default:
+		return fmt.Errorf("unsupported report format: %s", format)
+	}
+}
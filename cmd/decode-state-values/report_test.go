@@ -0,0 +1,303 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/tools/registers"
+)
+
+var testAddress1 = common.MustBytesToAddress([]byte{0x1})
+var testAddress2 = common.MustBytesToAddress([]byte{0x2})
+
+func newTestIntArray(inter *interpreter.Interpreter, address common.Address, count int) *interpreter.ArrayValue {
+	values := make([]interpreter.Value, 0, count)
+	for i := 0; i < count; i++ {
+		values = append(values, interpreter.NewUnmeteredIntValueFromInt64(int64(i)))
+	}
+
+	return interpreter.NewArrayValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		&interpreter.VariableSizedStaticType{
+			Type: interpreter.PrimitiveStaticTypeInt,
+		},
+		address,
+		values...,
+	)
+}
+
+// readTestPayloads stores test values in the storage domains of the test accounts,
+// and reads the resulting payloads, like a state dump.
+func readTestPayloads(t *testing.T) {
+	ledger := registers.New()
+
+	runtimeStorage := runtime.NewStorage(ledger, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		common.ScriptLocation{},
+		&interpreter.Config{
+			Storage: runtimeStorage,
+		},
+	)
+	require.NoError(t, err)
+
+	writeValue := func(address common.Address, key string, value interpreter.Value) {
+		storageMap := runtimeStorage.GetStorageMap(
+			address,
+			common.PathDomainStorage.Identifier(),
+			true,
+		)
+		storageMap.WriteValue(
+			inter,
+			interpreter.StringStorageMapKey(key),
+			value,
+		)
+	}
+
+	writeValue(
+		testAddress1,
+		"int",
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+	)
+	writeValue(
+		testAddress1,
+		"optional",
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.NewUnmeteredStringValue("a"),
+		),
+	)
+	writeValue(
+		testAddress1,
+		"small",
+		newTestIntArray(inter, testAddress1, 2),
+	)
+	writeValue(
+		testAddress2,
+		"dictionary",
+		interpreter.NewDictionaryValueWithAddress(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.DictionaryStaticType{
+				KeyType: interpreter.PrimitiveStaticTypeString,
+				ValueType: &interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+			},
+			testAddress2,
+			interpreter.NewUnmeteredStringValue("b"),
+			newTestIntArray(inter, testAddress2, 1),
+		),
+	)
+	writeValue(
+		testAddress2,
+		"large",
+		newTestIntArray(inter, testAddress2, 1000),
+	)
+
+	err = runtimeStorage.Commit(inter, false)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "payloads.jsonl")
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	err = ledger.Write(file)
+	require.NoError(t, err)
+
+	_, err = file.Seek(0, 0)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		storage = map[storageKey][]byte{}
+	})
+
+	read(file, nil)
+}
+
+func TestInventory(t *testing.T) {
+
+	readTestPayloads(t)
+
+	inventories := inventory()
+
+	for _, inventory := range inventories {
+		inventory.owners = nil
+	}
+
+	assert.Equal(t,
+		[]*typeInventory{
+			{
+				// the top-level integer, the elements of the small array,
+				// the element of the array in the dictionary, and the elements of the large array
+				Type:      "Int",
+				Count:     1004,
+				TotalSize: 5761,
+				MaxSize:   6,
+				MinDepth:  0,
+				MaxDepth:  2,
+				Owners: []string{
+					"0x0000000000000001",
+					"0x0000000000000002",
+				},
+			},
+			{
+				// the optional's inner value and the dictionary key
+				Type:      "String",
+				Count:     2,
+				TotalSize: 8,
+				MaxSize:   4,
+				MinDepth:  0,
+				MaxDepth:  1,
+				Owners: []string{
+					"0x0000000000000001",
+					"0x0000000000000002",
+				},
+			},
+			{
+				// the small array, the array in the dictionary, and the large array.
+				// The large array is stored in multiple slabs, which are all included in its size
+				Type:      "[Int]",
+				Count:     3,
+				TotalSize: 6003,
+				MaxSize:   5956,
+				MinDepth:  0,
+				MaxDepth:  1,
+				Owners: []string{
+					"0x0000000000000001",
+					"0x0000000000000002",
+				},
+			},
+			{
+				Type:      "{String:[Int]}",
+				Count:     1,
+				TotalSize: 56,
+				MaxSize:   56,
+				MinDepth:  0,
+				MaxDepth:  0,
+				Owners: []string{
+					"0x0000000000000002",
+				},
+			},
+		},
+		inventories,
+	)
+}
+
+func TestWriteInventory(t *testing.T) {
+
+	t.Parallel()
+
+	inventories := []*typeInventory{
+		{
+			Type:      "Int",
+			Count:     3,
+			TotalSize: 12,
+			MaxSize:   6,
+			MinDepth:  0,
+			MaxDepth:  1,
+			Owners: []string{
+				"0x0000000000000001",
+				"0x0000000000000002",
+			},
+		},
+		{
+			Type:      "[Int]",
+			Count:     1,
+			TotalSize: 20,
+			MaxSize:   20,
+			MinDepth:  0,
+			MaxDepth:  0,
+			Owners: []string{
+				"0x0000000000000001",
+			},
+		},
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		err := writeInventory(&buffer, inventories, reportFormatCSV)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			"type,count,total_size,max_size,min_depth,max_depth,owner_count\n"+
+				"Int,3,12,6,0,1,2\n"+
+				"[Int],1,20,20,0,0,1\n",
+			buffer.String(),
+		)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		err := writeInventory(&buffer, inventories, reportFormatJSON)
+		require.NoError(t, err)
+
+		assert.JSONEq(t,
+			`[
+              {
+                "type": "Int",
+                "count": 3,
+                "totalSize": 12,
+                "maxSize": 6,
+                "minDepth": 0,
+                "maxDepth": 1,
+                "owners": ["0x0000000000000001", "0x0000000000000002"]
+              },
+              {
+                "type": "[Int]",
+                "count": 1,
+                "totalSize": 20,
+                "maxSize": 20,
+                "minDepth": 0,
+                "maxDepth": 0,
+                "owners": ["0x0000000000000001"]
+              }
+            ]`,
+			buffer.String(),
+		)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+
+		t.Parallel()
+
+		var buffer bytes.Buffer
+		err := writeInventory(&buffer, inventories, "xml")
+		require.Error(t, err)
+	})
+}
@@ -48,18 +48,19 @@ type ConsoleREPL struct {
 	historyWriter      *csv.Writer
 }
 
-func NewConsoleREPL() (*ConsoleREPL, error) {
+func NewConsoleREPL(config runtime.REPLConfig) (*ConsoleREPL, error) {
 	consoleREPL := &ConsoleREPL{
 		lineNumber:         1,
 		errorPrettyPrinter: pretty.NewErrorPrettyPrinter(os.Stderr, true),
 	}
 
-	repl, err := runtime.NewREPL()
+	repl, err := runtime.NewREPLWithConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
 	repl.OnError = consoleREPL.onError
+	repl.OnWarning = consoleREPL.onWarning
 	repl.OnResult = consoleREPL.onResult
 
 	consoleREPL.repl = repl
@@ -74,6 +75,10 @@ func (consoleREPL *ConsoleREPL) onError(err error, location common.Location, cod
 	}
 }
 
+func (consoleREPL *ConsoleREPL) onWarning(warning error, _ common.Location, _ map[common.Location][]byte) {
+	fmt.Fprint(os.Stderr, pretty.FormatErrorMessage("warning", warning.Error(), true))
+}
+
 func (consoleREPL *ConsoleREPL) onResult(value interpreter.Value) {
 	fmt.Println(colorizeValue(value))
 }
@@ -19,15 +19,22 @@
 package main
 
 import (
+	"flag"
 	"os"
 	"os/signal"
 
 	"github.com/onflow/cadence/cmd/execute"
 	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
 )
 
+var looseFlag = flag.Bool("loose", false, "enable the loose mode in the REPL, i.e. allow omitting type annotations")
+
 func main() {
-	if len(os.Args) > 1 {
+	flag.Parse()
+	args := flag.Args()
+
+	if len(args) > 0 {
 		// TODO: also make the REPL support the interactive debugger
 
 		signals := make(chan os.Signal, 1)
@@ -44,9 +51,11 @@ func main() {
 			}
 		}()
 
-		execute.Execute(os.Args[1:], debugger)
+		execute.Execute(args, debugger)
 	} else {
-		repl, err := execute.NewConsoleREPL()
+		repl, err := execute.NewConsoleREPL(runtime.REPLConfig{
+			LooseModeEnabled: *looseFlag,
+		})
 		if err != nil {
 			panic(err)
 		}
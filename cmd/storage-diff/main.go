@@ -0,0 +1,187 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// A utility program that compares the account storage of two state dumps in JSON Lines format
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/tools/registers"
+	"github.com/onflow/cadence/tools/storagediff"
+)
+
+type stringSlice []string
+
+func (s stringSlice) String() string {
+	return strings.Join(s, ", ")
+}
+
+func (s *stringSlice) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+var addressesFlag stringSlice
+
+func init() {
+	flag.Var(&addressesFlag, "addresses", "only compare the storage of the given addresses")
+}
+
+var gzipFlag = flag.Bool("gzip", false, "set true if input files are gzipped")
+var jsonFlag = flag.Bool("json", false, "print differences in JSON Lines format")
+
+func main() {
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 2 {
+		log.Fatal("usage: storage-diff [flags] <old state dump> <new state dump>")
+	}
+
+	oldRegisters := read(args[0])
+	newRegisters := read(args[1])
+
+	var addresses []common.Address
+
+	if len(addressesFlag) > 0 {
+		for _, hexAddress := range addressesFlag {
+			address, err := common.HexToAddress(hexAddress)
+			if err != nil {
+				log.Fatalf("Invalid address: %s", hexAddress)
+			}
+			addresses = append(addresses, address)
+		}
+	} else {
+		addresses = owners(oldRegisters, newRegisters)
+	}
+
+	oldSnapshot, err := storagediff.NewSnapshot(oldRegisters)
+	if err != nil {
+		log.Fatalf("Failed to load old snapshot: %s", err)
+	}
+
+	newSnapshot, err := storagediff.NewSnapshot(newRegisters)
+	if err != nil {
+		log.Fatalf("Failed to load new snapshot: %s", err)
+	}
+
+	log.Printf("Comparing storage of %d accounts ...", len(addresses))
+
+	differences, err := storagediff.Compare(oldSnapshot, newSnapshot, addresses)
+	if err != nil {
+		log.Fatalf("Failed to compare snapshots: %s", err)
+	}
+
+	if *jsonFlag {
+		encoder := json.NewEncoder(os.Stdout)
+		for _, difference := range differences {
+			err := encoder.Encode(encodedDifference{
+				Address:  difference.Address.HexWithPrefix(),
+				Domain:   difference.Domain,
+				Key:      difference.Key,
+				Path:     difference.Path,
+				Kind:     difference.Kind.String(),
+				OldValue: difference.OldValue,
+				NewValue: difference.NewValue,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	} else {
+		for _, difference := range differences {
+			fmt.Println(difference)
+		}
+	}
+
+	log.Printf("Found %d differences", len(differences))
+}
+
+type encodedDifference struct {
+	Address  string
+	Domain   string
+	Key      string
+	Path     string `json:",omitempty"`
+	Kind     string
+	OldValue string `json:",omitempty"`
+	NewValue string `json:",omitempty"`
+}
+
+func read(path string) *registers.Registers {
+
+	log.Printf("Reading %s ...", path)
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if *gzipFlag {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	result, err := registers.Read(reader)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %s", path, err)
+	}
+
+	log.Printf("Read %d registers", result.Count())
+
+	return result
+}
+
+// owners returns the owners of the registers of both snapshots, in ascending order
+func owners(oldRegisters, newRegisters *registers.Registers) []common.Address {
+	addressSet := map[common.Address]struct{}{}
+	for _, address := range oldRegisters.Owners() {
+		addressSet[address] = struct{}{}
+	}
+	for _, address := range newRegisters.Owners() {
+		addressSet[address] = struct{}{}
+	}
+
+	addresses := make([]common.Address, 0, len(addressSet))
+	for address := range addressSet { //nolint:maprange
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+
+	return addresses
+}
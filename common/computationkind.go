@@ -72,8 +72,8 @@ const (
 	ComputationKindCreateDictionaryValue
 	ComputationKindTransferDictionaryValue
 	ComputationKindDestroyDictionaryValue
-	_
-	_
+	ComputationKindCreateSetValue
+	ComputationKindTransferSetValue
 	_
 	_
 	_
@@ -21,6 +21,8 @@ func _() {
 	_ = x[ComputationKindCreateDictionaryValue-1040]
 	_ = x[ComputationKindTransferDictionaryValue-1041]
 	_ = x[ComputationKindDestroyDictionaryValue-1042]
+	_ = x[ComputationKindCreateSetValue-1043]
+	_ = x[ComputationKindTransferSetValue-1044]
 	_ = x[ComputationKindEncodeValue-1080]
 	_ = x[ComputationKindSTDLIBPanic-1100]
 	_ = x[ComputationKindSTDLIBAssert-1101]
@@ -34,7 +36,7 @@ const (
 	_ComputationKind_name_1 = "StatementLoopFunctionInvocation"
 	_ComputationKind_name_2 = "CreateCompositeValueTransferCompositeValueDestroyCompositeValue"
 	_ComputationKind_name_3 = "CreateArrayValueTransferArrayValueDestroyArrayValue"
-	_ComputationKind_name_4 = "CreateDictionaryValueTransferDictionaryValueDestroyDictionaryValue"
+	_ComputationKind_name_4 = "CreateDictionaryValueTransferDictionaryValueDestroyDictionaryValueCreateSetValueTransferSetValue"
 	_ComputationKind_name_5 = "EncodeValue"
 	_ComputationKind_name_6 = "STDLIBPanicSTDLIBAssertSTDLIBRevertibleRandom"
 	_ComputationKind_name_7 = "STDLIBRLPDecodeStringSTDLIBRLPDecodeList"
@@ -44,7 +46,7 @@ var (
 	_ComputationKind_index_1 = [...]uint8{0, 9, 13, 31}
 	_ComputationKind_index_2 = [...]uint8{0, 20, 42, 63}
 	_ComputationKind_index_3 = [...]uint8{0, 16, 34, 51}
-	_ComputationKind_index_4 = [...]uint8{0, 21, 44, 66}
+	_ComputationKind_index_4 = [...]uint8{0, 21, 44, 66, 80, 96}
 	_ComputationKind_index_6 = [...]uint8{0, 11, 23, 45}
 	_ComputationKind_index_7 = [...]uint8{0, 21, 40}
 )
@@ -62,7 +64,7 @@ func (i ComputationKind) String() string {
 	case 1025 <= i && i <= 1027:
 		i -= 1025
 		return _ComputationKind_name_3[_ComputationKind_index_3[i]:_ComputationKind_index_3[i+1]]
-	case 1040 <= i && i <= 1042:
+	case 1040 <= i && i <= 1044:
 		i -= 1040
 		return _ComputationKind_name_4[_ComputationKind_index_4[i]:_ComputationKind_index_4[i+1]]
 	case i == 1080:
@@ -34,6 +34,7 @@ const (
 	MemoryKindNumberValue
 	MemoryKindArrayValueBase
 	MemoryKindDictionaryValueBase
+	MemoryKindSetValueBase
 	MemoryKindCompositeValueBase
 	MemoryKindSimpleCompositeValueBase
 	MemoryKindOptionalValue
@@ -69,6 +70,7 @@ const (
 	MemoryKindConstantSizedStaticType
 	MemoryKindDictionaryStaticType
 	MemoryKindInclusiveRangeStaticType
+	MemoryKindSetStaticType
 	MemoryKindOptionalStaticType
 	MemoryKindIntersectionStaticType
 	MemoryKindEntitlementSetStaticAccess
@@ -90,6 +92,7 @@ const (
 	MemoryKindCadenceArrayValueLength
 	MemoryKindCadenceDictionaryValue
 	MemoryKindCadenceInclusiveRangeValue
+	MemoryKindCadenceSetValue
 	MemoryKindCadenceKeyValuePair
 	MemoryKindCadenceStructValueBase
 	MemoryKindCadenceStructValueSize
@@ -116,6 +119,7 @@ const (
 	MemoryKindCadenceConstantSizedArrayType
 	MemoryKindCadenceDictionaryType
 	MemoryKindCadenceInclusiveRangeType
+	MemoryKindCadenceSetType
 	MemoryKindCadenceField
 	MemoryKindCadenceParameter
 	MemoryKindCadenceTypeParameter
@@ -168,6 +172,8 @@ const (
 	MemoryKindMembers
 	MemoryKindTypeAnnotation
 	MemoryKindDictionaryEntry
+	MemoryKindDestructuringPattern
+	MemoryKindDestructuringElement
 
 	MemoryKindFunctionDeclaration
 	MemoryKindCompositeDeclaration
@@ -183,6 +189,7 @@ const (
 	MemoryKindVariableDeclaration
 	MemoryKindSpecialFunctionDeclaration
 	MemoryKindPragmaDeclaration
+	MemoryKindTypeAliasDeclaration
 
 	MemoryKindAssignmentStatement
 	MemoryKindBreakStatement
@@ -194,13 +201,16 @@ const (
 	MemoryKindReturnStatement
 	MemoryKindSwapStatement
 	MemoryKindSwitchStatement
+	MemoryKindSwitchPattern
 	MemoryKindWhileStatement
 	MemoryKindRemoveStatement
+	MemoryKindTryStatement
 
 	MemoryKindBooleanExpression
 	MemoryKindVoidExpression
 	MemoryKindNilExpression
 	MemoryKindStringExpression
+	MemoryKindStringTemplateExpression
 	MemoryKindIntegerExpression
 	MemoryKindFixedPointExpression
 	MemoryKindArrayExpression
@@ -250,6 +260,7 @@ const (
 	MemoryKindEntitlementRelationSemaType
 	MemoryKindCapabilitySemaType
 	MemoryKindInclusiveRangeSemaType
+	MemoryKindSetSemaType
 
 	// ordered-map
 	MemoryKindOrderedMap
@@ -15,206 +15,217 @@ func _() {
 	_ = x[MemoryKindNumberValue-4]
 	_ = x[MemoryKindArrayValueBase-5]
 	_ = x[MemoryKindDictionaryValueBase-6]
-	_ = x[MemoryKindCompositeValueBase-7]
-	_ = x[MemoryKindSimpleCompositeValueBase-8]
-	_ = x[MemoryKindOptionalValue-9]
-	_ = x[MemoryKindTypeValue-10]
-	_ = x[MemoryKindPathValue-11]
-	_ = x[MemoryKindCapabilityValue-12]
-	_ = x[MemoryKindStorageReferenceValue-13]
-	_ = x[MemoryKindEphemeralReferenceValue-14]
-	_ = x[MemoryKindInterpretedFunctionValue-15]
-	_ = x[MemoryKindHostFunctionValue-16]
-	_ = x[MemoryKindBoundFunctionValue-17]
-	_ = x[MemoryKindBigInt-18]
-	_ = x[MemoryKindSimpleCompositeValue-19]
-	_ = x[MemoryKindPublishedValue-20]
-	_ = x[MemoryKindStorageCapabilityControllerValue-21]
-	_ = x[MemoryKindAccountCapabilityControllerValue-22]
-	_ = x[MemoryKindAtreeArrayDataSlab-23]
-	_ = x[MemoryKindAtreeArrayMetaDataSlab-24]
-	_ = x[MemoryKindAtreeArrayElementOverhead-25]
-	_ = x[MemoryKindAtreeMapDataSlab-26]
-	_ = x[MemoryKindAtreeMapMetaDataSlab-27]
-	_ = x[MemoryKindAtreeMapElementOverhead-28]
-	_ = x[MemoryKindAtreeMapPreAllocatedElement-29]
-	_ = x[MemoryKindAtreeEncodedSlab-30]
-	_ = x[MemoryKindPrimitiveStaticType-31]
-	_ = x[MemoryKindCompositeStaticType-32]
-	_ = x[MemoryKindInterfaceStaticType-33]
-	_ = x[MemoryKindVariableSizedStaticType-34]
-	_ = x[MemoryKindConstantSizedStaticType-35]
-	_ = x[MemoryKindDictionaryStaticType-36]
-	_ = x[MemoryKindInclusiveRangeStaticType-37]
-	_ = x[MemoryKindOptionalStaticType-38]
-	_ = x[MemoryKindIntersectionStaticType-39]
-	_ = x[MemoryKindEntitlementSetStaticAccess-40]
-	_ = x[MemoryKindEntitlementMapStaticAccess-41]
-	_ = x[MemoryKindReferenceStaticType-42]
-	_ = x[MemoryKindCapabilityStaticType-43]
-	_ = x[MemoryKindFunctionStaticType-44]
-	_ = x[MemoryKindCadenceVoidValue-45]
-	_ = x[MemoryKindCadenceOptionalValue-46]
-	_ = x[MemoryKindCadenceBoolValue-47]
-	_ = x[MemoryKindCadenceStringValue-48]
-	_ = x[MemoryKindCadenceCharacterValue-49]
-	_ = x[MemoryKindCadenceAddressValue-50]
-	_ = x[MemoryKindCadenceIntValue-51]
-	_ = x[MemoryKindCadenceNumberValue-52]
-	_ = x[MemoryKindCadenceArrayValueBase-53]
-	_ = x[MemoryKindCadenceArrayValueLength-54]
-	_ = x[MemoryKindCadenceDictionaryValue-55]
-	_ = x[MemoryKindCadenceInclusiveRangeValue-56]
-	_ = x[MemoryKindCadenceKeyValuePair-57]
-	_ = x[MemoryKindCadenceStructValueBase-58]
-	_ = x[MemoryKindCadenceStructValueSize-59]
-	_ = x[MemoryKindCadenceResourceValueBase-60]
-	_ = x[MemoryKindCadenceAttachmentValueBase-61]
-	_ = x[MemoryKindCadenceResourceValueSize-62]
-	_ = x[MemoryKindCadenceAttachmentValueSize-63]
-	_ = x[MemoryKindCadenceEventValueBase-64]
-	_ = x[MemoryKindCadenceEventValueSize-65]
-	_ = x[MemoryKindCadenceContractValueBase-66]
-	_ = x[MemoryKindCadenceContractValueSize-67]
-	_ = x[MemoryKindCadenceEnumValueBase-68]
-	_ = x[MemoryKindCadenceEnumValueSize-69]
-	_ = x[MemoryKindCadencePathValue-70]
-	_ = x[MemoryKindCadenceTypeValue-71]
-	_ = x[MemoryKindCadenceCapabilityValue-72]
-	_ = x[MemoryKindCadenceDeprecatedPathCapabilityType-73]
-	_ = x[MemoryKindCadenceFunctionValue-74]
-	_ = x[MemoryKindCadenceOptionalType-75]
-	_ = x[MemoryKindCadenceDeprecatedRestrictedType-76]
-	_ = x[MemoryKindCadenceVariableSizedArrayType-77]
-	_ = x[MemoryKindCadenceConstantSizedArrayType-78]
-	_ = x[MemoryKindCadenceDictionaryType-79]
-	_ = x[MemoryKindCadenceInclusiveRangeType-80]
-	_ = x[MemoryKindCadenceField-81]
-	_ = x[MemoryKindCadenceParameter-82]
-	_ = x[MemoryKindCadenceTypeParameter-83]
-	_ = x[MemoryKindCadenceStructType-84]
-	_ = x[MemoryKindCadenceResourceType-85]
-	_ = x[MemoryKindCadenceAttachmentType-86]
-	_ = x[MemoryKindCadenceEventType-87]
-	_ = x[MemoryKindCadenceContractType-88]
-	_ = x[MemoryKindCadenceStructInterfaceType-89]
-	_ = x[MemoryKindCadenceResourceInterfaceType-90]
-	_ = x[MemoryKindCadenceContractInterfaceType-91]
-	_ = x[MemoryKindCadenceFunctionType-92]
-	_ = x[MemoryKindCadenceEntitlementSetAccess-93]
-	_ = x[MemoryKindCadenceEntitlementMapAccess-94]
-	_ = x[MemoryKindCadenceReferenceType-95]
-	_ = x[MemoryKindCadenceIntersectionType-96]
-	_ = x[MemoryKindCadenceCapabilityType-97]
-	_ = x[MemoryKindCadenceEnumType-98]
-	_ = x[MemoryKindRawString-99]
-	_ = x[MemoryKindAddressLocation-100]
-	_ = x[MemoryKindBytes-101]
-	_ = x[MemoryKindVariable-102]
-	_ = x[MemoryKindCompositeTypeInfo-103]
-	_ = x[MemoryKindCompositeField-104]
-	_ = x[MemoryKindInvocation-105]
-	_ = x[MemoryKindStorageMap-106]
-	_ = x[MemoryKindStorageKey-107]
-	_ = x[MemoryKindTypeToken-108]
-	_ = x[MemoryKindErrorToken-109]
-	_ = x[MemoryKindSpaceToken-110]
-	_ = x[MemoryKindProgram-111]
-	_ = x[MemoryKindIdentifier-112]
-	_ = x[MemoryKindArgument-113]
-	_ = x[MemoryKindBlock-114]
-	_ = x[MemoryKindFunctionBlock-115]
-	_ = x[MemoryKindParameter-116]
-	_ = x[MemoryKindParameterList-117]
-	_ = x[MemoryKindTypeParameter-118]
-	_ = x[MemoryKindTypeParameterList-119]
-	_ = x[MemoryKindTransfer-120]
-	_ = x[MemoryKindMembers-121]
-	_ = x[MemoryKindTypeAnnotation-122]
-	_ = x[MemoryKindDictionaryEntry-123]
-	_ = x[MemoryKindFunctionDeclaration-124]
-	_ = x[MemoryKindCompositeDeclaration-125]
-	_ = x[MemoryKindAttachmentDeclaration-126]
-	_ = x[MemoryKindInterfaceDeclaration-127]
-	_ = x[MemoryKindEntitlementDeclaration-128]
-	_ = x[MemoryKindEntitlementMappingElement-129]
-	_ = x[MemoryKindEntitlementMappingDeclaration-130]
-	_ = x[MemoryKindEnumCaseDeclaration-131]
-	_ = x[MemoryKindFieldDeclaration-132]
-	_ = x[MemoryKindTransactionDeclaration-133]
-	_ = x[MemoryKindImportDeclaration-134]
-	_ = x[MemoryKindVariableDeclaration-135]
-	_ = x[MemoryKindSpecialFunctionDeclaration-136]
-	_ = x[MemoryKindPragmaDeclaration-137]
-	_ = x[MemoryKindAssignmentStatement-138]
-	_ = x[MemoryKindBreakStatement-139]
-	_ = x[MemoryKindContinueStatement-140]
-	_ = x[MemoryKindEmitStatement-141]
-	_ = x[MemoryKindExpressionStatement-142]
-	_ = x[MemoryKindForStatement-143]
-	_ = x[MemoryKindIfStatement-144]
-	_ = x[MemoryKindReturnStatement-145]
-	_ = x[MemoryKindSwapStatement-146]
-	_ = x[MemoryKindSwitchStatement-147]
-	_ = x[MemoryKindWhileStatement-148]
-	_ = x[MemoryKindRemoveStatement-149]
-	_ = x[MemoryKindBooleanExpression-150]
-	_ = x[MemoryKindVoidExpression-151]
-	_ = x[MemoryKindNilExpression-152]
-	_ = x[MemoryKindStringExpression-153]
-	_ = x[MemoryKindIntegerExpression-154]
-	_ = x[MemoryKindFixedPointExpression-155]
-	_ = x[MemoryKindArrayExpression-156]
-	_ = x[MemoryKindDictionaryExpression-157]
-	_ = x[MemoryKindIdentifierExpression-158]
-	_ = x[MemoryKindInvocationExpression-159]
-	_ = x[MemoryKindMemberExpression-160]
-	_ = x[MemoryKindIndexExpression-161]
-	_ = x[MemoryKindConditionalExpression-162]
-	_ = x[MemoryKindUnaryExpression-163]
-	_ = x[MemoryKindBinaryExpression-164]
-	_ = x[MemoryKindFunctionExpression-165]
-	_ = x[MemoryKindCastingExpression-166]
-	_ = x[MemoryKindCreateExpression-167]
-	_ = x[MemoryKindDestroyExpression-168]
-	_ = x[MemoryKindReferenceExpression-169]
-	_ = x[MemoryKindForceExpression-170]
-	_ = x[MemoryKindPathExpression-171]
-	_ = x[MemoryKindAttachExpression-172]
-	_ = x[MemoryKindConstantSizedType-173]
-	_ = x[MemoryKindDictionaryType-174]
-	_ = x[MemoryKindFunctionType-175]
-	_ = x[MemoryKindInstantiationType-176]
-	_ = x[MemoryKindNominalType-177]
-	_ = x[MemoryKindOptionalType-178]
-	_ = x[MemoryKindReferenceType-179]
-	_ = x[MemoryKindIntersectionType-180]
-	_ = x[MemoryKindVariableSizedType-181]
-	_ = x[MemoryKindPosition-182]
-	_ = x[MemoryKindRange-183]
-	_ = x[MemoryKindElaboration-184]
-	_ = x[MemoryKindActivation-185]
-	_ = x[MemoryKindActivationEntries-186]
-	_ = x[MemoryKindVariableSizedSemaType-187]
-	_ = x[MemoryKindConstantSizedSemaType-188]
-	_ = x[MemoryKindDictionarySemaType-189]
-	_ = x[MemoryKindOptionalSemaType-190]
-	_ = x[MemoryKindIntersectionSemaType-191]
-	_ = x[MemoryKindReferenceSemaType-192]
-	_ = x[MemoryKindEntitlementSemaType-193]
-	_ = x[MemoryKindEntitlementMapSemaType-194]
-	_ = x[MemoryKindEntitlementRelationSemaType-195]
-	_ = x[MemoryKindCapabilitySemaType-196]
-	_ = x[MemoryKindInclusiveRangeSemaType-197]
-	_ = x[MemoryKindOrderedMap-198]
-	_ = x[MemoryKindOrderedMapEntryList-199]
-	_ = x[MemoryKindOrderedMapEntry-200]
-	_ = x[MemoryKindLast-201]
+	_ = x[MemoryKindSetValueBase-7]
+	_ = x[MemoryKindCompositeValueBase-8]
+	_ = x[MemoryKindSimpleCompositeValueBase-9]
+	_ = x[MemoryKindOptionalValue-10]
+	_ = x[MemoryKindTypeValue-11]
+	_ = x[MemoryKindPathValue-12]
+	_ = x[MemoryKindCapabilityValue-13]
+	_ = x[MemoryKindStorageReferenceValue-14]
+	_ = x[MemoryKindEphemeralReferenceValue-15]
+	_ = x[MemoryKindInterpretedFunctionValue-16]
+	_ = x[MemoryKindHostFunctionValue-17]
+	_ = x[MemoryKindBoundFunctionValue-18]
+	_ = x[MemoryKindBigInt-19]
+	_ = x[MemoryKindSimpleCompositeValue-20]
+	_ = x[MemoryKindPublishedValue-21]
+	_ = x[MemoryKindStorageCapabilityControllerValue-22]
+	_ = x[MemoryKindAccountCapabilityControllerValue-23]
+	_ = x[MemoryKindAtreeArrayDataSlab-24]
+	_ = x[MemoryKindAtreeArrayMetaDataSlab-25]
+	_ = x[MemoryKindAtreeArrayElementOverhead-26]
+	_ = x[MemoryKindAtreeMapDataSlab-27]
+	_ = x[MemoryKindAtreeMapMetaDataSlab-28]
+	_ = x[MemoryKindAtreeMapElementOverhead-29]
+	_ = x[MemoryKindAtreeMapPreAllocatedElement-30]
+	_ = x[MemoryKindAtreeEncodedSlab-31]
+	_ = x[MemoryKindPrimitiveStaticType-32]
+	_ = x[MemoryKindCompositeStaticType-33]
+	_ = x[MemoryKindInterfaceStaticType-34]
+	_ = x[MemoryKindVariableSizedStaticType-35]
+	_ = x[MemoryKindConstantSizedStaticType-36]
+	_ = x[MemoryKindDictionaryStaticType-37]
+	_ = x[MemoryKindInclusiveRangeStaticType-38]
+	_ = x[MemoryKindSetStaticType-39]
+	_ = x[MemoryKindOptionalStaticType-40]
+	_ = x[MemoryKindIntersectionStaticType-41]
+	_ = x[MemoryKindEntitlementSetStaticAccess-42]
+	_ = x[MemoryKindEntitlementMapStaticAccess-43]
+	_ = x[MemoryKindReferenceStaticType-44]
+	_ = x[MemoryKindCapabilityStaticType-45]
+	_ = x[MemoryKindFunctionStaticType-46]
+	_ = x[MemoryKindCadenceVoidValue-47]
+	_ = x[MemoryKindCadenceOptionalValue-48]
+	_ = x[MemoryKindCadenceBoolValue-49]
+	_ = x[MemoryKindCadenceStringValue-50]
+	_ = x[MemoryKindCadenceCharacterValue-51]
+	_ = x[MemoryKindCadenceAddressValue-52]
+	_ = x[MemoryKindCadenceIntValue-53]
+	_ = x[MemoryKindCadenceNumberValue-54]
+	_ = x[MemoryKindCadenceArrayValueBase-55]
+	_ = x[MemoryKindCadenceArrayValueLength-56]
+	_ = x[MemoryKindCadenceDictionaryValue-57]
+	_ = x[MemoryKindCadenceInclusiveRangeValue-58]
+	_ = x[MemoryKindCadenceSetValue-59]
+	_ = x[MemoryKindCadenceKeyValuePair-60]
+	_ = x[MemoryKindCadenceStructValueBase-61]
+	_ = x[MemoryKindCadenceStructValueSize-62]
+	_ = x[MemoryKindCadenceResourceValueBase-63]
+	_ = x[MemoryKindCadenceAttachmentValueBase-64]
+	_ = x[MemoryKindCadenceResourceValueSize-65]
+	_ = x[MemoryKindCadenceAttachmentValueSize-66]
+	_ = x[MemoryKindCadenceEventValueBase-67]
+	_ = x[MemoryKindCadenceEventValueSize-68]
+	_ = x[MemoryKindCadenceContractValueBase-69]
+	_ = x[MemoryKindCadenceContractValueSize-70]
+	_ = x[MemoryKindCadenceEnumValueBase-71]
+	_ = x[MemoryKindCadenceEnumValueSize-72]
+	_ = x[MemoryKindCadencePathValue-73]
+	_ = x[MemoryKindCadenceTypeValue-74]
+	_ = x[MemoryKindCadenceCapabilityValue-75]
+	_ = x[MemoryKindCadenceDeprecatedPathCapabilityType-76]
+	_ = x[MemoryKindCadenceFunctionValue-77]
+	_ = x[MemoryKindCadenceOptionalType-78]
+	_ = x[MemoryKindCadenceDeprecatedRestrictedType-79]
+	_ = x[MemoryKindCadenceVariableSizedArrayType-80]
+	_ = x[MemoryKindCadenceConstantSizedArrayType-81]
+	_ = x[MemoryKindCadenceDictionaryType-82]
+	_ = x[MemoryKindCadenceInclusiveRangeType-83]
+	_ = x[MemoryKindCadenceSetType-84]
+	_ = x[MemoryKindCadenceField-85]
+	_ = x[MemoryKindCadenceParameter-86]
+	_ = x[MemoryKindCadenceTypeParameter-87]
+	_ = x[MemoryKindCadenceStructType-88]
+	_ = x[MemoryKindCadenceResourceType-89]
+	_ = x[MemoryKindCadenceAttachmentType-90]
+	_ = x[MemoryKindCadenceEventType-91]
+	_ = x[MemoryKindCadenceContractType-92]
+	_ = x[MemoryKindCadenceStructInterfaceType-93]
+	_ = x[MemoryKindCadenceResourceInterfaceType-94]
+	_ = x[MemoryKindCadenceContractInterfaceType-95]
+	_ = x[MemoryKindCadenceFunctionType-96]
+	_ = x[MemoryKindCadenceEntitlementSetAccess-97]
+	_ = x[MemoryKindCadenceEntitlementMapAccess-98]
+	_ = x[MemoryKindCadenceReferenceType-99]
+	_ = x[MemoryKindCadenceIntersectionType-100]
+	_ = x[MemoryKindCadenceCapabilityType-101]
+	_ = x[MemoryKindCadenceEnumType-102]
+	_ = x[MemoryKindRawString-103]
+	_ = x[MemoryKindAddressLocation-104]
+	_ = x[MemoryKindBytes-105]
+	_ = x[MemoryKindVariable-106]
+	_ = x[MemoryKindCompositeTypeInfo-107]
+	_ = x[MemoryKindCompositeField-108]
+	_ = x[MemoryKindInvocation-109]
+	_ = x[MemoryKindStorageMap-110]
+	_ = x[MemoryKindStorageKey-111]
+	_ = x[MemoryKindTypeToken-112]
+	_ = x[MemoryKindErrorToken-113]
+	_ = x[MemoryKindSpaceToken-114]
+	_ = x[MemoryKindProgram-115]
+	_ = x[MemoryKindIdentifier-116]
+	_ = x[MemoryKindArgument-117]
+	_ = x[MemoryKindBlock-118]
+	_ = x[MemoryKindFunctionBlock-119]
+	_ = x[MemoryKindParameter-120]
+	_ = x[MemoryKindParameterList-121]
+	_ = x[MemoryKindTypeParameter-122]
+	_ = x[MemoryKindTypeParameterList-123]
+	_ = x[MemoryKindTransfer-124]
+	_ = x[MemoryKindMembers-125]
+	_ = x[MemoryKindTypeAnnotation-126]
+	_ = x[MemoryKindDictionaryEntry-127]
+	_ = x[MemoryKindDestructuringPattern-128]
+	_ = x[MemoryKindDestructuringElement-129]
+	_ = x[MemoryKindFunctionDeclaration-130]
+	_ = x[MemoryKindCompositeDeclaration-131]
+	_ = x[MemoryKindAttachmentDeclaration-132]
+	_ = x[MemoryKindInterfaceDeclaration-133]
+	_ = x[MemoryKindEntitlementDeclaration-134]
+	_ = x[MemoryKindEntitlementMappingElement-135]
+	_ = x[MemoryKindEntitlementMappingDeclaration-136]
+	_ = x[MemoryKindEnumCaseDeclaration-137]
+	_ = x[MemoryKindFieldDeclaration-138]
+	_ = x[MemoryKindTransactionDeclaration-139]
+	_ = x[MemoryKindImportDeclaration-140]
+	_ = x[MemoryKindVariableDeclaration-141]
+	_ = x[MemoryKindSpecialFunctionDeclaration-142]
+	_ = x[MemoryKindPragmaDeclaration-143]
+	_ = x[MemoryKindTypeAliasDeclaration-144]
+	_ = x[MemoryKindAssignmentStatement-145]
+	_ = x[MemoryKindBreakStatement-146]
+	_ = x[MemoryKindContinueStatement-147]
+	_ = x[MemoryKindEmitStatement-148]
+	_ = x[MemoryKindExpressionStatement-149]
+	_ = x[MemoryKindForStatement-150]
+	_ = x[MemoryKindIfStatement-151]
+	_ = x[MemoryKindReturnStatement-152]
+	_ = x[MemoryKindSwapStatement-153]
+	_ = x[MemoryKindSwitchStatement-154]
+	_ = x[MemoryKindSwitchPattern-155]
+	_ = x[MemoryKindWhileStatement-156]
+	_ = x[MemoryKindRemoveStatement-157]
+	_ = x[MemoryKindTryStatement-158]
+	_ = x[MemoryKindBooleanExpression-159]
+	_ = x[MemoryKindVoidExpression-160]
+	_ = x[MemoryKindNilExpression-161]
+	_ = x[MemoryKindStringExpression-162]
+	_ = x[MemoryKindStringTemplateExpression-163]
+	_ = x[MemoryKindIntegerExpression-164]
+	_ = x[MemoryKindFixedPointExpression-165]
+	_ = x[MemoryKindArrayExpression-166]
+	_ = x[MemoryKindDictionaryExpression-167]
+	_ = x[MemoryKindIdentifierExpression-168]
+	_ = x[MemoryKindInvocationExpression-169]
+	_ = x[MemoryKindMemberExpression-170]
+	_ = x[MemoryKindIndexExpression-171]
+	_ = x[MemoryKindConditionalExpression-172]
+	_ = x[MemoryKindUnaryExpression-173]
+	_ = x[MemoryKindBinaryExpression-174]
+	_ = x[MemoryKindFunctionExpression-175]
+	_ = x[MemoryKindCastingExpression-176]
+	_ = x[MemoryKindCreateExpression-177]
+	_ = x[MemoryKindDestroyExpression-178]
+	_ = x[MemoryKindReferenceExpression-179]
+	_ = x[MemoryKindForceExpression-180]
+	_ = x[MemoryKindPathExpression-181]
+	_ = x[MemoryKindAttachExpression-182]
+	_ = x[MemoryKindConstantSizedType-183]
+	_ = x[MemoryKindDictionaryType-184]
+	_ = x[MemoryKindFunctionType-185]
+	_ = x[MemoryKindInstantiationType-186]
+	_ = x[MemoryKindNominalType-187]
+	_ = x[MemoryKindOptionalType-188]
+	_ = x[MemoryKindReferenceType-189]
+	_ = x[MemoryKindIntersectionType-190]
+	_ = x[MemoryKindVariableSizedType-191]
+	_ = x[MemoryKindPosition-192]
+	_ = x[MemoryKindRange-193]
+	_ = x[MemoryKindElaboration-194]
+	_ = x[MemoryKindActivation-195]
+	_ = x[MemoryKindActivationEntries-196]
+	_ = x[MemoryKindVariableSizedSemaType-197]
+	_ = x[MemoryKindConstantSizedSemaType-198]
+	_ = x[MemoryKindDictionarySemaType-199]
+	_ = x[MemoryKindOptionalSemaType-200]
+	_ = x[MemoryKindIntersectionSemaType-201]
+	_ = x[MemoryKindReferenceSemaType-202]
+	_ = x[MemoryKindEntitlementSemaType-203]
+	_ = x[MemoryKindEntitlementMapSemaType-204]
+	_ = x[MemoryKindEntitlementRelationSemaType-205]
+	_ = x[MemoryKindCapabilitySemaType-206]
+	_ = x[MemoryKindInclusiveRangeSemaType-207]
+	_ = x[MemoryKindSetSemaType-208]
+	_ = x[MemoryKindOrderedMap-209]
+	_ = x[MemoryKindOrderedMapEntryList-210]
+	_ = x[MemoryKindOrderedMapEntry-211]
+	_ = x[MemoryKindLast-212]
 }
 
-const _MemoryKind_name = "UnknownAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueTypeValuePathValueCapabilityValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValuePublishedValueStorageCapabilityControllerValueAccountCapabilityControllerValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeInclusiveRangeStaticTypeOptionalStaticTypeIntersectionStaticTypeEntitlementSetStaticAccessEntitlementMapStaticAccessReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceInclusiveRangeValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceAttachmentValueBaseCadenceResourceValueSizeCadenceAttachmentValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceDeprecatedPathCapabilityTypeCadenceFunctionValueCadenceOptionalTypeCadenceDeprecatedRestrictedTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceInclusiveRangeTypeCadenceFieldCadenceParameterCadenceTypeParameterCadenceStructTypeCadenceResourceTypeCadenceAttachmentTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceEntitlementSetAccessCadenceEntitlementMapAccessCadenceReferenceTypeCadenceIntersectionTypeCadenceCapabilityTypeCadenceEnumTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyTypeTokenErrorTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTypeParameterTypeParameterListTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationAttachmentDeclarationInterfaceDeclarationEntitlementDeclarationEntitlementMappingElementEntitlementMappingDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementWhileStatementRemoveStatementBooleanExpressionVoidExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionAttachExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeIntersectionTypeVariableSizedTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeIntersectionSemaTypeReferenceSemaTypeEntitlementSemaTypeEntitlementMapSemaTypeEntitlementRelationSemaTypeCapabilitySemaTypeInclusiveRangeSemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
+const _MemoryKind_name = "UnknownAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseSetValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueTypeValuePathValueCapabilityValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValuePublishedValueStorageCapabilityControllerValueAccountCapabilityControllerValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeInclusiveRangeStaticTypeSetStaticTypeOptionalStaticTypeIntersectionStaticTypeEntitlementSetStaticAccessEntitlementMapStaticAccessReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceInclusiveRangeValueCadenceSetValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceAttachmentValueBaseCadenceResourceValueSizeCadenceAttachmentValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceDeprecatedPathCapabilityTypeCadenceFunctionValueCadenceOptionalTypeCadenceDeprecatedRestrictedTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceInclusiveRangeTypeCadenceSetTypeCadenceFieldCadenceParameterCadenceTypeParameterCadenceStructTypeCadenceResourceTypeCadenceAttachmentTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceEntitlementSetAccessCadenceEntitlementMapAccessCadenceReferenceTypeCadenceIntersectionTypeCadenceCapabilityTypeCadenceEnumTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyTypeTokenErrorTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTypeParameterTypeParameterListTransferMembersTypeAnnotationDictionaryEntryDestructuringPatternDestructuringElementFunctionDeclarationCompositeDeclarationAttachmentDeclarationInterfaceDeclarationEntitlementDeclarationEntitlementMappingElementEntitlementMappingDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationTypeAliasDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementSwitchPatternWhileStatementRemoveStatementTryStatementBooleanExpressionVoidExpressionNilExpressionStringExpressionStringTemplateExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionAttachExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeIntersectionTypeVariableSizedTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeIntersectionSemaTypeReferenceSemaTypeEntitlementSemaTypeEntitlementMapSemaTypeEntitlementRelationSemaTypeCapabilitySemaTypeInclusiveRangeSemaTypeSetSemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
 
-var _MemoryKind_index = [...]uint16{0, 7, 19, 30, 44, 55, 69, 88, 106, 130, 143, 152, 161, 176, 197, 220, 244, 261, 279, 285, 305, 319, 351, 383, 401, 423, 448, 464, 484, 507, 534, 550, 569, 588, 607, 630, 653, 673, 697, 715, 737, 763, 789, 808, 828, 846, 862, 882, 898, 916, 937, 956, 971, 989, 1010, 1033, 1055, 1081, 1100, 1122, 1144, 1168, 1194, 1218, 1244, 1265, 1286, 1310, 1334, 1354, 1374, 1390, 1406, 1428, 1463, 1483, 1502, 1533, 1562, 1591, 1612, 1637, 1649, 1665, 1685, 1702, 1721, 1742, 1758, 1777, 1803, 1831, 1859, 1878, 1905, 1932, 1952, 1975, 1996, 2011, 2020, 2035, 2040, 2048, 2065, 2079, 2089, 2099, 2109, 2118, 2128, 2138, 2145, 2155, 2163, 2168, 2181, 2190, 2203, 2216, 2233, 2241, 2248, 2262, 2277, 2296, 2316, 2337, 2357, 2379, 2404, 2433, 2452, 2468, 2490, 2507, 2526, 2552, 2569, 2588, 2602, 2619, 2632, 2651, 2663, 2674, 2689, 2702, 2717, 2731, 2746, 2763, 2777, 2790, 2806, 2823, 2843, 2858, 2878, 2898, 2918, 2934, 2949, 2970, 2985, 3001, 3019, 3036, 3052, 3069, 3088, 3103, 3117, 3133, 3150, 3164, 3176, 3193, 3204, 3216, 3229, 3245, 3262, 3270, 3275, 3286, 3296, 3313, 3334, 3355, 3373, 3389, 3409, 3426, 3445, 3467, 3494, 3512, 3534, 3544, 3563, 3578, 3582}
+var _MemoryKind_index = [...]uint16{0, 7, 19, 30, 44, 55, 69, 88, 100, 118, 142, 155, 164, 173, 188, 209, 232, 256, 273, 291, 297, 317, 331, 363, 395, 413, 435, 460, 476, 496, 519, 546, 562, 581, 600, 619, 642, 665, 685, 709, 722, 740, 762, 788, 814, 833, 853, 871, 887, 907, 923, 941, 962, 981, 996, 1014, 1035, 1058, 1080, 1106, 1121, 1140, 1162, 1184, 1208, 1234, 1258, 1284, 1305, 1326, 1350, 1374, 1394, 1414, 1430, 1446, 1468, 1503, 1523, 1542, 1573, 1602, 1631, 1652, 1677, 1691, 1703, 1719, 1739, 1756, 1775, 1796, 1812, 1831, 1857, 1885, 1913, 1932, 1959, 1986, 2006, 2029, 2050, 2065, 2074, 2089, 2094, 2102, 2119, 2133, 2143, 2153, 2163, 2172, 2182, 2192, 2199, 2209, 2217, 2222, 2235, 2244, 2257, 2270, 2287, 2295, 2302, 2316, 2331, 2351, 2371, 2390, 2410, 2431, 2451, 2473, 2498, 2527, 2546, 2562, 2584, 2601, 2620, 2646, 2663, 2683, 2702, 2716, 2733, 2746, 2765, 2777, 2788, 2803, 2816, 2831, 2844, 2858, 2873, 2885, 2902, 2916, 2929, 2945, 2969, 2986, 3006, 3021, 3041, 3061, 3081, 3097, 3112, 3133, 3148, 3164, 3182, 3199, 3215, 3232, 3251, 3266, 3280, 3296, 3313, 3327, 3339, 3356, 3367, 3379, 3392, 3408, 3425, 3433, 3438, 3449, 3459, 3476, 3497, 3518, 3536, 3552, 3572, 3589, 3608, 3630, 3657, 3675, 3697, 3708, 3718, 3737, 3752, 3756}
 
 func (i MemoryKind) String() string {
 	if i >= MemoryKind(len(_MemoryKind_index)-1) {
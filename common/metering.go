@@ -44,18 +44,20 @@ var (
 
 	// AST
 
-	ProgramMemoryUsage           = NewConstantMemoryUsage(MemoryKindProgram)
-	IdentifierMemoryUsage        = NewConstantMemoryUsage(MemoryKindIdentifier)
-	ArgumentMemoryUsage          = NewConstantMemoryUsage(MemoryKindArgument)
-	BlockMemoryUsage             = NewConstantMemoryUsage(MemoryKindBlock)
-	FunctionBlockMemoryUsage     = NewConstantMemoryUsage(MemoryKindFunctionBlock)
-	ParameterMemoryUsage         = NewConstantMemoryUsage(MemoryKindParameter)
-	ParameterListMemoryUsage     = NewConstantMemoryUsage(MemoryKindParameterList)
-	TypeParameterMemoryUsage     = NewConstantMemoryUsage(MemoryKindTypeParameter)
-	TypeParameterListMemoryUsage = NewConstantMemoryUsage(MemoryKindTypeParameterList)
-	TransferMemoryUsage          = NewConstantMemoryUsage(MemoryKindTransfer)
-	TypeAnnotationMemoryUsage    = NewConstantMemoryUsage(MemoryKindTypeAnnotation)
-	DictionaryEntryMemoryUsage   = NewConstantMemoryUsage(MemoryKindDictionaryEntry)
+	ProgramMemoryUsage              = NewConstantMemoryUsage(MemoryKindProgram)
+	IdentifierMemoryUsage           = NewConstantMemoryUsage(MemoryKindIdentifier)
+	ArgumentMemoryUsage             = NewConstantMemoryUsage(MemoryKindArgument)
+	BlockMemoryUsage                = NewConstantMemoryUsage(MemoryKindBlock)
+	FunctionBlockMemoryUsage        = NewConstantMemoryUsage(MemoryKindFunctionBlock)
+	ParameterMemoryUsage            = NewConstantMemoryUsage(MemoryKindParameter)
+	ParameterListMemoryUsage        = NewConstantMemoryUsage(MemoryKindParameterList)
+	TypeParameterMemoryUsage        = NewConstantMemoryUsage(MemoryKindTypeParameter)
+	TypeParameterListMemoryUsage    = NewConstantMemoryUsage(MemoryKindTypeParameterList)
+	TransferMemoryUsage             = NewConstantMemoryUsage(MemoryKindTransfer)
+	TypeAnnotationMemoryUsage       = NewConstantMemoryUsage(MemoryKindTypeAnnotation)
+	DictionaryEntryMemoryUsage      = NewConstantMemoryUsage(MemoryKindDictionaryEntry)
+	DestructuringPatternMemoryUsage = NewConstantMemoryUsage(MemoryKindDestructuringPattern)
+	DestructuringElementMemoryUsage = NewConstantMemoryUsage(MemoryKindDestructuringElement)
 
 	// AST Declarations
 
@@ -73,6 +75,7 @@ var (
 	VariableDeclarationMemoryUsage           = NewConstantMemoryUsage(MemoryKindVariableDeclaration)
 	SpecialFunctionDeclarationMemoryUsage    = NewConstantMemoryUsage(MemoryKindSpecialFunctionDeclaration)
 	PragmaDeclarationMemoryUsage             = NewConstantMemoryUsage(MemoryKindPragmaDeclaration)
+	TypeAliasDeclarationMemoryUsage          = NewConstantMemoryUsage(MemoryKindTypeAliasDeclaration)
 
 	// AST Statements
 
@@ -86,32 +89,35 @@ var (
 	ReturnStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindReturnStatement)
 	SwapStatementMemoryUsage       = NewConstantMemoryUsage(MemoryKindSwapStatement)
 	SwitchStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindSwitchStatement)
+	SwitchPatternMemoryUsage       = NewConstantMemoryUsage(MemoryKindSwitchPattern)
 	WhileStatementMemoryUsage      = NewConstantMemoryUsage(MemoryKindWhileStatement)
 	RemoveStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindRemoveStatement)
+	TryStatementMemoryUsage        = NewConstantMemoryUsage(MemoryKindTryStatement)
 
 	// AST Expressions
 
-	BooleanExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindBooleanExpression)
-	VoidExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindVoidExpression)
-	NilExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindNilExpression)
-	StringExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindStringExpression)
-	IntegerExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindIntegerExpression)
-	FixedPointExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindFixedPointExpression)
-	IdentifierExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindIdentifierExpression)
-	InvocationExpressionMemoryUsage  = NewConstantMemoryUsage(MemoryKindInvocationExpression)
-	MemberExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindMemberExpression)
-	IndexExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindIndexExpression)
-	ConditionalExpressionMemoryUsage = NewConstantMemoryUsage(MemoryKindConditionalExpression)
-	UnaryExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindUnaryExpression)
-	BinaryExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindBinaryExpression)
-	FunctionExpressionMemoryUsage    = NewConstantMemoryUsage(MemoryKindFunctionExpression)
-	CastingExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindCastingExpression)
-	CreateExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindCreateExpression)
-	DestroyExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindDestroyExpression)
-	ReferenceExpressionMemoryUsage   = NewConstantMemoryUsage(MemoryKindReferenceExpression)
-	ForceExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindForceExpression)
-	PathExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindPathExpression)
-	AttachExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindAttachExpression)
+	BooleanExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindBooleanExpression)
+	VoidExpressionMemoryUsage           = NewConstantMemoryUsage(MemoryKindVoidExpression)
+	NilExpressionMemoryUsage            = NewConstantMemoryUsage(MemoryKindNilExpression)
+	StringExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindStringExpression)
+	StringTemplateExpressionMemoryUsage = NewConstantMemoryUsage(MemoryKindStringTemplateExpression)
+	IntegerExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindIntegerExpression)
+	FixedPointExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindFixedPointExpression)
+	IdentifierExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindIdentifierExpression)
+	InvocationExpressionMemoryUsage     = NewConstantMemoryUsage(MemoryKindInvocationExpression)
+	MemberExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindMemberExpression)
+	IndexExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindIndexExpression)
+	ConditionalExpressionMemoryUsage    = NewConstantMemoryUsage(MemoryKindConditionalExpression)
+	UnaryExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindUnaryExpression)
+	BinaryExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindBinaryExpression)
+	FunctionExpressionMemoryUsage       = NewConstantMemoryUsage(MemoryKindFunctionExpression)
+	CastingExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindCastingExpression)
+	CreateExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindCreateExpression)
+	DestroyExpressionMemoryUsage        = NewConstantMemoryUsage(MemoryKindDestroyExpression)
+	ReferenceExpressionMemoryUsage      = NewConstantMemoryUsage(MemoryKindReferenceExpression)
+	ForceExpressionMemoryUsage          = NewConstantMemoryUsage(MemoryKindForceExpression)
+	PathExpressionMemoryUsage           = NewConstantMemoryUsage(MemoryKindPathExpression)
+	AttachExpressionMemoryUsage         = NewConstantMemoryUsage(MemoryKindAttachExpression)
 
 	// AST Types
 
@@ -140,6 +146,7 @@ var (
 	CompositeTypeInfoMemoryUsage                = NewConstantMemoryUsage(MemoryKindCompositeTypeInfo)
 	CompositeFieldMemoryUsage                   = NewConstantMemoryUsage(MemoryKindCompositeField)
 	DictionaryValueBaseMemoryUsage              = NewConstantMemoryUsage(MemoryKindDictionaryValueBase)
+	SetValueBaseMemoryUsage                     = NewConstantMemoryUsage(MemoryKindSetValueBase)
 	ArrayValueBaseMemoryUsage                   = NewConstantMemoryUsage(MemoryKindArrayValueBase)
 	CompositeValueBaseMemoryUsage               = NewConstantMemoryUsage(MemoryKindCompositeValueBase)
 	AddressValueMemoryUsage                     = NewConstantMemoryUsage(MemoryKindAddressValue)
@@ -171,6 +178,7 @@ var (
 	FunctionStaticTypeMemoryUsage       = NewConstantMemoryUsage(MemoryKindFunctionStaticType)
 	EntitlementMapStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindEntitlementMapStaticAccess)
 	InclusiveRangeStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindInclusiveRangeStaticType)
+	SetStaticTypeMemoryUsage            = NewConstantMemoryUsage(MemoryKindSetStaticType)
 
 	// Sema types
 
@@ -185,6 +193,7 @@ var (
 	EntitlementRelationSemaTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindEntitlementRelationSemaType)
 	CapabilitySemaTypeMemoryUsage          = NewConstantMemoryUsage(MemoryKindCapabilitySemaType)
 	InclusiveRangeSemaTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindInclusiveRangeSemaType)
+	SetSemaTypeMemoryUsage                 = NewConstantMemoryUsage(MemoryKindSetSemaType)
 
 	// Storage related memory usages
 
@@ -197,6 +206,7 @@ var (
 
 	CadenceDictionaryValueMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceDictionaryValue)
 	CadenceInclusiveRangeValueMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceInclusiveRangeValue)
+	CadenceSetValueMemoryUsage                      = NewConstantMemoryUsage(MemoryKindCadenceSetValue)
 	CadenceArrayValueBaseMemoryUsage                = NewConstantMemoryUsage(MemoryKindCadenceArrayValueBase)
 	CadenceStructValueBaseMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceStructValueBase)
 	CadenceResourceValueBaseMemoryUsage             = NewConstantMemoryUsage(MemoryKindCadenceResourceValueBase)
@@ -224,6 +234,7 @@ var (
 	CadenceContractTypeMemoryUsage             = NewConstantMemoryUsage(MemoryKindCadenceContractType)
 	CadenceDictionaryTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceDictionaryType)
 	CadenceInclusiveRangeTypeMemoryUsage       = NewConstantMemoryUsage(MemoryKindCadenceInclusiveRangeType)
+	CadenceSetTypeMemoryUsage                  = NewConstantMemoryUsage(MemoryKindCadenceSetType)
 	CadenceEnumTypeMemoryUsage                 = NewConstantMemoryUsage(MemoryKindCadenceEnumType)
 	CadenceEventTypeMemoryUsage                = NewConstantMemoryUsage(MemoryKindCadenceEventType)
 	CadenceFunctionTypeMemoryUsage             = NewConstantMemoryUsage(MemoryKindCadenceFunctionType)
@@ -273,6 +284,7 @@ var (
 	IntersectionStaticTypeStringMemoryUsage          = NewRawStringMemoryUsage(2)  // {}
 	IntersectionStaticTypeSeparatorStringMemoryUsage = NewRawStringMemoryUsage(2)  // ,
 	InclusiveRangeStaticTypeStringMemoryUsage        = NewRawStringMemoryUsage(16) // InclusiveRange<>
+	SetStaticTypeStringMemoryUsage                   = NewRawStringMemoryUsage(5)  // Set<>
 )
 
 func UseMemory(gauge MemoryGauge, usage MemoryUsage) {
@@ -0,0 +1,280 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/binary"
+	goErrors "errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var InvalidPathComponentsError = goErrors.New("invalid path components")
+
+// PathComponentKind is the kind of a component of an extended path.
+//
+// NOTE: the kind is part of the storage key of extended paths.
+// NEVER change, only add/increment
+type PathComponentKind uint8
+
+const (
+	PathComponentKindUnknown PathComponentKind = iota
+	PathComponentKindAddress
+	PathComponentKindInteger
+	PathComponentKindType
+
+	// !!! *WARNING* !!!
+	// ADD NEW KINDS *BEFORE* THIS WARNING.
+	// DO *NOT* ADD NEW KINDS AFTER THIS LINE!
+	PathComponentKind_Count
+)
+
+func (k PathComponentKind) Name() string {
+	switch k {
+	case PathComponentKindAddress:
+		return "address"
+	case PathComponentKindInteger:
+		return "integer"
+	case PathComponentKindType:
+		return "type"
+	}
+
+	return ""
+}
+
+// PathComponentKindFromName returns the path component kind with the given name,
+// or PathComponentKindUnknown if there is no such kind
+func PathComponentKindFromName(name string) PathComponentKind {
+	for kind := PathComponentKindUnknown + 1; kind < PathComponentKind_Count; kind++ {
+		if kind.Name() == name {
+			return kind
+		}
+	}
+
+	return PathComponentKindUnknown
+}
+
+// PathComponent is a component of an extended path,
+// e.g. the `42` in the path `/storage/vault/\(42)`.
+//
+// The value is the canonical encoding of the embedded value:
+// The hex encoding of an address, e.g. `0x0000000000000001`,
+// the decimal encoding of an integer, e.g. `-42`,
+// or the ID of a type, e.g. `A.0000000000000001.FlowToken.Vault`.
+type PathComponent struct {
+	Value string
+	Kind  PathComponentKind
+}
+
+// NewAddressPathComponent returns the path component for the given address
+func NewAddressPathComponent(address Address) PathComponent {
+	return PathComponent{
+		Kind:  PathComponentKindAddress,
+		Value: address.HexWithPrefix(),
+	}
+}
+
+// NewIntegerPathComponent returns the path component for the given integer.
+//
+// The component only depends on the value of the integer, not its type,
+// so e.g. `1 as UInt8` and `1 as Int` result in the same component
+func NewIntegerPathComponent(value *big.Int) PathComponent {
+	return PathComponent{
+		Kind:  PathComponentKindInteger,
+		Value: value.String(),
+	}
+}
+
+// NewTypePathComponent returns the path component for the type with the given ID
+func NewTypePathComponent(typeID TypeID) PathComponent {
+	return PathComponent{
+		Kind:  PathComponentKindType,
+		Value: string(typeID),
+	}
+}
+
+// ParsePathComponent returns the path component of the given kind for the given encoded value,
+// e.g. when decoding an extended path.
+// An error is returned if the value is not the canonical encoding of a value of the kind
+func ParsePathComponent(kind PathComponentKind, value string) (PathComponent, error) {
+	var component PathComponent
+
+	switch kind {
+	case PathComponentKindAddress:
+		address, err := HexToAddressAssertPrefix(value)
+		if err != nil {
+			return PathComponent{}, err
+		}
+		component = NewAddressPathComponent(address)
+
+	case PathComponentKindInteger:
+		integer, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return PathComponent{}, fmt.Errorf("invalid integer path component: %q", value)
+		}
+		component = NewIntegerPathComponent(integer)
+
+	case PathComponentKindType:
+		// NOTE: the ID of an unknown type is empty
+		component = NewTypePathComponent(TypeID(value))
+
+	default:
+		return PathComponent{}, fmt.Errorf("invalid path component kind: %d", kind)
+	}
+
+	if component.Value != value {
+		return PathComponent{}, fmt.Errorf(
+			"invalid %s path component: %q is not canonical, expected %q",
+			kind.Name(),
+			value,
+			component.Value,
+		)
+	}
+
+	return component, nil
+}
+
+// String returns the component as it is written in a path expression,
+// e.g. `0x0000000000000001`, `42`, or `Type<Int>()`
+func (c PathComponent) String() string {
+	switch c.Kind {
+	case PathComponentKindType:
+		return fmt.Sprintf("Type<%s>()", c.Value)
+	default:
+		return c.Value
+	}
+}
+
+// PathComponents are the components of an extended path.
+//
+// The components are kept separately from the identifier of the path,
+// and they are held in a canonical encoding, so that paths stay comparable.
+// The encoding of each component is its kind (1 byte),
+// the length of its value (unsigned varint), and its value.
+//
+// The zero value are the components of a path without components.
+type PathComponents struct {
+	encoded string
+}
+
+// NewPathComponents returns the path components for the given components
+func NewPathComponents(components ...PathComponent) PathComponents {
+	if len(components) == 0 {
+		return PathComponents{}
+	}
+
+	var builder strings.Builder
+	var lengthBuffer [binary.MaxVarintLen64]byte
+
+	for _, component := range components {
+		builder.WriteByte(byte(component.Kind))
+		lengthLength := binary.PutUvarint(lengthBuffer[:], uint64(len(component.Value)))
+		builder.Write(lengthBuffer[:lengthLength])
+		builder.WriteString(component.Value)
+	}
+
+	return PathComponents{
+		encoded: builder.String(),
+	}
+}
+
+// DecodePathComponents returns the path components with the given encoding, see PathComponents.Encoded.
+// An error is returned if the encoding is invalid or not canonical
+func DecodePathComponents(encoded string) (PathComponents, error) {
+	components, err := decodePathComponents(encoded)
+	if err != nil {
+		return PathComponents{}, err
+	}
+
+	for _, component := range components {
+		_, err := ParsePathComponent(component.Kind, component.Value)
+		if err != nil {
+			return PathComponents{}, err
+		}
+	}
+
+	// Ensure the encoding is canonical, e.g. that the lengths are minimally encoded,
+	// so equal components always have the same encoding
+	result := NewPathComponents(components...)
+	if result.encoded != encoded {
+		return PathComponents{}, InvalidPathComponentsError
+	}
+
+	return result, nil
+}
+
+func decodePathComponents(encoded string) ([]PathComponent, error) {
+	var components []PathComponent
+
+	for len(encoded) > 0 {
+		kind := PathComponentKind(encoded[0])
+		encoded = encoded[1:]
+
+		length, lengthLength := binary.Uvarint([]byte(encoded[:min(len(encoded), binary.MaxVarintLen64)]))
+		if lengthLength <= 0 || length > uint64(len(encoded)-lengthLength) {
+			return nil, InvalidPathComponentsError
+		}
+		encoded = encoded[lengthLength:]
+
+		components = append(
+			components,
+			PathComponent{
+				Kind:  kind,
+				Value: encoded[:length],
+			},
+		)
+		encoded = encoded[length:]
+	}
+
+	return components, nil
+}
+
+// IsEmpty returns true if there are no components
+func (c PathComponents) IsEmpty() bool {
+	return c.encoded == ""
+}
+
+// Encoded returns the canonical encoding of the components
+func (c PathComponents) Encoded() string {
+	return c.encoded
+}
+
+// Components returns the components
+func (c PathComponents) Components() []PathComponent {
+	components, err := decodePathComponents(c.encoded)
+	if err != nil {
+		// The encoding is always valid, it is only produced by NewPathComponents
+		// or validated by DecodePathComponents
+		panic(err)
+	}
+	return components
+}
+
+// String returns the components as they are written in a path expression,
+// e.g. `/\(42)/\(Type<Int>())`
+func (c PathComponents) String() string {
+	var builder strings.Builder
+	for _, component := range c.Components() {
+		builder.WriteString(`/\(`)
+		builder.WriteString(component.String())
+		builder.WriteByte(')')
+	}
+	return builder.String()
+}
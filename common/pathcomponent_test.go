@@ -0,0 +1,156 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePathComponent(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("address", func(t *testing.T) {
+
+		t.Parallel()
+
+		component, err := ParsePathComponent(PathComponentKindAddress, "0x0000000000000001")
+		require.NoError(t, err)
+		assert.Equal(t,
+			NewAddressPathComponent(Address{0, 0, 0, 0, 0, 0, 0, 0x1}),
+			component,
+		)
+
+		_, err = ParsePathComponent(PathComponentKindAddress, "0x1")
+		require.Error(t, err)
+	})
+
+	t.Run("integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		component, err := ParsePathComponent(PathComponentKindInteger, "-42")
+		require.NoError(t, err)
+		assert.Equal(t,
+			NewIntegerPathComponent(big.NewInt(-42)),
+			component,
+		)
+
+		_, err = ParsePathComponent(PathComponentKindInteger, "042")
+		require.Error(t, err)
+
+		_, err = ParsePathComponent(PathComponentKindInteger, "+42")
+		require.Error(t, err)
+
+		_, err = ParsePathComponent(PathComponentKindInteger, "foo")
+		require.Error(t, err)
+	})
+
+	t.Run("type", func(t *testing.T) {
+
+		t.Parallel()
+
+		component, err := ParsePathComponent(PathComponentKindType, "Int")
+		require.NoError(t, err)
+		assert.Equal(t,
+			NewTypePathComponent("Int"),
+			component,
+		)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParsePathComponent(PathComponentKindUnknown, "42")
+		require.Error(t, err)
+	})
+}
+
+func TestPathComponents(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		components := NewPathComponents()
+		assert.True(t, components.IsEmpty())
+		assert.Equal(t, PathComponents{}, components)
+		assert.Equal(t, "", components.String())
+	})
+
+	t.Run("encode and decode", func(t *testing.T) {
+
+		t.Parallel()
+
+		components := NewPathComponents(
+			NewAddressPathComponent(Address{0, 0, 0, 0, 0, 0, 0, 0x1}),
+			NewIntegerPathComponent(big.NewInt(42)),
+			NewTypePathComponent("Int"),
+		)
+		assert.False(t, components.IsEmpty())
+		assert.Equal(t,
+			`/\(0x0000000000000001)/\(42)/\(Type<Int>())`,
+			components.String(),
+		)
+
+		decoded, err := DecodePathComponents(components.Encoded())
+		require.NoError(t, err)
+		assert.Equal(t, components, decoded)
+	})
+
+	t.Run("decode, non-canonical length", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The length 2 is encoded in two bytes
+		_, err := DecodePathComponents(string([]byte{byte(PathComponentKindInteger), 0x82, 0x0, '4', '2'}))
+		require.Error(t, err)
+	})
+
+	t.Run("decode, non-canonical value", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DecodePathComponents(string([]byte{byte(PathComponentKindInteger), 0x3, '0', '4', '2'}))
+		require.Error(t, err)
+	})
+
+	t.Run("decode, truncated", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DecodePathComponents(string([]byte{byte(PathComponentKindInteger), 0x3, '4', '2'}))
+		require.Error(t, err)
+	})
+
+	t.Run("decode, invalid kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DecodePathComponents(string([]byte{byte(PathComponentKind_Count), 0x2, '4', '2'}))
+		require.Error(t, err)
+	})
+}
@@ -108,11 +108,17 @@ func (compiler *Compiler) VisitSwitchStatement(_ *ast.SwitchStatement) ir.Stmt {
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTryStatement(_ *ast.TryStatement) ir.Stmt {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitVariableDeclaration(declaration *ast.VariableDeclaration) ir.Stmt {
 
 	// TODO: potential storage removal
 	// TODO: copy and convert
 	// TODO: second value
+	// TODO: destructuring
 
 	identifier := declaration.Identifier.Identifier
 	targetType := compiler.Checker.Elaboration.VariableDeclarationTypes(declaration).TargetType
@@ -254,6 +260,11 @@ func (compiler *Compiler) VisitStringExpression(e *ast.StringExpression) ir.Expr
 	}
 }
 
+func (compiler *Compiler) VisitStringTemplateExpression(_ *ast.StringTemplateExpression) ir.Expr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitCastingExpression(_ *ast.CastingExpression) ir.Expr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -400,6 +411,11 @@ func (compiler *Compiler) VisitEntitlementMappingDeclaration(_ *ast.EntitlementM
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ir.Stmt {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclaration) ir.Stmt {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -2861,6 +2861,160 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeTimestamp(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			name: "Zero",
+			val:  cadence.Timestamp(0),
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Timestamp","value":"1970-01-01T00:00:00Z"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), 0])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Timestamp type ID (99)
+				0x18, 0x63,
+				// 0
+				0x00,
+			},
+		},
+		{
+			name: "2020-03-13T19:52:43Z",
+			val:  cadence.Timestamp(158_412_916_300_000_000),
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Timestamp","value":"2020-03-13T19:52:43Z"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), 158412916300000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Timestamp type ID (99)
+				0x18, 0x63,
+				// 158412916300000000
+				0x1b, 0x02, 0x32, 0xcb, 0xb5, 0x43, 0xe5, 0x2b, 0x00,
+			},
+		},
+		{
+			name: "1969-12-31T23:59:59.5Z",
+			val:  cadence.Timestamp(-50_000_000),
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Timestamp","value":"1969-12-31T23:59:59.5Z"}
+				//
+				// language=edn, format=ccf
+				// 130([137(99), -50000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Timestamp type ID (99)
+				0x18, 0x63,
+				// -50000000
+				0x3a, 0x02, 0xfa, 0xf0, 0x7f,
+			},
+		},
+	}...)
+}
+
+func TestEncodeDuration(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			name: "Zero",
+			val:  cadence.Duration(0),
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Duration","value":"0.00000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), 0])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Duration type ID (100)
+				0x18, 0x64,
+				// 0
+				0x00,
+			},
+		},
+		{
+			name: "86400",
+			val:  cadence.Duration(8_640_000_000_000),
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Duration","value":"86400.00000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), 8640000000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Duration type ID (100)
+				0x18, 0x64,
+				// 8640000000000
+				0x1b, 0x00, 0x00, 0x07, 0xdb, 0xa8, 0x21, 0x80, 0x00,
+			},
+		},
+		{
+			name: "-1.5",
+			val:  cadence.Duration(-150_000_000),
+			expected: []byte{
+				// language=json, format=json-cdc
+				// {"type":"Duration","value":"-1.50000000"}
+				//
+				// language=edn, format=ccf
+				// 130([137(100), -150000000])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 items follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Duration type ID (100)
+				0x18, 0x64,
+				// -150000000
+				0x3a, 0x08, 0xf0, 0xd1, 0x7f,
+			},
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -5816,6 +5970,107 @@ func TestEncodeInclusiveRange(t *testing.T) {
 	)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	setType := cadence.NewSetType(cadence.Int8Type)
+
+	emptySet := encodeTest{
+		name: "empty",
+		val:  cadence.NewSet([]cadence.Value{}).WithType(setType),
+		expected: []byte{
+			// language=json, format=json-cdc
+			// {"type":"Set","value":[]}
+			//
+			// language=edn, format=ccf
+			// 130([148(137(5)), []])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// type (Set<Int8>)
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// Int8 type ID (5)
+			0x05,
+			// array, 0 items follow
+			0x80,
+		},
+	}
+
+	unsortedSet := encodeTest{
+		name: "unsorted",
+		val: cadence.NewSet([]cadence.Value{
+			cadence.NewInt8(3),
+			cadence.NewInt8(1),
+		}).WithType(setType),
+		expected: []byte{
+			// language=json, format=json-cdc
+			// {"type":"Set","value":[{"type":"Int8","value":"3"},{"type":"Int8","value":"1"}]}
+			//
+			// language=edn, format=ccf
+			// 130([148(137(5)), [1, 3]])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// type (Set<Int8>)
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// Int8 type ID (5)
+			0x05,
+			// array, 2 items follow
+			0x82,
+			// 1
+			0x01,
+			// 3
+			0x03,
+		},
+		expectedVal: cadence.NewSet([]cadence.Value{
+			cadence.NewInt8(1),
+			cadence.NewInt8(3),
+		}).WithType(setType),
+	}
+
+	testAllEncodeAndDecode(t,
+		emptySet,
+		unsortedSet,
+	)
+
+	t.Run("unsorted elements", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ccf.Decode(nil, []byte{
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 items follow
+			0x82,
+			// tag
+			0xd8, ccf.CBORTagSetType,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// Int8 type ID (5)
+			0x05,
+			// array, 2 items follow
+			0x82,
+			// 3
+			0x03,
+			// 1
+			0x01,
+		})
+		require.ErrorContains(t, err, "encoded set-value elements are not sorted")
+	})
+}
+
 func TestEncodeEvent(t *testing.T) {
 
 	t.Parallel()
@@ -8474,6 +8729,8 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		ccf.SimpleTypeBool:                             cadence.BoolType,
 		ccf.SimpleTypeString:                           cadence.StringType,
 		ccf.SimpleTypeCharacter:                        cadence.CharacterType,
+		ccf.SimpleTypeTimestamp:                        cadence.TimestampType,
+		ccf.SimpleTypeDuration:                         cadence.DurationType,
 		ccf.SimpleTypeBytes:                            cadence.TheBytesType,
 		ccf.SimpleTypeAddress:                          cadence.AddressType,
 		ccf.SimpleTypeNumber:                           cadence.NumberType,
@@ -8796,6 +9053,43 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static Set<Int>", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.SetType{
+					ElementType: cadence.IntType,
+				},
+			},
+			[]byte{
+				// language=json, format=json-cdc
+				// {"type":"Type","value":{"staticType":{"kind":"Set", "type" : {"kind" : "Int"}}}}
+				//
+				// language=edn, format=ccf
+				// 130([137(41), 197(185(4))])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 elements follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// Meta type ID (41)
+				0x18, 0x29,
+				// tag
+				0xd8, ccf.CBORTagSetTypeValue,
+				// tag
+				0xd8, ccf.CBORTagSimpleTypeValue,
+				// Int type (4)
+				0x04,
+			},
+		)
+
+	})
+
 	t.Run("with static InclusiveRange<Int>", func(t *testing.T) {
 		t.Parallel()
 
@@ -12349,6 +12643,134 @@ func TestEncodePath(t *testing.T) {
 		)
 	})
 
+	t.Run("Storage, extended", func(t *testing.T) {
+		t.Parallel()
+
+		path, err := cadence.NewExtendedPath(
+			common.PathDomainStorage,
+			"foo",
+			cadence.NewInt(42),
+			cadence.NewTypeValue(cadence.IntType),
+		)
+		require.NoError(t, err)
+
+		testEncodeAndDecode(
+			t,
+			path,
+			[]byte{
+				// language=json, format=json-cdc
+				// {"type":"Path","value":{"domain":"storage","identifier":"foo","components":[{"kind":"integer","value":"42"},{"kind":"type","value":"Int"}]}}
+				//
+				// language=edn, format=ccf
+				// 130([137(26), [1, "foo", [[2, "42"], [3, "Int"]]]])
+				//
+				// language=cbor, format=ccf
+				// tag
+				0xd8, ccf.CBORTagTypeAndValue,
+				// array, 2 elements follow
+				0x82,
+				// tag
+				0xd8, ccf.CBORTagSimpleType,
+				// StoragePath type ID (26)
+				0x18, 0x1a,
+				// array, 3 elements follow
+				0x83,
+				// 1
+				0x01,
+				// string, 3 bytes follow
+				0x63,
+				// foo
+				0x66, 0x6f, 0x6f,
+				// array, 2 elements follow
+				0x82,
+				// array, 2 elements follow
+				0x82,
+				// 2
+				0x02,
+				// string, 2 bytes follow
+				0x62,
+				// 42
+				0x34, 0x32,
+				// array, 2 elements follow
+				0x82,
+				// 3
+				0x03,
+				// string, 3 bytes follow
+				0x63,
+				// Int
+				0x49, 0x6e, 0x74,
+			},
+		)
+	})
+
+	t.Run("Storage, extended, invalid component", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ccf.Decode(nil, []byte{
+			// language=edn, format=ccf
+			// 130([137(26), [1, "foo", [[2, "042"]]]])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 elements follow
+			0x82,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// StoragePath type ID (26)
+			0x18, 0x1a,
+			// array, 3 elements follow
+			0x83,
+			// 1
+			0x01,
+			// string, 3 bytes follow
+			0x63,
+			// foo
+			0x66, 0x6f, 0x6f,
+			// array, 1 element follows
+			0x81,
+			// array, 2 elements follow
+			0x82,
+			// 2
+			0x02,
+			// string, 3 bytes follow
+			0x63,
+			// 042
+			0x30, 0x34, 0x32,
+		})
+		require.ErrorContains(t, err, "not canonical")
+	})
+
+	t.Run("Storage, extended, empty components", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ccf.Decode(nil, []byte{
+			// language=edn, format=ccf
+			// 130([137(26), [1, "foo", []]])
+			//
+			// language=cbor, format=ccf
+			// tag
+			0xd8, ccf.CBORTagTypeAndValue,
+			// array, 2 elements follow
+			0x82,
+			// tag
+			0xd8, ccf.CBORTagSimpleType,
+			// StoragePath type ID (26)
+			0x18, 0x1a,
+			// array, 3 elements follow
+			0x83,
+			// 1
+			0x01,
+			// string, 3 bytes follow
+			0x63,
+			// foo
+			0x66, 0x6f, 0x6f,
+			// array, 0 elements follow
+			0x80,
+		})
+		require.ErrorContains(t, err, "empty components")
+	})
+
 	t.Run("Array of StoragePath", func(t *testing.T) {
 		t.Parallel()
 
@@ -59,7 +59,7 @@ const (
 	_
 
 	// CBOR tag numbers (136-183) for types
-	// inline types (145-159 are reserved)
+	// inline types (149-159 are reserved)
 	CBORTagTypeRef
 	CBORTagSimpleType
 	CBORTagOptionalType
@@ -72,7 +72,7 @@ const (
 	CBORTagInclusiveRangeType
 	CBORTagEntitlementSetAuthorizationAccessType
 	CBORTagEntitlementMapAuthorizationAccessType
-	_
+	CBORTagSetType
 	_
 	_
 	_
@@ -114,7 +114,7 @@ const (
 	_
 
 	// CBOR tag numbers (184-231) for type value
-	// non-composite and non-interface type values (194-207 are reserved)
+	// non-composite and non-interface type values (198-207 are reserved)
 	CBORTagTypeValueRef
 	CBORTagSimpleTypeValue
 	CBORTagOptionalTypeValue
@@ -128,7 +128,7 @@ const (
 	CBORTagInclusiveRangeTypeValue // InclusiveRange is stored as a composite value.
 	CBORTagEntitlementSetAuthorizationAccessTypeValue
 	CBORTagEntitlementMapAuthorizationAccessTypeValue
-	_
+	CBORTagSetTypeValue
 	_
 	_
 	_
@@ -412,6 +412,8 @@ func (d *Decoder) decodeTypeAndValue(types *cadenceTypeByCCFTypeID) (cadence.Val
 //	/ word256-value
 //	/ fix64-value
 //	/ ufix64-value
+//	/ timestamp-value
+//	/ duration-value
 func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (cadence.Value, error) {
 	if t == nil {
 		return nil, fmt.Errorf("unexpected nil type")
@@ -506,6 +508,12 @@ func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (ca
 	case cadence.UFix64Type:
 		return d.decodeUFix64()
 
+	case cadence.TimestampType:
+		return d.decodeTimestamp()
+
+	case cadence.DurationType:
+		return d.decodeDuration()
+
 	case cadence.StoragePathType:
 		return d.decodePath()
 
@@ -569,6 +577,9 @@ func (d *Decoder) decodeValue(t cadence.Type, types *cadenceTypeByCCFTypeID) (ca
 	case *cadence.InclusiveRangeType:
 		return d.decodeInclusiveRange(t, types)
 
+	case *cadence.SetType:
+		return d.decodeSet(t, types)
+
 	default:
 		nt, err := d.dec.NextType()
 		if err != nil {
@@ -1026,6 +1037,28 @@ func (d *Decoder) decodeUFix64() (cadence.Value, error) {
 	return cadence.NewMeteredUFix64FromRawFixedPointNumber(d.gauge, i)
 }
 
+// decodeTimestamp decodes timestamp-value as
+// language=CDDL
+// timestamp-value = (int .ge -9223372036854775808) .le 9223372036854775807
+func (d *Decoder) decodeTimestamp() (cadence.Value, error) {
+	i, err := d.dec.DecodeInt64()
+	if err != nil {
+		return nil, err
+	}
+	return cadence.NewMeteredTimestampFromRawFixedPointNumber(d.gauge, i), nil
+}
+
+// decodeDuration decodes duration-value as
+// language=CDDL
+// duration-value = (int .ge -9223372036854775808) .le 9223372036854775807
+func (d *Decoder) decodeDuration() (cadence.Value, error) {
+	i, err := d.dec.DecodeInt64()
+	if err != nil {
+		return nil, err
+	}
+	return cadence.NewMeteredDurationFromRawFixedPointNumber(d.gauge, i), nil
+}
+
 // decodeOptional decodes encoded optional-value as
 // language=CDDL
 // optional-value = nil / value
@@ -1180,6 +1213,63 @@ func (d *Decoder) decodeDictionary(typ *cadence.DictionaryType, types *cadenceTy
 	return value.WithType(typ), nil
 }
 
+// decodeSet decodes encoded set-value as
+// language=CDDL
+// set-value = [* value]
+func (d *Decoder) decodeSet(typ *cadence.SetType, types *cadenceTypeByCCFTypeID) (cadence.Value, error) {
+	// Decode array length.
+	n, err := d.dec.DecodeArrayHead()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		int(n),
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, n)
+
+			// previousElementRawBytes is used to determine if set elements are sorted
+			var previousElementRawBytes []byte
+
+			for i := 0; i < int(n); i++ {
+				// Decode element as raw bytes to check that elements are sorted.
+				elementRawBytes, err := d.dec.DecodeRawBytes()
+				if err != nil {
+					return nil, err
+				}
+
+				// "Deterministic CCF Encoding Requirements" in CCF specs:
+				//
+				//   "set-value elements MUST be sorted."
+				if !bytesAreSortedBytewise(previousElementRawBytes, elementRawBytes) {
+					return nil, fmt.Errorf("encoded set-value elements are not sorted")
+				}
+
+				previousElementRawBytes = elementRawBytes
+
+				// decode element from raw bytes
+				elementDecoder := d.dm.NewDecoder(d.gauge, elementRawBytes)
+				element, err := elementDecoder.decodeValue(typ.ElementType, types)
+				if err != nil {
+					return nil, err
+				}
+
+				values[i] = element
+			}
+
+			// Like dictionary keys, uniqueness of set elements
+			// is not checked here and is delegated to Cadence runtime.
+			return values, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.WithType(typ), nil
+}
+
 // decodeComposite decodes encoded composite-value as
 // language=CDDL
 // composite-value = [* (field: value)]
@@ -1413,14 +1503,25 @@ func (d *Decoder) decodeInclusiveRange(typ *cadence.InclusiveRangeType, types *c
 //
 //	domain: uint,
 //	identifier: tstr,
+//	? components: [+ path-component-value],
+//
+// ]
+//
+// path-component-value = [
+//
+//	kind: uint,
+//	value: tstr,
 //
 // ]
 func (d *Decoder) decodePath() (cadence.Value, error) {
-	// Decode array head of length 2.
-	err := decodeCBORArrayWithKnownSize(d.dec, 2)
+	// Decode array head of length 2, or 3 for extended paths.
+	n, err := d.dec.DecodeArrayHead()
 	if err != nil {
 		return nil, err
 	}
+	if n != 2 && n != 3 {
+		return nil, fmt.Errorf("CBOR array has %d elements (expected 2 or 3 elements)", n)
+	}
 
 	// Decode domain.
 	pathDomain, err := d.dec.DecodeUint64()
@@ -1440,7 +1541,74 @@ func (d *Decoder) decodePath() (cadence.Value, error) {
 		Amount: uint64(len(identifier)),
 	})
 
-	return cadence.NewMeteredPath(d.gauge, common.PathDomain(pathDomain), identifier)
+	path, err := cadence.NewMeteredPath(d.gauge, common.PathDomain(pathDomain), identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 3 {
+		// Decode components.
+		path.Components, err = d.decodePathComponents()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return path, nil
+}
+
+// decodePathComponents decodes the components of an extended path-value as
+// language=CDDL
+// components: [+ path-component-value]
+func (d *Decoder) decodePathComponents() (common.PathComponents, error) {
+	n, err := d.dec.DecodeArrayHead()
+	if err != nil {
+		return common.PathComponents{}, err
+	}
+	if n == 0 {
+		return common.PathComponents{}, errors.New("encoded path-value has empty components")
+	}
+
+	components := make([]common.PathComponent, 0, n)
+
+	for i := uint64(0); i < n; i++ {
+		// Decode array head of length 2.
+		err := decodeCBORArrayWithKnownSize(d.dec, 2)
+		if err != nil {
+			return common.PathComponents{}, err
+		}
+
+		// Decode kind.
+		kind, err := d.dec.DecodeUint64()
+		if err != nil {
+			return common.PathComponents{}, err
+		}
+		if kind == uint64(common.PathComponentKindUnknown) ||
+			kind >= uint64(common.PathComponentKind_Count) {
+
+			return common.PathComponents{}, fmt.Errorf("encoded path-value has invalid component kind %d", kind)
+		}
+
+		// Decode value.
+		value, err := d.dec.DecodeString()
+		if err != nil {
+			return common.PathComponents{}, err
+		}
+
+		common.UseMemory(d.gauge, common.MemoryUsage{
+			Kind:   common.MemoryKindRawString,
+			Amount: uint64(len(value)),
+		})
+
+		component, err := common.ParsePathComponent(common.PathComponentKind(kind), value)
+		if err != nil {
+			return common.PathComponents{}, err
+		}
+
+		components = append(components, component)
+	}
+
+	return common.NewPathComponents(components...), nil
 }
 
 // decodeCapability decodes encoded capability-value as
@@ -1564,6 +1732,9 @@ func (d *Decoder) decodeTypeValue(visited *cadenceTypeByCCFTypeID) (cadence.Type
 	case CBORTagInclusiveRangeTypeValue:
 		return d.decodeInclusiveRangeType(visited, d.decodeTypeValue)
 
+	case CBORTagSetTypeValue:
+		return d.decodeSetType(visited, d.decodeTypeValue)
+
 	case CBORTagCapabilityTypeValue:
 		return d.decodeCapabilityType(visited, d.decodeNullableTypeValue)
 
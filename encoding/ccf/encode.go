@@ -466,6 +466,8 @@ func (e *Encoder) encodeTypeDefs(types []cadence.Type, tids ccfTypeIDByCadenceTy
 //	/ word256-value
 //	/ fix64-value
 //	/ ufix64-value
+//	/ timestamp-value
+//	/ duration-value
 //
 // IMPORTANT:
 // "Valid CCF Encoding Requirements" in CCF Specification states:
@@ -605,6 +607,12 @@ func (e *Encoder) encodeValue(
 	case cadence.UFix64:
 		return e.encodeUFix64(v)
 
+	case cadence.Timestamp:
+		return e.encodeTimestamp(v)
+
+	case cadence.Duration:
+		return e.encodeDuration(v)
+
 	case cadence.Array:
 		return e.encodeArray(v, tids)
 
@@ -614,6 +622,9 @@ func (e *Encoder) encodeValue(
 	case *cadence.InclusiveRange:
 		return e.encodeInclusiveRange(v, tids)
 
+	case cadence.Set:
+		return e.encodeSet(v, tids)
+
 	case cadence.Struct:
 		return e.encodeStruct(v, tids)
 
@@ -873,6 +884,20 @@ func (e *Encoder) encodeUFix64(v cadence.UFix64) error {
 	return e.enc.EncodeUint64(uint64(v))
 }
 
+// encodeTimestamp encodes cadence.Timestamp as
+// language=CDDL
+// timestamp-value = (int .ge -9223372036854775808) .le 9223372036854775807
+func (e *Encoder) encodeTimestamp(v cadence.Timestamp) error {
+	return e.enc.EncodeInt64(int64(v))
+}
+
+// encodeDuration encodes cadence.Duration as
+// language=CDDL
+// duration-value = (int .ge -9223372036854775808) .le 9223372036854775807
+func (e *Encoder) encodeDuration(v cadence.Duration) error {
+	return e.enc.EncodeInt64(int64(v))
+}
+
 // encodeArray encodes cadence.Array as
 // language=CDDL
 // array-value = [* value]
@@ -1056,6 +1081,91 @@ func (e *Encoder) encodeInclusiveRange(v *cadence.InclusiveRange, tids ccfTypeID
 	return e.encodeValue(v.Step, staticElementType, tids)
 }
 
+// encodeSet encodes cadence.Set as
+// language=CDDL
+// set-value = [* value]
+func (e *Encoder) encodeSet(v cadence.Set, tids ccfTypeIDByCadenceType) error {
+	// "Deterministic CCF Encoding Requirements" in CCF specs:
+	//
+	//   "set-value elements MUST be sorted."
+
+	// Use a new buffer for sorting elements.
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	// Encode and sort elements.
+	sortedElements, err := encodeAndSortSetElements(buf, v, tids, e.em)
+	if err != nil {
+		return err
+	}
+
+	// Encode array head with number of elements.
+	err = e.enc.EncodeArrayHead(uint64(len(v.Values)))
+	if err != nil {
+		return err
+	}
+
+	for _, element := range sortedElements {
+		// Encode element.
+		err = e.enc.EncodeRawBytes(element.encodedKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeAndSortSetElements(
+	buf *bytes.Buffer,
+	v cadence.Set,
+	tids ccfTypeIDByCadenceType,
+	em *encMode,
+) (
+	[]encodedKeyValuePair,
+	error,
+) {
+	staticElementType := v.SetType.ElementType
+
+	encodedElements := make([]encodedKeyValuePair, len(v.Values))
+
+	e := em.NewEncoder(buf)
+
+	for i, element := range v.Values {
+
+		off := buf.Len()
+
+		// Encode element as value.
+		err := e.encodeValue(element, staticElementType, tids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Get encoded element length (must flush first).
+		e.enc.Flush()
+		length := buf.Len() - off
+
+		encodedElements[i] = encodedKeyValuePair{keyLength: length, pairLength: length}
+	}
+
+	// Reslice buf for encoded elements by offset and length.
+	b := buf.Bytes()
+	off := 0
+	for i := 0; i < len(encodedElements); i++ {
+		encodedElements[i].encodedKey = b[off : off+encodedElements[i].keyLength]
+		encodedElements[i].encodedPair = encodedElements[i].encodedKey
+		off += encodedElements[i].keyLength
+	}
+	if off != len(b) {
+		// Sanity check
+		panic(cadenceErrors.NewUnexpectedError("encoded set elements' offset %d doesn't match buffer length %d", off, len(b)))
+	}
+
+	sort.Sort(bytewiseKeyValuePairSorter(encodedElements))
+
+	return encodedElements, nil
+}
+
 //go:linkname getCompositeFieldValues github.com/onflow/cadence.getCompositeFieldValues
 func getCompositeFieldValues(cadence.Composite) []cadence.Value
 
@@ -1216,14 +1326,36 @@ func (e *Encoder) encodeComposite(
 //
 //	domain: uint,
 //	identifier: tstr,
+//	? components: [+ path-component-value],
+//
+// ]
+//
+// path-component-value = [
+//
+//	kind: uint,
+//	value: tstr,
 //
 // ]
+//
+// The components are only encoded for extended paths,
+// so the encoding of paths without components is unchanged.
 func (e *Encoder) encodePath(x cadence.Path) error {
-	// Encode array head with length 2.
-	err := e.enc.EncodeRawBytes([]byte{
-		// array, 2 items follow
-		0x82,
-	})
+	hasComponents := !x.Components.IsEmpty()
+
+	var err error
+	if hasComponents {
+		// Encode array head with length 3.
+		err = e.enc.EncodeRawBytes([]byte{
+			// array, 3 items follow
+			0x83,
+		})
+	} else {
+		// Encode array head with length 2.
+		err = e.enc.EncodeRawBytes([]byte{
+			// array, 2 items follow
+			0x82,
+		})
+	}
 	if err != nil {
 		return err
 	}
@@ -1235,7 +1367,47 @@ func (e *Encoder) encodePath(x cadence.Path) error {
 	}
 
 	// element 1: identifier as CBOR tstr.
-	return e.enc.EncodeString(x.Identifier)
+	err = e.enc.EncodeString(x.Identifier)
+	if err != nil {
+		return err
+	}
+
+	if !hasComponents {
+		return nil
+	}
+
+	// element 2: components as array.
+	components := x.Components.Components()
+
+	err = e.enc.EncodeArrayHead(uint64(len(components)))
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		// Encode array head with length 2.
+		err = e.enc.EncodeRawBytes([]byte{
+			// array, 2 items follow
+			0x82,
+		})
+		if err != nil {
+			return err
+		}
+
+		// element 0: kind as CBOR uint.
+		err = e.enc.EncodeUint8(uint8(component.Kind))
+		if err != nil {
+			return err
+		}
+
+		// element 1: value as CBOR tstr.
+		err = e.enc.EncodeString(component.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // encodeCapability encodes cadence.Capability as
@@ -1398,6 +1570,9 @@ func (e *Encoder) encodeTypeValue(typ cadence.Type, visited ccfTypeIDByCadenceTy
 	case *cadence.InclusiveRangeType:
 		return e.encodeInclusiveRangeTypeValue(typ, visited)
 
+	case *cadence.SetType:
+		return e.encodeSetTypeValue(typ, visited)
+
 	case *cadence.StructInterfaceType:
 		return e.encodeStructInterfaceTypeValue(typ, visited)
 
@@ -1543,6 +1718,22 @@ func (e *Encoder) encodeInclusiveRangeTypeValue(typ *cadence.InclusiveRangeType,
 	)
 }
 
+// encodeSetTypeValue encodes cadence.SetType as
+// language=CDDL
+// set-type-value =
+//
+//	; cbor-tag-set-type-value
+//	#6.197(type-value)
+func (e *Encoder) encodeSetTypeValue(typ *cadence.SetType, visited ccfTypeIDByCadenceType) error {
+	rawTagNum := []byte{0xd8, CBORTagSetTypeValue}
+	return e.encodeSetTypeWithRawTag(
+		typ,
+		visited,
+		e.encodeTypeValue,
+		rawTagNum,
+	)
+}
+
 // encodeReferenceTypeValue encodes cadence.ReferenceType as
 // language=CDDL
 // reference-type-value =
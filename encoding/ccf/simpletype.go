@@ -139,6 +139,8 @@ const ( // Cadence simple type IDs
 	SimpleTypeAccountMapping
 	SimpleTypeHashableStruct
 	SimpleTypeFixedSizeUnsignedInteger
+	SimpleTypeTimestamp
+	SimpleTypeDuration
 
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
@@ -163,6 +165,8 @@ func initSimpleTypeIDBiMap() (m *bimap.BiMap[cadence.PrimitiveType, SimpleType])
 	m.Insert(cadence.BoolType, SimpleTypeBool)
 	m.Insert(cadence.StringType, SimpleTypeString)
 	m.Insert(cadence.CharacterType, SimpleTypeCharacter)
+	m.Insert(cadence.TimestampType, SimpleTypeTimestamp)
+	m.Insert(cadence.DurationType, SimpleTypeDuration)
 	m.Insert(cadence.HashableStructType, SimpleTypeHashableStruct)
 
 	m.Insert(cadence.NumberType, SimpleTypeNumber)
@@ -90,6 +90,11 @@ func (ct *compositeTypes) traverseValue(v cadence.Value) {
 			ct.traverseValue(element)
 		}
 
+	case cadence.Set:
+		for _, element := range v.Values {
+			ct.traverseValue(element)
+		}
+
 	case cadence.Dictionary:
 		for _, pair := range v.Pairs {
 			ct.traverseValue(pair.Key)
@@ -137,6 +142,9 @@ func (ct *compositeTypes) traverseType(typ cadence.Type) (checkRuntimeType bool)
 	case cadence.ArrayType:
 		return ct.traverseType(typ.Element())
 
+	case *cadence.SetType:
+		return ct.traverseType(typ.ElementType)
+
 	case *cadence.DictionaryType:
 		checkKeyRuntimeType := ct.traverseType(typ.KeyType)
 		checkValueRuntimeType := ct.traverseType(typ.ElementType)
@@ -221,6 +229,8 @@ func (ct *compositeTypes) traverseType(typ cadence.Type) (checkRuntimeType bool)
 		cadence.Word256Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.TimestampType,
+		cadence.DurationType,
 		cadence.PathType,
 		cadence.StoragePathType,
 		cadence.PublicPathType,
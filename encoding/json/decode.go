@@ -137,6 +137,7 @@ const (
 	borrowTypeKey        = "borrowType"
 	domainKey            = "domain"
 	identifierKey        = "identifier"
+	componentsKey        = "components"
 	staticTypeKey        = "staticType"
 	addressKey           = "address"
 	pathKey              = "path"
@@ -232,6 +233,10 @@ func (d *Decoder) DecodeJSON(v any) cadence.Value {
 		return d.decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return d.decodeUFix64(valueJSON)
+	case timestampTypeStr:
+		return d.decodeTimestamp(valueJSON)
+	case durationTypeStr:
+		return d.decodeDuration(valueJSON)
 	case arrayTypeStr:
 		return d.decodeArray(valueJSON)
 	case dictionaryTypeStr:
@@ -246,6 +251,8 @@ func (d *Decoder) DecodeJSON(v any) cadence.Value {
 		return d.decodeContract(valueJSON)
 	case inclusiveRangeTypeStr:
 		return d.decodeInclusiveRange(valueJSON)
+	case setTypeStr:
+		return d.decodeSet(valueJSON)
 	case pathTypeStr:
 		return d.decodePath(valueJSON)
 	case typeTypeStr:
@@ -660,6 +667,26 @@ func (d *Decoder) decodeUFix64(valueJSON any) cadence.UFix64 {
 	return v
 }
 
+func (d *Decoder) decodeTimestamp(valueJSON any) cadence.Timestamp {
+	v, err := cadence.NewMeteredTimestamp(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid Timestamp: %w", err))
+	}
+	return v
+}
+
+func (d *Decoder) decodeDuration(valueJSON any) cadence.Duration {
+	v, err := cadence.NewMeteredDuration(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid Duration: %w", err))
+	}
+	return v
+}
+
 func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	v := toSlice(valueJSON)
 
@@ -681,6 +708,27 @@ func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	return value
 }
 
+func (d *Decoder) decodeSet(valueJSON any) cadence.Set {
+	v := toSlice(valueJSON)
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		len(v),
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, len(v))
+			for i, val := range v {
+				values[i] = d.DecodeJSON(val)
+			}
+			return values, nil
+		},
+	)
+
+	if err != nil {
+		panic(errors.NewDefaultUserError("invalid set: %w", err))
+	}
+	return value
+}
+
 func (d *Decoder) decodeDictionary(valueJSON any) cadence.Dictionary {
 	v := toSlice(valueJSON)
 
@@ -934,9 +982,47 @@ func (d *Decoder) decodePath(valueJSON any) cadence.Path {
 	if err != nil {
 		panic(errors.NewDefaultUserError("failed to decode path: %w", err))
 	}
+
+	// The components of extended paths are optional
+	componentsJSON, ok := obj[componentsKey]
+	if ok {
+		path.Components = d.decodePathComponents(componentsJSON)
+	}
+
 	return path
 }
 
+func (d *Decoder) decodePathComponents(valueJSON any) common.PathComponents {
+	componentsJSON := toSlice(valueJSON)
+	if len(componentsJSON) == 0 {
+		panic(errors.NewDefaultUserError("failed to decode path: empty components"))
+	}
+
+	components := make([]common.PathComponent, 0, len(componentsJSON))
+
+	for _, componentJSON := range componentsJSON {
+		obj := toObject(componentJSON)
+
+		kindName := obj.GetString(kindKey)
+		kind := common.PathComponentKindFromName(kindName)
+		if kind == common.PathComponentKindUnknown {
+			panic(errors.NewDefaultUserError("failed to decode path: invalid component kind: %s", kindName))
+		}
+
+		value := obj.GetString(valueKey)
+		common.UseMemory(d.gauge, common.NewRawStringMemoryUsage(len(value)))
+
+		component, err := common.ParsePathComponent(kind, value)
+		if err != nil {
+			panic(errors.NewDefaultUserError("failed to decode path: %w", err))
+		}
+
+		components = append(components, component)
+	}
+
+	return common.NewPathComponents(components...)
+}
+
 func (d *Decoder) decodeFunction(valueJSON any) cadence.Function {
 	obj := toObject(valueJSON)
 
@@ -1329,6 +1415,11 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 			d.gauge,
 			d.decodeType(obj.Get(elementKey), results),
 		)
+	case "Set":
+		return cadence.NewMeteredSetType(
+			d.gauge,
+			d.decodeType(obj.Get(typeKey), results),
+		)
 	case "ConstantSizedArray":
 		size := toUInt(obj.Get(sizeKey))
 		return cadence.NewMeteredConstantSizedArrayType(
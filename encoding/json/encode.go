@@ -31,6 +31,7 @@ import (
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/format"
 	"github.com/onflow/cadence/sema"
 )
 
@@ -134,8 +135,14 @@ type jsonCompositeField struct {
 }
 
 type jsonPathValue struct {
-	Domain     string `json:"domain"`
-	Identifier string `json:"identifier"`
+	Domain     string              `json:"domain"`
+	Identifier string              `json:"identifier"`
+	Components []jsonPathComponent `json:"components,omitempty"`
+}
+
+type jsonPathComponent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
 }
 
 type jsonFieldType struct {
@@ -258,6 +265,8 @@ const (
 	word256TypeStr        = "Word256"
 	fix64TypeStr          = "Fix64"
 	ufix64TypeStr         = "UFix64"
+	timestampTypeStr      = "Timestamp"
+	durationTypeStr       = "Duration"
 	arrayTypeStr          = "Array"
 	dictionaryTypeStr     = "Dictionary"
 	structTypeStr         = "Struct"
@@ -271,6 +280,7 @@ const (
 	enumTypeStr           = "Enum"
 	functionTypeStr       = "Function"
 	inclusiveRangeTypeStr = "InclusiveRange"
+	setTypeStr            = "Set"
 )
 
 // Prepare traverses the object graph of the provided value and constructs
@@ -333,12 +343,18 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareFix64(v)
 	case cadence.UFix64:
 		return prepareUFix64(v)
+	case cadence.Timestamp:
+		return prepareTimestamp(v)
+	case cadence.Duration:
+		return prepareDuration(v)
 	case cadence.Array:
 		return prepareArray(v)
 	case cadence.Dictionary:
 		return prepareDictionary(v)
 	case *cadence.InclusiveRange:
 		return prepareInclusiveRange(v)
+	case cadence.Set:
+		return prepareSet(v)
 	case cadence.Struct:
 		return prepareStruct(v)
 	case cadence.Resource:
@@ -565,6 +581,20 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareTimestamp(v cadence.Timestamp) jsonValue {
+	return jsonValueObject{
+		Type:  timestampTypeStr,
+		Value: format.Timestamp(int64(v)),
+	}
+}
+
+func prepareDuration(v cadence.Duration) jsonValue {
+	return jsonValueObject{
+		Type:  durationTypeStr,
+		Value: encodeFix64(int64(v)),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -605,6 +635,19 @@ func prepareInclusiveRange(v *cadence.InclusiveRange) jsonValue {
 	}
 }
 
+func prepareSet(v cadence.Set) jsonValue {
+	values := make([]jsonValue, len(v.Values))
+
+	for i, value := range v.Values {
+		values[i] = Prepare(value)
+	}
+
+	return jsonValueObject{
+		Type:  setTypeStr,
+		Value: values,
+	}
+}
+
 //go:linkname getCompositeFieldValues github.com/onflow/cadence.getCompositeFieldValues
 func getCompositeFieldValues(cadence.Composite) []cadence.Value
 
@@ -742,11 +785,27 @@ func prepareAuthorization(auth cadence.Authorization) jsonAuthorization {
 }
 
 func preparePath(x cadence.Path) jsonValue {
+	var components []jsonPathComponent
+	if !x.Components.IsEmpty() {
+		pathComponents := x.Components.Components()
+		components = make([]jsonPathComponent, 0, len(pathComponents))
+		for _, component := range pathComponents {
+			components = append(
+				components,
+				jsonPathComponent{
+					Kind:  component.Kind.Name(),
+					Value: component.Value,
+				},
+			)
+		}
+	}
+
 	return jsonValueObject{
 		Type: pathTypeStr,
 		Value: jsonPathValue{
 			Domain:     x.Domain.Identifier(),
 			Identifier: x.Identifier,
+			Components: components,
 		},
 	}
 }
@@ -862,6 +921,11 @@ func PrepareType(typ cadence.Type, results TypePreparationResults) jsonValue {
 			Kind:        "InclusiveRange",
 			ElementType: PrepareType(typ.ElementType, results),
 		}
+	case *cadence.SetType:
+		return jsonUnaryType{
+			Kind: "Set",
+			Type: PrepareType(typ.ElementType, results),
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
 			Kind:         "Struct",
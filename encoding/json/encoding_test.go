@@ -737,6 +737,64 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeTimestamp(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.Timestamp(0),
+			// language=json
+			`{"type":"Timestamp","value":"1970-01-01T00:00:00Z"}`,
+		},
+		{
+			"2020-03-13T19:52:43Z",
+			cadence.Timestamp(158_412_916_300_000_000),
+			// language=json
+			`{"type":"Timestamp","value":"2020-03-13T19:52:43Z"}`,
+		},
+		{
+			"2020-03-13T19:52:43.25Z",
+			cadence.Timestamp(158_412_916_325_000_000),
+			// language=json
+			`{"type":"Timestamp","value":"2020-03-13T19:52:43.25Z"}`,
+		},
+		{
+			"1969-12-31T23:59:59.5Z",
+			cadence.Timestamp(-50_000_000),
+			// language=json
+			`{"type":"Timestamp","value":"1969-12-31T23:59:59.5Z"}`,
+		},
+	}...)
+}
+
+func TestEncodeDuration(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.Duration(0),
+			// language=json
+			`{"type":"Duration","value":"0.00000000"}`,
+		},
+		{
+			"86400",
+			cadence.Duration(8_640_000_000_000),
+			// language=json
+			`{"type":"Duration","value":"86400.00000000"}`,
+		},
+		{
+			"-1.5",
+			cadence.Duration(-150_000_000),
+			// language=json
+			`{"type":"Duration","value":"-1.50000000"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -1494,6 +1552,44 @@ func TestEncodeInclusiveRange(t *testing.T) {
 	testAllEncodeAndDecode(t, simpleInclusiveRange)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	emptySet := encodeTest{
+		"Empty",
+		cadence.NewSet([]cadence.Value{}),
+		// language=json
+		`{"type":"Set","value":[]}`,
+	}
+
+	intSet := encodeTest{
+		"Integers",
+		cadence.NewSet([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+		}),
+		// language=json
+		`
+          {
+            "type": "Set",
+            "value": [
+              {
+                "type": "Int",
+                "value": "1"
+              },
+              {
+                "type": "Int",
+                "value": "2"
+              }
+            ]
+          }
+        `,
+	}
+
+	testAllEncodeAndDecode(t, emptySet, intSet)
+}
+
 func TestEncodeEvent(t *testing.T) {
 
 	t.Parallel()
@@ -1883,6 +1979,32 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static Set<Int>", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.SetType{
+					ElementType: cadence.IntType,
+				},
+			},
+			// language=json
+			`
+              {
+                "type": "Type",
+                "value": {
+                  "staticType": {
+                    "kind": "Set",
+                    "type": {
+                      "kind": "Int"
+                    }
+                  }
+                }
+              }
+            `,
+		)
+	})
+
 	t.Run("with static InclusiveRange<Int>", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -3531,6 +3653,55 @@ func TestEncodePath(t *testing.T) {
 		))
 		require.ErrorContains(t, err, "unknown domain in path")
 	})
+
+	t.Run("extended", func(t *testing.T) {
+		t.Parallel()
+
+		testEncodeAndDecode(
+			t,
+			cadence.Path{
+				Domain:     common.PathDomainStorage,
+				Identifier: "foo",
+				Components: common.NewPathComponents(
+					common.NewAddressPathComponent(common.Address{0, 0, 0, 0, 0, 0, 0, 0x1}),
+					common.NewIntegerPathComponent(big.NewInt(-42)),
+					common.NewTypePathComponent("Int"),
+				),
+			},
+			// language=json
+			`{"type":"Path","value":{"domain":"storage","identifier":"foo","components":[{"kind":"address","value":"0x0000000000000001"},{"kind":"integer","value":"-42"},{"kind":"type","value":"Int"}]}}`,
+		)
+	})
+
+	t.Run("extended, empty components", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Decode(nil, []byte(
+			// language=json
+			`{"type":"Path","value":{"domain":"storage","identifier":"foo","components":[]}}`,
+		))
+		require.ErrorContains(t, err, "empty components")
+	})
+
+	t.Run("extended, invalid component kind", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Decode(nil, []byte(
+			// language=json
+			`{"type":"Path","value":{"domain":"storage","identifier":"foo","components":[{"kind":"string","value":"bar"}]}}`,
+		))
+		require.ErrorContains(t, err, "invalid component kind")
+	})
+
+	t.Run("extended, non-canonical component", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Decode(nil, []byte(
+			// language=json
+			`{"type":"Path","value":{"domain":"storage","identifier":"foo","components":[{"kind":"integer","value":"042"}]}}`,
+		))
+		require.ErrorContains(t, err, "not canonical")
+	})
 }
 
 func testAllEncodeAndDecode(t *testing.T, tests ...encodeTest) {
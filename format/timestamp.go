@@ -0,0 +1,42 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"time"
+
+	"github.com/onflow/cadence/fixedpoint"
+)
+
+// TimestampLayout is the ISO-8601 layout of timestamps in the UTC timezone.
+// Fractional seconds are only included if they are non-zero.
+const TimestampLayout = "2006-01-02T15:04:05.99999999Z"
+
+// Timestamp formats the given number of seconds since the Unix epoch,
+// a fixed-point number with the scale of Fix64, as an ISO-8601 string
+func Timestamp(v int64) string {
+	seconds := v / fixedpoint.Fix64Factor
+	fraction := v % fixedpoint.Fix64Factor
+	if fraction < 0 {
+		seconds--
+		fraction += fixedpoint.Fix64Factor
+	}
+	nanoseconds := fraction * (int64(time.Second) / fixedpoint.Fix64Factor)
+	return time.Unix(seconds, nanoseconds).UTC().Format(TimestampLayout)
+}
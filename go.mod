@@ -8,6 +8,7 @@ require (
 	github.com/c-bata/go-prompt v0.2.6
 	github.com/dave/dst v0.27.2
 	github.com/fxamacker/cbor/v2 v2.4.1-0.20230228173756-c0c9f774e40c
+	github.com/google/pprof v0.0.0-20241210010833-40e02aabc2ad
 	github.com/itchyny/gojq v0.12.14
 	github.com/k0kubun/pp/v3 v3.2.0
 	github.com/kr/pretty v0.3.1
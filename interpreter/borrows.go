@@ -0,0 +1,268 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/cadence/sema"
+)
+
+// ExclusiveBorrowMode configures how overlapping mutable borrows of stored resources are handled.
+//
+// A mutable borrow is the borrow of an authorized reference to a stored resource,
+// either directly from storage, or through a capability.
+// A mutable borrow is active until the function which performed it returns,
+// or, if the function returns the borrowed reference, e.g. `fun borrowVault(): auth(Withdraw) &Vault`,
+// until its caller returns.
+// A mutable borrow overlaps if the same stored resource is already mutably borrowed
+// by a function further up the call stack, e.g. when a function is re-entered.
+//
+// An overlap is reported or rejected when the overlapping borrow is taken,
+// i.e. before the code which performed it can use the reference.
+// The exception are borrows which may be returned to the function which already borrowed the resource,
+// i.e. if all functions in between return authorized references, e.g. `fun borrowVault(): auth(Withdraw) &Vault`:
+// If the borrowed reference is returned to that function, both borrows are borrows of the same function,
+// which do not overlap. Otherwise, the overlap is reported or rejected when the overlapping borrow ends.
+//
+// NOTE: Borrows of references which escape in other ways,
+// e.g. by being stored in a field or captured by a function, still end when the function returns.
+type ExclusiveBorrowMode uint8
+
+const (
+	// ExclusiveBorrowModeDisabled disables the tracking of borrows
+	ExclusiveBorrowModeDisabled ExclusiveBorrowMode = iota
+	// ExclusiveBorrowModeWarn reports overlapping mutable borrows through Config.OnOverlappingBorrow
+	ExclusiveBorrowModeWarn
+	// ExclusiveBorrowModeEnforce rejects overlapping mutable borrows with an OverlappingBorrowError
+	ExclusiveBorrowModeEnforce
+)
+
+// activeBorrow is a mutable borrow of a stored resource,
+// which is active until the invocation at the given call stack depth returns.
+type activeBorrow struct {
+	target AddressPath
+	depth  int
+	// overlapDepth is the call stack depth of the outermost invocation
+	// which already borrowed the same resource, or -1 if the borrow does not overlap
+	overlapDepth  int
+	locationRange LocationRange
+}
+
+// RecordBorrow records the borrow of the given reference, which references the given value.
+// If the reference is authorized and the referenced value is a stored resource,
+// an overlap with an active mutable borrow of the same resource is reported or rejected,
+// depending on the configured exclusive borrow mode.
+func (interpreter *Interpreter) RecordBorrow(
+	reference ReferenceValue,
+	referencedValue Value,
+	locationRange LocationRange,
+) {
+	sharedState := interpreter.SharedState
+	config := sharedState.Config
+
+	if config.ExclusiveBorrowMode == ExclusiveBorrowModeDisabled {
+		return
+	}
+
+	target, ok := borrowedTarget(reference)
+	if !ok || !referencedValue.IsResourceKinded(interpreter) {
+		return
+	}
+
+	depth := len(sharedState.callStack.Invocations)
+
+	overlapDepth := -1
+	for _, borrow := range sharedState.activeBorrows {
+		// Borrows by the same invocation do not overlap
+		if borrow.target != target || borrow.depth == depth {
+			continue
+		}
+
+		// Borrows are ordered by call stack depth,
+		// so the first overlapping borrow is the outermost one
+		overlapDepth = borrow.depth
+		break
+	}
+
+	borrow := activeBorrow{
+		target:        target,
+		depth:         depth,
+		overlapDepth:  overlapDepth,
+		locationRange: locationRange,
+	}
+
+	// Report the overlap right away, unless the borrowed reference
+	// may still be returned to the function which already borrowed the resource,
+	// in which case the overlap is reported when the borrow ends
+
+	if overlapDepth >= 0 && !interpreter.mayReturnBorrow(overlapDepth, depth) {
+		interpreter.reportOverlappingBorrow(borrow)
+		borrow.overlapDepth = -1
+	}
+
+	sharedState.activeBorrows = append(sharedState.activeBorrows, borrow)
+}
+
+// mayReturnBorrow returns true if a reference borrowed by the invocation at the given call stack depth
+// may be returned to the invocation at the given outer call stack depth,
+// i.e. if all invocations in between may return an authorized reference.
+func (interpreter *Interpreter) mayReturnBorrow(outerDepth int, depth int) bool {
+	invocations := interpreter.SharedState.callStack.Invocations
+
+	for ; depth > outerDepth; depth-- {
+		if !mayReturnAuthorizedReference(invocations[depth-1].functionReturnType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mayReturnAuthorizedReference returns true if a function with the given return type
+// may return an authorized reference, e.g. `auth(E) &R` or `auth(E) &R?`
+func mayReturnAuthorizedReference(returnType sema.Type) bool {
+	for {
+		optionalType, ok := returnType.(*sema.OptionalType)
+		if !ok {
+			break
+		}
+		returnType = optionalType.Type
+	}
+
+	switch returnType := returnType.(type) {
+	case *sema.ReferenceType:
+		return returnType.Authorization != sema.UnauthorizedAccess
+	case nil:
+		return false
+	}
+
+	return returnType == sema.AnyStructType ||
+		returnType == sema.AnyType
+}
+
+// endBorrows ends the borrows performed by invocations
+// which are deeper than the given call stack depth,
+// and reports or rejects the overlapping ones.
+//
+// The borrows of the given result of the returning invocation, if any, are not ended,
+// but transferred to the invocation at the given depth, i.e. the caller.
+// If the caller is the invocation which the borrow overlaps with, the borrow no longer overlaps.
+func (interpreter *Interpreter) endBorrows(depth int, result Value) {
+	sharedState := interpreter.SharedState
+
+	borrows := sharedState.activeBorrows
+	count := len(borrows)
+	start := count
+	for start > 0 && borrows[start-1].depth > depth {
+		start--
+	}
+	if start == count {
+		return
+	}
+
+	returnedTarget, returnsBorrow := borrowedTarget(result)
+
+	var overlappingBorrows []activeBorrow
+
+	end := start
+	for i := start; i < count; i++ {
+		borrow := borrows[i]
+
+		if returnsBorrow && borrow.target == returnedTarget {
+			borrow.depth = depth
+			if borrow.overlapDepth >= depth {
+				borrow.overlapDepth = -1
+			}
+			borrows[end] = borrow
+			end++
+
+		} else if borrow.overlapDepth >= 0 {
+			overlappingBorrows = append(overlappingBorrows, borrow)
+		}
+	}
+
+	for i := end; i < count; i++ {
+		borrows[i] = activeBorrow{}
+	}
+	sharedState.activeBorrows = borrows[:end]
+
+	for _, borrow := range overlappingBorrows {
+		interpreter.reportOverlappingBorrow(borrow)
+	}
+}
+
+// discardBorrows ends the borrows performed by invocations
+// which are deeper than the given call stack depth, without reporting overlaps,
+// e.g. when the invocations failed and their effects are reverted.
+func (interpreter *Interpreter) discardBorrows(depth int) {
+	sharedState := interpreter.SharedState
+
+	borrows := sharedState.activeBorrows
+	count := len(borrows)
+	for count > 0 && borrows[count-1].depth > depth {
+		borrows[count-1] = activeBorrow{}
+		count--
+	}
+	sharedState.activeBorrows = borrows[:count]
+}
+
+func (interpreter *Interpreter) reportOverlappingBorrow(borrow activeBorrow) {
+	config := interpreter.SharedState.Config
+
+	switch config.ExclusiveBorrowMode {
+	case ExclusiveBorrowModeWarn:
+		onOverlappingBorrow := config.OnOverlappingBorrow
+		if onOverlappingBorrow != nil {
+			onOverlappingBorrow(
+				interpreter,
+				borrow.locationRange,
+				borrow.target.Address,
+				borrow.target.Path,
+			)
+		}
+
+	case ExclusiveBorrowModeEnforce:
+		panic(OverlappingBorrowError{
+			Address:       borrow.target.Address,
+			Path:          borrow.target.Path,
+			LocationRange: borrow.locationRange,
+		})
+	}
+}
+
+// borrowedTarget returns the target of the given value,
+// if it is an authorized storage reference, or an optional of one.
+func borrowedTarget(value Value) (AddressPath, bool) {
+	for {
+		someValue, ok := value.(*SomeValue)
+		if !ok {
+			break
+		}
+		value = someValue.value
+	}
+
+	storageReference, ok := value.(*StorageReferenceValue)
+	if !ok || storageReference.Authorization == UnauthorizedAccess {
+		return AddressPath{}, false
+	}
+
+	return AddressPath{
+		Address: storageReference.TargetStorageAddress,
+		Path:    storageReference.TargetPath,
+	}, true
+}
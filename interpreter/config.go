@@ -55,6 +55,9 @@ type Config struct {
 	CompositeValueFunctionsHandler CompositeValueFunctionsHandlerFunc
 	BaseActivationHandler          func(location common.Location) *VariableActivation
 	Debugger                       *Debugger
+	// Profiler is notified of metered computation and memory usage,
+	// and attributes it to the current call stack
+	Profiler *Profiler
 	// OnStatement is triggered when a statement is about to be executed
 	OnStatement OnStatementFunc
 	// OnLoopIteration is triggered when a loop iteration is about to be executed
@@ -78,4 +81,8 @@ type Config struct {
 	ValidateAccountCapabilitiesGetHandler ValidateAccountCapabilitiesGetHandlerFunc
 	// ValidateAccountCapabilitiesPublishHandler is used to handle when a capability of an account is got.
 	ValidateAccountCapabilitiesPublishHandler ValidateAccountCapabilitiesPublishHandlerFunc
+	// ExclusiveBorrowMode configures how overlapping mutable borrows of stored resources are handled
+	ExclusiveBorrowMode ExclusiveBorrowMode
+	// OnOverlappingBorrow is triggered when an overlapping mutable borrow is detected in the warning mode
+	OnOverlappingBorrow OnOverlappingBorrowFunc
 }
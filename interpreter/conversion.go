@@ -20,9 +20,11 @@ package interpreter
 
 import (
 	"math"
+	"math/big"
 
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/sema"
 )
 
 func ByteArrayValueToByteSlice(interpreter *Interpreter, value Value, locationRange LocationRange) ([]byte, error) {
@@ -146,3 +148,66 @@ func ByteSliceToConstantSizedByteArrayValue(interpreter *Interpreter, buf []byte
 		values...,
 	)
 }
+
+// ConvertNumberChecked converts the given number value to the given number type,
+// like the conversion functions, e.g. `UInt8(x)`.
+//
+// Instead of wrapping around for Word types, and instead of aborting,
+// an OverflowError or UnderflowError is returned if the value is not in the range of the target type.
+func (interpreter *Interpreter) ConvertNumberChecked(
+	value NumberValue,
+	targetType sema.Type,
+	locationRange LocationRange,
+) (Value, error) {
+
+	rangedTargetType, ok := targetType.(sema.IntegerRangedType)
+	if !ok || rangedTargetType.IsSuperType() {
+		panic(errors.NewUnreachableError())
+	}
+
+	// Check the full value when converting between fixed-point types,
+	// as the integer parts might be in range, but not the fractional parts
+
+	switch value := value.(type) {
+	case Fix64Value:
+		if targetType == sema.UFix64Type && value < 0 {
+			return nil, UnderflowError{
+				LocationRange: locationRange,
+			}
+		}
+
+	case UFix64Value:
+		if targetType == sema.Fix64Type && value > Fix64MaxValue {
+			return nil, OverflowError{
+				LocationRange: locationRange,
+			}
+		}
+	}
+
+	// Check the integer part of the value
+
+	var integer *big.Int
+	if bigNumberValue, ok := value.(BigNumberValue); ok {
+		integer = bigNumberValue.ToBigInt(interpreter)
+	} else {
+		integer = big.NewInt(int64(value.ToInt(locationRange)))
+	}
+
+	minInt := rangedTargetType.MinInt()
+	if minInt != nil && integer.Cmp(minInt) < 0 {
+		return nil, UnderflowError{
+			LocationRange: locationRange,
+		}
+	}
+
+	maxInt := rangedTargetType.MaxInt()
+	if maxInt != nil && integer.Cmp(maxInt) > 0 {
+		return nil, OverflowError{
+			LocationRange: locationRange,
+		}
+	}
+
+	valueType := interpreter.MustSemaTypeOfValue(value)
+
+	return interpreter.convert(value, valueType, targetType, locationRange), nil
+}
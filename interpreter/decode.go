@@ -110,6 +110,22 @@ func decodeString(dec *cbor.StreamDecoder, memoryGauge common.MemoryGauge, strin
 	return dec.DecodeString()
 }
 
+func decodeBytes(dec *cbor.StreamDecoder, memoryGauge common.MemoryGauge) ([]byte, error) {
+	length, err := dec.NextSize()
+	if err != nil {
+		return nil, err
+	}
+	if length > goMaxInt {
+		return nil, InvalidStringLengthError{
+			Length: length,
+		}
+	}
+
+	common.UseMemory(memoryGauge, common.NewBytesMemoryUsage(int(length)))
+
+	return dec.DecodeBytes()
+}
+
 func decodeInt64(d StorableDecoder) (int64, error) {
 	common.UseMemory(d.memoryGauge, Int64MemoryUsage)
 	return d.decoder.DecodeInt64()
@@ -347,11 +363,22 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		// Time
+
+		case CBORTagTimestampValue:
+			storable, err = d.decodeTimestamp()
+
+		case CBORTagDurationValue:
+			storable, err = d.decodeDuration()
+
 		// Storage
 
 		case CBORTagPathValue:
 			storable, err = d.decodePath()
 
+		case CBORTagExtendedPathAtreeValue:
+			storable, err = d.decodeExtendedPathAtreeValue()
+
 		case CBORTagCapabilityValue:
 			storable, err = d.decodeCapability()
 
@@ -857,6 +884,32 @@ func (d StorableDecoder) decodeUFix64() (UFix64Value, error) {
 	return NewUnmeteredUFix64Value(value), nil
 }
 
+func (d StorableDecoder) decodeTimestamp() (TimestampValue, error) {
+	value, err := decodeInt64(d)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return 0, errors.NewUnexpectedError("unknown Timestamp encoding: %s", e.ActualType.String())
+		}
+		return 0, err
+	}
+
+	// Already metered at `decodeInt64`
+	return NewUnmeteredTimestampValue(value), nil
+}
+
+func (d StorableDecoder) decodeDuration() (DurationValue, error) {
+	value, err := decodeInt64(d)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return 0, errors.NewUnexpectedError("unknown Duration encoding: %s", e.ActualType.String())
+		}
+		return 0, err
+	}
+
+	// Already metered at `decodeInt64`
+	return NewUnmeteredDurationValue(value), nil
+}
+
 func (d StorableDecoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -987,7 +1040,8 @@ func (d StorableDecoder) decodePath() (PathValue, error) {
 		return EmptyPathValue, err
 	}
 
-	if size != expectedLength {
+	// The components are omitted if the path has no components
+	if size != expectedLength && size != encodedPathValueWithoutComponentsLength {
 		return EmptyPathValue, errors.NewUnexpectedError(
 			"invalid path encoding: expected [%d]any, got [%d]any",
 			expectedLength,
@@ -1019,13 +1073,101 @@ func (d StorableDecoder) decodePath() (PathValue, error) {
 		return EmptyPathValue, err
 	}
 
-	return NewPathValue(
+	var components common.PathComponents
+	if size == expectedLength {
+		// Decode components at array index encodedPathValueComponentsFieldKey
+		components, err = d.decodePathComponents()
+		if err != nil {
+			return EmptyPathValue, err
+		}
+	}
+
+	return NewExtendedPathValue(
 		d.memoryGauge,
 		common.PathDomain(domain),
 		identifier,
+		components,
 	), nil
 }
 
+func (d StorableDecoder) decodePathComponents() (common.PathComponents, error) {
+	encodedComponents, err := decodeBytes(d.decoder, d.memoryGauge)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return common.PathComponents{}, errors.NewUnexpectedError(
+				"invalid path components encoding: %s",
+				e.ActualType.String(),
+			)
+		}
+		return common.PathComponents{}, err
+	}
+
+	components, err := common.DecodePathComponents(string(encodedComponents))
+	if err != nil {
+		return common.PathComponents{}, errors.NewUnexpectedError(
+			"invalid path components encoding: %w",
+			err,
+		)
+	}
+
+	if components.IsEmpty() {
+		return common.PathComponents{}, errors.NewUnexpectedError(
+			"invalid path components encoding: no components",
+		)
+	}
+
+	return components, nil
+}
+
+func (d StorableDecoder) decodeExtendedPathAtreeValue() (ExtendedPathAtreeValue, error) {
+
+	const expectedLength = encodedExtendedPathAtreeValueLength
+
+	size, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return ExtendedPathAtreeValue{}, errors.NewUnexpectedError(
+				"invalid extended path key encoding: expected [%d]any, got %s",
+				expectedLength,
+				e.ActualType.String(),
+			)
+		}
+		return ExtendedPathAtreeValue{}, err
+	}
+
+	if size != expectedLength {
+		return ExtendedPathAtreeValue{}, errors.NewUnexpectedError(
+			"invalid extended path key encoding: expected [%d]any, got [%d]any",
+			expectedLength,
+			size,
+		)
+	}
+
+	// Decode identifier at array index encodedExtendedPathAtreeValueIdentifierFieldKey
+	identifier, err := decodeString(d.decoder, d.memoryGauge, common.MemoryKindRawString)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return ExtendedPathAtreeValue{}, errors.NewUnexpectedError(
+				"invalid extended path key identifier encoding: %s",
+				e.ActualType.String(),
+			)
+		}
+		return ExtendedPathAtreeValue{}, err
+	}
+
+	// Decode components at array index encodedExtendedPathAtreeValueComponentsFieldKey
+	components, err := d.decodePathComponents()
+	if err != nil {
+		return ExtendedPathAtreeValue{}, err
+	}
+
+	// Identifier and components are already metered
+	return ExtendedPathAtreeValue{
+		Identifier: identifier,
+		Components: components,
+	}, nil
+}
+
 func (d StorableDecoder) decodeCapability() (*IDCapabilityValue, error) {
 
 	const expectedLength = encodedCapabilityValueLength
@@ -1541,6 +1683,9 @@ func (d TypeDecoder) DecodeStaticType() (StaticType, error) {
 	case CBORTagInclusiveRangeStaticType:
 		return d.decodeInclusiveRangeStaticType()
 
+	case CBORTagSetStaticType:
+		return d.decodeSetStaticType()
+
 	default:
 		return nil, errors.NewUnexpectedError("invalid static type encoding tag: %d", number)
 	}
@@ -1587,10 +1732,13 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	if size != expectedLength {
+	if size != expectedLength &&
+		size != encodedCompositeInstanceStaticTypeLength {
+
 		return nil, errors.NewUnexpectedError(
-			"invalid composite static type encoding: expected [%d]any, got [%d]any",
+			"invalid composite static type encoding: expected [%d]any or [%d]any, got [%d]any",
 			expectedLength,
+			encodedCompositeInstanceStaticTypeLength,
 			size,
 		)
 	}
@@ -1613,6 +1761,19 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
+	if size == encodedCompositeInstanceStaticTypeLength {
+		// Decode type arguments at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+		typeArguments, err := d.decodeStaticTypes()
+		if err != nil {
+			return nil, errors.NewUnexpectedError(
+				"invalid composite static type type arguments encoding: %w",
+				err,
+			)
+		}
+
+		return NewCompositeInstanceStaticType(d.memoryGauge, location, qualifiedIdentifier, typeArguments), nil
+	}
+
 	return NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier), nil
 }
 
@@ -2103,10 +2264,14 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		return nil, err
 	}
 
-	if length != encodedCompositeTypeInfoLength {
+	if length != encodedCompositeTypeInfoLength &&
+		length != encodedCompositeInstanceTypeInfoLength {
+
 		return nil, errors.NewUnexpectedError(
-			"invalid composite type info: expected %d elements, got %d",
-			encodedCompositeTypeInfoLength, length,
+			"invalid composite type info: expected %d or %d elements, got %d",
+			encodedCompositeTypeInfoLength,
+			encodedCompositeInstanceTypeInfoLength,
+			length,
 		)
 	}
 
@@ -2132,14 +2297,54 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		)
 	}
 
+	var typeArguments []StaticType
+	if length == encodedCompositeInstanceTypeInfoLength {
+		typeArguments, err = d.decodeStaticTypes()
+		if err != nil {
+			return nil, errors.NewUnexpectedError(
+				"invalid composite type info type arguments encoding: %w",
+				err,
+			)
+		}
+	}
+
 	return NewCompositeTypeInfo(
 		d.memoryGauge,
 		location,
 		qualifiedIdentifier,
 		common.CompositeKind(kind),
+		typeArguments,
 	), nil
 }
 
+// decodeStaticTypes decodes a non-empty array of static types
+func (d TypeDecoder) decodeStaticTypes() ([]StaticType, error) {
+	size, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return nil, errors.NewUnexpectedError(
+				"invalid static types encoding: expected array, got %s",
+				e.ActualType.String(),
+			)
+		}
+		return nil, err
+	}
+
+	if size == 0 {
+		return nil, errors.NewUnexpectedError("invalid static types encoding: empty array")
+	}
+
+	types := make([]StaticType, size)
+	for i := 0; i < int(size); i++ {
+		types[i], err = d.DecodeStaticType()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return types, nil
+}
+
 func (d TypeDecoder) decodeInclusiveRangeStaticType() (StaticType, error) {
 	elementType, err := d.DecodeStaticType()
 	if err != nil {
@@ -2151,6 +2356,17 @@ func (d TypeDecoder) decodeInclusiveRangeStaticType() (StaticType, error) {
 	return NewInclusiveRangeStaticType(d.memoryGauge, elementType), nil
 }
 
+func (d TypeDecoder) decodeSetStaticType() (*SetStaticType, error) {
+	elementType, err := d.DecodeStaticType()
+	if err != nil {
+		return nil, errors.NewUnexpectedError(
+			"invalid set static type encoding: %w",
+			err,
+		)
+	}
+	return NewSetStaticType(d.memoryGauge, elementType), nil
+}
+
 func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge) (atree.TypeInfo, error) {
 	d := NewTypeDecoder(decoder, memoryGauge)
 
@@ -2173,6 +2389,8 @@ func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge)
 			return d.decodeVariableSizedStaticType()
 		case CBORTagDictionaryStaticType:
 			return d.decodeDictionaryStaticType()
+		case CBORTagSetStaticType:
+			return d.decodeSetStaticType()
 		case CBORTagCompositeValue:
 			return d.decodeCompositeTypeInfo()
 		default:
@@ -118,8 +118,8 @@ const (
 	CBORTagStringValue
 	CBORTagCharacterValue
 	CBORTagSomeValueWithNestedLevels
-	_
-	_
+	CBORTagTimestampValue
+	CBORTagDurationValue
 	_
 	_
 	_
@@ -207,7 +207,7 @@ const (
 	CBORTagStorageCapabilityControllerValue
 	CBORTagAccountCapabilityControllerValue
 	CBORTagCapabilityValue
-	_
+	CBORTagExtendedPathAtreeValue
 	_
 	_
 
@@ -233,6 +233,7 @@ const (
 	_
 
 	CBORTagInclusiveRangeStaticType
+	CBORTagSetStaticType
 
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
@@ -334,6 +335,49 @@ func (v Uint64AtreeValue) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// NOTE: NEVER change, only add/increment; ensure uint64
+const (
+	// encodedExtendedPathAtreeValueIdentifierFieldKey uint64 = 0
+	// encodedExtendedPathAtreeValueComponentsFieldKey uint64 = 1
+
+	// !!! *WARNING* !!!
+	//
+	// encodedExtendedPathAtreeValueLength MUST be updated when new element is added.
+	// It is used to verify encoded extended path key length during decoding.
+	encodedExtendedPathAtreeValueLength = 2
+)
+
+// Encode encodes ExtendedPathAtreeValue as
+//
+//	cbor.Tag{
+//				Number: CBORTagExtendedPathAtreeValue,
+//				Content: []any{
+//					encodedExtendedPathAtreeValueIdentifierFieldKey: string(v.Identifier),
+//					encodedExtendedPathAtreeValueComponentsFieldKey: []byte(v.Components.Encoded()),
+//				},
+//	}
+func (v ExtendedPathAtreeValue) Encode(e *atree.Encoder) error {
+	// Encode tag number and array head
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagExtendedPathAtreeValue,
+		// array, 2 items follow
+		0x82,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Encode identifier at array index encodedExtendedPathAtreeValueIdentifierFieldKey
+	err = e.CBOR.EncodeString(v.Identifier)
+	if err != nil {
+		return err
+	}
+
+	// Encode components at array index encodedExtendedPathAtreeValueComponentsFieldKey
+	return e.CBOR.EncodeBytes([]byte(v.Components.Encoded()))
+}
+
 // cborVoidValue represents the CBOR value:
 //
 //	cbor.Tag{
@@ -730,6 +774,40 @@ func (v UFix64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes TimestampValue as
+//
+//	cbor.Tag{
+//			Number:  CBORTagTimestampValue,
+//			Content: int64(v),
+//	}
+func (v TimestampValue) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagTimestampValue,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeInt64(int64(v))
+}
+
+// Encode encodes DurationValue as
+//
+//	cbor.Tag{
+//			Number:  CBORTagDurationValue,
+//			Content: int64(v),
+//	}
+func (v DurationValue) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagDurationValue,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeInt64(int64(v))
+}
+
 var _ atree.ContainerStorable = &SomeStorable{}
 
 func (s SomeStorable) Encode(e *atree.Encoder) error {
@@ -814,12 +892,17 @@ func (v AddressValue) Encode(e *atree.Encoder) error {
 const (
 	// encodedPathValueDomainFieldKey     uint64 = 0
 	// encodedPathValueIdentifierFieldKey uint64 = 1
+	// encodedPathValueComponentsFieldKey uint64 = 2
 
 	// !!! *WARNING* !!!
 	//
 	// encodedPathValueLength MUST be updated when new element is added.
 	// It is used to verify encoded path length during decoding.
-	encodedPathValueLength = 2
+	encodedPathValueLength = 3
+
+	// encodedPathValueWithoutComponentsLength is the length of the encoding of paths without components.
+	// The components are omitted, so the encoding of these paths is unchanged
+	encodedPathValueWithoutComponentsLength = 2
 )
 
 // Encode encodes PathValue as
@@ -829,15 +912,25 @@ const (
 //				Content: []any{
 //					encodedPathValueDomainFieldKey:     uint(v.Domain),
 //					encodedPathValueIdentifierFieldKey: string(v.Identifier),
+//					encodedPathValueComponentsFieldKey: []byte(v.Components.Encoded()),
 //				},
 //	}
+//
+// The components are omitted if the path has no components.
 func (v PathValue) Encode(e *atree.Encoder) error {
+	hasComponents := !v.Components.IsEmpty()
+
+	var arrayHead byte = 0x80 | encodedPathValueWithoutComponentsLength
+	if hasComponents {
+		arrayHead = 0x80 | encodedPathValueLength
+	}
+
 	// Encode tag number and array head
 	err := e.CBOR.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagPathValue,
-		// array, 2 items follow
-		0x82,
+		// array, 2 or 3 items follow
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -850,7 +943,17 @@ func (v PathValue) Encode(e *atree.Encoder) error {
 	}
 
 	// Encode identifier at array index encodedPathValueIdentifierFieldKey
-	return e.CBOR.EncodeString(v.Identifier)
+	err = e.CBOR.EncodeString(v.Identifier)
+	if err != nil {
+		return err
+	}
+
+	if !hasComponents {
+		return nil
+	}
+
+	// Encode components at array index encodedPathValueComponentsFieldKey
+	return e.CBOR.EncodeBytes([]byte(v.Components.Encoded()))
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -1253,12 +1356,18 @@ func (t *OptionalStaticType) Encode(e *cbor.StreamEncoder) error {
 const (
 	// encodedCompositeStaticTypeLocationFieldKey            uint64 = 0
 	// encodedCompositeStaticTypeQualifiedIdentifierFieldKey uint64 = 1
+	// encodedCompositeStaticTypeTypeArgumentsFieldKey       uint64 = 2
 
 	// !!! *WARNING* !!!
 	//
 	// encodedCompositeStaticTypeLength MUST be updated when new element is added.
 	// It is used to verify encoded composite static type length during decoding.
 	encodedCompositeStaticTypeLength = 2
+
+	// encodedCompositeInstanceStaticTypeLength is the length of the encoding
+	// of the static type of an instance of a generic composite type,
+	// which additionally includes the type arguments
+	encodedCompositeInstanceStaticTypeLength = 3
 )
 
 // Encode encodes CompositeStaticType as
@@ -1268,15 +1377,21 @@ const (
 //				Content: cborArray{
 //					encodedCompositeStaticTypeLocationFieldKey:            Location(v.Location),
 //					encodedCompositeStaticTypeQualifiedIdentifierFieldKey: string(v.QualifiedIdentifier),
+//					encodedCompositeStaticTypeTypeArgumentsFieldKey:       []StaticType(v.TypeArguments), // only if not empty
 //			},
 //	}
 func (t *CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
+	length := byte(encodedCompositeStaticTypeLength)
+	if len(t.TypeArguments) > 0 {
+		length = encodedCompositeInstanceStaticTypeLength
+	}
+
 	// Encode tag number and array head
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeStaticType,
-		// array, 2 items follow
-		0x82,
+		// array, 2 or 3 items follow
+		0x80 | length,
 	})
 	if err != nil {
 		return err
@@ -1289,7 +1404,17 @@ func (t *CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
 	}
 
 	// Encode qualified identifier at array index encodedCompositeStaticTypeQualifiedIdentifierFieldKey
-	return e.EncodeString(t.QualifiedIdentifier)
+	err = e.EncodeString(t.QualifiedIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if len(t.TypeArguments) > 0 {
+		// Encode type arguments at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+		return encodeStaticTypes(e, t.TypeArguments)
+	}
+
+	return nil
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -1565,6 +1690,25 @@ func (t InclusiveRangeStaticType) Encode(e *cbor.StreamEncoder) error {
 	return t.ElementType.Encode(e)
 }
 
+// Encode encodes SetStaticType as
+//
+//	cbor.Tag{
+//			Number: CBORTagSetStaticType,
+//			Content: StaticType(v.ElementType),
+//	}
+func (t *SetStaticType) Encode(e *cbor.StreamEncoder) error {
+	// Encode tag number
+	err := e.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagSetStaticType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.ElementType.Encode(e)
+}
+
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
 	// encodedIntersectionStaticTypeLegacyTypeFieldKey  uint64 = 0
@@ -1659,6 +1803,8 @@ type compositeTypeInfo struct {
 	location            common.Location
 	qualifiedIdentifier string
 	kind                common.CompositeKind
+	// typeArguments are the type arguments of an instance of a generic composite type, if any
+	typeArguments []StaticType
 }
 
 func NewCompositeTypeInfo(
@@ -1666,6 +1812,7 @@ func NewCompositeTypeInfo(
 	location common.Location,
 	qualifiedIdentifier string,
 	kind common.CompositeKind,
+	typeArguments []StaticType,
 ) compositeTypeInfo {
 	common.UseMemory(memoryGauge, common.CompositeTypeInfoMemoryUsage)
 
@@ -1673,6 +1820,7 @@ func NewCompositeTypeInfo(
 		location:            location,
 		qualifiedIdentifier: qualifiedIdentifier,
 		kind:                kind,
+		typeArguments:       typeArguments,
 	}
 }
 
@@ -1680,11 +1828,26 @@ var _ atree.TypeInfo = compositeTypeInfo{}
 
 const encodedCompositeTypeInfoLength = 3
 
+// encodedCompositeInstanceTypeInfoLength is the length of the encoding
+// of the type info of an instance of a generic composite type,
+// which additionally includes the type arguments
+const encodedCompositeInstanceTypeInfoLength = 4
+
 func (c compositeTypeInfo) IsComposite() bool {
 	return true
 }
 
 func (c compositeTypeInfo) Identifier() string {
+	if len(c.typeArguments) > 0 {
+		return string(
+			NewCompositeInstanceStaticType(
+				nil,
+				c.location,
+				c.qualifiedIdentifier,
+				c.typeArguments,
+			).ID(),
+		)
+	}
 	return string(c.location.TypeID(nil, c.qualifiedIdentifier))
 }
 
@@ -1694,11 +1857,19 @@ func (c compositeTypeInfo) Copy() atree.TypeInfo {
 }
 
 func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
+	// NOTE: The type arguments are only encoded for instances of generic composite types,
+	// so the encoding of all other composite values is unchanged
+
+	length := byte(encodedCompositeTypeInfoLength)
+	if len(c.typeArguments) > 0 {
+		length = encodedCompositeInstanceTypeInfoLength
+	}
+
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeValue,
-		// array, 3 items follow
-		0x83,
+		// array, 3 or 4 items follow
+		0x80 | length,
 	})
 	if err != nil {
 		return err
@@ -1719,6 +1890,10 @@ func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
 		return err
 	}
 
+	if len(c.typeArguments) > 0 {
+		return encodeStaticTypes(e, c.typeArguments)
+	}
+
 	return nil
 }
 
@@ -1727,7 +1902,25 @@ func (c compositeTypeInfo) Equal(o atree.TypeInfo) bool {
 	return ok &&
 		c.location == other.location &&
 		c.qualifiedIdentifier == other.qualifiedIdentifier &&
-		c.kind == other.kind
+		c.kind == other.kind &&
+		staticTypesEqual(c.typeArguments, other.typeArguments)
+}
+
+// encodeStaticTypes encodes the given static types as an array
+func encodeStaticTypes(e *cbor.StreamEncoder, types []StaticType) error {
+	err := e.EncodeArrayHead(uint64(len(types)))
+	if err != nil {
+		return err
+	}
+
+	for _, ty := range types {
+		err = ty.Encode(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // EmptyTypeInfo
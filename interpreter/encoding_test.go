@@ -406,6 +406,95 @@ func TestEncodeDecodeUint64AtreeValue(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodeExtendedPathAtreeValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		expected := ExtendedPathAtreeValue{
+			Identifier: "foo",
+			Components: common.NewPathComponents(
+				common.NewIntegerPathComponent(big.NewInt(42)),
+			),
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: expected,
+				encoded: []byte{
+					// tag
+					0xd8, CBORTagExtendedPathAtreeValue,
+					// array, 2 items follow
+					0x82,
+					// UTF-8 string, 3 bytes follow
+					0x63,
+					// f, o, o
+					0x66, 0x6f, 0x6f,
+					// byte string, 4 bytes follow
+					0x44,
+					// integer component, 2 bytes follow
+					0x2, 0x2,
+					// 4, 2
+					0x34, 0x32,
+				},
+			},
+		)
+	})
+
+	t.Run("non-canonical components", func(t *testing.T) {
+
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					// tag
+					0xd8, CBORTagExtendedPathAtreeValue,
+					// array, 2 items follow
+					0x82,
+					// UTF-8 string, 3 bytes follow
+					0x63,
+					// f, o, o
+					0x66, 0x6f, 0x6f,
+					// byte string, 5 bytes follow
+					0x45,
+					// integer component, 3 bytes follow
+					0x2, 0x3,
+					// 0, 4, 2
+					0x30, 0x34, 0x32,
+				},
+				invalid: true,
+			},
+		)
+	})
+
+	t.Run("empty components", func(t *testing.T) {
+
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					// tag
+					0xd8, CBORTagExtendedPathAtreeValue,
+					// array, 2 items follow
+					0x82,
+					// UTF-8 string, 3 bytes follow
+					0x63,
+					// f, o, o
+					0x66, 0x6f, 0x6f,
+					// byte string, 0 bytes follow
+					0x40,
+				},
+				invalid: true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeArray(t *testing.T) {
 
 	t.Skip("skipping ArrayValue encoding and decoding test because it involves implementation details in atree repo")
@@ -3760,6 +3849,72 @@ func TestEncodeDecodePathValue(t *testing.T) {
 		)
 	})
 
+	t.Run("extended", func(t *testing.T) {
+
+		t.Parallel()
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPathValue,
+			// array, 3 items follow
+			0x83,
+			// positive integer 1
+			0x1,
+			// UTF-8 string, 3 bytes follow
+			0x63,
+			// f, o, o
+			0x66, 0x6f, 0x6f,
+			// byte string, 4 bytes follow
+			0x44,
+			// integer component, 2 bytes follow
+			0x2, 0x2,
+			// 4, 2
+			0x34, 0x32,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value: NewUnmeteredExtendedPathValue(
+					common.PathDomainStorage,
+					"foo",
+					common.NewPathComponents(
+						common.NewIntegerPathComponent(big.NewInt(42)),
+					),
+				),
+				encoded: encoded,
+			},
+		)
+	})
+
+	t.Run("extended, invalid components", func(t *testing.T) {
+
+		t.Parallel()
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded: []byte{
+					// tag
+					0xd8, CBORTagPathValue,
+					// array, 3 items follow
+					0x83,
+					// positive integer 1
+					0x1,
+					// UTF-8 string, 3 bytes follow
+					0x63,
+					// f, o, o
+					0x66, 0x6f, 0x6f,
+					// byte string, 3 bytes follow
+					0x43,
+					// unknown component, 1 byte follows
+					0x0, 0x1,
+					// 4
+					0x34,
+				},
+				invalid: true,
+			},
+		)
+	})
+
 	t.Run("larger than max inline size", func(t *testing.T) {
 
 		t.Parallel()
@@ -4118,7 +4273,7 @@ func TestCBORTagValue(t *testing.T) {
 	t.Parallel()
 
 	t.Run("No new types added in between", func(t *testing.T) {
-		require.Equal(t, byte(231), byte(CBORTag_Count))
+		require.Equal(t, byte(232), byte(CBORTag_Count))
 	})
 }
 
@@ -313,6 +313,20 @@ func (e DivisionByZeroError) Error() string {
 	return "division by zero"
 }
 
+// NegativeSquareRootError
+
+type NegativeSquareRootError struct {
+	LocationRange
+}
+
+var _ errors.UserError = NegativeSquareRootError{}
+
+func (NegativeSquareRootError) IsUserError() {}
+
+func (e NegativeSquareRootError) Error() string {
+	return "square root of negative number"
+}
+
 // InvalidatedResourceError
 type InvalidatedResourceError struct {
 	LocationRange
@@ -340,6 +354,46 @@ func (e DestroyedResourceError) Error() string {
 	return "resource was destroyed and cannot be used anymore"
 }
 
+// NonRevertibleOperationError is the error which is reported
+// when an operation which cannot be reverted is performed in a try block.
+// The error cannot be caught by a try statement
+type NonRevertibleOperationError struct {
+	Operation string
+	LocationRange
+}
+
+var _ errors.UserError = NonRevertibleOperationError{}
+
+func (NonRevertibleOperationError) IsUserError() {}
+
+func (e NonRevertibleOperationError) Error() string {
+	return fmt.Sprintf(
+		"cannot %s in try block: the operation cannot be reverted",
+		e.Operation,
+	)
+}
+
+// OverlappingBorrowError is the error which is reported
+// when a mutable borrow of a stored resource overlaps
+// with an active mutable borrow of the same resource
+type OverlappingBorrowError struct {
+	Address common.Address
+	Path    PathValue
+	LocationRange
+}
+
+var _ errors.UserError = OverlappingBorrowError{}
+
+func (OverlappingBorrowError) IsUserError() {}
+
+func (e OverlappingBorrowError) Error() string {
+	return fmt.Sprintf(
+		"cannot borrow resource at %s in account %s: the resource is already borrowed mutably",
+		e.Path,
+		e.Address.HexWithPrefix(),
+	)
+}
+
 // ForceNilError
 type ForceNilError struct {
 	LocationRange
@@ -401,6 +455,23 @@ func (e TypeMismatchError) Error() string {
 	)
 }
 
+// NotIndexableError
+type NotIndexableError struct {
+	Type sema.Type
+	LocationRange
+}
+
+var _ errors.UserError = NotIndexableError{}
+
+func (NotIndexableError) IsUserError() {}
+
+func (e NotIndexableError) Error() string {
+	return fmt.Sprintf(
+		"cannot index into value of type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
 // InvalidMemberReferenceError
 type InvalidMemberReferenceError struct {
 	ExpectedType sema.Type
@@ -494,6 +565,25 @@ func (e ArrayIndexOutOfBoundsError) Error() string {
 	)
 }
 
+// DestructuringLengthMismatchError
+type DestructuringLengthMismatchError struct {
+	LocationRange
+	ExpectedLength int
+	ActualLength   int
+}
+
+var _ errors.UserError = DestructuringLengthMismatchError{}
+
+func (DestructuringLengthMismatchError) IsUserError() {}
+
+func (e DestructuringLengthMismatchError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure array: expected %d elements, got %d",
+		e.ExpectedLength,
+		e.ActualLength,
+	)
+}
+
 // ArraySliceIndicesError
 type ArraySliceIndicesError struct {
 	LocationRange
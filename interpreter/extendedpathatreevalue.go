@@ -0,0 +1,115 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"encoding/binary"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+)
+
+// ExtendedPathAtreeValue is the key of a value stored at an extended path in a storage map.
+//
+// The key of a path without components is the identifier of the path (StringAtreeValue).
+// The key of an extended path is encoded differently, so the keys of extended paths
+// never collide with the keys of paths without components, whatever their identifier
+type ExtendedPathAtreeValue struct {
+	Identifier string
+	Components common.PathComponents
+}
+
+var _ atree.Value = ExtendedPathAtreeValue{}
+var _ atree.Storable = ExtendedPathAtreeValue{}
+
+func NewExtendedPathAtreeValue(
+	gauge common.MemoryGauge,
+	identifier string,
+	components common.PathComponents,
+) ExtendedPathAtreeValue {
+	common.UseMemory(gauge, common.NewRawStringMemoryUsage(len(identifier)+len(components.Encoded())))
+	return ExtendedPathAtreeValue{
+		Identifier: identifier,
+		Components: components,
+	}
+}
+
+func (v ExtendedPathAtreeValue) Storable(
+	storage atree.SlabStorage,
+	address atree.Address,
+	maxInlineSize uint64,
+) (
+	atree.Storable,
+	error,
+) {
+	return maybeLargeImmutableStorable(v, storage, address, maxInlineSize)
+}
+
+func (v ExtendedPathAtreeValue) ByteSize() uint32 {
+	// tag number (2 bytes) + array head (1 byte) + identifier (CBOR string) + components (CBOR byte string)
+	return cborTagSize + 1 +
+		getBytesCBORSize([]byte(v.Identifier)) +
+		getBytesCBORSize([]byte(v.Components.Encoded()))
+}
+
+func (v ExtendedPathAtreeValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (ExtendedPathAtreeValue) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// PathValue returns the path in the given domain which has this key
+func (v ExtendedPathAtreeValue) PathValue(gauge common.MemoryGauge, domain common.PathDomain) PathValue {
+	return NewExtendedPathValue(gauge, domain, v.Identifier, v.Components)
+}
+
+func ExtendedPathAtreeValueHashInput(v atree.Value, scratch []byte) ([]byte, error) {
+	key := v.(ExtendedPathAtreeValue)
+	encodedComponents := key.Components.Encoded()
+
+	length := binary.MaxVarintLen64 + len(key.Identifier) + len(encodedComponents)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:0]
+	} else {
+		buffer = make([]byte, 0, length)
+	}
+
+	// Prefix the identifier with its length, so the boundary to the components is unambiguous
+	buffer = binary.AppendUvarint(buffer, uint64(len(key.Identifier)))
+	buffer = append(buffer, key.Identifier...)
+	buffer = append(buffer, encodedComponents...)
+	return buffer, nil
+}
+
+func ExtendedPathAtreeValueComparator(
+	storage atree.SlabStorage,
+	value atree.Value,
+	otherStorable atree.Storable,
+) (bool, error) {
+	otherValue, err := otherStorable.StoredValue(storage)
+	if err != nil {
+		return false, err
+	}
+	otherKey, ok := otherValue.(ExtendedPathAtreeValue)
+	return ok && value.(ExtendedPathAtreeValue) == otherKey, nil
+}
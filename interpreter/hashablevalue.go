@@ -61,8 +61,8 @@ const (
 	HashInputTypePath
 	HashInputTypeType
 	HashInputTypeCharacter
-	_
-	_
+	HashInputTypeHashable
+	HashInputTypeDistinct
 	_
 	// Int*
 	HashInputTypeInt
@@ -114,6 +114,10 @@ const (
 	_ // future: UFix256
 	_
 
+	// Time
+	HashInputTypeTimestamp
+	HashInputTypeDuration
+
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
 	// DO *NOT* ADD NEW TYPES AFTER THIS LINE!
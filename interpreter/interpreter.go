@@ -26,8 +26,10 @@ import (
 	"math/big"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"golang.org/x/exp/slices"
 	"golang.org/x/xerrors"
 
 	"github.com/fxamacker/cbor/v2"
@@ -97,6 +99,15 @@ type OnResourceOwnerChangeFunc func(
 	newOwner common.Address,
 )
 
+// OnOverlappingBorrowFunc is a function that is triggered when a mutable borrow of a stored resource
+// overlaps with an active mutable borrow of the same resource.
+type OnOverlappingBorrowFunc func(
+	inter *Interpreter,
+	locationRange LocationRange,
+	address common.Address,
+	path PathValue,
+)
+
 // OnMeterComputationFunc is a function that is called when some computation is about to happen.
 // intensity captures the intensity of the computation and can be set using input sizes
 // complexity of computation given input sizes, or any other factors that could help the upper levels
@@ -364,6 +375,27 @@ func (interpreter *Interpreter) declareGlobal(declaration ast.Declaration) {
 		return
 	}
 	name := identifier.Identifier
+
+	elaboration := interpreter.Program.Elaboration
+
+	switch declaration := declaration.(type) {
+	case *ast.FunctionDeclaration:
+		// Overloads of functions are declared under their function overload name
+		if overloadName, ok := elaboration.FunctionDeclarationOverloadName(declaration); ok {
+			name = overloadName
+		}
+
+	case ast.CompositeLikeDeclaration:
+		// The constructors for the overloads of the initializer
+		// are declared under their function overload name
+		if compositeType := elaboration.CompositeDeclarationType(declaration); compositeType != nil {
+			for _, constructorOverloadType := range compositeType.ConstructorOverloads {
+				overloadName := sema.FunctionOverloadName(name, constructorOverloadType.ArgumentLabels())
+				interpreter.Globals.Set(overloadName, interpreter.FindVariable(overloadName))
+			}
+		}
+	}
+
 	// NOTE: semantic analysis already checked possible invalid redeclaration
 	interpreter.Globals.Set(name, interpreter.FindVariable(name))
 }
@@ -705,6 +737,11 @@ func (interpreter *Interpreter) VisitFunctionDeclaration(declaration *ast.Functi
 
 	identifier := declaration.Identifier.Identifier
 
+	// Overloads of functions are declared under their function overload name
+	if overloadName, ok := interpreter.Program.Elaboration.FunctionDeclarationOverloadName(declaration); ok {
+		identifier = overloadName
+	}
+
 	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionType(declaration)
 
 	// NOTE: find *or* declare, as the function might have not been pre-declared (e.g. in the REPL)
@@ -789,6 +826,8 @@ func (interpreter *Interpreter) visitFunctionBody(
 	interpreter.activations.PushNewWithCurrent()
 	defer interpreter.activations.Pop()
 
+	returnType = interpreter.SubstituteTypeArguments(returnType)
+
 	result := interpreter.visitStatements(beforeStatements)
 	if result, ok := result.(ReturnResult); ok {
 		return result.Value
@@ -1179,6 +1218,15 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 			memberIdentifier := nestedCompositeDeclaration.Identifier.Identifier
 			nestedVariables[memberIdentifier] = nestedVariable
 
+			// The constructors for the overloads of the nested composite's initializer
+			// are declared under their function overload name
+
+			nestedCompositeType := declarationInterpreter.Program.Elaboration.CompositeDeclarationType(nestedCompositeDeclaration)
+			for _, constructorOverloadType := range nestedCompositeType.ConstructorOverloads {
+				overloadName := sema.FunctionOverloadName(memberIdentifier, constructorOverloadType.ArgumentLabels())
+				nestedVariables[overloadName] = declarationInterpreter.FindVariable(overloadName)
+			}
+
 			// statically we know there is at most one of these
 			if nestedCompositeDeclaration.IsResourceDestructionDefaultEvent() {
 				destroyEventConstructor = nestedVariable.GetValue(declarationInterpreter).(FunctionValue)
@@ -1265,6 +1313,12 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 		}
 	}
 
+	// The overloads of the initializer, if any.
+	// NOTE: The initializer overload functions are in the same order
+	// as the constructor overloads of the composite type
+
+	initializerOverloadFunctions := declarationInterpreter.compositeInitializerOverloadFunctions(declaration, lexicalScope)
+
 	functions := declarationInterpreter.compositeFunctions(declaration, lexicalScope)
 
 	if destroyEventConstructor != nil {
@@ -1300,7 +1354,27 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 			code.InitializerFunctionWrapper
 
 		if initializerFunctionWrapper != nil {
-			initializerFunction = initializerFunctionWrapper(initializerFunction)
+
+			// The interface's initializer requirement is satisfied
+			// by the initializer with the same argument labels
+
+			interfaceInitializerType := &sema.FunctionType{
+				Parameters: ty.InitializerParameters,
+			}
+			interfaceArgumentLabels := interfaceInitializerType.ArgumentLabels()
+
+			wrappedOverload := false
+			for i, constructorOverload := range compositeType.ConstructorOverloads {
+				if slices.Equal(constructorOverload.ArgumentLabels(), interfaceArgumentLabels) {
+					initializerOverloadFunctions[i] = initializerFunctionWrapper(initializerOverloadFunctions[i])
+					wrappedOverload = true
+					break
+				}
+			}
+
+			if !wrappedOverload {
+				initializerFunction = initializerFunctionWrapper(initializerFunction)
+			}
 		}
 
 		// Wrap functions
@@ -1315,7 +1389,16 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 			if !ok {
 				panic(errors.NewUnreachableError())
 			}
-			functions.Set(name, functionWrapper(fn))
+			wrappedFunction := functionWrapper(fn)
+			functions.Set(name, wrappedFunction)
+
+			// The wrapper is keyed by the function overload name.
+			// If the function is also set under its identifier, wrap it there, too
+
+			identifier, _, _ := strings.Cut(name, "(")
+			if identifierFunction, ok := functions.Get(identifier); ok && identifierFunction == fn {
+				functions.Set(identifier, wrappedFunction)
+			}
 		}
 
 		if code.DefaultDestroyEventConstructor != nil {
@@ -1351,9 +1434,11 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 
 	config := declarationInterpreter.SharedState.Config
 
-	constructorType := compositeType.ConstructorFunctionType()
-
-	constructorGenerator := func(address common.Address) *HostFunctionValue {
+	newConstructor := func(
+		address common.Address,
+		constructorType *sema.FunctionType,
+		initializerFunction FunctionValue,
+	) *HostFunctionValue {
 		// Constructor is a static function.
 		return NewStaticHostFunctionValue(
 			declarationInterpreter,
@@ -1420,11 +1505,27 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 					)
 				}
 
-				value := NewCompositeValue(
+				// The type arguments of an instance of a generic composite type
+				// are the type arguments of the constructor invocation
+
+				var typeArguments []StaticType
+				if typeParameters := compositeType.TypeParameters(); len(typeParameters) > 0 {
+					typeArguments = make([]StaticType, len(typeParameters))
+					for i, typeParameter := range typeParameters {
+						typeArgument, ok := invocation.TypeParameterTypes.Get(typeParameter)
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+						typeArguments[i] = ConvertSemaToStaticType(interpreter, typeArgument)
+					}
+				}
+
+				value := NewCompositeInstanceValue(
 					interpreter,
 					locationRange,
 					location,
 					qualifiedIdentifier,
+					typeArguments,
 					declaration.Kind(),
 					fields,
 					address,
@@ -1484,6 +1585,14 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 		)
 	}
 
+	constructorGenerator := func(address common.Address) *HostFunctionValue {
+		return newConstructor(
+			address,
+			compositeType.ConstructorFunctionType(),
+			initializerFunction,
+		)
+	}
+
 	// Contract declarations declare a value / instance (singleton),
 	// for all other composite kinds, the constructor is declared
 
@@ -1512,6 +1621,35 @@ func (declarationInterpreter *Interpreter) declareNonEnumCompositeValue(
 			},
 			constructor,
 		)
+
+		// Declare the constructors for the overloads of the initializer,
+		// under their function overload names
+
+		for i, constructorOverloadType := range compositeType.ConstructorOverloads {
+			overloadName := sema.FunctionOverloadName(
+				identifier,
+				constructorOverloadType.ArgumentLabels(),
+			)
+
+			overloadConstructor := newConstructor(
+				common.ZeroAddress,
+				constructorOverloadType,
+				initializerOverloadFunctions[i],
+			)
+			overloadConstructor.NestedVariables = nestedVariables
+
+			overloadVariable := declarationInterpreter.findOrDeclareVariable(overloadName)
+			lexicalScope.Set(overloadName, overloadVariable)
+
+			overloadVariable.SetValue(
+				declarationInterpreter,
+				LocationRange{
+					Location:    location,
+					HasPosition: declaration,
+				},
+				overloadConstructor,
+			)
+		}
 	}
 
 	return lexicalScope, variable
@@ -1661,16 +1799,48 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 	lexicalScope *VariableActivation,
 ) *InterpretedFunctionValue {
 
-	// TODO: support multiple overloaded initializers
-
 	initializers := compositeDeclaration.DeclarationMembers().Initializers()
-	var initializer *ast.SpecialFunctionDeclaration
 	if len(initializers) == 0 {
 		return nil
 	}
 
-	initializer = initializers[0]
+	return interpreter.initializerFunction(initializers[0], lexicalScope)
+}
+
+// compositeInitializerOverloadFunctions returns the functions for the initializers
+// which overload the first initializer of the given composite declaration, if any.
+func (interpreter *Interpreter) compositeInitializerOverloadFunctions(
+	compositeDeclaration ast.CompositeLikeDeclaration,
+	lexicalScope *VariableActivation,
+) []FunctionValue {
+
+	initializers := compositeDeclaration.DeclarationMembers().Initializers()
+	if len(initializers) < 2 {
+		return nil
+	}
+
+	var functions []FunctionValue
+
+	for _, initializer := range initializers[1:] {
+		function := interpreter.initializerFunction(initializer, lexicalScope)
+		if function == nil {
+			continue
+		}
+		functions = append(functions, function)
+	}
+
+	return functions
+}
+
+func (interpreter *Interpreter) initializerFunction(
+	initializer *ast.SpecialFunctionDeclaration,
+	lexicalScope *VariableActivation,
+) *InterpretedFunctionValue {
+
 	functionType := interpreter.Program.Elaboration.ConstructorFunctionType(initializer)
+	if functionType == nil {
+		return nil
+	}
 
 	parameterList := initializer.FunctionDeclaration.ParameterList
 
@@ -1720,13 +1890,13 @@ func (interpreter *Interpreter) defaultFunctions(
 	functions := orderedmap.New[FunctionOrderedMap](functionCount)
 
 	for _, functionDeclaration := range functionDeclarations {
-		name := functionDeclaration.Identifier.Identifier
 		if !functionDeclaration.FunctionBlock.HasStatements() {
 			continue
 		}
 
-		functions.Set(
-			name,
+		interpreter.setCompositeFunction(
+			functions,
+			functionDeclaration,
 			interpreter.compositeFunction(
 				functionDeclaration,
 				lexicalScope,
@@ -1745,9 +1915,9 @@ func (interpreter *Interpreter) compositeFunctions(
 	functions := orderedmap.New[FunctionOrderedMap](len(compositeDeclaration.DeclarationMembers().Functions()))
 
 	for _, functionDeclaration := range compositeDeclaration.DeclarationMembers().Functions() {
-		name := functionDeclaration.Identifier.Identifier
-		functions.Set(
-			name,
+		interpreter.setCompositeFunction(
+			functions,
+			functionDeclaration,
 			interpreter.compositeFunction(
 				functionDeclaration,
 				lexicalScope,
@@ -1758,6 +1928,39 @@ func (interpreter *Interpreter) compositeFunctions(
 	return functions
 }
 
+// functionOverloadName returns the function overload name of the given function declaration,
+// and true if the function is an overload of a previously declared function with the same identifier.
+func (interpreter *Interpreter) functionOverloadName(declaration *ast.FunctionDeclaration) (string, bool) {
+	overloadName, ok := interpreter.Program.Elaboration.FunctionDeclarationOverloadName(declaration)
+	if ok {
+		return overloadName, true
+	}
+
+	return sema.FunctionOverloadName(
+		declaration.Identifier.Identifier,
+		declaration.ParameterList.EffectiveArgumentLabels(),
+	), false
+}
+
+// setCompositeFunction sets the given function of a composite or interface.
+//
+// Functions are always set under their function overload name,
+// so that they can be dispatched by their argument labels,
+// e.g. when the function is invoked through an interface,
+// which declares the functions in a different order.
+// Functions which are not overloads are also set under their identifier.
+func (interpreter *Interpreter) setCompositeFunction(
+	functions *FunctionOrderedMap,
+	declaration *ast.FunctionDeclaration,
+	function FunctionValue,
+) {
+	overloadName, isOverload := interpreter.functionOverloadName(declaration)
+	if !isOverload {
+		functions.Set(declaration.Identifier.Identifier, function)
+	}
+	functions.Set(overloadName, function)
+}
+
 func (interpreter *Interpreter) functionWrappers(
 	members *ast.Members,
 	lexicalScope *VariableActivation,
@@ -1769,7 +1972,10 @@ func (interpreter *Interpreter) functionWrappers(
 
 		functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionType(functionDeclaration)
 
-		name := functionDeclaration.Identifier.Identifier
+		// NOTE: Function wrappers are keyed by the function overload name,
+		// so that they wrap the function with the same argument labels
+
+		name, _ := interpreter.functionOverloadName(functionDeclaration)
 		functionWrapper := interpreter.functionConditionsWrapper(
 			functionDeclaration,
 			functionType,
@@ -1853,6 +2059,50 @@ func (interpreter *Interpreter) SubstituteMappedEntitlements(ty sema.Type) sema.
 	})
 }
 
+// SubstituteTypeArguments replaces the generic types in the given type
+// with the type arguments of the currently executing generic function or composite value, if any.
+func (interpreter *Interpreter) SubstituteTypeArguments(ty sema.Type) sema.Type {
+	typeArguments := interpreter.SharedState.currentTypeArguments
+	if ty == nil || typeArguments == nil {
+		return ty
+	}
+
+	return sema.SubstituteTypeArguments(interpreter, ty, typeArguments)
+}
+
+// substituteTypeArgumentsOfTypes is like SubstituteTypeArguments,
+// but for a list of types. The given list is returned as-is if no substitution is necessary.
+func (interpreter *Interpreter) substituteTypeArgumentsOfTypes(types []sema.Type) []sema.Type {
+	if interpreter.SharedState.currentTypeArguments == nil || len(types) == 0 {
+		return types
+	}
+
+	result := make([]sema.Type, len(types))
+	for i, ty := range types {
+		result[i] = interpreter.SubstituteTypeArguments(ty)
+	}
+	return result
+}
+
+// substituteTypeArgumentsOfTypeParameterTypes is like SubstituteTypeArguments,
+// but for the type arguments of an invocation. The given map is returned as-is if no substitution is necessary.
+func (interpreter *Interpreter) substituteTypeArgumentsOfTypeParameterTypes(
+	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
+) *sema.TypeParameterTypeOrderedMap {
+	if interpreter.SharedState.currentTypeArguments == nil ||
+		typeParameterTypes == nil ||
+		typeParameterTypes.Len() == 0 {
+
+		return typeParameterTypes
+	}
+
+	result := &sema.TypeParameterTypeOrderedMap{}
+	typeParameterTypes.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		result.Set(typeParameter, interpreter.SubstituteTypeArguments(ty))
+	})
+	return result
+}
+
 func (interpreter *Interpreter) ValueIsSubtypeOfSemaType(value Value, targetType sema.Type) bool {
 	return interpreter.IsSubTypeOfSemaType(value.StaticType(interpreter), targetType)
 }
@@ -1873,7 +2123,10 @@ func (interpreter *Interpreter) transferAndConvert(
 		true, // value is standalone.
 	)
 
-	targetType = interpreter.SubstituteMappedEntitlements(targetType)
+	targetType = interpreter.SubstituteMappedEntitlements(
+		interpreter.SubstituteTypeArguments(targetType),
+	)
+	valueType = interpreter.SubstituteTypeArguments(valueType)
 
 	result := interpreter.ConvertAndBox(
 		locationRange,
@@ -1996,6 +2249,14 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		return value
 	}
 
+	// The type argument of a generic type may not be known here,
+	// e.g. for the result of a function of a generic composite value.
+	// The value already has the type of the type argument.
+
+	if _, ok := valueType.(*sema.GenericType); ok {
+		return value
+	}
+
 	unwrappedTargetType := sema.UnwrapOptionalType(targetType)
 
 	// if the value is optional, convert the inner value to the unwrapped target type
@@ -2492,12 +2753,8 @@ func (interpreter *Interpreter) functionConditionsWrapper(
 				interpreter.activations.PushNewWithParent(lexicalScope)
 				defer interpreter.activations.Pop()
 
-				if declaration.ParameterList != nil {
-					interpreter.bindParameterArguments(
-						declaration.ParameterList,
-						invocation.Arguments,
-					)
-				}
+				// NOTE: declare `self` and `base` before binding the parameters,
+				// as default arguments may refer to them
 
 				if invocation.Self != nil {
 					interpreter.declareSelfVariable(*invocation.Self, invocation.LocationRange)
@@ -2506,6 +2763,16 @@ func (interpreter *Interpreter) functionConditionsWrapper(
 					interpreter.declareVariable(sema.BaseIdentifier, invocation.Base)
 				}
 
+				if declaration.ParameterList != nil {
+					// Pass the evaluated default arguments (if any) to the inner function,
+					// so the conditions and the body observe the same arguments
+
+					invocation.Arguments = interpreter.bindParameterArguments(
+						declaration.ParameterList,
+						invocation.Arguments,
+					)
+				}
+
 				// NOTE: It is important to wrap the invocation in a function,
 				//  so the inner function isn't invoked here
 
@@ -3375,6 +3642,34 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 			},
 		},
 	},
+	{
+		name:         sema.TimestampTypeName,
+		functionType: sema.TimestampConversionFunctionType,
+		convert: func(interpreter *Interpreter, value Value, locationRange LocationRange) Value {
+			return ConvertTimestamp(interpreter, value, locationRange)
+		},
+		nestedVariables: []struct {
+			Name  string
+			Value Value
+		}{
+			// Converter functions are static functions.
+			{
+				Name: sema.TimestampTypeFromStringFunctionName,
+				Value: NewUnmeteredStaticHostFunctionValue(
+					sema.TimestampTypeFromStringFunctionType,
+					TimestampFromString,
+				),
+			},
+		},
+	},
+	{
+		name:         sema.DurationTypeName,
+		functionType: sema.DurationConversionFunctionType,
+		convert: func(interpreter *Interpreter, value Value, locationRange LocationRange) Value {
+			return ConvertDuration(interpreter, value, locationRange)
+		},
+		nestedVariables: durationConstantVariables(),
+	},
 	{
 		name:         sema.PublicPathType.Name,
 		functionType: sema.PublicPathConversionFunctionType,
@@ -3398,6 +3693,35 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	},
 }
 
+func durationConstantVariables() []struct {
+	Name  string
+	Value Value
+} {
+	variables := make(
+		[]struct {
+			Name  string
+			Value Value
+		},
+		0,
+		len(sema.DurationConstants),
+	)
+
+	for _, constant := range sema.DurationConstants {
+		variables = append(
+			variables,
+			struct {
+				Name  string
+				Value Value
+			}{
+				Name:  constant.Name,
+				Value: NewUnmeteredDurationValue(constant.Seconds * sema.Fix64Factor),
+			},
+		)
+	}
+
+	return variables
+}
+
 func lookupInterface(interpreter *Interpreter, typeID string) (*sema.InterfaceType, error) {
 	location, qualifiedIdentifier, err := common.DecodeTypeID(interpreter, typeID)
 	// if the typeID is invalid, return nil
@@ -4046,6 +4370,8 @@ func (interpreter *Interpreter) IsSubTypeOfSemaType(staticSubType StaticType, su
 		return true
 	}
 
+	superType = interpreter.SubstituteTypeArguments(superType)
+
 	// Optimization: Implement subtyping for common cases directly,
 	// without converting the subtype to a sema type.
 
@@ -4081,8 +4407,7 @@ func (interpreter *Interpreter) domainPaths(address common.Address, domain commo
 		paths = make([]Value, 0, count)
 		for key := iterator.NextKey(); key != nil; key = iterator.NextKey() {
 			// TODO: unfortunately, the iterator only returns an atree.Value, not a StorageMapKey
-			identifier := string(key.(StringAtreeValue))
-			path := NewPathValue(interpreter, domain, identifier)
+			path := storageMapKeyPathValue(interpreter, domain, key)
 			paths = append(paths, path)
 		}
 	}
@@ -4196,8 +4521,7 @@ func (interpreter *Interpreter) newStorageIterationFunction(
 				}
 
 				// TODO: unfortunately, the iterator only returns an atree.Value, not a StorageMapKey
-				identifier := string(key.(StringAtreeValue))
-				pathValue := NewPathValue(inter, domain, identifier)
+				pathValue := storageMapKeyPathValue(inter, domain, key)
 				runtimeType := NewTypeValue(inter, staticType)
 
 				result := inter.invokeFunctionValue(
@@ -4328,13 +4652,12 @@ func (interpreter *Interpreter) authAccountSaveFunction(
 			}
 
 			domain := path.Domain.Identifier()
-			identifier := path.Identifier
 
 			// Prevent an overwrite
 
 			locationRange := invocation.LocationRange
 
-			storageMapKey := StringStorageMapKey(identifier)
+			storageMapKey := path.StorageMapKey()
 
 			if interpreter.StoredValueExists(address, domain, storageMapKey) {
 				panic(
@@ -4391,9 +4714,8 @@ func (interpreter *Interpreter) authAccountTypeFunction(
 			}
 
 			domain := path.Domain.Identifier()
-			identifier := path.Identifier
 
-			storageMapKey := StringStorageMapKey(identifier)
+			storageMapKey := path.StorageMapKey()
 
 			value := interpreter.ReadStored(address, domain, storageMapKey)
 
@@ -4449,9 +4771,8 @@ func (interpreter *Interpreter) authAccountReadFunction(
 			}
 
 			domain := path.Domain.Identifier()
-			identifier := path.Identifier
 
-			storageMapKey := StringStorageMapKey(identifier)
+			storageMapKey := path.StorageMapKey()
 
 			value := interpreter.ReadStored(address, domain, storageMapKey)
 
@@ -4564,6 +4885,8 @@ func (interpreter *Interpreter) authAccountBorrowFunction(
 				return Nil
 			}
 
+			interpreter.RecordBorrow(reference, *value, invocation.LocationRange)
+
 			return NewSomeValueNonCopying(interpreter, reference)
 		},
 	)
@@ -4590,9 +4913,8 @@ func (interpreter *Interpreter) authAccountCheckFunction(
 			}
 
 			domain := path.Domain.Identifier()
-			identifier := path.Identifier
 
-			storageMapKey := StringStorageMapKey(identifier)
+			storageMapKey := path.StorageMapKey()
 
 			value := interpreter.ReadStored(address, domain, storageMapKey)
 
@@ -4912,6 +5234,11 @@ func (interpreter *Interpreter) GetInterfaceType(
 	typeID TypeID,
 ) (*sema.InterfaceType, error) {
 	if location == nil {
+		interfaceType := sema.NativeInterfaceTypes[qualifiedIdentifier]
+		if interfaceType != nil {
+			return interfaceType, nil
+		}
+
 		return nil, InterfaceMissingLocationError{
 			QualifiedIdentifier: qualifiedIdentifier,
 		}
@@ -4946,10 +5273,7 @@ func (interpreter *Interpreter) GetInterfaceType(
 func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
 	config := interpreter.SharedState.Config
 
-	onMeterComputation := config.OnMeterComputation
-	if onMeterComputation != nil {
-		onMeterComputation(common.ComputationKindLoop, 1)
-	}
+	interpreter.ReportComputation(common.ComputationKindLoop, 1)
 
 	onLoopIteration := config.OnLoopIteration
 	if onLoopIteration != nil {
@@ -4961,10 +5285,7 @@ func (interpreter *Interpreter) reportLoopIteration(pos ast.HasPosition) {
 func (interpreter *Interpreter) reportFunctionInvocation() {
 	config := interpreter.SharedState.Config
 
-	onMeterComputation := config.OnMeterComputation
-	if onMeterComputation != nil {
-		onMeterComputation(common.ComputationKindFunctionInvocation, 1)
-	}
+	interpreter.ReportComputation(common.ComputationKindFunctionInvocation, 1)
 
 	onFunctionInvocation := config.OnFunctionInvocation
 	if onFunctionInvocation != nil {
@@ -4990,6 +5311,11 @@ func (interpreter *Interpreter) ReportComputation(compKind common.ComputationKin
 	if onMeterComputation != nil {
 		onMeterComputation(compKind, intensity)
 	}
+
+	profiler := config.Profiler
+	if profiler != nil {
+		profiler.onComputation(interpreter, compKind, intensity)
+	}
 }
 
 func (interpreter *Interpreter) getAccessOfMember(self Value, identifier string) sema.Access {
@@ -5194,10 +5520,13 @@ func (interpreter *Interpreter) RemoveReferencedSlab(storable atree.Storable) {
 	}
 
 	slabID := atree.SlabID(slabIDStorable)
-	err := interpreter.Storage().Remove(slabID)
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+
+	interpreter.removeSlabs(func() {
+		err := interpreter.Storage().Remove(slabID)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+	})
 }
 
 func (interpreter *Interpreter) maybeValidateAtreeValue(v atree.Value) {
@@ -5228,6 +5557,8 @@ func (interpreter *Interpreter) ValidateAtreeValue(value atree.Value) {
 			return info.Equal(other.(StaticType))
 		case *DictionaryStaticType:
 			return info.Equal(other.(StaticType))
+		case *SetStaticType:
+			return info.Equal(other.(StaticType))
 		case compositeTypeInfo:
 			return info.Equal(other)
 		case EmptyTypeInfo:
@@ -5245,6 +5576,8 @@ func (interpreter *Interpreter) ValidateAtreeValue(value atree.Value) {
 			return StringAtreeValueHashInput(value, buffer)
 		case Uint64AtreeValue:
 			return Uint64AtreeValueHashInput(value, buffer)
+		case ExtendedPathAtreeValue:
+			return ExtendedPathAtreeValueHashInput(value, buffer)
 		default:
 			return defaultHIP(value, buffer)
 		}
@@ -5284,6 +5617,18 @@ func (interpreter *Interpreter) ValidateAtreeValue(value atree.Value) {
 
 			return equal
 
+		case ExtendedPathAtreeValue:
+			equal, err := ExtendedPathAtreeValueComparator(
+				storage,
+				value,
+				otherStorable,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			return equal
+
 		case EquatableValue:
 			otherValue := StoredValue(interpreter, otherStorable, storage)
 			return value.Equal(interpreter, EmptyLocationRange, otherValue)
@@ -5432,6 +5777,20 @@ func (interpreter *Interpreter) invalidateReferencedResources(
 		return
 	}
 
+	if interpreter.inRevertibleBlock() {
+		referencedValues := make(map[*EphemeralReferenceValue]Value, len(values))
+		for value := range values { //nolint:maprange
+			referencedValues[value] = value.Value
+		}
+
+		interpreter.recordUndo(func(_ *stateRollback) {
+			for value, referencedValue := range referencedValues { //nolint:maprange
+				value.Value = referencedValue
+			}
+			interpreter.SharedState.referencedResourceKindedValues[valueID] = values
+		})
+	}
+
 	for value := range values { //nolint:maprange
 		value.Value = nil
 	}
@@ -5474,6 +5833,12 @@ func (interpreter *Interpreter) startResourceTracking(
 		})
 	}
 
+	if interpreter.inRevertibleBlock() {
+		interpreter.recordUndo(func(_ *stateRollback) {
+			delete(interpreter.SharedState.resourceVariables, resourceKindedValue)
+		})
+	}
+
 	interpreter.SharedState.resourceVariables[resourceKindedValue] = variable
 }
 
@@ -5537,6 +5902,15 @@ func (interpreter *Interpreter) invalidateResource(value Value) {
 		panic(errors.NewUnreachableError())
 	}
 
+	if interpreter.inRevertibleBlock() {
+		variable, ok := interpreter.SharedState.resourceVariables[resourceKindedValue]
+		if ok {
+			interpreter.recordUndo(func(_ *stateRollback) {
+				interpreter.SharedState.resourceVariables[resourceKindedValue] = variable
+			})
+		}
+	}
+
 	// Remove the resource-to-variable mapping.
 	delete(interpreter.SharedState.resourceVariables, resourceKindedValue)
 }
@@ -5546,6 +5920,11 @@ func (interpreter *Interpreter) MeterMemory(usage common.MemoryUsage) error {
 	if interpreter != nil {
 		config := interpreter.SharedState.Config
 		common.UseMemory(config.MemoryGauge, usage)
+
+		profiler := config.Profiler
+		if profiler != nil {
+			profiler.onMemory(interpreter, usage)
+		}
 	}
 	return nil
 }
@@ -5709,6 +6088,12 @@ func (interpreter *Interpreter) withResourceDestruction(
 ) {
 	interpreter.enforceNotResourceDestruction(valueID, locationRange)
 
+	if interpreter.inRevertibleBlock() {
+		interpreter.recordUndo(func(_ *stateRollback) {
+			delete(interpreter.SharedState.destroyedResources, valueID)
+		})
+	}
+
 	interpreter.SharedState.destroyedResources[valueID] = struct{}{}
 
 	f()
@@ -20,6 +20,7 @@ package interpreter
 
 import (
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/onflow/atree"
@@ -65,7 +66,7 @@ func (interpreter *Interpreter) identifierExpressionGetterSetter(
 	identifierExpression *ast.IdentifierExpression,
 	locationRange LocationRange,
 ) getterSetter {
-	identifier := identifierExpression.Identifier.Identifier
+	identifier := interpreter.identifierExpressionName(identifierExpression)
 	variable := interpreter.FindVariable(identifier)
 
 	return getterSetter{
@@ -267,7 +268,16 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(
 			if isNestedResourceMove {
 				resultValue = target.(MemberAccessibleValue).RemoveMember(interpreter, locationRange, identifier)
 			} else {
-				resultValue = interpreter.getMemberWithAuthMapping(target, locationRange, identifier, memberAccessInfo)
+				// If the invoked function is overloaded, get the overload by its function overload name.
+				// The function might not be declared as an overload, e.g. when invoked through an interface,
+				// so fall back to the identifier
+
+				if memberAccessInfo.OverloadName != "" {
+					resultValue = interpreter.getMemberWithAuthMapping(target, locationRange, memberAccessInfo.OverloadName, memberAccessInfo)
+				}
+				if resultValue == nil {
+					resultValue = interpreter.getMemberWithAuthMapping(target, locationRange, identifier, memberAccessInfo)
+				}
 			}
 
 			if resultValue == nil && !allowMissing {
@@ -309,6 +319,8 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(
 // e.g.2: Given T?, this returns (&T)?
 func (interpreter *Interpreter) getReferenceValue(value Value, resultType sema.Type, locationRange LocationRange) Value {
 
+	resultType = interpreter.SubstituteTypeArguments(resultType)
+
 	// `resultType` is always an [optional] reference.
 	// This is guaranteed by the checker.
 	referenceType, ok := sema.UnwrapOptionalType(resultType).(*sema.ReferenceType)
@@ -364,7 +376,7 @@ func (interpreter *Interpreter) checkMemberAccess(
 	interpreter.checkInvalidatedResourceOrResourceReference(target, memberExpression)
 
 	memberInfo, _ := interpreter.Program.Elaboration.MemberExpressionMemberAccessInfo(memberExpression)
-	expectedType := memberInfo.AccessedType
+	expectedType := interpreter.SubstituteTypeArguments(memberInfo.AccessedType)
 
 	switch expectedType := expectedType.(type) {
 	case *sema.TransactionType:
@@ -420,8 +432,20 @@ func (interpreter *Interpreter) checkMemberAccess(
 	}
 }
 
+// identifierExpressionName returns the name of the variable the given identifier expression refers to.
+// If the identifier refers to an overloaded function,
+// the name is the function overload name of the overload which was resolved by the checker.
+func (interpreter *Interpreter) identifierExpressionName(expression *ast.IdentifierExpression) string {
+	if overloadName, ok := interpreter.Program.Elaboration.IdentifierExpressionOverloadName(expression); ok {
+		return overloadName
+	}
+
+	return expression.Identifier.Identifier
+}
+
 func (interpreter *Interpreter) VisitIdentifierExpression(expression *ast.IdentifierExpression) Value {
-	name := expression.Identifier.Identifier
+	name := interpreter.identifierExpressionName(expression)
+
 	variable := interpreter.FindVariable(name)
 	value := variable.GetValue(interpreter)
 
@@ -433,9 +457,81 @@ func (interpreter *Interpreter) VisitIdentifierExpression(expression *ast.Identi
 func (interpreter *Interpreter) evalExpression(expression ast.Expression) Value {
 	result := ast.AcceptExpression[Value](expression, interpreter)
 	interpreter.checkInvalidatedResourceOrResourceReference(result, expression)
+
+	// In the loose mode, the checker may have deferred the type check of the expression to run-time
+	elaboration := interpreter.Program.Elaboration
+	if elaboration.IsLooseModeChecked {
+		if expectedType := elaboration.RuntimeCheckedType(expression); expectedType != nil {
+			result = interpreter.checkRuntimeType(result, expectedType, expression)
+		}
+	}
+
 	return result
 }
 
+// checkRuntimeType checks that the given value has the given expected type,
+// and returns the value converted to the expected type, e.g. boxed into an optional.
+// The check is performed like a force cast
+func (interpreter *Interpreter) checkRuntimeType(
+	value Value,
+	expectedType sema.Type,
+	hasPosition ast.HasPosition,
+) Value {
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: hasPosition,
+	}
+
+	expectedType = interpreter.SubstituteTypeArguments(expectedType)
+
+	// If the expected type is `AnyStruct` or `AnyResource`, preserve optionals
+	unboxedExpectedType := sema.UnwrapOptionalType(expectedType)
+	if !(unboxedExpectedType == sema.AnyStructType || unboxedExpectedType == sema.AnyResourceType) {
+		value = interpreter.Unbox(locationRange, value)
+	}
+
+	valueSemaType := interpreter.MustSemaTypeOfValue(value)
+	valueStaticType := ConvertSemaToStaticType(interpreter, valueSemaType)
+
+	if !interpreter.IsSubTypeOfSemaType(valueStaticType, expectedType) {
+		panic(TypeMismatchError{
+			ExpectedType:  expectedType,
+			ActualType:    valueSemaType,
+			LocationRange: locationRange,
+		})
+	}
+
+	return interpreter.ConvertAndBox(locationRange, value, valueSemaType, expectedType)
+}
+
+// checkRuntimeIndexing checks that the given target value can be indexed into
+// using the given indexing value
+func (interpreter *Interpreter) checkRuntimeIndexing(
+	targetValue Value,
+	indexingValue Value,
+	locationRange LocationRange,
+) {
+	targetType := interpreter.MustSemaTypeOfValue(targetValue)
+
+	_, ok := targetValue.(ValueIndexableValue)
+	if !ok || !sema.IsValueIndexableType(targetType) {
+		panic(NotIndexableError{
+			Type:          targetType,
+			LocationRange: locationRange,
+		})
+	}
+
+	indexingType := targetType.(sema.ValueIndexableType).IndexingType()
+
+	if !interpreter.IsSubTypeOfSemaType(indexingValue.StaticType(interpreter), indexingType) {
+		panic(TypeMismatchError{
+			ExpectedType:  indexingType,
+			ActualType:    interpreter.MustSemaTypeOfValue(indexingValue),
+			LocationRange: locationRange,
+		})
+	}
+}
+
 func (interpreter *Interpreter) checkInvalidatedResourceOrResourceReference(value Value, hasPosition ast.HasPosition) {
 	if interpreter == nil {
 		return
@@ -500,85 +596,23 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 	}
 
 	switch expression.Operation {
-	case ast.OperationPlus:
-		left, leftOk := leftValue.(NumberValue)
-		right, rightOk := rightValue().(NumberValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.Plus(interpreter, right, locationRange)
-
-	case ast.OperationMinus:
-		left, leftOk := leftValue.(NumberValue)
-		right, rightOk := rightValue().(NumberValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.Minus(interpreter, right, locationRange)
-
-	case ast.OperationMod:
-		left, leftOk := leftValue.(NumberValue)
-		right, rightOk := rightValue().(NumberValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.Mod(interpreter, right, locationRange)
-
-	case ast.OperationMul:
-		left, leftOk := leftValue.(NumberValue)
-		right, rightOk := rightValue().(NumberValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.Mul(interpreter, right, locationRange)
-
-	case ast.OperationDiv:
-		left, leftOk := leftValue.(NumberValue)
-		right, rightOk := rightValue().(NumberValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.Div(interpreter, right, locationRange)
-
-	case ast.OperationBitwiseOr:
-		left, leftOk := leftValue.(IntegerValue)
-		right, rightOk := rightValue().(IntegerValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.BitwiseOr(interpreter, right, locationRange)
-
-	case ast.OperationBitwiseXor:
-		left, leftOk := leftValue.(IntegerValue)
-		right, rightOk := rightValue().(IntegerValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.BitwiseXor(interpreter, right, locationRange)
-
-	case ast.OperationBitwiseAnd:
-		left, leftOk := leftValue.(IntegerValue)
-		right, rightOk := rightValue().(IntegerValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.BitwiseAnd(interpreter, right, locationRange)
-
-	case ast.OperationBitwiseLeftShift:
-		left, leftOk := leftValue.(IntegerValue)
-		right, rightOk := rightValue().(IntegerValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.BitwiseLeftShift(interpreter, right, locationRange)
-
-	case ast.OperationBitwiseRightShift:
-		left, leftOk := leftValue.(IntegerValue)
-		right, rightOk := rightValue().(IntegerValue)
-		if !leftOk || !rightOk {
-			error(right)
-		}
-		return left.BitwiseRightShift(interpreter, right, locationRange)
+	case ast.OperationPlus,
+		ast.OperationMinus,
+		ast.OperationMod,
+		ast.OperationMul,
+		ast.OperationDiv,
+		ast.OperationBitwiseOr,
+		ast.OperationBitwiseXor,
+		ast.OperationBitwiseAnd,
+		ast.OperationBitwiseLeftShift,
+		ast.OperationBitwiseRightShift:
+
+		return interpreter.arithmeticOrBitwiseOperation(
+			expression.Operation,
+			leftValue,
+			rightValue(),
+			locationRange,
+		)
 
 	case ast.OperationLess,
 		ast.OperationLessEqual,
@@ -660,6 +694,173 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 	})
 }
 
+// arithmeticOrBitwiseOperation performs the given arithmetic or bitwise operation.
+// It is used for binary expressions (e.g. `a + b`) and compound assignments (e.g. `a += b`).
+func (interpreter *Interpreter) arithmeticOrBitwiseOperation(
+	operation ast.Operation,
+	leftValue, rightValue Value,
+	locationRange LocationRange,
+) Value {
+
+	error := func(right Value) {
+		panic(InvalidOperandsError{
+			Operation:     operation,
+			LeftType:      leftValue.StaticType(interpreter),
+			RightType:     right.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	// Operations on values of distinct types,
+	// which opted in to the operations of their underlying type,
+	// are performed on the underlying values, and the result is wrapped again
+
+	if leftComposite, ok := leftValue.(*CompositeValue); ok {
+		rightComposite, ok := rightValue.(*CompositeValue)
+		if !ok {
+			error(rightValue)
+		}
+
+		result := interpreter.arithmeticOrBitwiseOperation(
+			operation,
+			leftComposite.DistinctUnderlyingValue(interpreter, locationRange),
+			rightComposite.DistinctUnderlyingValue(interpreter, locationRange),
+			locationRange,
+		)
+
+		return leftComposite.NewDistinctValue(interpreter, locationRange, result)
+	}
+
+	// Timestamps and durations only support a restricted set of arithmetic operations
+
+	switch left := leftValue.(type) {
+	case TimestampValue:
+		switch operation {
+		case ast.OperationPlus:
+			right, ok := rightValue.(DurationValue)
+			if !ok {
+				error(rightValue)
+			}
+			return left.Plus(interpreter, right, locationRange)
+
+		case ast.OperationMinus:
+			return left.Minus(interpreter, rightValue, locationRange)
+		}
+
+		error(rightValue)
+
+	case DurationValue:
+		switch operation {
+		case ast.OperationPlus, ast.OperationMinus:
+			right, ok := rightValue.(DurationValue)
+			if !ok {
+				error(rightValue)
+			}
+			if operation == ast.OperationPlus {
+				return left.Plus(interpreter, right, locationRange)
+			}
+			return left.Minus(interpreter, right, locationRange)
+
+		case ast.OperationMul, ast.OperationDiv:
+			right, ok := rightValue.(Int64Value)
+			if !ok {
+				error(rightValue)
+			}
+			if operation == ast.OperationMul {
+				return left.Mul(interpreter, right, locationRange)
+			}
+			return left.Div(interpreter, right, locationRange)
+		}
+
+		error(rightValue)
+	}
+
+	switch operation {
+	case ast.OperationPlus:
+		left, leftOk := leftValue.(NumberValue)
+		right, rightOk := rightValue.(NumberValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.Plus(interpreter, right, locationRange)
+
+	case ast.OperationMinus:
+		left, leftOk := leftValue.(NumberValue)
+		right, rightOk := rightValue.(NumberValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.Minus(interpreter, right, locationRange)
+
+	case ast.OperationMod:
+		left, leftOk := leftValue.(NumberValue)
+		right, rightOk := rightValue.(NumberValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.Mod(interpreter, right, locationRange)
+
+	case ast.OperationMul:
+		left, leftOk := leftValue.(NumberValue)
+		right, rightOk := rightValue.(NumberValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.Mul(interpreter, right, locationRange)
+
+	case ast.OperationDiv:
+		left, leftOk := leftValue.(NumberValue)
+		right, rightOk := rightValue.(NumberValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.Div(interpreter, right, locationRange)
+
+	case ast.OperationBitwiseOr:
+		left, leftOk := leftValue.(IntegerValue)
+		right, rightOk := rightValue.(IntegerValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.BitwiseOr(interpreter, right, locationRange)
+
+	case ast.OperationBitwiseXor:
+		left, leftOk := leftValue.(IntegerValue)
+		right, rightOk := rightValue.(IntegerValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.BitwiseXor(interpreter, right, locationRange)
+
+	case ast.OperationBitwiseAnd:
+		left, leftOk := leftValue.(IntegerValue)
+		right, rightOk := rightValue.(IntegerValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.BitwiseAnd(interpreter, right, locationRange)
+
+	case ast.OperationBitwiseLeftShift:
+		left, leftOk := leftValue.(IntegerValue)
+		right, rightOk := rightValue.(IntegerValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.BitwiseLeftShift(interpreter, right, locationRange)
+
+	case ast.OperationBitwiseRightShift:
+		left, leftOk := leftValue.(IntegerValue)
+		right, rightOk := rightValue.(IntegerValue)
+		if !leftOk || !rightOk {
+			error(rightValue)
+		}
+		return left.BitwiseRightShift(interpreter, right, locationRange)
+
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) testEqual(left, right Value, expression *ast.BinaryExpression) BoolValue {
 	left = interpreter.Unbox(
 		LocationRange{
@@ -700,6 +901,16 @@ func (interpreter *Interpreter) testComparison(left, right Value, expression *as
 		HasPosition: expression,
 	}
 
+	// Values of distinct types, which opted in to the operations of their underlying type,
+	// are compared using their underlying values
+
+	if leftComposite, ok := left.(*CompositeValue); ok {
+		left = leftComposite.DistinctUnderlyingValue(interpreter, locationRange)
+	}
+	if rightComposite, ok := right.(*CompositeValue); ok {
+		right = rightComposite.DistinctUnderlyingValue(interpreter, locationRange)
+	}
+
 	leftComparable, leftOk := left.(ComparableValue)
 	rightComparable, rightOk := right.(ComparableValue)
 
@@ -754,25 +965,26 @@ func (interpreter *Interpreter) VisitUnaryExpression(expression *ast.UnaryExpres
 	value := interpreter.evalExpression(expression.Expression)
 
 	switch expression.Operation {
-	case ast.OperationNegate:
-		boolValue, ok := value.(BoolValue)
-		if !ok {
-			panic(errors.NewUnreachableError())
+	case ast.OperationNegate, ast.OperationMinus:
+		locationRange := LocationRange{
+			Location:    interpreter.Location,
+			HasPosition: expression,
 		}
-		return boolValue.Negate(interpreter)
 
-	case ast.OperationMinus:
-		integerValue, ok := value.(NumberValue)
-		if !ok {
-			panic(errors.NewUnreachableError())
+		// Operations on values of distinct types,
+		// which opted in to the operations of their underlying type,
+		// are performed on the underlying value, and the result is wrapped again
+
+		if compositeValue, ok := value.(*CompositeValue); ok {
+			result := interpreter.unaryOperation(
+				expression.Operation,
+				compositeValue.DistinctUnderlyingValue(interpreter, locationRange),
+				locationRange,
+			)
+			return compositeValue.NewDistinctValue(interpreter, locationRange, result)
 		}
-		return integerValue.Negate(
-			interpreter,
-			LocationRange{
-				Location:    interpreter.Location,
-				HasPosition: expression,
-			},
-		)
+
+		return interpreter.unaryOperation(expression.Operation, value, locationRange)
 
 	case ast.OperationMul:
 
@@ -815,6 +1027,35 @@ func (interpreter *Interpreter) VisitUnaryExpression(expression *ast.UnaryExpres
 	})
 }
 
+// unaryOperation performs the given negation or minus operation.
+func (interpreter *Interpreter) unaryOperation(
+	operation ast.Operation,
+	value Value,
+	locationRange LocationRange,
+) Value {
+	switch operation {
+	case ast.OperationNegate:
+		boolValue, ok := value.(BoolValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+		return boolValue.Negate(interpreter)
+
+	case ast.OperationMinus:
+		if durationValue, ok := value.(DurationValue); ok {
+			return durationValue.Negate(interpreter, locationRange)
+		}
+
+		integerValue, ok := value.(NumberValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+		return integerValue.Negate(interpreter, locationRange)
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) VisitVoidExpression(_ *ast.VoidExpression) Value {
 	return Void
 }
@@ -957,6 +1198,19 @@ func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpr
 	switch stringType {
 	case sema.CharacterType:
 		return NewUnmeteredCharacterValue(expression.Value)
+
+	case sema.TimestampType:
+		// NOTE: the literal was already validated by the checker
+		value, ok := sema.ParseTimestamp(expression.Value)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+		return NewTimestampValue(
+			interpreter,
+			func() int64 {
+				return value
+			},
+		)
 	}
 
 	// Optimization: If the string is empty, return the empty string singleton
@@ -969,6 +1223,81 @@ func (interpreter *Interpreter) VisitStringExpression(expression *ast.StringExpr
 	return NewUnmeteredStringValue(expression.Value)
 }
 
+func (interpreter *Interpreter) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) Value {
+	values := expression.Values
+	expressions := expression.Expressions
+
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: expression,
+	}
+
+	parts := make([]string, 0, len(values)+len(expressions))
+	length := 0
+
+	for i, value := range values {
+		parts = append(parts, value)
+		length = safeAdd(length, len(value), locationRange)
+
+		if i < len(expressions) {
+			part := interpreter.interpolatedString(expressions[i])
+			parts = append(parts, part)
+			length = safeAdd(length, len(part), locationRange)
+		}
+	}
+
+	return NewStringValue(
+		interpreter,
+		common.NewStringMemoryUsage(length),
+		func() string {
+			return strings.Join(parts, "")
+		},
+	)
+}
+
+// interpolatedString evaluates the given interpolated expression of a string template,
+// and returns its string representation.
+// Strings and characters are used as-is, all other values are converted using their `toString` function.
+func (interpreter *Interpreter) interpolatedString(expression ast.Expression) string {
+	value := interpreter.evalExpression(expression)
+
+	switch value := value.(type) {
+	case *StringValue:
+		return value.Str
+
+	case CharacterValue:
+		return value.Str
+	}
+
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: expression,
+	}
+
+	function, ok := interpreter.getMember(value, locationRange, sema.ToStringFunctionName).(FunctionValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	result := interpreter.invokeFunctionValue(
+		function,
+		nil,
+		nil,
+		nil,
+		nil,
+		sema.StringType,
+		nil,
+		expression,
+	)
+
+	str, ok := result.(*StringValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return str.Str
+}
+
 func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpression) Value {
 	values := interpreter.visitExpressionsNonCopying(expression.Values)
 
@@ -994,7 +1323,10 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	}
 
 	// TODO: cache
-	arrayStaticType := ConvertSemaArrayTypeToStaticArrayType(interpreter, arrayType)
+	arrayStaticType := ConvertSemaArrayTypeToStaticArrayType(
+		interpreter,
+		interpreter.SubstituteTypeArguments(arrayType).(sema.ArrayType),
+	)
 
 	locationRange := LocationRange{
 		Location:    interpreter.Location,
@@ -1050,7 +1382,10 @@ func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.Dictio
 		)
 	}
 
-	dictionaryStaticType := ConvertSemaDictionaryTypeToStaticDictionaryType(interpreter, dictionaryType)
+	dictionaryStaticType := ConvertSemaDictionaryTypeToStaticDictionaryType(
+		interpreter,
+		interpreter.SubstituteTypeArguments(dictionaryType).(*sema.DictionaryType),
+	)
 
 	locationRange := LocationRange{
 		Location:    interpreter.Location,
@@ -1092,15 +1427,22 @@ func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpres
 		}
 		return typedResult.GetTypeKey(interpreter, locationRange, attachmentType)
 	} else {
-		typedResult, ok := interpreter.evalExpression(expression.TargetExpression).(ValueIndexableValue)
-		if !ok {
-			panic(errors.NewUnreachableError())
-		}
+		targetValue := interpreter.evalExpression(expression.TargetExpression)
 		indexingValue := interpreter.evalExpression(expression.IndexingExpression)
 		locationRange := LocationRange{
 			Location:    interpreter.Location,
 			HasPosition: expression,
 		}
+
+		// In the loose mode, the checker may have deferred the check of the indexing to run-time
+		if interpreter.Program.Elaboration.IsRuntimeCheckedIndexExpression(expression) {
+			interpreter.checkRuntimeIndexing(targetValue, indexingValue, locationRange)
+		}
+
+		typedResult, ok := targetValue.(ValueIndexableValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
 		value := typedResult.GetKey(interpreter, locationRange, indexingValue)
 
 		// If the indexing value is a reference, then return a reference for the resulting value.
@@ -1216,10 +1558,10 @@ func (interpreter *Interpreter) visitInvocationExpressionWithImplicitArgument(in
 
 	invocationExpressionTypes := elaboration.InvocationExpressionTypes(invocationExpression)
 
-	typeParameterTypes := invocationExpressionTypes.TypeArguments
-	argumentTypes := invocationExpressionTypes.ArgumentTypes
-	parameterTypes := invocationExpressionTypes.TypeParameterTypes
-	returnType := invocationExpressionTypes.ReturnType
+	typeParameterTypes := interpreter.substituteTypeArgumentsOfTypeParameterTypes(invocationExpressionTypes.TypeArguments)
+	argumentTypes := interpreter.substituteTypeArgumentsOfTypes(invocationExpressionTypes.ArgumentTypes)
+	parameterTypes := interpreter.substituteTypeArgumentsOfTypes(invocationExpressionTypes.TypeParameterTypes)
+	returnType := interpreter.SubstituteTypeArguments(invocationExpressionTypes.ReturnType)
 
 	// add the implicit argument to the end of the argument list, if it exists
 	if implicitArg != nil {
@@ -1336,7 +1678,9 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 	}
 
 	castingExpressionTypes := interpreter.Program.Elaboration.CastingExpressionTypes(expression)
-	expectedType := interpreter.SubstituteMappedEntitlements(castingExpressionTypes.TargetType)
+	expectedType := interpreter.SubstituteMappedEntitlements(
+		interpreter.SubstituteTypeArguments(castingExpressionTypes.TargetType),
+	)
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
@@ -1356,6 +1700,28 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 			// otherwise dynamic cast now always unboxes optionals
 			value = interpreter.Unbox(locationRange, value)
 		}
+
+		if castingExpressionTypes.ConvertsNumber {
+			// The checker determined that the cast converts the number, e.g. `Int` to `UInt8`.
+			// The failable cast fails, and the force cast aborts,
+			// if the value is not in the range of the target type.
+			// NOTE: the value is nil if the static type of the value is optional,
+			// and it might not be a number if the static type of the value is abstract, e.g. `AnyStruct`
+
+			if numberValue, ok := value.(NumberValue); ok &&
+				interpreter.MustSemaTypeOfValue(numberValue) != unboxedExpectedType {
+
+				convertedValue, err := interpreter.ConvertNumberChecked(numberValue, unboxedExpectedType, locationRange)
+				if err != nil {
+					if expression.Operation == ast.OperationFailableCast {
+						return Nil
+					}
+					panic(err)
+				}
+				value = convertedValue
+			}
+		}
+
 		valueSemaType := interpreter.SubstituteMappedEntitlements(interpreter.MustSemaTypeOfValue(value))
 		valueStaticType := ConvertSemaToStaticType(interpreter, valueSemaType)
 		isSubType := interpreter.IsSubTypeOfSemaType(valueStaticType, expectedType)
@@ -1397,7 +1763,7 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 		return value
 
 	case ast.OperationCast:
-		staticValueType := castingExpressionTypes.StaticValueType
+		staticValueType := interpreter.SubstituteTypeArguments(castingExpressionTypes.StaticValueType)
 		// The cast may upcast to an optional type, e.g. `1 as Int?`, so box
 		return interpreter.ConvertAndBox(locationRange, value, staticValueType, expectedType)
 
@@ -1427,7 +1793,9 @@ func (interpreter *Interpreter) VisitDestroyExpression(expression *ast.DestroyEx
 
 func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *ast.ReferenceExpression) Value {
 
-	borrowType := interpreter.Program.Elaboration.ReferenceExpressionBorrowType(referenceExpression)
+	borrowType := interpreter.SubstituteTypeArguments(
+		interpreter.Program.Elaboration.ReferenceExpressionBorrowType(referenceExpression),
+	)
 
 	result := interpreter.evalExpression(referenceExpression.Expression)
 
@@ -1554,16 +1922,61 @@ func (interpreter *Interpreter) VisitForceExpression(expression *ast.ForceExpres
 func (interpreter *Interpreter) VisitPathExpression(expression *ast.PathExpression) Value {
 	domain := common.PathDomainFromIdentifier(expression.Domain.Identifier)
 
+	identifier := expression.Identifier.Identifier
+
 	// meter the Path's Identifier since path is just a container
-	common.UseMemory(interpreter, common.NewRawStringMemoryUsage(len(expression.Identifier.Identifier)))
+	common.UseMemory(interpreter, common.NewRawStringMemoryUsage(len(identifier)))
+
+	// The components of an extended path are kept separately from the identifier,
+	// see PathValue.StorageMapKey
+
+	var components common.PathComponents
+
+	if len(expression.Components) > 0 {
+		pathComponents := make([]common.PathComponent, 0, len(expression.Components))
+		for _, component := range expression.Components {
+			value := interpreter.evalExpression(component)
+			pathComponents = append(pathComponents, interpreter.pathComponent(value))
+		}
+
+		components = common.NewPathComponents(pathComponents...)
+
+		common.UseMemory(interpreter, common.NewRawStringMemoryUsage(len(components.Encoded())))
+	}
 
-	return NewPathValue(
+	return NewExtendedPathValue(
 		interpreter,
 		domain,
-		expression.Identifier.Identifier,
+		identifier,
+		components,
 	)
 }
 
+// pathComponent returns the path component for the given value.
+// The checker ensures that only addresses, integers, and types are embedded in paths
+func (interpreter *Interpreter) pathComponent(value Value) common.PathComponent {
+	switch value := value.(type) {
+	case AddressValue:
+		return common.NewAddressPathComponent(common.Address(value))
+
+	case BigNumberValue:
+		return common.NewIntegerPathComponent(value.ToBigInt(interpreter))
+
+	case IntegerValue:
+		return common.NewIntegerPathComponent(big.NewInt(int64(value.ToInt(EmptyLocationRange))))
+
+	case TypeValue:
+		var typeID TypeID
+		if value.Type != nil {
+			typeID = value.Type.ID()
+		}
+		return common.NewTypePathComponent(typeID)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
 func (interpreter *Interpreter) VisitAttachExpression(attachExpression *ast.AttachExpression) Value {
 
 	locationRange := LocationRange{
@@ -20,6 +20,7 @@ package interpreter
 
 import (
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/onflow/cadence/ast"
@@ -64,6 +65,16 @@ func (interpreter *Interpreter) importResolvedLocation(resolvedLocation sema.Res
 		for _, identifier := range resolvedLocation.Identifiers {
 			variables[identifier.Identifier] =
 				subInterpreter.Globals.Get(identifier.Identifier)
+
+			// Also import the overloads of the function or constructor, if any.
+			// They are declared under their function overload name, e.g. `foo(bar:)`
+
+			overloadPrefix := identifier.Identifier + "("
+			for name, variable := range subInterpreter.Globals.variables { //nolint:maprange
+				if strings.HasPrefix(name, overloadPrefix) {
+					variables[name] = variable
+				}
+			}
 		}
 	} else {
 		// Only take the global values defined in the program.
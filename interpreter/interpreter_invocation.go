@@ -22,6 +22,7 @@ import (
 	"github.com/onflow/atree"
 
 	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/errors"
 	"github.com/onflow/cadence/sema"
 )
 
@@ -128,7 +129,14 @@ func (interpreter *Interpreter) invokeFunctionValue(
 
 	resultValue := function.invoke(invocation)
 
-	functionReturnType := function.FunctionType().ReturnTypeAnnotation.Type
+	// The return type of a generic function refers to its type parameters,
+	// so substitute them with the type arguments of the invocation
+
+	functionReturnType := sema.SubstituteTypeArguments(
+		interpreter,
+		function.FunctionType().ReturnTypeAnnotation.Type,
+		typeParameterTypes,
+	)
 
 	// Only convert and box.
 	// No need to transfer, since transfer would happen later, when the return value gets assigned.
@@ -168,6 +176,7 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 	current := interpreter.activations.PushNewWithParent(function.Activation)
 	current.IsFunction = true
 
+	invocation.functionReturnType = function.Type.ReturnTypeAnnotation.Type
 	interpreter.SharedState.callStack.Push(invocation)
 
 	// Make `self` available, if any
@@ -185,21 +194,80 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 		}()
 	}
 
+	oldTypeArguments := interpreter.SharedState.currentTypeArguments
+	interpreter.SharedState.currentTypeArguments = interpreter.invocationTypeArguments(function, invocation)
+	defer func() {
+		interpreter.SharedState.currentTypeArguments = oldTypeArguments
+	}()
+
 	return interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments, invocation.LocationRange)
 }
 
+// invocationTypeArguments returns the type arguments which are in scope in the body of the invoked function:
+// The type arguments captured when the function was created,
+// the type arguments of the composite value the function is invoked on, if it is an instance of a generic composite type,
+// and the type arguments of the invocation.
+func (interpreter *Interpreter) invocationTypeArguments(
+	function *InterpretedFunctionValue,
+	invocation Invocation,
+) *sema.TypeParameterTypeOrderedMap {
+
+	var selfCompositeValue *CompositeValue
+	if invocation.Self != nil {
+		selfCompositeValue, _ = (*invocation.Self).(*CompositeValue)
+	}
+
+	hasSelfTypeArguments := selfCompositeValue != nil && len(selfCompositeValue.TypeArguments) > 0
+	hasInvocationTypeArguments := invocation.TypeParameterTypes != nil && invocation.TypeParameterTypes.Len() > 0
+
+	if !hasSelfTypeArguments && !hasInvocationTypeArguments {
+		return function.TypeArguments
+	}
+
+	typeArguments := &sema.TypeParameterTypeOrderedMap{}
+	setTypeArgument := func(typeParameter *sema.TypeParameter, typeArgument sema.Type) {
+		typeArguments.Set(typeParameter, typeArgument)
+	}
+
+	if function.TypeArguments != nil {
+		function.TypeArguments.Foreach(setTypeArgument)
+	}
+
+	if hasSelfTypeArguments {
+		compositeType, ok := interpreter.MustConvertStaticToSemaType(
+			selfCompositeValue.StaticType(interpreter),
+		).(*sema.CompositeType)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		genericCompositeType := compositeType.GenericCompositeType()
+		for i, typeParameter := range genericCompositeType.TypeParameters() {
+			typeArguments.Set(typeParameter, compositeType.TypeArguments()[i])
+		}
+	}
+
+	if hasInvocationTypeArguments {
+		invocation.TypeParameterTypes.Foreach(setTypeArgument)
+	}
+
+	return typeArguments
+}
+
 // NOTE: assumes the function's activation (or an extension of it) is pushed!
 func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	function *InterpretedFunctionValue,
 	arguments []Value,
 	declarationLocationRange LocationRange,
-) Value {
+) (result Value) {
 	defer func() {
 		// Only unwind the call stack if there was no error
 		if r := recover(); r != nil {
 			panic(r)
 		}
-		interpreter.SharedState.callStack.Pop()
+		callStack := interpreter.SharedState.callStack
+		callStack.Pop()
+		interpreter.endBorrows(len(callStack.Invocations), result)
 	}()
 	defer interpreter.activations.Pop()
 
@@ -219,13 +287,58 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	)
 }
 
-// bindParameterArguments binds the argument values to the given parameters
+// bindParameterArguments binds the argument values to the given parameters.
+//
+// Arguments for trailing parameters with default arguments may be omitted.
+// The default arguments are evaluated in the current activation,
+// so they may refer to `self` and to preceding parameters.
+//
+// The arguments, including the evaluated default arguments, are returned.
 func (interpreter *Interpreter) bindParameterArguments(
 	parameterList *ast.ParameterList,
 	arguments []Value,
-) {
+) []Value {
+	argumentCount := len(arguments)
+	if argumentCount < len(parameterList.Parameters) {
+		// Ensure appending the default arguments does not modify the given arguments
+		arguments = arguments[:argumentCount:argumentCount]
+	}
+
 	for parameterIndex, parameter := range parameterList.Parameters {
-		argument := arguments[parameterIndex]
+		var argument Value
+		if parameterIndex < argumentCount {
+			argument = arguments[parameterIndex]
+		} else {
+			argument = interpreter.evalDefaultArgument(parameter)
+			arguments = append(arguments, argument)
+		}
 		interpreter.declareVariable(parameter.Identifier.Identifier, argument)
 	}
+
+	return arguments
+}
+
+// evalDefaultArgument evaluates the default argument of the given parameter,
+// and converts it to the parameter's type
+func (interpreter *Interpreter) evalDefaultArgument(parameter *ast.Parameter) Value {
+	defaultArgument := parameter.DefaultArgument
+	if defaultArgument == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	value := interpreter.evalExpression(defaultArgument)
+
+	defaultArgumentTypes := interpreter.Program.Elaboration.DefaultArgumentTypes(parameter)
+
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: defaultArgument,
+	}
+
+	return interpreter.transferAndConvert(
+		value,
+		defaultArgumentTypes.ValueType,
+		defaultArgumentTypes.ParameterType,
+		locationRange,
+	)
 }
@@ -36,12 +36,9 @@ func (interpreter *Interpreter) evalStatement(statement ast.Statement) Statement
 
 	interpreter.statement = statement
 
-	config := interpreter.SharedState.Config
+	interpreter.ReportComputation(common.ComputationKindStatement, 1)
 
-	onMeterComputation := config.OnMeterComputation
-	if onMeterComputation != nil {
-		onMeterComputation(common.ComputationKindStatement, 1)
-	}
+	config := interpreter.SharedState.Config
 
 	debugger := config.Debugger
 	if debugger != nil {
@@ -115,6 +112,12 @@ func (interpreter *Interpreter) VisitEntitlementMappingDeclaration(_ *ast.Entitl
 	panic(errors.NewUnreachableError())
 }
 
+func (interpreter *Interpreter) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) StatementResult {
+	// Type aliases are resolved statically by the checker,
+	// there is nothing to do at run-time
+	return nil
+}
+
 func (interpreter *Interpreter) VisitIfStatement(statement *ast.IfStatement) StatementResult {
 	switch test := statement.Test.(type) {
 	case ast.Expression:
@@ -178,66 +181,235 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 
 func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.SwitchStatement) StatementResult {
 
-	testValue, ok := interpreter.evalExpression(switchStatement.Expression).(EquatableValue)
+	testValue := interpreter.evalExpression(switchStatement.Expression)
+
+	for _, switchCase := range switchStatement.Cases {
+
+		// If the case has neither an expression nor a pattern, it is the default case.
+		// Evaluate it, i.e. all statements
+
+		if switchCase.IsDefault() {
+			return interpreter.visitSwitchCaseStatements(switchCase)
+		}
+
+		// The case has an expression or a pattern.
+		// Match the test value against it,
+		// and if it matches, evaluate the case's statements
+
+		result, taken := interpreter.visitSwitchCase(switchCase, testValue)
+		if taken {
+			return result
+		}
+
+		// If the test value does not match the case,
+		// then try the next case
+	}
+
+	return nil
+}
+
+func (interpreter *Interpreter) visitSwitchCase(
+	switchCase *ast.SwitchCase,
+	testValue Value,
+) (
+	result StatementResult,
+	taken bool,
+) {
+	var binding Value
+
+	if switchCase.Pattern != nil {
+		var matched bool
+		binding, matched = interpreter.matchSwitchPattern(switchCase.Pattern, testValue)
+		if !matched {
+			return nil, false
+		}
+	} else if !interpreter.matchSwitchCaseExpression(switchCase.Expression, testValue) {
+		return nil, false
+	}
+
+	// If the pattern binds a variable,
+	// declare it in a new scope, so it is available in the guard and the statements
+
+	if binding != nil {
+		interpreter.activations.PushNewWithCurrent()
+		defer interpreter.activations.Pop()
+
+		interpreter.declareVariable(
+			switchCase.Pattern.Identifier.Identifier,
+			binding,
+		)
+	}
+
+	if switchCase.Guard != nil {
+		guardValue, ok := interpreter.evalExpression(switchCase.Guard).(BoolValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if !bool(guardValue) {
+			return nil, false
+		}
+	}
+
+	return interpreter.visitSwitchCaseStatements(switchCase), true
+}
+
+func (interpreter *Interpreter) visitSwitchCaseStatements(switchCase *ast.SwitchCase) StatementResult {
+	// NOTE: the new block ensures that a new scope is introduced
+
+	block := ast.NewBlock(
+		interpreter,
+		switchCase.Statements,
+		ast.EmptyRange,
+	)
+
+	result := interpreter.visitBlock(block)
+
+	if _, ok := result.(BreakResult); ok {
+		return nil
+	}
+
+	return result
+}
+
+func (interpreter *Interpreter) matchSwitchCaseExpression(expression ast.Expression, testValue Value) bool {
+
+	caseValue := interpreter.evalExpression(expression)
+
+	// Any optional value can be compared to `nil`,
+	// even if it is not equatable
+
+	if _, ok := caseValue.(NilValue); ok {
+		_, ok := testValue.(NilValue)
+		return ok
+	}
+
+	equatableTestValue, ok := testValue.(EquatableValue)
 	if !ok {
 		panic(errors.NewUnreachableError())
 	}
 
-	for _, switchCase := range switchStatement.Cases {
+	equatableCaseValue, ok := caseValue.(EquatableValue)
+	if !ok {
+		return false
+	}
 
-		runStatements := func() StatementResult {
-			// NOTE: the new block ensures that a new scope is introduced
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: expression,
+	}
 
-			block := ast.NewBlock(
-				interpreter,
-				switchCase.Statements,
-				ast.EmptyRange,
-			)
+	return equatableTestValue.Equal(interpreter, locationRange, equatableCaseValue)
+}
 
-			result := interpreter.visitBlock(block)
+// matchSwitchPattern matches the test value against the given pattern.
+// If the pattern matches and binds a variable, the value of the variable is returned
+func (interpreter *Interpreter) matchSwitchPattern(
+	pattern *ast.SwitchPattern,
+	testValue Value,
+) (
+	binding Value,
+	matched bool,
+) {
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: pattern,
+	}
 
-			if _, ok := result.(BreakResult); ok {
-				return nil
-			}
+	patternTypes := interpreter.Program.Elaboration.SwitchPatternTypes(pattern)
 
-			return result
+	switch pattern.Kind {
+	case ast.SwitchPatternKindType:
+		targetType := interpreter.SubstituteMappedEntitlements(
+			interpreter.SubstituteTypeArguments(patternTypes.TargetType),
+		)
+
+		// Like failable casts, unbox optionals,
+		// unless the target type is AnyStruct or AnyResource
+
+		value := testValue
+
+		unboxedTargetType := sema.UnwrapOptionalType(targetType)
+		if !(unboxedTargetType == sema.AnyStructType || unboxedTargetType == sema.AnyResourceType) {
+			value = interpreter.Unbox(locationRange, value)
 		}
 
-		// If the case has no expression it is the default case.
-		// Evaluate it, i.e. all statements
+		valueSemaType := interpreter.SubstituteMappedEntitlements(interpreter.MustSemaTypeOfValue(value))
+		valueStaticType := ConvertSemaToStaticType(interpreter, valueSemaType)
 
-		if switchCase.Expression == nil {
-			return runStatements()
+		if !interpreter.IsSubTypeOfSemaType(valueStaticType, targetType) {
+			return nil, false
 		}
 
-		// The case has an expression.
-		// Evaluate it and compare it to the test value
+		binding = interpreter.transferAndConvert(
+			value,
+			valueSemaType,
+			targetType,
+			locationRange,
+		)
 
-		result := interpreter.evalExpression(switchCase.Expression)
+		// Matching is a potential resource move
+		interpreter.invalidateResource(value)
 
-		caseValue, ok := result.(EquatableValue)
+		return binding, true
 
+	case ast.SwitchPatternKindOptional:
+		someValue, ok := testValue.(*SomeValue)
 		if !ok {
-			continue
+			return nil, false
 		}
 
-		// If the test value and case values are equal,
-		// evaluate the case's statements
+		innerValue := someValue.InnerValue(interpreter, locationRange)
 
-		locationRange := LocationRange{
-			Location:    interpreter.Location,
-			HasPosition: switchCase.Expression,
+		binding = interpreter.transferAndConvert(
+			innerValue,
+			patternTypes.TargetType,
+			patternTypes.TargetType,
+			locationRange,
+		)
+
+		return binding, true
+
+	case ast.SwitchPatternKindRange:
+		rangeValue, ok := interpreter.evalExpression(pattern.Expression).(*CompositeValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
 		}
 
-		if testValue.Equal(interpreter, locationRange, caseValue) {
-			return runStatements()
+		rangeType, ok := rangeValue.StaticType(interpreter).(InclusiveRangeStaticType)
+		if !ok {
+			panic(errors.NewUnreachableError())
 		}
 
-		// If the test value and the case values are unequal,
-		// then try the next case
+		contains := rangeContains(
+			rangeValue,
+			rangeType,
+			interpreter,
+			locationRange,
+			convertAndAssertIntegerValue(testValue),
+		)
+
+		return nil, bool(contains)
 	}
 
-	return nil
+	panic(errors.NewUnreachableError())
+}
+
+func (interpreter *Interpreter) VisitTryStatement(statement *ast.TryStatement) StatementResult {
+
+	// If the try block fails, all its state changes are reverted,
+	// and the catch block is executed instead
+
+	var result StatementResult
+
+	reverted := interpreter.revertOnFailure(func() {
+		result = interpreter.visitBlock(statement.Block)
+	})
+	if reverted {
+		return interpreter.visitBlock(statement.CatchBlock)
+	}
+
+	return result
 }
 
 func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatement) StatementResult {
@@ -345,10 +517,14 @@ func (interpreter *Interpreter) visitForStatementBody(
 		)
 	}
 
-	interpreter.declareVariable(
-		statement.Identifier.Identifier,
-		value,
-	)
+	if statement.Pattern != nil {
+		interpreter.declareDestructuringPattern(statement.Pattern, value)
+	} else {
+		interpreter.declareVariable(
+			statement.Identifier.Identifier,
+			value,
+		)
+	}
 
 	result = interpreter.visitBlock(statement.Block)
 
@@ -377,10 +553,13 @@ func (interpreter *Interpreter) emitEvent(event *CompositeValue, eventType *sema
 		})
 	}
 
-	err := onEventEmitted(interpreter, locationRange, event, eventType)
-	if err != nil {
-		panic(err)
-	}
+	// Events emitted in a try block are only emitted if the block succeeds
+	interpreter.WhenCommitted(func() {
+		err := onEventEmitted(interpreter, locationRange, event, eventType)
+		if err != nil {
+			panic(err)
+		}
+	})
 }
 
 func (interpreter *Interpreter) VisitEmitStatement(statement *ast.EmitStatement) StatementResult {
@@ -464,6 +643,11 @@ func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.Variab
 	// NOTE: lexical scope, always declare a new variable.
 	// Do not find an existing variable and assign the value!
 
+	if declaration.Pattern != nil {
+		interpreter.declareDestructuringPattern(declaration.Pattern, value)
+		return nil
+	}
+
 	_ = interpreter.declareVariable(
 		declaration.Identifier.Identifier,
 		value,
@@ -537,6 +721,111 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 	return transferredValue
 }
 
+// declareDestructuringPattern destructures the given value using the given pattern,
+// and declares a variable for each element of the pattern.
+//
+// The value must already have been transferred,
+// i.e. it is owned by the destructuring declaration.
+func (interpreter *Interpreter) declareDestructuringPattern(
+	pattern *ast.DestructuringPattern,
+	value Value,
+) {
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: pattern,
+	}
+
+	elementTypes := interpreter.Program.Elaboration.DestructuringPatternTypes(pattern).ElementTypes
+
+	elements := pattern.Elements
+	elementValues := make([]Value, len(elements))
+
+	switch pattern.Kind {
+	case ast.DestructuringPatternKindArray:
+		array, ok := value.(*ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		count := array.Count()
+		if count != len(elements) {
+			panic(DestructuringLengthMismatchError{
+				ExpectedLength: len(elements),
+				ActualLength:   count,
+				LocationRange:  locationRange,
+			})
+		}
+
+		// Move the elements out of the array.
+		// Removing from the end avoids shifting the remaining elements.
+
+		for i := count - 1; i >= 0; i-- {
+			elementValues[i] = array.Remove(interpreter, locationRange, i)
+		}
+
+		// The array is now empty.
+		// If it is a resource, it was moved into the declaration, so destroy it
+
+		if array.IsResourceKinded(interpreter) {
+			array.Destroy(interpreter, locationRange)
+		}
+
+	case ast.DestructuringPatternKindDictionary:
+		dictionary, ok := value.(*DictionaryValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		for i, element := range elements {
+			keyValue := interpreter.evalExpression(element.Key)
+			elementValue := dictionary.GetKey(interpreter, locationRange, keyValue)
+
+			elementType := elementTypes[i]
+			elementValues[i] = interpreter.transferAndConvert(
+				elementValue,
+				elementType,
+				elementType,
+				locationRange,
+			)
+		}
+
+	case ast.DestructuringPatternKindComposite:
+		composite, ok := value.(MemberAccessibleValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		for i, element := range elements {
+			fieldValue := composite.GetMember(interpreter, locationRange, element.Field.Identifier)
+			if fieldValue == nil {
+				panic(errors.NewUnreachableError())
+			}
+
+			elementType := elementTypes[i]
+			elementValues[i] = interpreter.transferAndConvert(
+				fieldValue,
+				elementType,
+				elementType,
+				locationRange,
+			)
+		}
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	for i, element := range elements {
+		if element.IsDiscard() {
+			continue
+		}
+
+		interpreter.declareVariable(
+			element.Identifier.Identifier,
+			elementValues[i],
+		)
+	}
+}
+
 func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.AssignmentStatement) StatementResult {
 	assignmentStatementTypes := interpreter.Program.Elaboration.AssignmentStatementTypes(assignment)
 	targetType := assignmentStatementTypes.TargetType
@@ -552,16 +841,56 @@ func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.Assignm
 
 	getterSetter := interpreter.assignmentGetterSetter(target, locationRange)
 
-	interpreter.visitAssignment(
-		assignment.Transfer.Operation,
-		getterSetter, targetType,
-		value, valueType,
-		assignment,
-	)
+	if assignment.IsCompound() {
+		interpreter.visitCompoundAssignment(
+			assignment.Operation,
+			getterSetter, targetType,
+			value,
+			assignment,
+		)
+	} else {
+		interpreter.visitAssignment(
+			assignment.Transfer.Operation,
+			getterSetter, targetType,
+			value, valueType,
+			assignment,
+		)
+	}
 
 	return nil
 }
 
+// visitCompoundAssignment performs a compound assignment, e.g. `x += 1`.
+// The target (e.g. the indexed value and the index, or the accessed value)
+// is only evaluated once, through the given getter/setter pair.
+func (interpreter *Interpreter) visitCompoundAssignment(
+	operation ast.Operation,
+	targetGetterSetter getterSetter, targetType sema.Type,
+	valueExpression ast.Expression,
+	position ast.HasPosition,
+) {
+	locationRange := LocationRange{
+		Location:    interpreter.Location,
+		HasPosition: position,
+	}
+
+	const allowMissing = false
+	targetValue := targetGetterSetter.get(allowMissing)
+
+	value := interpreter.evalExpression(valueExpression)
+
+	result := interpreter.arithmeticOrBitwiseOperation(
+		operation,
+		targetValue,
+		value,
+		locationRange,
+	)
+
+	transferredResult := interpreter.transferAndConvert(result, targetType, targetType, locationRange)
+
+	targetGetterSetter.set(transferredResult)
+}
+
 func (interpreter *Interpreter) VisitSwapStatement(swap *ast.SwapStatement) StatementResult {
 
 	// Get type information
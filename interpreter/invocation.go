@@ -33,6 +33,9 @@ type Invocation struct {
 	Interpreter        *Interpreter
 	Arguments          []Value
 	ArgumentTypes      []sema.Type
+	// functionReturnType is the return type of the invoked interpreted function,
+	// set when the invocation is pushed onto the call stack
+	functionReturnType sema.Type
 }
 
 func NewInvocation(
@@ -82,8 +82,8 @@ const (
 	PrimitiveStaticTypeAnyResourceAttachment
 	PrimitiveStaticTypeAnyStructAttachment
 	PrimitiveStaticTypeHashableStruct
-	_
-	_
+	PrimitiveStaticTypeTimestamp
+	PrimitiveStaticTypeDuration
 	_
 
 	// Number
@@ -327,7 +327,9 @@ func (t PrimitiveStaticType) elementSize() uint {
 		PrimitiveStaticTypeUInt64,
 		PrimitiveStaticTypeWord64,
 		PrimitiveStaticTypeFix64,
-		PrimitiveStaticTypeUFix64:
+		PrimitiveStaticTypeUFix64,
+		PrimitiveStaticTypeTimestamp,
+		PrimitiveStaticTypeDuration:
 		return cborTagSize + 9
 
 	case PrimitiveStaticTypePath,
@@ -495,6 +497,12 @@ func (t PrimitiveStaticType) SemaType() sema.Type {
 	case PrimitiveStaticTypeCharacter:
 		return sema.CharacterType
 
+	case PrimitiveStaticTypeTimestamp:
+		return sema.TimestampType
+
+	case PrimitiveStaticTypeDuration:
+		return sema.DurationType
+
 	case PrimitiveStaticTypeMetaType:
 		return sema.MetaType
 
@@ -843,6 +851,10 @@ func ConvertSemaToPrimitiveStaticType(
 		typ = PrimitiveStaticTypeBool
 	case sema.CharacterType:
 		typ = PrimitiveStaticTypeCharacter
+	case sema.TimestampType:
+		typ = PrimitiveStaticTypeTimestamp
+	case sema.DurationType:
+		typ = PrimitiveStaticTypeDuration
 	case sema.AnyType:
 		typ = PrimitiveStaticTypeAny
 	case sema.AnyStructType:
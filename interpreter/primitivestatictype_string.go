@@ -23,6 +23,8 @@ func _() {
 	_ = x[PrimitiveStaticTypeAnyResourceAttachment-12]
 	_ = x[PrimitiveStaticTypeAnyStructAttachment-13]
 	_ = x[PrimitiveStaticTypeHashableStruct-14]
+	_ = x[PrimitiveStaticTypeTimestamp-15]
+	_ = x[PrimitiveStaticTypeDuration-16]
 	_ = x[PrimitiveStaticTypeNumber-18]
 	_ = x[PrimitiveStaticTypeSignedNumber-19]
 	_ = x[PrimitiveStaticTypeInteger-24]
@@ -115,7 +117,7 @@ func _() {
 	_ = x[PrimitiveStaticType_Count-152]
 }
 
-const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockAnyResourceAttachmentAnyStructAttachmentHashableStructNumberSignedNumberIntegerSignedIntegerFixedSizeUnsignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Word128Word256Fix64UFix64PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKeyAuthAccountInboxStorageCapabilityControllerAccountCapabilityControllerAuthAccountStorageCapabilitiesAuthAccountAccountCapabilitiesAuthAccountCapabilitiesPublicAccountCapabilitiesAccountAccount_ContractsAccount_KeysAccount_InboxAccount_StorageCapabilitiesAccount_AccountCapabilitiesAccount_CapabilitiesAccount_StorageMutateInsertRemoveIdentityStorageSaveValueLoadValueCopyValueBorrowValueContractsAddContractUpdateContractRemoveContractKeysAddKeyRevokeKeyInboxPublishInboxCapabilityUnpublishInboxCapabilityClaimInboxCapabilityCapabilitiesStorageCapabilitiesAccountCapabilitiesPublishCapabilityUnpublishCapabilityGetStorageCapabilityControllerIssueStorageCapabilityControllerGetAccountCapabilityControllerIssueAccountCapabilityControllerCapabilitiesMappingAccountMapping_Count"
+const _PrimitiveStaticType_name = "UnknownVoidAnyNeverAnyStructAnyResourceBoolAddressStringCharacterMetaTypeBlockAnyResourceAttachmentAnyStructAttachmentHashableStructTimestampDurationNumberSignedNumberIntegerSignedIntegerFixedSizeUnsignedIntegerFixedPointSignedFixedPointIntInt8Int16Int32Int64Int128Int256UIntUInt8UInt16UInt32UInt64UInt128UInt256Word8Word16Word32Word64Word128Word256Fix64UFix64PathCapabilityStoragePathCapabilityPathPublicPathPrivatePathAuthAccountPublicAccountDeployedContractAuthAccountContractsPublicAccountContractsAuthAccountKeysPublicAccountKeysAccountKeyAuthAccountInboxStorageCapabilityControllerAccountCapabilityControllerAuthAccountStorageCapabilitiesAuthAccountAccountCapabilitiesAuthAccountCapabilitiesPublicAccountCapabilitiesAccountAccount_ContractsAccount_KeysAccount_InboxAccount_StorageCapabilitiesAccount_AccountCapabilitiesAccount_CapabilitiesAccount_StorageMutateInsertRemoveIdentityStorageSaveValueLoadValueCopyValueBorrowValueContractsAddContractUpdateContractRemoveContractKeysAddKeyRevokeKeyInboxPublishInboxCapabilityUnpublishInboxCapabilityClaimInboxCapabilityCapabilitiesStorageCapabilitiesAccountCapabilitiesPublishCapabilityUnpublishCapabilityGetStorageCapabilityControllerIssueStorageCapabilityControllerGetAccountCapabilityControllerIssueAccountCapabilityControllerCapabilitiesMappingAccountMapping_Count"
 
 var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	0:   _PrimitiveStaticType_name[0:7],
@@ -133,96 +135,98 @@ var _PrimitiveStaticType_map = map[PrimitiveStaticType]string{
 	12:  _PrimitiveStaticType_name[78:99],
 	13:  _PrimitiveStaticType_name[99:118],
 	14:  _PrimitiveStaticType_name[118:132],
-	18:  _PrimitiveStaticType_name[132:138],
-	19:  _PrimitiveStaticType_name[138:150],
-	24:  _PrimitiveStaticType_name[150:157],
-	25:  _PrimitiveStaticType_name[157:170],
-	26:  _PrimitiveStaticType_name[170:194],
-	30:  _PrimitiveStaticType_name[194:204],
-	31:  _PrimitiveStaticType_name[204:220],
-	36:  _PrimitiveStaticType_name[220:223],
-	37:  _PrimitiveStaticType_name[223:227],
-	38:  _PrimitiveStaticType_name[227:232],
-	39:  _PrimitiveStaticType_name[232:237],
-	40:  _PrimitiveStaticType_name[237:242],
-	41:  _PrimitiveStaticType_name[242:248],
-	42:  _PrimitiveStaticType_name[248:254],
-	44:  _PrimitiveStaticType_name[254:258],
-	45:  _PrimitiveStaticType_name[258:263],
-	46:  _PrimitiveStaticType_name[263:269],
-	47:  _PrimitiveStaticType_name[269:275],
-	48:  _PrimitiveStaticType_name[275:281],
-	49:  _PrimitiveStaticType_name[281:288],
-	50:  _PrimitiveStaticType_name[288:295],
-	53:  _PrimitiveStaticType_name[295:300],
-	54:  _PrimitiveStaticType_name[300:306],
-	55:  _PrimitiveStaticType_name[306:312],
-	56:  _PrimitiveStaticType_name[312:318],
-	57:  _PrimitiveStaticType_name[318:325],
-	58:  _PrimitiveStaticType_name[325:332],
-	64:  _PrimitiveStaticType_name[332:337],
-	72:  _PrimitiveStaticType_name[337:343],
-	76:  _PrimitiveStaticType_name[343:347],
-	77:  _PrimitiveStaticType_name[347:357],
-	78:  _PrimitiveStaticType_name[357:368],
-	79:  _PrimitiveStaticType_name[368:382],
-	80:  _PrimitiveStaticType_name[382:392],
-	81:  _PrimitiveStaticType_name[392:403],
-	90:  _PrimitiveStaticType_name[403:414],
-	91:  _PrimitiveStaticType_name[414:427],
-	92:  _PrimitiveStaticType_name[427:443],
-	93:  _PrimitiveStaticType_name[443:463],
-	94:  _PrimitiveStaticType_name[463:485],
-	95:  _PrimitiveStaticType_name[485:500],
-	96:  _PrimitiveStaticType_name[500:517],
-	97:  _PrimitiveStaticType_name[517:527],
-	98:  _PrimitiveStaticType_name[527:543],
-	99:  _PrimitiveStaticType_name[543:570],
-	100: _PrimitiveStaticType_name[570:597],
-	101: _PrimitiveStaticType_name[597:627],
-	102: _PrimitiveStaticType_name[627:657],
-	103: _PrimitiveStaticType_name[657:680],
-	104: _PrimitiveStaticType_name[680:705],
-	105: _PrimitiveStaticType_name[705:712],
-	106: _PrimitiveStaticType_name[712:729],
-	107: _PrimitiveStaticType_name[729:741],
-	108: _PrimitiveStaticType_name[741:754],
-	109: _PrimitiveStaticType_name[754:781],
-	110: _PrimitiveStaticType_name[781:808],
-	111: _PrimitiveStaticType_name[808:828],
-	112: _PrimitiveStaticType_name[828:843],
-	118: _PrimitiveStaticType_name[843:849],
-	119: _PrimitiveStaticType_name[849:855],
-	120: _PrimitiveStaticType_name[855:861],
-	121: _PrimitiveStaticType_name[861:869],
-	125: _PrimitiveStaticType_name[869:876],
-	126: _PrimitiveStaticType_name[876:885],
-	127: _PrimitiveStaticType_name[885:894],
-	128: _PrimitiveStaticType_name[894:903],
-	129: _PrimitiveStaticType_name[903:914],
-	130: _PrimitiveStaticType_name[914:923],
-	131: _PrimitiveStaticType_name[923:934],
-	132: _PrimitiveStaticType_name[934:948],
-	133: _PrimitiveStaticType_name[948:962],
-	134: _PrimitiveStaticType_name[962:966],
-	135: _PrimitiveStaticType_name[966:972],
-	136: _PrimitiveStaticType_name[972:981],
-	137: _PrimitiveStaticType_name[981:986],
-	138: _PrimitiveStaticType_name[986:1008],
-	139: _PrimitiveStaticType_name[1008:1032],
-	140: _PrimitiveStaticType_name[1032:1052],
-	141: _PrimitiveStaticType_name[1052:1064],
-	142: _PrimitiveStaticType_name[1064:1083],
-	143: _PrimitiveStaticType_name[1083:1102],
-	144: _PrimitiveStaticType_name[1102:1119],
-	145: _PrimitiveStaticType_name[1119:1138],
-	146: _PrimitiveStaticType_name[1138:1168],
-	147: _PrimitiveStaticType_name[1168:1200],
-	148: _PrimitiveStaticType_name[1200:1230],
-	149: _PrimitiveStaticType_name[1230:1262],
-	150: _PrimitiveStaticType_name[1262:1281],
-	151: _PrimitiveStaticType_name[1281:1295],
-	152: _PrimitiveStaticType_name[1295:1301],
+	15:  _PrimitiveStaticType_name[132:141],
+	16:  _PrimitiveStaticType_name[141:149],
+	18:  _PrimitiveStaticType_name[149:155],
+	19:  _PrimitiveStaticType_name[155:167],
+	24:  _PrimitiveStaticType_name[167:174],
+	25:  _PrimitiveStaticType_name[174:187],
+	26:  _PrimitiveStaticType_name[187:211],
+	30:  _PrimitiveStaticType_name[211:221],
+	31:  _PrimitiveStaticType_name[221:237],
+	36:  _PrimitiveStaticType_name[237:240],
+	37:  _PrimitiveStaticType_name[240:244],
+	38:  _PrimitiveStaticType_name[244:249],
+	39:  _PrimitiveStaticType_name[249:254],
+	40:  _PrimitiveStaticType_name[254:259],
+	41:  _PrimitiveStaticType_name[259:265],
+	42:  _PrimitiveStaticType_name[265:271],
+	44:  _PrimitiveStaticType_name[271:275],
+	45:  _PrimitiveStaticType_name[275:280],
+	46:  _PrimitiveStaticType_name[280:286],
+	47:  _PrimitiveStaticType_name[286:292],
+	48:  _PrimitiveStaticType_name[292:298],
+	49:  _PrimitiveStaticType_name[298:305],
+	50:  _PrimitiveStaticType_name[305:312],
+	53:  _PrimitiveStaticType_name[312:317],
+	54:  _PrimitiveStaticType_name[317:323],
+	55:  _PrimitiveStaticType_name[323:329],
+	56:  _PrimitiveStaticType_name[329:335],
+	57:  _PrimitiveStaticType_name[335:342],
+	58:  _PrimitiveStaticType_name[342:349],
+	64:  _PrimitiveStaticType_name[349:354],
+	72:  _PrimitiveStaticType_name[354:360],
+	76:  _PrimitiveStaticType_name[360:364],
+	77:  _PrimitiveStaticType_name[364:374],
+	78:  _PrimitiveStaticType_name[374:385],
+	79:  _PrimitiveStaticType_name[385:399],
+	80:  _PrimitiveStaticType_name[399:409],
+	81:  _PrimitiveStaticType_name[409:420],
+	90:  _PrimitiveStaticType_name[420:431],
+	91:  _PrimitiveStaticType_name[431:444],
+	92:  _PrimitiveStaticType_name[444:460],
+	93:  _PrimitiveStaticType_name[460:480],
+	94:  _PrimitiveStaticType_name[480:502],
+	95:  _PrimitiveStaticType_name[502:517],
+	96:  _PrimitiveStaticType_name[517:534],
+	97:  _PrimitiveStaticType_name[534:544],
+	98:  _PrimitiveStaticType_name[544:560],
+	99:  _PrimitiveStaticType_name[560:587],
+	100: _PrimitiveStaticType_name[587:614],
+	101: _PrimitiveStaticType_name[614:644],
+	102: _PrimitiveStaticType_name[644:674],
+	103: _PrimitiveStaticType_name[674:697],
+	104: _PrimitiveStaticType_name[697:722],
+	105: _PrimitiveStaticType_name[722:729],
+	106: _PrimitiveStaticType_name[729:746],
+	107: _PrimitiveStaticType_name[746:758],
+	108: _PrimitiveStaticType_name[758:771],
+	109: _PrimitiveStaticType_name[771:798],
+	110: _PrimitiveStaticType_name[798:825],
+	111: _PrimitiveStaticType_name[825:845],
+	112: _PrimitiveStaticType_name[845:860],
+	118: _PrimitiveStaticType_name[860:866],
+	119: _PrimitiveStaticType_name[866:872],
+	120: _PrimitiveStaticType_name[872:878],
+	121: _PrimitiveStaticType_name[878:886],
+	125: _PrimitiveStaticType_name[886:893],
+	126: _PrimitiveStaticType_name[893:902],
+	127: _PrimitiveStaticType_name[902:911],
+	128: _PrimitiveStaticType_name[911:920],
+	129: _PrimitiveStaticType_name[920:931],
+	130: _PrimitiveStaticType_name[931:940],
+	131: _PrimitiveStaticType_name[940:951],
+	132: _PrimitiveStaticType_name[951:965],
+	133: _PrimitiveStaticType_name[965:979],
+	134: _PrimitiveStaticType_name[979:983],
+	135: _PrimitiveStaticType_name[983:989],
+	136: _PrimitiveStaticType_name[989:998],
+	137: _PrimitiveStaticType_name[998:1003],
+	138: _PrimitiveStaticType_name[1003:1025],
+	139: _PrimitiveStaticType_name[1025:1049],
+	140: _PrimitiveStaticType_name[1049:1069],
+	141: _PrimitiveStaticType_name[1069:1081],
+	142: _PrimitiveStaticType_name[1081:1100],
+	143: _PrimitiveStaticType_name[1100:1119],
+	144: _PrimitiveStaticType_name[1119:1136],
+	145: _PrimitiveStaticType_name[1136:1155],
+	146: _PrimitiveStaticType_name[1155:1185],
+	147: _PrimitiveStaticType_name[1185:1217],
+	148: _PrimitiveStaticType_name[1217:1247],
+	149: _PrimitiveStaticType_name[1247:1279],
+	150: _PrimitiveStaticType_name[1279:1298],
+	151: _PrimitiveStaticType_name[1298:1312],
+	152: _PrimitiveStaticType_name[1312:1318],
 }
 
 func (i PrimitiveStaticType) String() string {
@@ -0,0 +1,322 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+// ProfileFrame is a frame of a sampled Cadence call stack.
+type ProfileFrame struct {
+	Location common.Location
+	// Function is the qualified name of the function containing the position,
+	// e.g. `Vault.withdraw`, or empty if the position is not inside a function
+	Function string
+	Line     int
+}
+
+// ProfileSample is the usage aggregated for a unique call stack and usage kind.
+type ProfileSample struct {
+	// Frames are the frames of the call stack, starting with the innermost frame
+	Frames []ProfileFrame
+	// Kind is the name of the computation or memory kind
+	Kind        string
+	Computation uint64
+	Memory      uint64
+}
+
+// Profiler attributes metered computation and memory usage to the Cadence call stack.
+//
+// On each metering event, the current call stack is sampled,
+// and the usage is aggregated per unique call stack and computation/memory kind.
+// The collected samples can be written as a pprof profile using WritePprof.
+type Profiler struct {
+	samples map[string]*ProfileSample
+	// functions contains the function indices of the programs of the profiled locations
+	functions map[common.Location]*profilerFunctionIndex
+}
+
+func NewProfiler() *Profiler {
+	return &Profiler{
+		samples:   map[string]*ProfileSample{},
+		functions: map[common.Location]*profilerFunctionIndex{},
+	}
+}
+
+// Samples returns the collected samples, in a deterministic order.
+func (p *Profiler) Samples() []*ProfileSample {
+	keys := make([]string, 0, len(p.samples))
+	for key := range p.samples { //nolint:maprange
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	samples := make([]*ProfileSample, 0, len(keys))
+	for _, key := range keys {
+		samples = append(samples, p.samples[key])
+	}
+	return samples
+}
+
+// Reset removes all collected samples.
+func (p *Profiler) Reset() {
+	p.samples = map[string]*ProfileSample{}
+}
+
+func (p *Profiler) onComputation(
+	interpreter *Interpreter,
+	kind common.ComputationKind,
+	intensity uint,
+) {
+	sample := p.sample(interpreter, kind.String())
+	sample.Computation += uint64(intensity)
+}
+
+func (p *Profiler) onMemory(interpreter *Interpreter, usage common.MemoryUsage) {
+	sample := p.sample(interpreter, usage.Kind.String())
+	sample.Memory += usage.Amount
+}
+
+// sample returns the sample for the current call stack of the given interpreter
+// and the given kind, creating it if needed.
+func (p *Profiler) sample(interpreter *Interpreter, kind string) *ProfileSample {
+	frames := p.callStackFrames(interpreter)
+
+	var keyBuilder strings.Builder
+	keyBuilder.WriteString(kind)
+	for _, frame := range frames {
+		keyBuilder.WriteByte('|')
+		if frame.Location != nil {
+			keyBuilder.WriteString(frame.Location.ID())
+		}
+		keyBuilder.WriteByte('|')
+		keyBuilder.WriteString(frame.Function)
+		keyBuilder.WriteByte('|')
+		keyBuilder.WriteString(strconv.Itoa(frame.Line))
+	}
+	key := keyBuilder.String()
+
+	sample, ok := p.samples[key]
+	if !ok {
+		sample = &ProfileSample{
+			Frames: frames,
+			Kind:   kind,
+		}
+		p.samples[key] = sample
+	}
+	return sample
+}
+
+// callStackFrames returns the frames of the current call stack, starting with the innermost frame.
+//
+// The innermost frame is the statement currently executed by the given interpreter.
+// Each invocation on the call stack contributes the frame of its call site.
+func (p *Profiler) callStackFrames(interpreter *Interpreter) []ProfileFrame {
+	invocations := interpreter.CallStack()
+
+	frames := make([]ProfileFrame, 0, len(invocations)+1)
+
+	var position ast.HasPosition
+	if interpreter.statement != nil {
+		position = interpreter.statement
+	}
+	frames = append(frames, p.frame(interpreter, interpreter.Location, position))
+
+	for i := len(invocations) - 1; i >= 0; i-- {
+		invocation := invocations[i]
+		locationRange := invocation.LocationRange
+
+		// Invocations which are not originating from Cadence code,
+		// e.g. invocations performed by the host, have no call site
+		if locationRange.Location == nil || locationRange.HasPosition == nil {
+			continue
+		}
+
+		frames = append(
+			frames,
+			p.frame(invocation.Interpreter, locationRange.Location, locationRange.HasPosition),
+		)
+	}
+
+	return frames
+}
+
+func (p *Profiler) frame(
+	interpreter *Interpreter,
+	location common.Location,
+	position ast.HasPosition,
+) ProfileFrame {
+	frame := ProfileFrame{
+		Location: location,
+	}
+
+	if position == nil {
+		return frame
+	}
+
+	startPosition := position.StartPosition()
+	frame.Line = startPosition.Line
+
+	index := p.functionIndex(interpreter, location)
+	if index != nil {
+		frame.Function = index.functionAt(startPosition)
+	}
+
+	return frame
+}
+
+func (p *Profiler) functionIndex(interpreter *Interpreter, location common.Location) *profilerFunctionIndex {
+	if location == nil {
+		return nil
+	}
+
+	index, ok := p.functions[location]
+	if ok {
+		return index
+	}
+
+	// The program of the location is only available
+	// if the interpreter is the one for the location
+	if interpreter == nil || interpreter.Location != location {
+		return nil
+	}
+
+	program := interpreter.Program
+	if program != nil && program.Program != nil {
+		index = newProfilerFunctionIndex(program.Program)
+	}
+
+	// NOTE: also cache the absence of an index,
+	// the program of a location does not change
+	p.functions[location] = index
+
+	return index
+}
+
+// profilerFunctionIndex allows finding the function which contains a position in a program.
+type profilerFunctionIndex struct {
+	// functions are the functions of the program, in pre-order,
+	// i.e. nested functions occur after their enclosing functions
+	functions []profilerFunction
+}
+
+type profilerFunction struct {
+	name string
+	ast.Range
+}
+
+func newProfilerFunctionIndex(program *ast.Program) *profilerFunctionIndex {
+	index := &profilerFunctionIndex{}
+
+	walker := profilerFunctionWalker{
+		index: index,
+	}
+	for _, declaration := range program.Declarations() {
+		ast.Walk(walker, declaration)
+	}
+
+	return index
+}
+
+// functionAt returns the qualified name of the innermost function containing the given position,
+// or an empty string if the position is not inside a function.
+func (i *profilerFunctionIndex) functionAt(position ast.Position) string {
+	var name string
+	for _, function := range i.functions {
+		if function.StartPos.Offset <= position.Offset &&
+			position.Offset <= function.EndPos.Offset {
+
+			name = function.name
+		}
+	}
+	return name
+}
+
+// profilerFunctionWalker collects the functions of a program, qualified by their enclosing declarations.
+type profilerFunctionWalker struct {
+	index  *profilerFunctionIndex
+	prefix string
+}
+
+var _ ast.Walker = profilerFunctionWalker{}
+
+func (w profilerFunctionWalker) Walk(element ast.Element) ast.Walker {
+	switch element := element.(type) {
+	case nil:
+		return nil
+
+	case *ast.CompositeDeclaration:
+		return w.nested(element.Identifier.Identifier)
+
+	case *ast.InterfaceDeclaration:
+		return w.nested(element.Identifier.Identifier)
+
+	case *ast.AttachmentDeclaration:
+		return w.nested(element.Identifier.Identifier)
+
+	case *ast.TransactionDeclaration:
+		return w.function("transaction", element)
+
+	case *ast.FunctionDeclaration:
+		return w.function(element.Identifier.Identifier, element)
+
+	case *ast.SpecialFunctionDeclaration:
+		name := element.FunctionDeclaration.Identifier.Identifier
+		if name == "" {
+			name = element.Kind.Keywords()
+		}
+		return w.function(name, element)
+
+	case *ast.FunctionExpression:
+		return w.function("<function>", element)
+	}
+
+	return w
+}
+
+func (w profilerFunctionWalker) nested(identifier string) profilerFunctionWalker {
+	prefix := identifier
+	if w.prefix != "" {
+		prefix = w.prefix + "." + identifier
+	}
+
+	return profilerFunctionWalker{
+		index:  w.index,
+		prefix: prefix,
+	}
+}
+
+func (w profilerFunctionWalker) function(identifier string, element ast.Element) ast.Walker {
+	nested := w.nested(identifier)
+
+	w.index.functions = append(
+		w.index.functions,
+		profilerFunction{
+			name:  nested.prefix,
+			Range: ast.NewUnmeteredRangeFromPositioned(element),
+		},
+	)
+
+	return nested
+}
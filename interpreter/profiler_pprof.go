@@ -0,0 +1,310 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"compress/gzip"
+	"io"
+
+	"github.com/onflow/cadence/common"
+)
+
+// Field numbers of the pprof profile protobuf messages,
+// see https://github.com/google/pprof/blob/main/proto/profile.proto
+
+const (
+	pprofProfileSampleType        = 1
+	pprofProfileSample            = 2
+	pprofProfileLocation          = 4
+	pprofProfileFunction          = 5
+	pprofProfileStringTable       = 6
+	pprofProfileDefaultSampleType = 14
+)
+
+const (
+	pprofValueTypeType = 1
+	pprofValueTypeUnit = 2
+)
+
+const (
+	pprofSampleLocationID = 1
+	pprofSampleValue      = 2
+	pprofSampleLabel      = 3
+)
+
+const (
+	pprofLabelKey = 1
+	pprofLabelStr = 2
+)
+
+const (
+	pprofLocationID   = 1
+	pprofLocationLine = 4
+)
+
+const (
+	pprofLineFunctionID = 1
+	pprofLineLine       = 2
+)
+
+const (
+	pprofFunctionID         = 1
+	pprofFunctionName       = 2
+	pprofFunctionSystemName = 3
+	pprofFunctionFilename   = 4
+)
+
+const (
+	protobufWireTypeVarint = 0
+	protobufWireTypeBytes  = 2
+)
+
+// WritePprof writes the collected samples as a gzip-compressed pprof profile,
+// which can be inspected with `go tool pprof`.
+//
+// The profile has two sample types, `computation` and `memory`.
+// Each sample is labeled with the computation or memory kind (label `kind`).
+func (p *Profiler) WritePprof(w io.Writer) error {
+	builder := newPprofBuilder()
+
+	data := builder.build(p.Samples())
+
+	gzipWriter := gzip.NewWriter(w)
+	_, err := gzipWriter.Write(data)
+	if err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+type pprofFunctionKey struct {
+	locationID string
+	function   string
+}
+
+type pprofLocationKey struct {
+	function pprofFunctionKey
+	line     int
+}
+
+type pprofBuilder struct {
+	strings     map[string]int64
+	stringTable []string
+	functions   map[pprofFunctionKey]uint64
+	locations   map[pprofLocationKey]uint64
+	profile     protobufBuffer
+}
+
+func newPprofBuilder() *pprofBuilder {
+	builder := &pprofBuilder{
+		strings:   map[string]int64{},
+		functions: map[pprofFunctionKey]uint64{},
+		locations: map[pprofLocationKey]uint64{},
+	}
+	// The first entry of the string table must be the empty string
+	builder.string("")
+	return builder
+}
+
+func (b *pprofBuilder) build(samples []*ProfileSample) []byte {
+
+	computationType := b.string("computation")
+	memoryType := b.string("memory")
+	countUnit := b.string("count")
+
+	b.profile.message(pprofProfileSampleType, func(valueType *protobufBuffer) {
+		valueType.int64Field(pprofValueTypeType, computationType)
+		valueType.int64Field(pprofValueTypeUnit, countUnit)
+	})
+	b.profile.message(pprofProfileSampleType, func(valueType *protobufBuffer) {
+		valueType.int64Field(pprofValueTypeType, memoryType)
+		valueType.int64Field(pprofValueTypeUnit, countUnit)
+	})
+
+	kindKey := b.string("kind")
+
+	for _, sample := range samples {
+		locationIDs := make([]uint64, 0, len(sample.Frames))
+		for _, frame := range sample.Frames {
+			locationIDs = append(locationIDs, b.location(frame))
+		}
+
+		kind := b.string(sample.Kind)
+
+		b.profile.message(pprofProfileSample, func(buffer *protobufBuffer) {
+			buffer.packedUint64Field(pprofSampleLocationID, locationIDs)
+			buffer.packedUint64Field(pprofSampleValue, []uint64{
+				sample.Computation,
+				sample.Memory,
+			})
+			buffer.message(pprofSampleLabel, func(label *protobufBuffer) {
+				label.int64Field(pprofLabelKey, kindKey)
+				label.int64Field(pprofLabelStr, kind)
+			})
+		})
+	}
+
+	b.profile.int64Field(pprofProfileDefaultSampleType, computationType)
+
+	// NOTE: the string table must be written last,
+	// as writing other messages might add strings
+
+	for _, s := range b.stringTable {
+		b.profile.stringField(pprofProfileStringTable, s)
+	}
+
+	return b.profile.data
+}
+
+func (b *pprofBuilder) string(s string) int64 {
+	index, ok := b.strings[s]
+	if !ok {
+		index = int64(len(b.stringTable))
+		b.stringTable = append(b.stringTable, s)
+		b.strings[s] = index
+	}
+	return index
+}
+
+func (b *pprofBuilder) location(frame ProfileFrame) uint64 {
+	functionID := b.function(frame)
+
+	key := pprofLocationKey{
+		function: pprofFunctionKey{
+			locationID: locationID(frame),
+			function:   frame.Function,
+		},
+		line: frame.Line,
+	}
+
+	id, ok := b.locations[key]
+	if ok {
+		return id
+	}
+
+	id = uint64(len(b.locations) + 1)
+	b.locations[key] = id
+
+	b.profile.message(pprofProfileLocation, func(location *protobufBuffer) {
+		location.uint64Field(pprofLocationID, id)
+		location.message(pprofLocationLine, func(line *protobufBuffer) {
+			line.uint64Field(pprofLineFunctionID, functionID)
+			line.int64Field(pprofLineLine, int64(frame.Line))
+		})
+	})
+
+	return id
+}
+
+func (b *pprofBuilder) function(frame ProfileFrame) uint64 {
+	key := pprofFunctionKey{
+		locationID: locationID(frame),
+		function:   frame.Function,
+	}
+
+	id, ok := b.functions[key]
+	if ok {
+		return id
+	}
+
+	id = uint64(len(b.functions) + 1)
+	b.functions[key] = id
+
+	qualifiedIdentifier := frame.Function
+	if qualifiedIdentifier == "" {
+		qualifiedIdentifier = "<global>"
+	}
+	name := b.string(string(common.NewTypeIDFromQualifiedName(nil, frame.Location, qualifiedIdentifier)))
+
+	var filename int64
+	if frame.Location != nil {
+		filename = b.string(frame.Location.String())
+	}
+
+	b.profile.message(pprofProfileFunction, func(function *protobufBuffer) {
+		function.uint64Field(pprofFunctionID, id)
+		function.int64Field(pprofFunctionName, name)
+		function.int64Field(pprofFunctionSystemName, name)
+		function.int64Field(pprofFunctionFilename, filename)
+	})
+
+	return id
+}
+
+func locationID(frame ProfileFrame) string {
+	if frame.Location == nil {
+		return ""
+	}
+	return frame.Location.ID()
+}
+
+// protobufBuffer is a minimal protocol buffer encoder
+type protobufBuffer struct {
+	data []byte
+}
+
+func (b *protobufBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		b.data = append(b.data, byte(x)|0x80)
+		x >>= 7
+	}
+	b.data = append(b.data, byte(x))
+}
+
+func (b *protobufBuffer) key(field int, wireType int) {
+	b.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (b *protobufBuffer) uint64Field(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	b.key(field, protobufWireTypeVarint)
+	b.varint(x)
+}
+
+func (b *protobufBuffer) int64Field(field int, x int64) {
+	b.uint64Field(field, uint64(x))
+}
+
+func (b *protobufBuffer) packedUint64Field(field int, xs []uint64) {
+	if len(xs) == 0 {
+		return
+	}
+	b.message(field, func(packed *protobufBuffer) {
+		for _, x := range xs {
+			packed.varint(x)
+		}
+	})
+}
+
+func (b *protobufBuffer) stringField(field int, s string) {
+	b.key(field, protobufWireTypeBytes)
+	b.varint(uint64(len(s)))
+	b.data = append(b.data, s...)
+}
+
+func (b *protobufBuffer) message(field int, encode func(*protobufBuffer)) {
+	var nested protobufBuffer
+	encode(&nested)
+
+	b.key(field, protobufWireTypeBytes)
+	b.varint(uint64(len(nested.data)))
+	b.data = append(b.data, nested.data...)
+}
@@ -46,6 +46,13 @@ type SharedState struct {
 	containerValueIteration                     map[atree.ValueID]struct{}
 	destroyedResources                          map[atree.ValueID]struct{}
 	currentEntitlementMappedValue               Authorization
+	// journal records the state changes of the current revertible block, if any
+	journal *stateJournal
+	// activeBorrows are the active mutable borrows of stored resources, ordered by call stack depth
+	activeBorrows []activeBorrow
+	// currentTypeArguments are the type arguments of the currently executing generic function
+	// and/or of the generic composite value it is a function of, if any
+	currentTypeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewSharedState(config *Config) *SharedState {
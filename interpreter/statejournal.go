@@ -0,0 +1,334 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	goerrors "errors"
+	"maps"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/errors"
+)
+
+// stateJournal records the state changes performed while executing a revertible block,
+// i.e. the block of a try statement, so that they can be reverted if the execution fails.
+//
+// Journals are nested: When a revertible block completes successfully,
+// its journal is merged into the journal of the enclosing revertible block, if any.
+// The recorded state changes only become final once the outermost revertible block completes.
+type stateJournal struct {
+	parent *stateJournal
+	// undos revert the recorded state changes, when applied in reverse order
+	undos []func(*stateRollback)
+	// removals are the deferred removals of values from storage.
+	// Removed values get restored if the block is reverted,
+	// so they can only be removed once the outermost block has completed.
+	removals []func()
+	// effects are the deferred side effects, e.g. the emission of events
+	effects []func()
+}
+
+// stateRollback keeps track of the stored values affected by the undos of a rollback.
+// Stored values which are no longer referenced after all undos have been applied,
+// e.g. values that were created in the reverted block, are removed from storage.
+type stateRollback struct {
+	live    map[atree.SlabID]bool
+	slabIDs []atree.SlabID
+}
+
+// restore marks the stored value as referenced again.
+func (r *stateRollback) restore(storable atree.Storable) {
+	r.track(storable, true)
+}
+
+// discard marks the stored value as no longer referenced.
+func (r *stateRollback) discard(storable atree.Storable) {
+	r.track(storable, false)
+}
+
+func (r *stateRollback) track(storable atree.Storable, live bool) {
+	for {
+		someStorable, ok := storable.(SomeStorable)
+		if !ok {
+			break
+		}
+		storable = someStorable.Storable
+	}
+
+	slabIDStorable, ok := storable.(atree.SlabIDStorable)
+	if !ok {
+		return
+	}
+
+	slabID := atree.SlabID(slabIDStorable)
+
+	if _, ok := r.live[slabID]; !ok {
+		r.slabIDs = append(r.slabIDs, slabID)
+	}
+
+	// Undos are applied in reverse order,
+	// so the last undo determines if the value is still referenced
+	r.live[slabID] = live
+}
+
+// sharedStateSnapshot is the part of the shared state
+// which is not restored by the unwinding of a failed revertible block.
+type sharedStateSnapshot struct {
+	callStackDepth                              int
+	containerValueIteration                     map[atree.ValueID]struct{}
+	storageMutatedDuringIteration               bool
+	mutationDuringCapabilityControllerIteration bool
+}
+
+func (interpreter *Interpreter) inRevertibleBlock() bool {
+	return interpreter.SharedState.journal != nil
+}
+
+// recordUndo records the given function, which reverts a state change.
+// It must only be called inside a revertible block.
+func (interpreter *Interpreter) recordUndo(undo func(*stateRollback)) {
+	journal := interpreter.SharedState.journal
+	journal.undos = append(journal.undos, undo)
+}
+
+// removeSlabs performs the given removal of values from storage.
+// Inside a revertible block, the removal is deferred until the outermost revertible block completes.
+func (interpreter *Interpreter) removeSlabs(remove func()) {
+	journal := interpreter.SharedState.journal
+	if journal == nil {
+		remove()
+		return
+	}
+	journal.removals = append(journal.removals, remove)
+}
+
+// recordStoredValueCreation records that a value was stored in its own slab,
+// so it can be removed again if the enclosing revertible block is reverted.
+func (interpreter *Interpreter) recordStoredValueCreation(slabID atree.SlabID) {
+	if !interpreter.inRevertibleBlock() || slabID.HasTempAddress() {
+		return
+	}
+	interpreter.recordUndo(func(rollback *stateRollback) {
+		rollback.discard(atree.SlabIDStorable(slabID))
+	})
+}
+
+// WhenCommitted performs the given side effect, e.g. the emission of an event,
+// once all enclosing revertible blocks have completed successfully.
+// If an enclosing revertible block is reverted, the side effect is dropped.
+func (interpreter *Interpreter) WhenCommitted(effect func()) {
+	journal := interpreter.SharedState.journal
+	if journal == nil {
+		effect()
+		return
+	}
+	journal.effects = append(journal.effects, effect)
+}
+
+// EnforceRevertibleOperation reports an error if the given operation,
+// which cannot be reverted, is performed inside a revertible block.
+func (interpreter *Interpreter) EnforceRevertibleOperation(operation string, locationRange LocationRange) {
+	if !interpreter.inRevertibleBlock() {
+		return
+	}
+	panic(NonRevertibleOperationError{
+		Operation:     operation,
+		LocationRange: locationRange,
+	})
+}
+
+// revertOnFailure calls the given function in a new revertible block.
+// If the function fails with a revertible error, all state changes it performed are reverted,
+// and true is returned. Otherwise, the state changes are committed.
+func (interpreter *Interpreter) revertOnFailure(f func()) (reverted bool) {
+	sharedState := interpreter.SharedState
+
+	snapshot := sharedStateSnapshot{
+		callStackDepth:                              len(sharedState.callStack.Invocations),
+		containerValueIteration:                     maps.Clone(sharedState.containerValueIteration),
+		storageMutatedDuringIteration:               sharedState.storageMutatedDuringIteration,
+		mutationDuringCapabilityControllerIteration: sharedState.MutationDuringCapabilityControllerIteration,
+	}
+
+	journal := &stateJournal{
+		parent: sharedState.journal,
+	}
+	sharedState.journal = journal
+
+	func() {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+
+			if !isRevertibleFailure(r) {
+				sharedState.journal = journal.parent
+				panic(r)
+			}
+
+			interpreter.revert(journal)
+			interpreter.restoreSharedState(snapshot)
+			reverted = true
+		}()
+
+		f()
+	}()
+
+	if !reverted {
+		interpreter.commit(journal)
+	}
+
+	return reverted
+}
+
+func (interpreter *Interpreter) commit(journal *stateJournal) {
+	parent := journal.parent
+	interpreter.SharedState.journal = parent
+
+	if parent != nil {
+		parent.undos = append(parent.undos, journal.undos...)
+		parent.removals = append(parent.removals, journal.removals...)
+		parent.effects = append(parent.effects, journal.effects...)
+		return
+	}
+
+	for _, remove := range journal.removals {
+		remove()
+	}
+
+	interpreter.maybeValidateAtreeStorage()
+
+	for _, effect := range journal.effects {
+		effect()
+	}
+}
+
+func (interpreter *Interpreter) revert(journal *stateJournal) {
+	sharedState := interpreter.SharedState
+
+	// Undos must not be recorded themselves
+	sharedState.journal = nil
+	defer func() {
+		sharedState.journal = journal.parent
+	}()
+
+	rollback := &stateRollback{
+		live: map[atree.SlabID]bool{},
+	}
+
+	undos := journal.undos
+	for i := len(undos) - 1; i >= 0; i-- {
+		undos[i](rollback)
+	}
+
+	// Remove the stored values which are no longer referenced.
+	// Temporary values are not referenced from account storage,
+	// and may still be referenced by restored variables.
+
+	storage := interpreter.Storage()
+
+	for _, slabID := range rollback.slabIDs {
+		if slabID.HasTempAddress() {
+			continue
+		}
+
+		// The value might have already been removed as part of another value,
+		// or might have been inlined into another value again
+		slab, found, err := storage.Retrieve(slabID)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if !found {
+			continue
+		}
+
+		// Restored containers are stored again in their existing slabs,
+		// but restored non-container values (e.g. large strings) are stored in new slabs
+		if _, ok := slab.(*atree.StorableSlab); !ok && rollback.live[slabID] {
+			continue
+		}
+
+		storable := atree.SlabIDStorable(slabID)
+		value := StoredValue(interpreter, storable, storage)
+		value.DeepRemove(interpreter, true) // value is standalone because it is no longer referenced.
+		interpreter.RemoveReferencedSlab(storable)
+	}
+
+	interpreter.maybeValidateAtreeStorage()
+}
+
+func (interpreter *Interpreter) restoreSharedState(snapshot sharedStateSnapshot) {
+	sharedState := interpreter.SharedState
+
+	callStack := sharedState.callStack
+	for len(callStack.Invocations) > snapshot.callStackDepth {
+		callStack.Pop()
+	}
+	interpreter.discardBorrows(snapshot.callStackDepth)
+
+	sharedState.containerValueIteration = snapshot.containerValueIteration
+	sharedState.storageMutatedDuringIteration = snapshot.storageMutatedDuringIteration
+	sharedState.MutationDuringCapabilityControllerIteration = snapshot.mutationDuringCapabilityControllerIteration
+}
+
+// isRevertibleFailure returns true if the given recovered panic is a failure
+// which is caught by a try statement, i.e. a user error.
+// Internal errors, external errors (e.g. exceeded limits), memory errors,
+// and non-revertible operations abort the whole execution.
+func isRevertibleFailure(recovered any) bool {
+	err, ok := recovered.(error)
+	if !ok {
+		return false
+	}
+
+	// Unwrap the location and position information added by the interpreter
+	for {
+		switch typedErr := err.(type) {
+		case Error:
+			err = typedErr.Err
+			continue
+		case PositionedError:
+			err = typedErr.Err
+			continue
+		}
+		break
+	}
+
+	if errors.IsInternalError(err) {
+		return false
+	}
+
+	if _, ok := errors.GetExternalError(err); ok {
+		return false
+	}
+
+	var memoryErr errors.MemoryError
+	if goerrors.As(err, &memoryErr) {
+		return false
+	}
+
+	var nonRevertibleErr NonRevertibleOperationError
+	if goerrors.As(err, &nonRevertibleErr) {
+		return false
+	}
+
+	return errors.IsUserError(err)
+}
@@ -60,6 +60,8 @@ type CompositeStaticType struct {
 	Location            common.Location
 	QualifiedIdentifier string
 	TypeID              TypeID
+	// TypeArguments are the type arguments of an instance of a generic composite type, if any
+	TypeArguments []StaticType
 }
 
 var _ StaticType = &CompositeStaticType{}
@@ -102,6 +104,36 @@ func NewCompositeStaticTypeComputeTypeID(
 	)
 }
 
+// NewCompositeInstanceStaticType returns the static type
+// of the instance of the given generic composite type with the given type arguments,
+// e.g. `Box<Int>`
+func NewCompositeInstanceStaticType(
+	memoryGauge common.MemoryGauge,
+	location common.Location,
+	qualifiedIdentifier string,
+	typeArguments []StaticType,
+) *CompositeStaticType {
+	genericTypeID := common.NewTypeIDFromQualifiedName(
+		memoryGauge,
+		location,
+		qualifiedIdentifier,
+	)
+
+	typeArgumentIDs := make([]TypeID, len(typeArguments))
+	for i, typeArgument := range typeArguments {
+		typeArgumentIDs[i] = typeArgument.ID()
+	}
+
+	staticType := NewCompositeStaticType(
+		memoryGauge,
+		location,
+		qualifiedIdentifier,
+		sema.FormatCompositeInstanceTypeID(genericTypeID, typeArgumentIDs),
+	)
+	staticType.TypeArguments = typeArguments
+	return staticType
+}
+
 func (*CompositeStaticType) isStaticType() {}
 
 func (*CompositeStaticType) elementSize() uint {
@@ -126,6 +158,20 @@ func (t *CompositeStaticType) Equal(other StaticType) bool {
 	return otherCompositeType.TypeID == t.TypeID
 }
 
+func staticTypesEqual(types, otherTypes []StaticType) bool {
+	if len(types) != len(otherTypes) {
+		return false
+	}
+
+	for i, ty := range types {
+		if !ty.Equal(otherTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (t *CompositeStaticType) ID() TypeID {
 	return t.TypeID
 }
@@ -350,6 +396,74 @@ func (t InclusiveRangeStaticType) IsDeprecated() bool {
 	return t.ElementType.IsDeprecated()
 }
 
+// SetStaticType
+
+type SetStaticType struct {
+	ElementType StaticType
+}
+
+var _ StaticType = &SetStaticType{}
+var _ atree.TypeInfo = &SetStaticType{}
+
+func NewSetStaticType(
+	memoryGauge common.MemoryGauge,
+	elementType StaticType,
+) *SetStaticType {
+	common.UseMemory(memoryGauge, common.SetStaticTypeMemoryUsage)
+
+	return &SetStaticType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetStaticType) IsComposite() bool {
+	return false
+}
+
+func (t *SetStaticType) Copy() atree.TypeInfo {
+	// SetStaticType is never mutated, return a shallow copy
+	return t
+}
+
+func (t *SetStaticType) Identifier() string {
+	return string(t.ID())
+}
+
+func (*SetStaticType) isStaticType() {}
+
+func (*SetStaticType) elementSize() uint {
+	return UnknownElementSize
+}
+
+func (t *SetStaticType) String() string {
+	return t.MeteredString(nil)
+}
+
+func (t *SetStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
+	common.UseMemory(memoryGauge, common.SetStaticTypeStringMemoryUsage)
+
+	elementStr := t.ElementType.MeteredString(memoryGauge)
+
+	return fmt.Sprintf("Set<%s>", elementStr)
+}
+
+func (t *SetStaticType) Equal(other StaticType) bool {
+	otherSetType, ok := other.(*SetStaticType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherSetType.ElementType)
+}
+
+func (t *SetStaticType) ID() TypeID {
+	return sema.SetTypeID(string(t.ElementType.ID()))
+}
+
+func (t *SetStaticType) IsDeprecated() bool {
+	return t.ElementType.IsDeprecated()
+}
+
 // ConstantSizedStaticType
 
 type ConstantSizedStaticType struct {
@@ -1066,6 +1180,10 @@ func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) Static
 		memberType := ConvertSemaToStaticType(memoryGauge, t.MemberType)
 		return NewInclusiveRangeStaticType(memoryGauge, memberType)
 
+	case *sema.SetType:
+		elementType := ConvertSemaToStaticType(memoryGauge, t.ElementType)
+		return NewSetStaticType(memoryGauge, elementType)
+
 	case *sema.FunctionType:
 		return NewFunctionStaticType(memoryGauge, t)
 	}
@@ -1157,6 +1275,20 @@ func ConvertSemaCompositeTypeToStaticCompositeType(
 	memoryGauge common.MemoryGauge,
 	t *sema.CompositeType,
 ) *CompositeStaticType {
+	if semaTypeArguments := t.TypeArguments(); len(semaTypeArguments) > 0 {
+		typeArguments := make([]StaticType, len(semaTypeArguments))
+		for i, typeArgument := range semaTypeArguments {
+			typeArguments[i] = ConvertSemaToStaticType(memoryGauge, typeArgument)
+		}
+
+		return NewCompositeInstanceStaticType(
+			memoryGauge,
+			t.Location,
+			t.QualifiedIdentifier(),
+			typeArguments,
+		)
+	}
+
 	return NewCompositeStaticType(
 		memoryGauge,
 		t.Location,
@@ -1230,6 +1362,40 @@ type StaticTypeConversionHandler interface {
 	GetCompositeType(location common.Location, qualifiedIdentifier string, typeID TypeID) (*sema.CompositeType, error)
 }
 
+func convertStaticCompositeInstanceTypeToSemaType(
+	memoryGauge common.MemoryGauge,
+	t *CompositeStaticType,
+	handler StaticTypeConversionHandler,
+) (sema.Type, error) {
+	genericType, err := handler.GetCompositeType(
+		t.Location,
+		t.QualifiedIdentifier,
+		common.NewTypeIDFromQualifiedName(memoryGauge, t.Location, t.QualifiedIdentifier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	typeArguments := make([]sema.Type, len(t.TypeArguments))
+	for i, typeArgument := range t.TypeArguments {
+		typeArguments[i], err = ConvertStaticToSemaType(memoryGauge, typeArgument, handler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(typeArguments) != len(genericType.TypeParameters()) {
+		return nil, errors.NewUnexpectedError(
+			"invalid type argument count for composite type %s: expected %d, got %d",
+			genericType.ID(),
+			len(genericType.TypeParameters()),
+			len(typeArguments),
+		)
+	}
+
+	return genericType.Instantiate(memoryGauge, typeArguments, nil, nil), nil
+}
+
 func ConvertStaticToSemaType(
 	memoryGauge common.MemoryGauge,
 	typ StaticType,
@@ -1237,6 +1403,10 @@ func ConvertStaticToSemaType(
 ) (_ sema.Type, err error) {
 	switch t := typ.(type) {
 	case *CompositeStaticType:
+		if len(t.TypeArguments) > 0 {
+			return convertStaticCompositeInstanceTypeToSemaType(memoryGauge, t, handler)
+		}
+
 		return handler.GetCompositeType(
 			t.Location,
 			t.QualifiedIdentifier,
@@ -1317,6 +1487,21 @@ func ConvertStaticToSemaType(
 			elementType,
 		), nil
 
+	case *SetStaticType:
+		elementType, err := ConvertStaticToSemaType(
+			memoryGauge,
+			t.ElementType,
+			handler,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return sema.NewSetType(
+			memoryGauge,
+			elementType,
+		), nil
+
 	case *OptionalStaticType:
 		ty, err := ConvertStaticToSemaType(
 			memoryGauge,
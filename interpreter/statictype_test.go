@@ -1602,6 +1602,16 @@ func TestStaticTypeConversion(t *testing.T) {
 			semaType:   sema.HashableStructType,
 			staticType: PrimitiveStaticTypeHashableStruct,
 		},
+		{
+			name:       "Timestamp",
+			semaType:   sema.TimestampType,
+			staticType: PrimitiveStaticTypeTimestamp,
+		},
+		{
+			name:       "Duration",
+			semaType:   sema.DurationType,
+			staticType: PrimitiveStaticTypeDuration,
+		},
 		{
 			name: "InclusiveRange",
 			semaType: &sema.InclusiveRangeType{
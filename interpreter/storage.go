@@ -82,6 +82,14 @@ func ConvertStoredValue(gauge common.MemoryGauge, value atree.Value) (Value, err
 				value,
 			), nil
 
+		case *SetStaticType:
+			return newSetValueFromAtreeMap(
+				gauge,
+				staticType,
+				SetElementSize(staticType),
+				value,
+			), nil
+
 		case compositeTypeInfo:
 			return newCompositeValueFromAtreeMap(
 				gauge,
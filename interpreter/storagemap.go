@@ -129,6 +129,35 @@ func (s StorageMap) SetValue(interpreter *Interpreter, key StorageMapKey, value
 	interpreter.maybeValidateAtreeValue(s.orderedMap)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			if existingStorable == nil {
+				keyStorable, valueStorable, err := s.orderedMap.Remove(
+					key.AtreeValueCompare,
+					key.AtreeValueHashInput,
+					key.AtreeValue(),
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				interpreter.RemoveReferencedSlab(keyStorable)
+				rollback.discard(valueStorable)
+			} else {
+				valueStorable, err := s.orderedMap.Set(
+					key.AtreeValueCompare,
+					key.AtreeValueHashInput,
+					key.AtreeValue(),
+					StoredValue(interpreter, existingStorable, s.orderedMap.Storage),
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				rollback.discard(valueStorable)
+				rollback.restore(existingStorable)
+			}
+		})
+	}
+
 	existed = existingStorable != nil
 	if existed {
 		existingValue := StoredValue(interpreter, existingStorable, interpreter.Storage())
@@ -158,6 +187,21 @@ func (s StorageMap) RemoveValue(interpreter *Interpreter, key StorageMapKey) (ex
 	interpreter.maybeValidateAtreeValue(s.orderedMap)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			_, err := s.orderedMap.Set(
+				key.AtreeValueCompare,
+				key.AtreeValueHashInput,
+				key.AtreeValue(),
+				StoredValue(interpreter, existingValueStorable, s.orderedMap.Storage),
+			)
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			rollback.restore(existingValueStorable)
+		})
+	}
+
 	// Key
 
 	// NOTE: Key is just an atree.Value, not an interpreter.Value,
@@ -21,6 +21,7 @@ package interpreter
 import (
 	"github.com/onflow/atree"
 
+	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/errors"
 )
 
@@ -77,6 +78,43 @@ func (k Uint64StorageMapKey) AtreeValue() atree.Value {
 	return Uint64AtreeValue(k)
 }
 
+// ExtendedPathStorageMapKey is a StorageMapKey backed by an ExtendedPathAtreeValue
+type ExtendedPathStorageMapKey ExtendedPathAtreeValue
+
+var _ StorageMapKey = ExtendedPathStorageMapKey{}
+
+func (ExtendedPathStorageMapKey) isStorageMapKey() {}
+
+func (ExtendedPathStorageMapKey) AtreeValueHashInput(v atree.Value, scratch []byte) ([]byte, error) {
+	return ExtendedPathAtreeValueHashInput(v, scratch)
+}
+
+func (ExtendedPathStorageMapKey) AtreeValueCompare(
+	slabStorage atree.SlabStorage,
+	value atree.Value,
+	otherStorable atree.Storable,
+) (bool, error) {
+	return ExtendedPathAtreeValueComparator(slabStorage, value, otherStorable)
+}
+
+func (k ExtendedPathStorageMapKey) AtreeValue() atree.Value {
+	return ExtendedPathAtreeValue(k)
+}
+
+// storageMapKeyPathValue returns the path in the given domain for the given key of a storage map
+func storageMapKeyPathValue(gauge common.MemoryGauge, domain common.PathDomain, key atree.Value) PathValue {
+	switch key := key.(type) {
+	case StringAtreeValue:
+		return NewPathValue(gauge, domain, string(key))
+
+	case ExtendedPathAtreeValue:
+		return key.PathValue(gauge, domain)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
 func StorageMapKeyAtreeValueHashInput(value atree.Value, scratch []byte) ([]byte, error) {
 	var smk StorageMapKey
 	switch value := value.(type) {
@@ -86,8 +124,11 @@ func StorageMapKeyAtreeValueHashInput(value atree.Value, scratch []byte) ([]byte
 	case Uint64AtreeValue:
 		smk = Uint64StorageMapKey(value)
 
+	case ExtendedPathAtreeValue:
+		smk = ExtendedPathStorageMapKey(value)
+
 	default:
-		return nil, errors.NewUnexpectedError("StorageMapKeyAtreeValueHashInput expected StringAtreeValue or Uint64AtreeValue, got %T", value)
+		return nil, errors.NewUnexpectedError("StorageMapKeyAtreeValueHashInput expected StringAtreeValue, Uint64AtreeValue, or ExtendedPathAtreeValue, got %T", value)
 	}
 
 	return smk.AtreeValueHashInput(value, scratch)
@@ -102,8 +143,11 @@ func StorageMapKeyAtreeValueComparator(slabStorage atree.SlabStorage, value atre
 	case Uint64AtreeValue:
 		smk = Uint64StorageMapKey(value)
 
+	case ExtendedPathAtreeValue:
+		smk = ExtendedPathStorageMapKey(value)
+
 	default:
-		return false, errors.NewUnexpectedError("StorageMapKeyAtreeValueComparator expected StringAtreeValue or Uint64AtreeValue, got %T", value)
+		return false, errors.NewUnexpectedError("StorageMapKeyAtreeValueComparator expected StringAtreeValue, Uint64AtreeValue, or ExtendedPathAtreeValue, got %T", value)
 	}
 
 	return smk.AtreeValueCompare(slabStorage, value, otherStorable)
@@ -87,6 +87,7 @@ func StringAtreeValueComparator(storage atree.SlabStorage, value atree.Value, ot
 	if err != nil {
 		return false, err
 	}
-	result := value.(StringAtreeValue) == otherValue.(StringAtreeValue)
-	return result, nil
+	// The other key may be a key of a different kind, e.g. the key of an extended path
+	otherString, ok := otherValue.(StringAtreeValue)
+	return ok && value.(StringAtreeValue) == otherString, nil
 }
@@ -395,10 +395,30 @@ func (v *ArrayValue) Destroy(interpreter *Interpreter, locationRange LocationRan
 		},
 	)
 
+	if interpreter.inRevertibleBlock() {
+		isDestroyed := v.isDestroyed
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.isDestroyed = isDestroyed
+		})
+	}
+
 	v.isDestroyed = true
 
 	interpreter.invalidateReferencedResources(v, locationRange)
 
+	v.invalidate(interpreter)
+}
+
+// invalidate unsets the backing array of the resource array,
+// which marks it as invalidated
+func (v *ArrayValue) invalidate(interpreter *Interpreter) {
+	if interpreter.inRevertibleBlock() {
+		array := v.array
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.array = array
+		})
+	}
+
 	v.array = nil
 }
 
@@ -564,6 +584,19 @@ func (v *ArrayValue) Set(interpreter *Interpreter, locationRange LocationRange,
 	interpreter.maybeValidateAtreeValue(v.array)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		array := v.array
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			existingValue := StoredValue(interpreter, existingStorable, array.Storage)
+			storable, err := array.Set(uint64(index), existingValue)
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			rollback.discard(storable)
+			rollback.restore(existingStorable)
+		})
+	}
+
 	existingValue := StoredValue(interpreter, existingStorable, interpreter.Storage())
 	interpreter.checkResourceLoss(existingValue, locationRange)
 	existingValue.DeepRemove(interpreter, true) // existingValue is standalone because it was overwritten in parent container.
@@ -643,6 +676,10 @@ func (v *ArrayValue) Append(interpreter *Interpreter, locationRange LocationRang
 
 	interpreter.maybeValidateAtreeValue(v.array)
 	interpreter.maybeValidateAtreeStorage()
+
+	if interpreter.inRevertibleBlock() {
+		v.recordInsertionUndo(interpreter, v.array.Count()-1)
+	}
 }
 
 func (v *ArrayValue) AppendAll(interpreter *Interpreter, locationRange LocationRange, other *ArrayValue) {
@@ -697,6 +734,23 @@ func (v *ArrayValue) InsertWithoutTransfer(
 	}
 	interpreter.maybeValidateAtreeValue(v.array)
 	interpreter.maybeValidateAtreeStorage()
+
+	if interpreter.inRevertibleBlock() {
+		v.recordInsertionUndo(interpreter, uint64(index))
+	}
+}
+
+// recordInsertionUndo records the removal of the element inserted at the given index,
+// in case the enclosing revertible block is reverted.
+func (v *ArrayValue) recordInsertionUndo(interpreter *Interpreter, index uint64) {
+	array := v.array
+	interpreter.recordUndo(func(rollback *stateRollback) {
+		storable, err := array.Remove(index)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		rollback.discard(storable)
+	})
 }
 
 func (v *ArrayValue) Insert(interpreter *Interpreter, locationRange LocationRange, index int, element Value) {
@@ -761,6 +815,18 @@ func (v *ArrayValue) RemoveWithoutTransfer(
 	interpreter.maybeValidateAtreeValue(v.array)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		array := v.array
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			value := StoredValue(interpreter, storable, array.Storage)
+			err := array.Insert(uint64(index), value)
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			rollback.restore(storable)
+		})
+	}
+
 	return storable
 }
 
@@ -1394,18 +1460,25 @@ func (v *ArrayValue) Transfer(
 			panic(errors.NewExternalError(err))
 		}
 
+		if hasNoParentContainer {
+			interpreter.recordStoredValueCreation(array.SlabID())
+		}
+
 		if remove {
-			err = v.array.PopIterate(interpreter.RemoveReferencedSlab)
-			if err != nil {
-				panic(errors.NewExternalError(err))
-			}
+			sourceArray := v.array
+			interpreter.removeSlabs(func() {
+				err := sourceArray.PopIterate(interpreter.RemoveReferencedSlab)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
 
-			interpreter.maybeValidateAtreeValue(v.array)
-			if hasNoParentContainer {
-				interpreter.maybeValidateAtreeStorage()
-			}
+				interpreter.maybeValidateAtreeValue(sourceArray)
+				if hasNoParentContainer {
+					interpreter.maybeValidateAtreeStorage()
+				}
 
-			interpreter.RemoveReferencedSlab(storable)
+				interpreter.RemoveReferencedSlab(storable)
+			})
 		}
 	}
 
@@ -1421,7 +1494,7 @@ func (v *ArrayValue) Transfer(
 
 		interpreter.invalidateReferencedResources(v, locationRange)
 
-		v.array = nil
+		v.invalidate(interpreter)
 	}
 
 	res := newArrayValueFromAtreeArray(
@@ -1505,21 +1578,24 @@ func (v *ArrayValue) DeepRemove(interpreter *Interpreter, hasNoParentContainer b
 
 	// Remove nested values and storables
 
-	storage := v.array.Storage
+	array := v.array
+	storage := array.Storage
 
-	err := v.array.PopIterate(func(storable atree.Storable) {
-		value := StoredValue(interpreter, storable, storage)
-		value.DeepRemove(interpreter, false) // existingValue is an element of v.array because it is from PopIterate() callback.
-		interpreter.RemoveReferencedSlab(storable)
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+	interpreter.removeSlabs(func() {
+		err := array.PopIterate(func(storable atree.Storable) {
+			value := StoredValue(interpreter, storable, storage)
+			value.DeepRemove(interpreter, false) // existingValue is an element of v.array because it is from PopIterate() callback.
+			interpreter.RemoveReferencedSlab(storable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
 
-	interpreter.maybeValidateAtreeValue(v.array)
-	if hasNoParentContainer {
-		interpreter.maybeValidateAtreeStorage()
-	}
+		interpreter.maybeValidateAtreeValue(array)
+		if hasNoParentContainer {
+			interpreter.maybeValidateAtreeStorage()
+		}
+	})
 }
 
 func (v *ArrayValue) SlabID() atree.SlabID {
@@ -19,6 +19,7 @@
 package interpreter
 
 import (
+	"encoding/binary"
 	goerrors "errors"
 	"strings"
 	"time"
@@ -60,8 +61,12 @@ type CompositeValue struct {
 	// 3) When a value is transferred, this field is copied between its attachments
 	base                *CompositeValue
 	QualifiedIdentifier string
-	Kind                common.CompositeKind
-	isDestroyed         bool
+	// TypeArguments are the type arguments of an instance of a generic composite type, if any.
+	// NOTE: The type ID of the value is the type ID of the generic composite type,
+	// the static type of the value is the type of the instance
+	TypeArguments []StaticType
+	Kind          common.CompositeKind
+	isDestroyed   bool
 }
 
 type ComputedField func(*Interpreter, LocationRange, *CompositeValue) Value
@@ -125,6 +130,30 @@ func NewCompositeValue(
 	fields []CompositeField,
 	address common.Address,
 ) *CompositeValue {
+	return NewCompositeInstanceValue(
+		interpreter,
+		locationRange,
+		location,
+		qualifiedIdentifier,
+		nil,
+		kind,
+		fields,
+		address,
+	)
+}
+
+// NewCompositeInstanceValue creates a value of an instance of a generic composite type,
+// i.e. a composite value with the given type arguments.
+func NewCompositeInstanceValue(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	location common.Location,
+	qualifiedIdentifier string,
+	typeArguments []StaticType,
+	kind common.CompositeKind,
+	fields []CompositeField,
+	address common.Address,
+) *CompositeValue {
 
 	interpreter.ReportComputation(common.ComputationKindCreateCompositeValue, 1)
 
@@ -165,6 +194,7 @@ func NewCompositeValue(
 				location,
 				qualifiedIdentifier,
 				kind,
+				typeArguments,
 			),
 		)
 		if err != nil {
@@ -178,6 +208,7 @@ func NewCompositeValue(
 		location,
 		qualifiedIdentifier,
 		kind,
+		typeArguments,
 	)
 
 	v = newCompositeValueFromConstructor(interpreter, uint64(len(fields)), typeInfo, constructor)
@@ -226,6 +257,7 @@ func newCompositeValueFromAtreeMap(
 		dictionary:          atreeOrderedMap,
 		Location:            typeInfo.location,
 		QualifiedIdentifier: typeInfo.qualifiedIdentifier,
+		TypeArguments:       typeInfo.typeArguments,
 		Kind:                typeInfo.kind,
 	}
 }
@@ -266,14 +298,23 @@ func (v *CompositeValue) Walk(interpreter *Interpreter, walkChild func(Value), l
 
 func (v *CompositeValue) StaticType(interpreter *Interpreter) StaticType {
 	if v.staticType == nil {
-		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
-		// use the TypeID accessor, which may return an already computed type ID
-		v.staticType = NewCompositeStaticType(
-			interpreter,
-			v.Location,
-			v.QualifiedIdentifier,
-			v.TypeID(),
-		)
+		if len(v.TypeArguments) > 0 {
+			v.staticType = NewCompositeInstanceStaticType(
+				interpreter,
+				v.Location,
+				v.QualifiedIdentifier,
+				v.TypeArguments,
+			)
+		} else {
+			// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
+			// use the TypeID accessor, which may return an already computed type ID
+			v.staticType = NewCompositeStaticType(
+				interpreter,
+				v.Location,
+				v.QualifiedIdentifier,
+				v.TypeID(),
+			)
+		}
 	}
 	return v.staticType
 }
@@ -402,13 +443,55 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, locationRange Locatio
 		},
 	)
 
+	if interpreter.inRevertibleBlock() {
+		isDestroyed := v.isDestroyed
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.isDestroyed = isDestroyed
+		})
+	}
+
 	v.isDestroyed = true
 
 	interpreter.invalidateReferencedResources(v, locationRange)
 
+	v.invalidate(interpreter)
+}
+
+// invalidate unsets the backing dictionary of the resource,
+// which marks it as invalidated
+func (v *CompositeValue) invalidate(interpreter *Interpreter) {
+	if interpreter.inRevertibleBlock() {
+		dictionary := v.dictionary
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.dictionary = dictionary
+		})
+	}
+
 	v.dictionary = nil
 }
 
+// recordFieldRemovalUndo records the restoration of the removed field,
+// in case the enclosing revertible block is reverted.
+func (v *CompositeValue) recordFieldRemovalUndo(
+	interpreter *Interpreter,
+	name string,
+	existingValueStorable atree.Storable,
+) {
+	dictionary := v.dictionary
+	interpreter.recordUndo(func(rollback *stateRollback) {
+		_, err := dictionary.Set(
+			StringAtreeValueComparator,
+			StringAtreeValueHashInput,
+			NewStringAtreeValue(nil, name),
+			StoredValue(interpreter, existingValueStorable, dictionary.Storage),
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		rollback.restore(existingValueStorable)
+	})
+}
+
 func (v *CompositeValue) getBuiltinMember(interpreter *Interpreter, locationRange LocationRange, name string) Value {
 
 	switch name {
@@ -667,6 +750,10 @@ func (v *CompositeValue) RemoveMember(
 	interpreter.maybeValidateAtreeValue(v.dictionary)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		v.recordFieldRemovalUndo(interpreter, name, existingValueStorable)
+	}
+
 	// Key
 	interpreter.RemoveReferencedSlab(existingKeyStorable)
 
@@ -730,6 +817,38 @@ func (v *CompositeValue) SetMemberWithoutTransfer(
 	interpreter.maybeValidateAtreeValue(v.dictionary)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		dictionary := v.dictionary
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			if existingStorable == nil {
+				keyStorable, valueStorable, err := dictionary.Remove(
+					StringAtreeValueComparator,
+					StringAtreeValueHashInput,
+					StringAtreeValue(name),
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				// NOTE: key / field name is stringAtreeValue,
+				// and not a Value, so no need to deep remove
+				interpreter.RemoveReferencedSlab(keyStorable)
+				rollback.discard(valueStorable)
+			} else {
+				valueStorable, err := dictionary.Set(
+					StringAtreeValueComparator,
+					StringAtreeValueHashInput,
+					StringAtreeValue(name),
+					StoredValue(interpreter, existingStorable, dictionary.Storage),
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				rollback.discard(valueStorable)
+				rollback.restore(existingStorable)
+			}
+		})
+	}
+
 	if existingStorable != nil {
 		existingValue := StoredValue(interpreter, existingStorable, config.Storage)
 
@@ -882,12 +1001,22 @@ func (v *CompositeValue) Equal(interpreter *Interpreter, locationRange LocationR
 	}
 
 	if !v.StaticType(interpreter).Equal(otherComposite.StaticType(interpreter)) ||
-		v.Kind != otherComposite.Kind ||
-		v.dictionary.Count() != otherComposite.dictionary.Count() {
+		v.Kind != otherComposite.Kind {
 
 		return false
 	}
 
+	// Structures conforming to the `Equatable` interface
+	// define their own notion of equality
+
+	if v.conformsToNativeInterface(interpreter, sema.EquatableType) {
+		return v.invokeEquals(interpreter, locationRange, otherComposite)
+	}
+
+	if v.dictionary.Count() != otherComposite.dictionary.Count() {
+		return false
+	}
+
 	iterator, err := v.dictionary.ReadOnlyIterator()
 	if err != nil {
 		panic(errors.NewExternalError(err))
@@ -915,10 +1044,20 @@ func (v *CompositeValue) Equal(interpreter *Interpreter, locationRange LocationR
 	}
 }
 
-// HashInput returns a byte slice containing:
+// HashInput returns a byte slice containing, for enums:
 // - HashInputTypeEnum (1 byte)
 // - type id (n bytes)
 // - hash input of raw value field name (n bytes)
+//
+// for values of distinct types:
+// - HashInputTypeDistinct (1 byte)
+// - type id (n bytes)
+// - hash input of the underlying value (n bytes)
+//
+// and for structures conforming to the `Hashable` interface:
+// - HashInputTypeHashable (1 byte)
+// - type id (n bytes)
+// - result of the `hashValue` function, big-endian encoded (8 bytes)
 func (v *CompositeValue) HashInput(interpreter *Interpreter, locationRange LocationRange, scratch []byte) []byte {
 	if v.Kind == common.CompositeKindEnum {
 		typeID := v.TypeID()
@@ -945,9 +1084,202 @@ func (v *CompositeValue) HashInput(interpreter *Interpreter, locationRange Locat
 		return buffer
 	}
 
+	if v.isDistinct(interpreter) {
+		typeID := v.TypeID()
+
+		underlyingValue := v.DistinctUnderlyingValue(interpreter, locationRange)
+		underlyingValueHashInput := underlyingValue.(HashableValue).
+			HashInput(interpreter, locationRange, scratch)
+
+		length := 1 + len(typeID) + len(underlyingValueHashInput)
+		if length <= len(scratch) {
+			// Copy underlyingValueHashInput first because
+			// underlyingValueHashInput and scratch can point to the same underlying scratch buffer
+			copy(scratch[1+len(typeID):], underlyingValueHashInput)
+
+			scratch[0] = byte(HashInputTypeDistinct)
+			copy(scratch[1:], typeID)
+			return scratch[:length]
+		}
+
+		buffer := make([]byte, length)
+		buffer[0] = byte(HashInputTypeDistinct)
+		copy(buffer[1:], typeID)
+		copy(buffer[1+len(typeID):], underlyingValueHashInput)
+		return buffer
+	}
+
+	// Otherwise, the value must be a structure conforming to the `Hashable` interface.
+	// NOTE: Like when checking for the `Equatable` interface,
+	// a failure to load the type of the value is reported.
+
+	if v.conformsToNativeInterface(interpreter, sema.HashableType) {
+
+		typeID := v.TypeID()
+
+		hashValue := v.invokeHashValue(interpreter, locationRange)
+
+		length := 1 + len(typeID) + 8
+		var buffer []byte
+		if length <= len(scratch) {
+			buffer = scratch[:length]
+		} else {
+			buffer = make([]byte, length)
+		}
+
+		buffer[0] = byte(HashInputTypeHashable)
+		copy(buffer[1:], typeID)
+		binary.BigEndian.PutUint64(buffer[1+len(typeID):], uint64(hashValue))
+		return buffer
+	}
+
 	panic(errors.NewUnreachableError())
 }
 
+// isDistinct returns true if the composite value is a value of a distinct type,
+// e.g. declared as `type PersonID = distinct String`.
+func (v *CompositeValue) isDistinct(interpreter *Interpreter) bool {
+	if v.Kind != common.CompositeKindStructure {
+		return false
+	}
+
+	compositeType, err := interpreter.GetCompositeType(v.Location, v.QualifiedIdentifier, v.TypeID())
+	if err != nil {
+		return false
+	}
+
+	return compositeType.IsDistinctType()
+}
+
+// DistinctUnderlyingValue returns the underlying value of a value of a distinct type.
+func (v *CompositeValue) DistinctUnderlyingValue(interpreter *Interpreter, locationRange LocationRange) Value {
+	return v.GetField(interpreter, locationRange, ast.DistinctTypeUnderlyingValueFieldName)
+}
+
+// NewDistinctValue returns a new value of the same distinct type as the composite value,
+// which wraps the given underlying value.
+func (v *CompositeValue) NewDistinctValue(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	underlyingValue Value,
+) *CompositeValue {
+	return NewCompositeValue(
+		interpreter,
+		locationRange,
+		v.Location,
+		v.QualifiedIdentifier,
+		v.Kind,
+		[]CompositeField{
+			NewCompositeField(
+				interpreter,
+				ast.DistinctTypeUnderlyingValueFieldName,
+				underlyingValue,
+			),
+		},
+		common.ZeroAddress,
+	)
+}
+
+// conformsToNativeInterface returns true if the composite value is a structure
+// which conforms to the given native interface type, e.g. `Equatable` or `Hashable`.
+func (v *CompositeValue) conformsToNativeInterface(
+	interpreter *Interpreter,
+	interfaceType *sema.InterfaceType,
+) bool {
+	// Native structures, e.g. `InclusiveRange`, never conform to user-implementable interfaces
+	if v.Kind != common.CompositeKindStructure || v.Location == nil {
+		return false
+	}
+
+	// NOTE: The type of the value is loaded both when comparing and when hashing the value.
+	// A failure to load the type is reported, instead of falling back to structural equality,
+	// so equality and hashing of the same value never disagree.
+
+	compositeType, err := interpreter.GetCompositeType(v.Location, v.QualifiedIdentifier, v.TypeID())
+	if err != nil {
+		panic(err)
+	}
+
+	return compositeType.EffectiveInterfaceConformanceSet().Contains(interfaceType)
+}
+
+// invokeEquals calls the structure's implementation of the `Equatable.equals` function.
+// The invocation is metered like any other function invocation,
+// and is bounded by the call stack depth limit.
+func (v *CompositeValue) invokeEquals(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	other *CompositeValue,
+) bool {
+	function := v.GetFunction(interpreter, locationRange, sema.EquatableTypeEqualsFunctionName)
+	if function == nil {
+		// The functions of the value's type could not be loaded
+		panic(TypeLoadingError{
+			TypeID: v.TypeID(),
+		})
+	}
+
+	interpreter.reportFunctionInvocation()
+
+	result := interpreter.invokeFunctionValue(
+		function,
+		[]Value{other},
+		nil,
+		[]sema.Type{interpreter.MustSemaTypeOfValue(other)},
+		[]sema.Type{sema.AnyStructType},
+		sema.BoolType,
+		nil,
+		locationRange,
+	)
+
+	interpreter.reportInvokedFunctionReturn()
+
+	equal, ok := result.(BoolValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return bool(equal)
+}
+
+// invokeHashValue calls the structure's implementation of the `Hashable.hashValue` function.
+// The invocation is metered like any other function invocation,
+// and the result is a fixed-size integer, which bounds the size of the hash input.
+func (v *CompositeValue) invokeHashValue(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+) UInt64Value {
+	function := v.GetFunction(interpreter, locationRange, sema.HashableTypeHashValueFunctionName)
+	if function == nil {
+		// The functions of the value's type could not be loaded
+		panic(TypeLoadingError{
+			TypeID: v.TypeID(),
+		})
+	}
+
+	interpreter.reportFunctionInvocation()
+
+	result := interpreter.invokeFunctionValue(
+		function,
+		nil,
+		nil,
+		nil,
+		nil,
+		sema.UInt64Type,
+		nil,
+		locationRange,
+	)
+
+	interpreter.reportInvokedFunctionReturn()
+
+	hashValue, ok := result.(UInt64Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return hashValue
+}
+
 func (v *CompositeValue) TypeID() TypeID {
 	if v.typeID == "" {
 		v.typeID = common.NewTypeIDFromQualifiedName(nil, v.Location, v.QualifiedIdentifier)
@@ -1005,11 +1337,15 @@ func (v *CompositeValue) CompositeStaticTypeConformsToStaticType(
 	compositeType, ok := semaType.(*sema.CompositeType)
 	if !ok ||
 		v.Kind != compositeType.Kind ||
-		v.TypeID() != compositeType.ID() {
+		v.StaticType(interpreter).ID() != compositeType.ID() {
 
 		return false
 	}
 
+	// NOTE: Get the members before the fields,
+	// as the members and fields of instances of generic composite types are initialized lazily
+	members := compositeType.MemberMap()
+
 	if compositeType.Kind == common.CompositeKindAttachment {
 		base := v.getBaseValue(interpreter, UnauthorizedAccess, locationRange).Value
 		if base == nil || !base.ConformsToStaticType(interpreter, locationRange, results) {
@@ -1045,7 +1381,7 @@ func (v *CompositeValue) CompositeStaticTypeConformsToStaticType(
 			value = fieldGetter(interpreter, locationRange, v)
 		}
 
-		member, ok := compositeType.Members.Get(fieldName)
+		member, ok := members.Get(fieldName)
 		if !ok {
 			return false
 		}
@@ -1277,21 +1613,28 @@ func (v *CompositeValue) Transfer(
 			panic(errors.NewExternalError(err))
 		}
 
+		if hasNoParentContainer {
+			interpreter.recordStoredValueCreation(dictionary.SlabID())
+		}
+
 		if remove {
-			err = v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
-				interpreter.RemoveReferencedSlab(nameStorable)
-				interpreter.RemoveReferencedSlab(valueStorable)
-			})
-			if err != nil {
-				panic(errors.NewExternalError(err))
-			}
+			sourceDictionary := v.dictionary
+			interpreter.removeSlabs(func() {
+				err := sourceDictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
+					interpreter.RemoveReferencedSlab(nameStorable)
+					interpreter.RemoveReferencedSlab(valueStorable)
+				})
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
 
-			interpreter.maybeValidateAtreeValue(v.dictionary)
-			if hasNoParentContainer {
-				interpreter.maybeValidateAtreeStorage()
-			}
+				interpreter.maybeValidateAtreeValue(sourceDictionary)
+				if hasNoParentContainer {
+					interpreter.maybeValidateAtreeStorage()
+				}
 
-			interpreter.RemoveReferencedSlab(storable)
+				interpreter.RemoveReferencedSlab(storable)
+			})
 		}
 	}
 
@@ -1307,7 +1650,7 @@ func (v *CompositeValue) Transfer(
 
 		interpreter.invalidateReferencedResources(v, locationRange)
 
-		v.dictionary = nil
+		v.invalidate(interpreter)
 	}
 
 	info := NewCompositeTypeInfo(
@@ -1315,6 +1658,7 @@ func (v *CompositeValue) Transfer(
 		v.Location,
 		v.QualifiedIdentifier,
 		v.Kind,
+		v.TypeArguments,
 	)
 
 	res := newCompositeValueFromAtreeMap(
@@ -1403,6 +1747,7 @@ func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 		dictionary:          dictionary,
 		Location:            v.Location,
 		QualifiedIdentifier: v.QualifiedIdentifier,
+		TypeArguments:       v.TypeArguments,
 		Kind:                v.Kind,
 		injectedFields:      v.injectedFields,
 		computedFields:      v.computedFields,
@@ -1438,25 +1783,28 @@ func (v *CompositeValue) DeepRemove(interpreter *Interpreter, hasNoParentContain
 
 	// Remove nested values and storables
 
-	storage := v.dictionary.Storage
+	dictionary := v.dictionary
+	storage := dictionary.Storage
 
-	err := v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
-		// NOTE: key / field name is stringAtreeValue,
-		// and not a Value, so no need to deep remove
-		interpreter.RemoveReferencedSlab(nameStorable)
+	interpreter.removeSlabs(func() {
+		err := dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
+			// NOTE: key / field name is stringAtreeValue,
+			// and not a Value, so no need to deep remove
+			interpreter.RemoveReferencedSlab(nameStorable)
 
-		value := StoredValue(interpreter, valueStorable, storage)
-		value.DeepRemove(interpreter, false) // value is an element of v.dictionary because it is from PopIterate() callback.
-		interpreter.RemoveReferencedSlab(valueStorable)
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+			value := StoredValue(interpreter, valueStorable, storage)
+			value.DeepRemove(interpreter, false) // value is an element of v.dictionary because it is from PopIterate() callback.
+			interpreter.RemoveReferencedSlab(valueStorable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
 
-	interpreter.maybeValidateAtreeValue(v.dictionary)
-	if hasNoParentContainer {
-		interpreter.maybeValidateAtreeStorage()
-	}
+		interpreter.maybeValidateAtreeValue(dictionary)
+		if hasNoParentContainer {
+			interpreter.maybeValidateAtreeStorage()
+		}
+	})
 }
 
 func (v *CompositeValue) GetOwner() common.Address {
@@ -1590,6 +1938,10 @@ func (v *CompositeValue) RemoveField(
 	interpreter.maybeValidateAtreeValue(v.dictionary)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		v.recordFieldRemovalUndo(interpreter, name, existingValueStorable)
+	}
+
 	// Key
 
 	// NOTE: key / field name is stringAtreeValue,
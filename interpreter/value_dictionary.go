@@ -519,10 +519,30 @@ func (v *DictionaryValue) Destroy(interpreter *Interpreter, locationRange Locati
 		},
 	)
 
+	if interpreter.inRevertibleBlock() {
+		isDestroyed := v.isDestroyed
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.isDestroyed = isDestroyed
+		})
+	}
+
 	v.isDestroyed = true
 
 	interpreter.invalidateReferencedResources(v, locationRange)
 
+	v.invalidate(interpreter)
+}
+
+// invalidate unsets the backing dictionary of the resource dictionary,
+// which marks it as invalidated
+func (v *DictionaryValue) invalidate(interpreter *Interpreter) {
+	if interpreter.inRevertibleBlock() {
+		dictionary := v.dictionary
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.dictionary = dictionary
+		})
+	}
+
 	v.dictionary = nil
 }
 
@@ -949,6 +969,23 @@ func (v *DictionaryValue) RemoveWithoutTransfer(
 	interpreter.maybeValidateAtreeValue(v.dictionary)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		dictionary := v.dictionary
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			_, err := dictionary.Set(
+				valueComparator,
+				hashInputProvider,
+				StoredValue(interpreter, existingKeyStorable, dictionary.Storage),
+				StoredValue(interpreter, existingValueStorable, dictionary.Storage),
+			)
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			rollback.restore(existingKeyStorable)
+			rollback.restore(existingValueStorable)
+		})
+	}
+
 	return existingKeyStorable, existingValueStorable
 }
 
@@ -1029,6 +1066,36 @@ func (v *DictionaryValue) InsertWithoutTransfer(
 	interpreter.maybeValidateAtreeValue(v.dictionary)
 	interpreter.maybeValidateAtreeStorage()
 
+	if interpreter.inRevertibleBlock() {
+		dictionary := v.dictionary
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			if existingValueStorable == nil {
+				keyStorable, valueStorable, err := dictionary.Remove(
+					valueComparator,
+					hashInputProvider,
+					keyValue,
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				rollback.discard(keyStorable)
+				rollback.discard(valueStorable)
+			} else {
+				valueStorable, err := dictionary.Set(
+					valueComparator,
+					hashInputProvider,
+					keyValue,
+					StoredValue(interpreter, existingValueStorable, dictionary.Storage),
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				rollback.discard(valueStorable)
+				rollback.restore(existingValueStorable)
+			}
+		})
+	}
+
 	return existingValueStorable
 }
 
@@ -1397,21 +1464,28 @@ func (v *DictionaryValue) Transfer(
 			panic(errors.NewExternalError(err))
 		}
 
+		if hasNoParentContainer {
+			interpreter.recordStoredValueCreation(dictionary.SlabID())
+		}
+
 		if remove {
-			err = v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
-				interpreter.RemoveReferencedSlab(keyStorable)
-				interpreter.RemoveReferencedSlab(valueStorable)
-			})
-			if err != nil {
-				panic(errors.NewExternalError(err))
-			}
+			sourceDictionary := v.dictionary
+			interpreter.removeSlabs(func() {
+				err := sourceDictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
+					interpreter.RemoveReferencedSlab(keyStorable)
+					interpreter.RemoveReferencedSlab(valueStorable)
+				})
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
 
-			interpreter.maybeValidateAtreeValue(v.dictionary)
-			if hasNoParentContainer {
-				interpreter.maybeValidateAtreeStorage()
-			}
+				interpreter.maybeValidateAtreeValue(sourceDictionary)
+				if hasNoParentContainer {
+					interpreter.maybeValidateAtreeStorage()
+				}
 
-			interpreter.RemoveReferencedSlab(storable)
+				interpreter.RemoveReferencedSlab(storable)
+			})
 		}
 	}
 
@@ -1427,7 +1501,7 @@ func (v *DictionaryValue) Transfer(
 
 		interpreter.invalidateReferencedResources(v, locationRange)
 
-		v.dictionary = nil
+		v.invalidate(interpreter)
 	}
 
 	res := newDictionaryValueFromAtreeMap(
@@ -1521,26 +1595,29 @@ func (v *DictionaryValue) DeepRemove(interpreter *Interpreter, hasNoParentContai
 
 	// Remove nested values and storables
 
-	storage := v.dictionary.Storage
+	dictionary := v.dictionary
+	storage := dictionary.Storage
 
-	err := v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
+	interpreter.removeSlabs(func() {
+		err := dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
 
-		key := StoredValue(interpreter, keyStorable, storage)
-		key.DeepRemove(interpreter, false) // key is an element of v.dictionary because it is from PopIterate() callback.
-		interpreter.RemoveReferencedSlab(keyStorable)
+			key := StoredValue(interpreter, keyStorable, storage)
+			key.DeepRemove(interpreter, false) // key is an element of v.dictionary because it is from PopIterate() callback.
+			interpreter.RemoveReferencedSlab(keyStorable)
 
-		value := StoredValue(interpreter, valueStorable, storage)
-		value.DeepRemove(interpreter, false) // value is an element of v.dictionary because it is from PopIterate() callback.
-		interpreter.RemoveReferencedSlab(valueStorable)
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+			value := StoredValue(interpreter, valueStorable, storage)
+			value.DeepRemove(interpreter, false) // value is an element of v.dictionary because it is from PopIterate() callback.
+			interpreter.RemoveReferencedSlab(valueStorable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
 
-	interpreter.maybeValidateAtreeValue(v.dictionary)
-	if hasNoParentContainer {
-		interpreter.maybeValidateAtreeStorage()
-	}
+		interpreter.maybeValidateAtreeValue(dictionary)
+		if hasNoParentContainer {
+			interpreter.maybeValidateAtreeStorage()
+		}
+	})
 }
 
 func (v *DictionaryValue) GetOwner() common.Address {
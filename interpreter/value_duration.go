@@ -0,0 +1,298 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/format"
+	"github.com/onflow/cadence/sema"
+)
+
+// DurationValue
+
+// DurationValue represents a duration as a number of seconds.
+// It is a fixed-point number with the scale of Fix64
+type DurationValue int64
+
+const durationSize = int(unsafe.Sizeof(DurationValue(0)))
+
+var durationMemoryUsage = common.NewNumberMemoryUsage(durationSize)
+
+func NewDurationValue(gauge common.MemoryGauge, valueGetter func() int64) DurationValue {
+	common.UseMemory(gauge, durationMemoryUsage)
+	return NewUnmeteredDurationValue(valueGetter())
+}
+
+func NewUnmeteredDurationValue(value int64) DurationValue {
+	return DurationValue(value)
+}
+
+var _ Value = DurationValue(0)
+var _ atree.Storable = DurationValue(0)
+var _ EquatableValue = DurationValue(0)
+var _ ComparableValue = DurationValue(0)
+var _ HashableValue = DurationValue(0)
+var _ MemberAccessibleValue = DurationValue(0)
+
+func (DurationValue) isValue() {}
+
+func (v DurationValue) Accept(interpreter *Interpreter, visitor Visitor, _ LocationRange) {
+	visitor.VisitDurationValue(interpreter, v)
+}
+
+func (DurationValue) Walk(_ *Interpreter, _ func(Value), _ LocationRange) {
+	// NO-OP
+}
+
+func (DurationValue) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeDuration)
+}
+
+func (DurationValue) IsImportable(_ *Interpreter, _ LocationRange) bool {
+	return sema.DurationType.Importable
+}
+
+func (v DurationValue) String() string {
+	return format.Fix64(int64(v))
+}
+
+func (v DurationValue) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v DurationValue) MeteredString(interpreter *Interpreter, _ SeenReferences, _ LocationRange) string {
+	common.UseMemory(
+		interpreter,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(interpreter, Fix64Value(v)),
+		),
+	)
+	return v.String()
+}
+
+func (v DurationValue) Negate(interpreter *Interpreter, locationRange LocationRange) DurationValue {
+	result := Int64Value(v).Negate(interpreter, locationRange)
+	return DurationValue(result.(Int64Value))
+}
+
+func (v DurationValue) Plus(interpreter *Interpreter, other DurationValue, locationRange LocationRange) DurationValue {
+	result := Int64Value(v).Plus(interpreter, Int64Value(other), locationRange)
+	return DurationValue(result.(Int64Value))
+}
+
+func (v DurationValue) Minus(interpreter *Interpreter, other DurationValue, locationRange LocationRange) DurationValue {
+	result := Int64Value(v).Minus(interpreter, Int64Value(other), locationRange)
+	return DurationValue(result.(Int64Value))
+}
+
+func (v DurationValue) Mul(interpreter *Interpreter, other Int64Value, locationRange LocationRange) DurationValue {
+	result := Int64Value(v).Mul(interpreter, other, locationRange)
+	return DurationValue(result.(Int64Value))
+}
+
+func (v DurationValue) Div(interpreter *Interpreter, other Int64Value, locationRange LocationRange) DurationValue {
+	result := Int64Value(v).Div(interpreter, other, locationRange)
+	return DurationValue(result.(Int64Value))
+}
+
+func (v DurationValue) Equal(_ *Interpreter, _ LocationRange, other Value) bool {
+	otherDuration, ok := other.(DurationValue)
+	if !ok {
+		return false
+	}
+	return v == otherDuration
+}
+
+func (v DurationValue) Less(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLess,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v < o)
+}
+
+func (v DurationValue) LessEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLessEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v <= o)
+}
+
+func (v DurationValue) Greater(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreater,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v > o)
+}
+
+func (v DurationValue) GreaterEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreaterEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v >= o)
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeDuration (1 byte)
+// - int64 value encoded in big-endian (8 bytes)
+func (v DurationValue) HashInput(_ *Interpreter, _ LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeDuration)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+// ConvertDuration converts the given number of seconds to a duration.
+// The number is converted like a Fix64
+func ConvertDuration(memoryGauge common.MemoryGauge, value Value, locationRange LocationRange) DurationValue {
+	seconds := ConvertFix64(memoryGauge, value, locationRange)
+	return NewUnmeteredDurationValue(int64(seconds))
+}
+
+func (v DurationValue) GetMember(interpreter *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case sema.DurationTypeSecondsFieldName:
+		return NewFix64Value(
+			interpreter,
+			func() int64 {
+				return int64(v)
+			},
+		)
+
+	case sema.DurationTypeToStringFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.DurationTypeToStringFunctionType,
+			func(v DurationValue, invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				memoryUsage := common.NewStringMemoryUsage(
+					OverEstimateNumberStringLength(interpreter, Fix64Value(v)),
+				)
+
+				return NewStringValue(
+					interpreter,
+					memoryUsage,
+					v.String,
+				)
+			},
+		)
+	}
+
+	return nil
+}
+
+func (DurationValue) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Durations have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (DurationValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Durations have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) ConformsToStaticType(
+	_ *Interpreter,
+	_ LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (v DurationValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (DurationValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (DurationValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v DurationValue) Transfer(
+	interpreter *Interpreter,
+	_ LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+	_ map[atree.ValueID]struct{},
+	_ bool,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v DurationValue) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (DurationValue) DeepRemove(_ *Interpreter, _ bool) {
+	// NO-OP
+}
+
+func (v DurationValue) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v DurationValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (DurationValue) ChildStorables() []atree.Storable {
+	return nil
+}
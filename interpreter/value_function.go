@@ -48,6 +48,9 @@ type InterpretedFunctionValue struct {
 	PreConditions    []ast.Condition
 	Statements       []ast.Statement
 	PostConditions   []ast.Condition
+	// TypeArguments are the type arguments of the enclosing generic functions and composite values
+	// at the time the function was created, if any
+	TypeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewInterpretedFunctionValue(
@@ -63,6 +66,13 @@ func NewInterpretedFunctionValue(
 
 	common.UseMemory(interpreter, common.InterpretedFunctionValueMemoryUsage)
 
+	// Like the lexical scope, capture the type arguments of the enclosing generic functions, if any
+
+	typeArguments := interpreter.SharedState.currentTypeArguments
+	if typeArguments != nil {
+		functionType = interpreter.SubstituteTypeArguments(functionType).(*sema.FunctionType)
+	}
+
 	return &InterpretedFunctionValue{
 		Interpreter:      interpreter,
 		ParameterList:    parameterList,
@@ -72,6 +82,7 @@ func NewInterpretedFunctionValue(
 		PreConditions:    preConditions,
 		Statements:       statements,
 		PostConditions:   postConditions,
+		TypeArguments:    typeArguments,
 	}
 }
 
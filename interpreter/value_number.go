@@ -19,6 +19,7 @@
 package interpreter
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/onflow/cadence/common"
@@ -155,11 +156,493 @@ func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string,
 				)
 			},
 		)
+
+	case sema.NumericTypeCheckedAddFunctionName:
+		return newCheckedArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			NumberValue.Plus,
+			(*big.Int).Add,
+			common.NewPlusBigIntMemoryUsage,
+			locationRange,
+		)
+
+	case sema.NumericTypeCheckedSubtractFunctionName:
+		return newCheckedArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			NumberValue.Minus,
+			(*big.Int).Sub,
+			common.NewMinusBigIntMemoryUsage,
+			locationRange,
+		)
+
+	case sema.NumericTypeCheckedMultiplyFunctionName:
+		return newCheckedArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			NumberValue.Mul,
+			(*big.Int).Mul,
+			common.NewMulBigIntMemoryUsage,
+			locationRange,
+		)
+
+	case sema.NumericTypeCheckedDivideFunctionName:
+		// Integer division is not computed on big integers,
+		// as the rounding of the division operator differs between integer types
+		return newCheckedArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			func(v NumberValue, interpreter *Interpreter, other NumberValue, locationRange LocationRange) NumberValue {
+				// The division of fixed-point values does not check for division by zero
+				zero := numberValueFromInt64(interpreter, 0, typ, locationRange)
+				if other.Equal(interpreter, locationRange, zero) {
+					panic(DivisionByZeroError{
+						LocationRange: locationRange,
+					})
+				}
+
+				return v.Div(interpreter, other, locationRange)
+			},
+			nil,
+			nil,
+			locationRange,
+		)
+
+	case sema.NumericTypeWrappingAddFunctionName:
+		return newWrappingArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			name,
+			(*big.Int).Add,
+			common.NewPlusBigIntMemoryUsage,
+			locationRange,
+		)
+
+	case sema.NumericTypeWrappingSubtractFunctionName:
+		return newWrappingArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			name,
+			(*big.Int).Sub,
+			common.NewMinusBigIntMemoryUsage,
+			locationRange,
+		)
+
+	case sema.NumericTypeWrappingMultiplyFunctionName:
+		return newWrappingArithmeticFunction(
+			interpreter,
+			v,
+			typ,
+			name,
+			(*big.Int).Mul,
+			common.NewMulBigIntMemoryUsage,
+			locationRange,
+		)
+
+	case sema.NumericTypeMinFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.NumericTypeBinaryFunctionTypes[typ],
+			func(v NumberValue, invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				if other.Less(invocation.Interpreter, v, locationRange) {
+					return other
+				}
+				return v
+			},
+		)
+
+	case sema.NumericTypeMaxFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.NumericTypeBinaryFunctionTypes[typ],
+			func(v NumberValue, invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				if other.Greater(invocation.Interpreter, v, locationRange) {
+					return other
+				}
+				return v
+			},
+		)
+
+	case sema.NumericTypeAbsFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.NumericTypeUnaryFunctionTypes[typ],
+			func(v NumberValue, invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				zero := numberValueFromInt64(interpreter, 0, typ, locationRange)
+				if !v.Less(interpreter, zero, locationRange) {
+					return v
+				}
+
+				if numberValueAbsOverflows(interpreter, v, typ, locationRange) {
+					panic(OverflowError{
+						LocationRange: locationRange,
+					})
+				}
+
+				return v.Negate(interpreter, locationRange)
+			},
+		)
+
+	case sema.NumericTypeSqrtFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.NumericTypeUnaryFunctionTypes[typ],
+			func(v NumberValue, invocation Invocation) Value {
+				return numberValueSqrt(
+					invocation.Interpreter,
+					v,
+					typ,
+					locationRange,
+				)
+			},
+		)
+
+	case sema.NumericTypePowFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.NumericTypePowFunctionTypes[typ],
+			func(v NumberValue, invocation Invocation) Value {
+				exponent, ok := invocation.Arguments[0].(UInt32Value)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return numberValuePow(
+					invocation.Interpreter,
+					v,
+					typ,
+					uint32(exponent),
+					locationRange,
+				)
+			},
+		)
 	}
 
 	return nil
 }
 
+type numberValueOperation func(
+	v NumberValue,
+	interpreter *Interpreter,
+	other NumberValue,
+	locationRange LocationRange,
+) NumberValue
+
+type bigIntOperation func(result, a, b *big.Int) *big.Int
+
+// newCheckedArithmeticFunction returns a function which performs the given operation,
+// and returns nil instead of aborting if the result overflows or a division by zero occurs.
+//
+// If a big integer operation is given, the result for integer types is computed
+// on big integers and checked against the bounds of the type,
+// as not all integer types check for overflow, e.g. `Word8`.
+func newCheckedArithmeticFunction(
+	interpreter *Interpreter,
+	v NumberValue,
+	typ sema.Type,
+	operation numberValueOperation,
+	bigOperation bigIntOperation,
+	memoryUsage func(a, b *big.Int) common.MemoryUsage,
+	locationRange LocationRange,
+) BoundFunctionValue {
+	return NewBoundHostFunctionValue(
+		interpreter,
+		v,
+		sema.CheckedArithmeticTypeFunctionTypes[typ],
+		func(v NumberValue, invocation Invocation) Value {
+			interpreter := invocation.Interpreter
+
+			other, ok := invocation.Arguments[0].(NumberValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			if bigOperation != nil && sema.IsSubType(typ, sema.IntegerType) {
+				a := integerValueBigInt(interpreter, v, locationRange)
+				b := integerValueBigInt(interpreter, other, locationRange)
+
+				result := NewIntValueFromBigInt(
+					interpreter,
+					memoryUsage(a, b),
+					func() *big.Int {
+						return bigOperation(new(big.Int), a, b)
+					},
+				)
+
+				if !inIntegerRange(result.BigInt, typ) {
+					return Nil
+				}
+
+				return NewSomeValueNonCopying(
+					interpreter,
+					interpreter.convert(result, sema.IntType, typ, locationRange),
+				)
+			}
+
+			return checkedArithmetic(
+				interpreter,
+				func() NumberValue {
+					return operation(v, interpreter, other, locationRange)
+				},
+			)
+		},
+	)
+}
+
+// checkedArithmetic performs the given operation,
+// and returns nil instead of aborting if the result overflows or a division by zero occurs.
+func checkedArithmetic(interpreter *Interpreter, operation func() NumberValue) (result OptionalValue) {
+	defer func() {
+		r := recover()
+		switch r.(type) {
+		case nil:
+			return
+		case OverflowError, UnderflowError, DivisionByZeroError:
+			result = NilOptionalValue
+		default:
+			panic(r)
+		}
+	}()
+
+	return NewSomeValueNonCopying(interpreter, operation())
+}
+
+// newWrappingArithmeticFunction returns a function which performs the given operation
+// on big integers, and wraps the result around at the bounds of the fixed-size integer type.
+func newWrappingArithmeticFunction(
+	interpreter *Interpreter,
+	v NumberValue,
+	typ sema.Type,
+	functionName string,
+	operation bigIntOperation,
+	memoryUsage func(a, b *big.Int) common.MemoryUsage,
+	locationRange LocationRange,
+) BoundFunctionValue {
+	return NewBoundHostFunctionValue(
+		interpreter,
+		v,
+		sema.NumericTypeBinaryFunctionTypes[typ],
+		func(v NumberValue, invocation Invocation) Value {
+			interpreter := invocation.Interpreter
+
+			other, ok := invocation.Arguments[0].(NumberValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			if !v.StaticType(interpreter).Equal(other.StaticType(interpreter)) {
+				panic(InvalidOperandsError{
+					FunctionName:  functionName,
+					LeftType:      v.StaticType(interpreter),
+					RightType:     other.StaticType(interpreter),
+					LocationRange: locationRange,
+				})
+			}
+
+			rangedType, ok := typ.(sema.IntegerRangedType)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			minInt := rangedType.MinInt()
+			maxInt := rangedType.MaxInt()
+
+			a := integerValueBigInt(interpreter, v, locationRange)
+			b := integerValueBigInt(interpreter, other, locationRange)
+
+			result := NewIntValueFromBigInt(
+				interpreter,
+				memoryUsage(a, b),
+				func() *big.Int {
+					result := operation(new(big.Int), a, b)
+
+					// Wrap the result around into the range [min, max]
+
+					size := new(big.Int).Sub(maxInt, minInt)
+					size.Add(size, big.NewInt(1))
+
+					result.Sub(result, minInt)
+					result.Mod(result, size)
+					return result.Add(result, minInt)
+				},
+			)
+
+			return interpreter.convert(result, sema.IntType, typ, locationRange)
+		},
+	)
+}
+
+// numberValuePow computes v raised to the power of the given exponent,
+// by exponentiation by squaring.
+// Overflow and rounding are handled by the multiplication of the number type.
+func numberValuePow(
+	interpreter *Interpreter,
+	v NumberValue,
+	typ sema.Type,
+	exponent uint32,
+	locationRange LocationRange,
+) NumberValue {
+	result := numberValueFromInt64(interpreter, 1, typ, locationRange)
+	base := v
+
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result = result.Mul(interpreter, base, locationRange)
+		}
+
+		exponent >>= 1
+
+		// Only square the base if it is still needed,
+		// so the squaring does not overflow unnecessarily
+		if exponent > 0 {
+			base = base.Mul(interpreter, base, locationRange)
+		}
+	}
+
+	return result
+}
+
+// numberValueAbsOverflows returns true if the absolute value of the given negative value
+// is outside the bounds of the given type,
+// i.e. if the value is the minimum value of a fixed-size signed type, e.g. `Int8.min`.
+func numberValueAbsOverflows(
+	interpreter *Interpreter,
+	v NumberValue,
+	typ sema.Type,
+	locationRange LocationRange,
+) bool {
+	if v, ok := v.(Fix64Value); ok {
+		return v == math.MinInt64
+	}
+
+	if !sema.IsSubType(typ, sema.IntegerType) {
+		return false
+	}
+
+	a := integerValueBigInt(interpreter, v, locationRange)
+	return !inIntegerRange(a.Neg(a), typ)
+}
+
+// numberValueSqrt computes the square root of v, rounded towards zero.
+func numberValueSqrt(
+	interpreter *Interpreter,
+	v NumberValue,
+	typ sema.Type,
+	locationRange LocationRange,
+) NumberValue {
+
+	switch v := v.(type) {
+	case Fix64Value:
+		if v < 0 {
+			panic(NegativeSquareRootError{
+				LocationRange: locationRange,
+			})
+		}
+
+		return NewFix64Value(
+			interpreter,
+			func() int64 {
+				// The square root of the scaled value is scaled by the square root of the factor,
+				// so scale the value once more before computing the square root
+				result := new(big.Int).SetInt64(int64(v))
+				result.Mul(result, sema.Fix64FactorBig)
+				return result.Sqrt(result).Int64()
+			},
+		)
+
+	case UFix64Value:
+		return NewUFix64Value(
+			interpreter,
+			func() uint64 {
+				result := new(big.Int).SetUint64(uint64(v))
+				result.Mul(result, sema.Fix64FactorBig)
+				return result.Sqrt(result).Uint64()
+			},
+		)
+	}
+
+	a := integerValueBigInt(interpreter, v, locationRange)
+	if a.Sign() < 0 {
+		panic(NegativeSquareRootError{
+			LocationRange: locationRange,
+		})
+	}
+
+	result := NewIntValueFromBigInt(
+		interpreter,
+		common.NewBigIntMemoryUsage(common.BigIntByteLength(a)),
+		func() *big.Int {
+			return new(big.Int).Sqrt(a)
+		},
+	)
+
+	return interpreter.convert(result, sema.IntType, typ, locationRange).(NumberValue)
+}
+
+// integerValueBigInt returns the value of the given integer value as a big integer
+func integerValueBigInt(memoryGauge common.MemoryGauge, v NumberValue, locationRange LocationRange) *big.Int {
+	if bigNumberValue, ok := v.(BigNumberValue); ok {
+		return bigNumberValue.ToBigInt(memoryGauge)
+	}
+
+	common.UseMemory(memoryGauge, common.NewBigIntMemoryUsage(8))
+	return big.NewInt(int64(v.ToInt(locationRange)))
+}
+
+// numberValueFromInt64 returns the given integer as a number value of the given type
+func numberValueFromInt64(interpreter *Interpreter, value int64, typ sema.Type, locationRange LocationRange) NumberValue {
+	return interpreter.convert(
+		NewIntValueFromInt64(interpreter, value),
+		sema.IntType,
+		typ,
+		locationRange,
+	).(NumberValue)
+}
+
+// inIntegerRange returns true if the given integer is within the bounds of the given integer type
+func inIntegerRange(value *big.Int, typ sema.Type) bool {
+	rangedType, ok := typ.(sema.IntegerRangedType)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	minInt := rangedType.MinInt()
+	if minInt != nil && value.Cmp(minInt) < 0 {
+		return false
+	}
+
+	maxInt := rangedType.MaxInt()
+	if maxInt != nil && value.Cmp(maxInt) > 0 {
+		return false
+	}
+
+	return true
+}
+
 type IntegerValue interface {
 	NumberValue
 	BitwiseOr(interpreter *Interpreter, other IntegerValue, locationRange LocationRange) IntegerValue
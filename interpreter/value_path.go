@@ -31,6 +31,9 @@ import (
 
 type PathValue struct {
 	Identifier string
+	// Components are the embedded components of an extended path, e.g. `/storage/vault/\(42)`.
+	// They are kept separately from the identifier, see StorageMapKey
+	Components common.PathComponents
 	Domain     common.PathDomain
 }
 
@@ -47,6 +50,28 @@ func NewPathValue(
 	return NewUnmeteredPathValue(domain, identifier)
 }
 
+func NewUnmeteredExtendedPathValue(
+	domain common.PathDomain,
+	identifier string,
+	components common.PathComponents,
+) PathValue {
+	return PathValue{
+		Domain:     domain,
+		Identifier: identifier,
+		Components: components,
+	}
+}
+
+func NewExtendedPathValue(
+	memoryGauge common.MemoryGauge,
+	domain common.PathDomain,
+	identifier string,
+	components common.PathComponents,
+) PathValue {
+	common.UseMemory(memoryGauge, common.PathValueMemoryUsage)
+	return NewUnmeteredExtendedPathValue(domain, identifier, components)
+}
+
 var EmptyPathValue = PathValue{}
 
 var _ Value = PathValue{}
@@ -92,10 +117,14 @@ func (v PathValue) IsImportable(_ *Interpreter, _ LocationRange) bool {
 }
 
 func (v PathValue) String() string {
-	return format.Path(
+	path := format.Path(
 		v.Domain.Identifier(),
 		v.Identifier,
 	)
+	if v.Components.IsEmpty() {
+		return path
+	}
+	return path + v.Components.String()
 }
 
 func (v PathValue) RecursiveString(_ SeenReferences) string {
@@ -103,8 +132,8 @@ func (v PathValue) RecursiveString(_ SeenReferences) string {
 }
 
 func (v PathValue) MeteredString(interpreter *Interpreter, _ SeenReferences, locationRange LocationRange) string {
-	// len(domain) + len(identifier) + '/' x2
-	strLen := len(v.Domain.Identifier()) + len(v.Identifier) + 2
+	// len(domain) + len(identifier) + '/' x2 + components
+	strLen := len(v.Domain.Identifier()) + len(v.Identifier) + 2 + v.componentsStringLength()
 	common.UseMemory(interpreter, common.NewRawStringMemoryUsage(strLen))
 	return v.String()
 }
@@ -121,7 +150,7 @@ func (v PathValue) GetMember(inter *Interpreter, locationRange LocationRange, na
 				interpreter := invocation.Interpreter
 
 				domainLength := len(v.Domain.Identifier())
-				identifierLength := len(v.Identifier)
+				identifierLength := safeAdd(len(v.Identifier), v.componentsStringLength(), locationRange)
 
 				memoryUsage := common.NewStringMemoryUsage(
 					safeAdd(domainLength, identifierLength, locationRange),
@@ -164,6 +193,7 @@ func (v PathValue) Equal(_ *Interpreter, _ LocationRange, other Value) bool {
 	}
 
 	return otherPath.Identifier == v.Identifier &&
+		otherPath.Components == v.Components &&
 		otherPath.Domain == v.Domain
 }
 
@@ -171,8 +201,11 @@ func (v PathValue) Equal(_ *Interpreter, _ LocationRange, other Value) bool {
 // - HashInputTypePath (1 byte)
 // - domain (1 byte)
 // - identifier (n bytes)
+// - encoded components (m bytes), if any
 func (v PathValue) HashInput(_ *Interpreter, _ LocationRange, scratch []byte) []byte {
-	length := 1 + 1 + len(v.Identifier)
+	encodedComponents := v.Components.Encoded()
+
+	length := 1 + 1 + len(v.Identifier) + len(encodedComponents)
 	var buffer []byte
 	if length <= len(scratch) {
 		buffer = scratch[:length]
@@ -183,9 +216,33 @@ func (v PathValue) HashInput(_ *Interpreter, _ LocationRange, scratch []byte) []
 	buffer[0] = byte(HashInputTypePath)
 	buffer[1] = byte(v.Domain)
 	copy(buffer[2:], v.Identifier)
+	copy(buffer[2+len(v.Identifier):], encodedComponents)
 	return buffer
 }
 
+// StorageMapKey returns the key of the value stored at this path in the storage map of its domain.
+//
+// The key of a path without components is its identifier.
+// The key of an extended path is a dedicated key, see ExtendedPathAtreeValue
+func (v PathValue) StorageMapKey() StorageMapKey {
+	if v.Components.IsEmpty() {
+		return StringStorageMapKey(v.Identifier)
+	}
+
+	return ExtendedPathStorageMapKey{
+		Identifier: v.Identifier,
+		Components: v.Components,
+	}
+}
+
+// componentsStringLength returns the length of the string representation of the components
+func (v PathValue) componentsStringLength() int {
+	if v.Components.IsEmpty() {
+		return 0
+	}
+	return len(v.Components.String())
+}
+
 func (PathValue) IsStorable() bool {
 	return true
 }
@@ -254,7 +311,12 @@ func (PathValue) DeepRemove(_ *Interpreter, _ bool) {
 
 func (v PathValue) ByteSize() uint32 {
 	// tag number (2 bytes) + array head (1 byte) + domain (CBOR uint) + identifier (CBOR string)
-	return cborTagSize + 1 + getUintCBORSize(uint64(v.Domain)) + getBytesCBORSize([]byte(v.Identifier))
+	size := cborTagSize + 1 + getUintCBORSize(uint64(v.Domain)) + getBytesCBORSize([]byte(v.Identifier))
+	if !v.Components.IsEmpty() {
+		// components (CBOR byte string)
+		size += getBytesCBORSize([]byte(v.Components.Encoded()))
+	}
+	return size
 }
 
 func (v PathValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
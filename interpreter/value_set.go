@@ -0,0 +1,1040 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	goerrors "errors"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/format"
+	"github.com/onflow/cadence/sema"
+)
+
+// SetValue
+//
+// A set is backed by an atree.OrderedMap.
+// The elements of the set are the keys of the map,
+// and the values of the map are placeholders (void).
+// Set elements are hashable, and therefore never resources.
+
+type SetValue struct {
+	Type        *SetStaticType
+	semaType    *sema.SetType
+	set         *atree.OrderedMap
+	elementSize uint
+}
+
+func NewSetValue(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	setType *SetStaticType,
+	address common.Address,
+	elements ...Value,
+) *SetValue {
+
+	interpreter.ReportComputation(common.ComputationKindCreateSetValue, 1)
+
+	config := interpreter.SharedState.Config
+
+	elementSize := SetElementSize(setType)
+
+	overheadUsage, dataSlabs, metaDataSlabs :=
+		common.NewAtreeMapMemoryUsages(0, elementSize)
+	common.UseMemory(interpreter, overheadUsage)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	set, err := atree.NewMap(
+		config.Storage,
+		atree.Address(address),
+		atree.NewDefaultDigesterBuilder(),
+		setType,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	v := newSetValueFromAtreeMap(
+		interpreter,
+		setType,
+		elementSize,
+		set,
+	)
+
+	// elements are added to the set after creation, not here
+	for _, element := range elements {
+		v.Insert(interpreter, locationRange, element)
+	}
+
+	return v
+}
+
+func SetElementSize(staticType *SetStaticType) uint {
+	return staticType.ElementType.elementSize()
+}
+
+func newSetValueFromAtreeMap(
+	gauge common.MemoryGauge,
+	staticType *SetStaticType,
+	elementSize uint,
+	atreeOrderedMap *atree.OrderedMap,
+) *SetValue {
+
+	common.UseMemory(gauge, common.SetValueBaseMemoryUsage)
+
+	return &SetValue{
+		Type:        staticType,
+		set:         atreeOrderedMap,
+		elementSize: elementSize,
+	}
+}
+
+var _ Value = &SetValue{}
+var _ atree.Value = &SetValue{}
+var _ EquatableValue = &SetValue{}
+var _ MemberAccessibleValue = &SetValue{}
+var _ IterableValue = &SetValue{}
+var _ OwnedValue = &SetValue{}
+
+func (*SetValue) isValue() {}
+
+func (v *SetValue) Accept(interpreter *Interpreter, visitor Visitor, locationRange LocationRange) {
+	descend := visitor.VisitSetValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.Walk(
+		interpreter,
+		func(element Value) {
+			element.Accept(interpreter, visitor, locationRange)
+		},
+		locationRange,
+	)
+}
+
+// Iterate iterates over all elements of the set.
+// The order of iteration is undefined
+func (v *SetValue) Iterate(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	f func(element Value) (resume bool),
+) {
+	iterate := func() {
+		err := v.set.IterateReadOnlyKeys(func(element atree.Value) (resume bool, err error) {
+			// atree.OrderedMap iteration provides low-level atree.Value,
+			// convert to high-level interpreter.Value
+
+			resume = f(
+				MustConvertStoredValue(interpreter, element),
+			)
+
+			return resume, nil
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+	}
+
+	interpreter.withMutationPrevention(v.ValueID(), iterate)
+}
+
+type SetValueIterator struct {
+	mapIterator atree.MapIterator
+}
+
+var _ ValueIterator = SetValueIterator{}
+
+func (i SetValueIterator) Next(interpreter *Interpreter, _ LocationRange) Value {
+	atreeValue, err := i.mapIterator.NextKey()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	if atreeValue == nil {
+		return nil
+	}
+
+	// atree.OrderedMap iterator returns low-level atree.Value,
+	// convert to high-level interpreter.Value
+	return MustConvertStoredValue(interpreter, atreeValue)
+}
+
+func (v *SetValue) Iterator(_ *Interpreter, _ LocationRange) ValueIterator {
+	mapIterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return SetValueIterator{
+		mapIterator: mapIterator,
+	}
+}
+
+func (v *SetValue) ForEach(
+	interpreter *Interpreter,
+	_ sema.Type,
+	function func(value Value) (resume bool),
+	transferElements bool,
+	locationRange LocationRange,
+) {
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(element Value) (resume bool) {
+			if transferElements {
+				// Each element must be transferred before passing onto the function.
+				element = element.Transfer(
+					interpreter,
+					locationRange,
+					atree.Address{},
+					false,
+					nil,
+					nil,
+					false, // value has a parent container because it is from iterator.
+				)
+			}
+
+			return function(element)
+		},
+	)
+}
+
+func (v *SetValue) Walk(interpreter *Interpreter, walkChild func(Value), locationRange LocationRange) {
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(element Value) (resume bool) {
+			walkChild(element)
+			return true
+		},
+	)
+}
+
+func (v *SetValue) StaticType(_ *Interpreter) StaticType {
+	// TODO meter
+	return v.Type
+}
+
+func (v *SetValue) IsImportable(inter *Interpreter, locationRange LocationRange) bool {
+	importable := true
+	v.Iterate(
+		inter,
+		locationRange,
+		func(element Value) (resume bool) {
+			if !element.IsImportable(inter, locationRange) {
+				importable = false
+				// stop iteration
+				return false
+			}
+
+			// continue iteration
+			return true
+		},
+	)
+
+	return importable
+}
+
+func (v *SetValue) Count() int {
+	return int(v.set.Count())
+}
+
+func (v *SetValue) Contains(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	exists, err := v.set.Has(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	return AsBoolValue(exists)
+}
+
+// Insert inserts the given element into the set.
+// It returns true if the element was inserted,
+// and false if the set already contained the element.
+func (v *SetValue) Insert(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	interpreter.validateMutation(v.ValueID(), locationRange)
+
+	address := v.set.Address()
+
+	preventTransfer := map[atree.ValueID]struct{}{
+		v.ValueID(): {},
+	}
+
+	element = element.Transfer(
+		interpreter,
+		locationRange,
+		address,
+		true,
+		nil,
+		preventTransfer,
+		true, // element is standalone before it is inserted into parent container.
+	)
+
+	interpreter.checkContainerMutation(v.Type.ElementType, element, locationRange)
+
+	// length increases by 1
+	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.set.Count(), v.elementSize, false)
+	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	// atree only calls Storable() on element if needed,
+	// i.e., if the element is a new element
+	existingValueStorable, err := v.set.Set(
+		valueComparator,
+		hashInputProvider,
+		element,
+		Void,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	interpreter.maybeValidateAtreeValue(v.set)
+	interpreter.maybeValidateAtreeStorage()
+
+	if existingValueStorable == nil {
+		if interpreter.inRevertibleBlock() {
+			set := v.set
+			interpreter.recordUndo(func(rollback *stateRollback) {
+				elementStorable, valueStorable, err := set.Remove(
+					valueComparator,
+					hashInputProvider,
+					element,
+				)
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				rollback.discard(elementStorable)
+				rollback.discard(valueStorable)
+			})
+		}
+
+		return TrueValue
+	}
+
+	// The set already contained the element.
+	// Like for dictionaries, atree.OrderedMap reuses the existing stored key,
+	// so the transferred element is not stored or referenced by the set, and must be removed.
+
+	element.DeepRemove(interpreter, true)
+
+	// Enum composites are the only hashable values which are transferred to their own slab
+	if composite, ok := element.(*CompositeValue); ok {
+
+		compositeSlabID := composite.SlabID()
+
+		if compositeSlabID == atree.SlabIDUndefined {
+			panic(errors.NewUnexpectedError("transferred enum value as set element should not be inlined"))
+		}
+
+		interpreter.RemoveReferencedSlab(atree.SlabIDStorable(compositeSlabID))
+	}
+
+	return FalseValue
+}
+
+// Remove removes the given element from the set.
+// It returns true if the element was removed,
+// and false if the set did not contain the element.
+func (v *SetValue) Remove(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	element Value,
+) BoolValue {
+
+	interpreter.validateMutation(v.ValueID(), locationRange)
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	// No need to clean up storable for passed-in element,
+	// as atree never calls Storable()
+	existingElementStorable, existingValueStorable, err := v.set.Remove(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+	if err != nil {
+		var keyNotFoundError *atree.KeyNotFoundError
+		if goerrors.As(err, &keyNotFoundError) {
+			return FalseValue
+		}
+		panic(errors.NewExternalError(err))
+	}
+
+	interpreter.maybeValidateAtreeValue(v.set)
+	interpreter.maybeValidateAtreeStorage()
+
+	if interpreter.inRevertibleBlock() {
+		set := v.set
+		interpreter.recordUndo(func(rollback *stateRollback) {
+			_, err := set.Set(
+				valueComparator,
+				hashInputProvider,
+				StoredValue(interpreter, existingElementStorable, set.Storage),
+				Void,
+			)
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			rollback.restore(existingElementStorable)
+		})
+	}
+
+	storage := interpreter.Storage()
+
+	existingElement := StoredValue(interpreter, existingElementStorable, storage)
+	existingElement.DeepRemove(interpreter, true) // existingElement is standalone because it was removed from parent container.
+	interpreter.RemoveReferencedSlab(existingElementStorable)
+	interpreter.RemoveReferencedSlab(existingValueStorable)
+
+	return TrueValue
+}
+
+// newEmpty returns a new, empty, temporary set of the same type
+func (v *SetValue) newEmpty(interpreter *Interpreter, locationRange LocationRange) *SetValue {
+	return NewSetValue(
+		interpreter,
+		locationRange,
+		v.Type,
+		common.ZeroAddress,
+	)
+}
+
+// insertCopy inserts a copy of the given element, which is an element of another set
+func (v *SetValue) insertCopy(interpreter *Interpreter, locationRange LocationRange, element Value) {
+	element = element.Transfer(
+		interpreter,
+		locationRange,
+		atree.Address{},
+		false,
+		nil,
+		nil,
+		false, // element has a parent container because it is from iterator.
+	)
+
+	v.Insert(interpreter, locationRange, element)
+}
+
+// Union returns a new set containing all elements which are in this set, in the other set, or in both
+func (v *SetValue) Union(interpreter *Interpreter, locationRange LocationRange, other *SetValue) *SetValue {
+	result := v.newEmpty(interpreter, locationRange)
+
+	for _, set := range []*SetValue{v, other} {
+		set.Iterate(
+			interpreter,
+			locationRange,
+			func(element Value) (resume bool) {
+				result.insertCopy(interpreter, locationRange, element)
+				return true
+			},
+		)
+	}
+
+	return result
+}
+
+// Intersection returns a new set containing all elements which are both in this set and in the other set
+func (v *SetValue) Intersection(interpreter *Interpreter, locationRange LocationRange, other *SetValue) *SetValue {
+	result := v.newEmpty(interpreter, locationRange)
+
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(element Value) (resume bool) {
+			if other.Contains(interpreter, locationRange, element) {
+				result.insertCopy(interpreter, locationRange, element)
+			}
+			return true
+		},
+	)
+
+	return result
+}
+
+// Difference returns a new set containing all elements which are in this set, but not in the other set
+func (v *SetValue) Difference(interpreter *Interpreter, locationRange LocationRange, other *SetValue) *SetValue {
+	result := v.newEmpty(interpreter, locationRange)
+
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(element Value) (resume bool) {
+			if !other.Contains(interpreter, locationRange, element) {
+				result.insertCopy(interpreter, locationRange, element)
+			}
+			return true
+		},
+	)
+
+	return result
+}
+
+// ToArray returns a new array containing all elements of the set
+func (v *SetValue) ToArray(interpreter *Interpreter, locationRange LocationRange) *ArrayValue {
+
+	// Use ReadOnlyIterator here because new ArrayValue is created with copied elements (not removed) from original.
+	iterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return NewArrayValueWithIterator(
+		interpreter,
+		NewVariableSizedStaticType(interpreter, v.Type.ElementType),
+		common.ZeroAddress,
+		v.set.Count(),
+		func() Value {
+
+			element, err := iterator.NextKey()
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			if element == nil {
+				return nil
+			}
+
+			return MustConvertStoredValue(interpreter, element).
+				Transfer(
+					interpreter,
+					locationRange,
+					atree.Address{},
+					false,
+					nil,
+					nil,
+					false, // value is an element of parent container because it is returned from iterator.
+				)
+		},
+	)
+}
+
+func (v *SetValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *SetValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences, EmptyLocationRange)
+}
+
+func (v *SetValue) MeteredString(interpreter *Interpreter, seenReferences SeenReferences, locationRange LocationRange) string {
+
+	elements := make([]string, 0, v.Count())
+
+	v.Iterate(
+		interpreter,
+		locationRange,
+		func(element Value) (resume bool) {
+			elements = append(
+				elements,
+				element.MeteredString(interpreter, seenReferences, locationRange),
+			)
+			return true
+		},
+	)
+
+	// len = len("Set([") + len("])") + ((n-1) times comma+space)
+	//     = 5 + 2 + 2n - 2
+	//
+	// Since (-2) only occurs if its non-empty (i.e: n>0), ignore the (-2). i.e: overestimate
+	//    len = 2n + 7
+	//
+	// String of each element is metered separately.
+	strLen := len(elements)*2 + 7
+
+	common.UseMemory(interpreter, common.NewRawStringMemoryUsage(strLen))
+
+	return format.Set(elements)
+}
+
+func (v *SetValue) GetMember(
+	interpreter *Interpreter,
+	_ LocationRange,
+	name string,
+) Value {
+
+	switch name {
+	case sema.SetTypeLengthFieldName:
+		return NewIntValueFromInt64(interpreter, int64(v.Count()))
+
+	case sema.SetTypeContainsFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetElementFunctionType(
+				v.SemaType(interpreter),
+				sema.FunctionPurityView,
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.Contains(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeInsertFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetElementFunctionType(
+				v.SemaType(interpreter),
+				sema.FunctionPurityImpure,
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeRemoveFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetElementFunctionType(
+				v.SemaType(interpreter),
+				sema.FunctionPurityImpure,
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.Remove(
+					invocation.Interpreter,
+					invocation.LocationRange,
+					invocation.Arguments[0],
+				)
+			},
+		)
+
+	case sema.SetTypeUnionFunctionName:
+		return v.newSetOperationFunction(interpreter, (*SetValue).Union)
+
+	case sema.SetTypeIntersectionFunctionName:
+		return v.newSetOperationFunction(interpreter, (*SetValue).Intersection)
+
+	case sema.SetTypeDifferenceFunctionName:
+		return v.newSetOperationFunction(interpreter, (*SetValue).Difference)
+
+	case sema.SetTypeToArrayFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.SetToArrayFunctionType(
+				v.SemaType(interpreter),
+			),
+			func(v *SetValue, invocation Invocation) Value {
+				return v.ToArray(
+					invocation.Interpreter,
+					invocation.LocationRange,
+				)
+			},
+		)
+	}
+
+	return nil
+}
+
+func (v *SetValue) newSetOperationFunction(
+	interpreter *Interpreter,
+	operation func(v *SetValue, interpreter *Interpreter, locationRange LocationRange, other *SetValue) *SetValue,
+) BoundFunctionValue {
+	return NewBoundHostFunctionValue(
+		interpreter,
+		v,
+		sema.SetOperationFunctionType(
+			v.SemaType(interpreter),
+		),
+		func(v *SetValue, invocation Invocation) Value {
+			other, ok := invocation.Arguments[0].(*SetValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			return operation(
+				v,
+				invocation.Interpreter,
+				invocation.LocationRange,
+				other,
+			)
+		},
+	)
+}
+
+func (v *SetValue) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Sets have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *SetValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Sets have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *SetValue) ConformsToStaticType(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	results TypeConformanceResults,
+) bool {
+
+	elementType := v.Type.ElementType
+
+	iterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		atreeElement, err := iterator.NextKey()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if atreeElement == nil {
+			return true
+		}
+
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+		element := MustConvertStoredValue(interpreter, atreeElement)
+
+		if !interpreter.IsSubType(element.StaticType(interpreter), elementType) {
+			return false
+		}
+
+		if !element.ConformsToStaticType(
+			interpreter,
+			locationRange,
+			results,
+		) {
+			return false
+		}
+	}
+}
+
+func (v *SetValue) Equal(interpreter *Interpreter, locationRange LocationRange, other Value) bool {
+
+	otherSet, ok := other.(*SetValue)
+	if !ok {
+		return false
+	}
+
+	if v.Count() != otherSet.Count() {
+		return false
+	}
+
+	if !v.Type.Equal(otherSet.Type) {
+		return false
+	}
+
+	iterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		element, err := iterator.NextKey()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if element == nil {
+			return true
+		}
+
+		// Do NOT use an iterator, as other value may be stored in another account,
+		// leading to a different iteration order, as the storage ID is used in the seed
+		if !otherSet.Contains(
+			interpreter,
+			locationRange,
+			MustConvertStoredValue(interpreter, element),
+		) {
+			return false
+		}
+	}
+}
+
+func (v *SetValue) Storable(
+	storage atree.SlabStorage,
+	address atree.Address,
+	maxInlineSize uint64,
+) (atree.Storable, error) {
+	return v.set.Storable(storage, address, maxInlineSize)
+}
+
+func (*SetValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v *SetValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageAddress()
+}
+
+func (v *SetValue) Transfer(
+	interpreter *Interpreter,
+	locationRange LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+	preventTransfer map[atree.ValueID]struct{},
+	hasNoParentContainer bool,
+) Value {
+
+	config := interpreter.SharedState.Config
+
+	interpreter.ReportComputation(
+		common.ComputationKindTransferSetValue,
+		uint(v.Count()),
+	)
+
+	currentValueID := v.ValueID()
+
+	if preventTransfer == nil {
+		preventTransfer = map[atree.ValueID]struct{}{}
+	} else if _, ok := preventTransfer[currentValueID]; ok {
+		panic(RecursiveTransferError{
+			LocationRange: locationRange,
+		})
+	}
+	preventTransfer[currentValueID] = struct{}{}
+	defer delete(preventTransfer, currentValueID)
+
+	// Sets are never resources, so they are always copied
+
+	valueComparator := newValueComparator(interpreter, locationRange)
+	hashInputProvider := newHashInputProvider(interpreter, locationRange)
+
+	// Use non-readonly iterator here because iterated
+	// value can be removed if remove parameter is true.
+	iterator, err := v.set.Iterator(valueComparator, hashInputProvider)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	elementCount := v.set.Count()
+
+	elementOverhead, dataUse, metaDataUse := common.NewAtreeMapMemoryUsages(
+		elementCount,
+		v.elementSize,
+	)
+	common.UseMemory(interpreter, elementOverhead)
+	common.UseMemory(interpreter, dataUse)
+	common.UseMemory(interpreter, metaDataUse)
+
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(
+		elementCount,
+		v.elementSize,
+	)
+	common.UseMemory(config.MemoryGauge, elementMemoryUse)
+
+	set, err := atree.NewMapFromBatchData(
+		config.Storage,
+		address,
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeElement, err := iterator.NextKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil {
+				return nil, nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Transfer(
+					interpreter,
+					locationRange,
+					address,
+					remove,
+					nil,
+					preventTransfer,
+					false, // atreeElement has parent container because it is returned from iterator.
+				)
+
+			return element, Void, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	if hasNoParentContainer {
+		interpreter.recordStoredValueCreation(set.SlabID())
+	}
+
+	if remove {
+		sourceSet := v.set
+		interpreter.removeSlabs(func() {
+			err := sourceSet.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+				interpreter.RemoveReferencedSlab(elementStorable)
+				interpreter.RemoveReferencedSlab(valueStorable)
+			})
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+
+			interpreter.maybeValidateAtreeValue(sourceSet)
+			if hasNoParentContainer {
+				interpreter.maybeValidateAtreeStorage()
+			}
+
+			interpreter.RemoveReferencedSlab(storable)
+		})
+	}
+
+	res := newSetValueFromAtreeMap(
+		interpreter,
+		v.Type,
+		v.elementSize,
+		set,
+	)
+
+	res.semaType = v.semaType
+
+	return res
+}
+
+func (v *SetValue) Clone(interpreter *Interpreter) Value {
+	config := interpreter.SharedState.Config
+
+	valueComparator := newValueComparator(interpreter, EmptyLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, EmptyLocationRange)
+
+	iterator, err := v.set.ReadOnlyIterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	orderedMap, err := atree.NewMapFromBatchData(
+		config.Storage,
+		v.StorageAddress(),
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
+
+			atreeElement, err := iterator.NextKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeElement == nil {
+				return nil, nil, nil
+			}
+
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Clone(interpreter)
+
+			return element, Void, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	set := newSetValueFromAtreeMap(
+		interpreter,
+		v.Type,
+		v.elementSize,
+		orderedMap,
+	)
+
+	set.semaType = v.semaType
+
+	return set
+}
+
+func (v *SetValue) DeepRemove(interpreter *Interpreter, hasNoParentContainer bool) {
+
+	// Remove nested values and storables
+
+	set := v.set
+	storage := set.Storage
+
+	interpreter.removeSlabs(func() {
+		err := set.PopIterate(func(elementStorable atree.Storable, valueStorable atree.Storable) {
+
+			element := StoredValue(interpreter, elementStorable, storage)
+			element.DeepRemove(interpreter, false) // element is an element of v.set because it is from PopIterate() callback.
+			interpreter.RemoveReferencedSlab(elementStorable)
+
+			interpreter.RemoveReferencedSlab(valueStorable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+
+		interpreter.maybeValidateAtreeValue(set)
+		if hasNoParentContainer {
+			interpreter.maybeValidateAtreeStorage()
+		}
+	})
+}
+
+func (v *SetValue) GetOwner() common.Address {
+	return common.Address(v.StorageAddress())
+}
+
+func (v *SetValue) SlabID() atree.SlabID {
+	return v.set.SlabID()
+}
+
+func (v *SetValue) StorageAddress() atree.Address {
+	return v.set.Address()
+}
+
+func (v *SetValue) ValueID() atree.ValueID {
+	return v.set.ValueID()
+}
+
+func (v *SetValue) SemaType(interpreter *Interpreter) *sema.SetType {
+	if v.semaType == nil {
+		// this function will panic already if this conversion fails
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.SetType)
+	}
+	return v.semaType
+}
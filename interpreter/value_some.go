@@ -104,7 +104,27 @@ func (v *SomeValue) Destroy(interpreter *Interpreter, locationRange LocationRang
 	innerValue := v.InnerValue(interpreter, locationRange)
 	maybeDestroy(interpreter, locationRange, innerValue)
 
+	if interpreter.inRevertibleBlock() {
+		isDestroyed := v.isDestroyed
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.isDestroyed = isDestroyed
+		})
+	}
+
 	v.isDestroyed = true
+	v.invalidate(interpreter)
+}
+
+// invalidate unsets the inner value of the resource optional,
+// which marks it as invalidated
+func (v *SomeValue) invalidate(interpreter *Interpreter) {
+	if interpreter.inRevertibleBlock() {
+		value := v.value
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.value = value
+		})
+	}
+
 	v.value = nil
 }
 
@@ -343,7 +363,7 @@ func (v *SomeValue) Transfer(
 		if !needsStoreTo {
 			interpreter.invalidateReferencedResources(v.value, locationRange)
 		}
-		v.value = nil
+		v.invalidate(interpreter)
 	}
 
 	res := NewSomeValueNonCopying(interpreter, innerValue)
@@ -124,9 +124,8 @@ func (*StorageReferenceValue) IsImportable(_ *Interpreter, _ LocationRange) bool
 func (v *StorageReferenceValue) dereference(interpreter *Interpreter, locationRange LocationRange) (*Value, error) {
 	address := v.TargetStorageAddress
 	domain := v.TargetPath.Domain.Identifier()
-	identifier := v.TargetPath.Identifier
 
-	storageMapKey := StringStorageMapKey(identifier)
+	storageMapKey := v.TargetPath.StorageMapKey()
 
 	referenced := interpreter.ReadStored(address, domain, storageMapKey)
 	if referenced == nil {
@@ -2960,7 +2960,7 @@ func TestCompositeValue_Equal(t *testing.T) {
 				inter,
 				EmptyLocationRange,
 				utils.TestLocation,
-				"X",
+				"Test",
 				common.CompositeKindStructure,
 				fields1,
 				common.ZeroAddress,
@@ -2971,7 +2971,7 @@ func TestCompositeValue_Equal(t *testing.T) {
 					inter,
 					EmptyLocationRange,
 					utils.TestLocation,
-					"X",
+					"Test",
 					common.CompositeKindStructure,
 					fields2,
 					common.ZeroAddress,
@@ -2980,6 +2980,41 @@ func TestCompositeValue_Equal(t *testing.T) {
 		)
 	})
 
+	t.Run("type loading failure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		newValue := func() *CompositeValue {
+			return NewCompositeValue(
+				inter,
+				EmptyLocationRange,
+				utils.TestLocation,
+				"X",
+				common.CompositeKindStructure,
+				nil,
+				common.ZeroAddress,
+			)
+		}
+
+		value := newValue()
+
+		expectedErr := TypeLoadingError{
+			TypeID: value.TypeID(),
+		}
+
+		// Equality and hashing must fail in the same way
+
+		require.PanicsWithValue(t, expectedErr, func() {
+			value.Equal(inter, EmptyLocationRange, newValue())
+		})
+
+		require.PanicsWithValue(t, expectedErr, func() {
+			value.HashInput(inter, EmptyLocationRange, nil)
+		})
+	})
+
 	t.Run("different location", func(t *testing.T) {
 
 		t.Parallel()
@@ -3094,8 +3129,8 @@ func TestCompositeValue_Equal(t *testing.T) {
 			NewCompositeValue(
 				inter,
 				EmptyLocationRange,
-				common.IdentifierLocation("A"),
-				"X",
+				utils.TestLocation,
+				"Test",
 				common.CompositeKindStructure,
 				fields1,
 				common.ZeroAddress,
@@ -3105,8 +3140,8 @@ func TestCompositeValue_Equal(t *testing.T) {
 				NewCompositeValue(
 					inter,
 					EmptyLocationRange,
-					common.IdentifierLocation("A"),
-					"X",
+					utils.TestLocation,
+					"Test",
 					common.CompositeKindStructure,
 					fields2,
 					common.ZeroAddress,
@@ -3143,8 +3178,8 @@ func TestCompositeValue_Equal(t *testing.T) {
 			NewCompositeValue(
 				inter,
 				EmptyLocationRange,
-				common.IdentifierLocation("A"),
-				"X",
+				utils.TestLocation,
+				"Test",
 				common.CompositeKindStructure,
 				fields1,
 				common.ZeroAddress,
@@ -3154,8 +3189,8 @@ func TestCompositeValue_Equal(t *testing.T) {
 				NewCompositeValue(
 					inter,
 					EmptyLocationRange,
-					common.IdentifierLocation("A"),
-					"X",
+					utils.TestLocation,
+					"Test",
 					common.CompositeKindStructure,
 					fields2,
 					common.ZeroAddress,
@@ -3192,8 +3227,8 @@ func TestCompositeValue_Equal(t *testing.T) {
 			NewCompositeValue(
 				inter,
 				EmptyLocationRange,
-				common.IdentifierLocation("A"),
-				"X",
+				utils.TestLocation,
+				"Test",
 				common.CompositeKindStructure,
 				fields1,
 				common.ZeroAddress,
@@ -3203,8 +3238,8 @@ func TestCompositeValue_Equal(t *testing.T) {
 				NewCompositeValue(
 					inter,
 					EmptyLocationRange,
-					common.IdentifierLocation("A"),
-					"X",
+					utils.TestLocation,
+					"Test",
 					common.CompositeKindStructure,
 					fields2,
 					common.ZeroAddress,
@@ -3587,8 +3622,16 @@ func newTestInterpreter(tb testing.TB) *Interpreter {
 
 	storage := newUnmeteredInMemoryStorage()
 
+	elaboration := sema.NewElaboration(nil)
+	elaboration.SetCompositeType(
+		testCompositeValueType.ID(),
+		testCompositeValueType,
+	)
+
 	inter, err := NewInterpreter(
-		nil,
+		&Program{
+			Elaboration: elaboration,
+		},
 		utils.TestLocation,
 		&Config{
 			Storage:                       storage,
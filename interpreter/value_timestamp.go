@@ -0,0 +1,322 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/format"
+	"github.com/onflow/cadence/sema"
+)
+
+// TimestampValue
+
+// TimestampValue represents a point in time as the number of seconds
+// since the Unix epoch (00:00:00 UTC on 1 January 1970).
+// It is a fixed-point number with the scale of Fix64
+type TimestampValue int64
+
+const timestampSize = int(unsafe.Sizeof(TimestampValue(0)))
+
+var timestampMemoryUsage = common.NewNumberMemoryUsage(timestampSize)
+
+// timestampStringLength is the maximum length of a formatted timestamp:
+// the timestamp layout, and a sign for years before 0
+var timestampStringLength = len(format.TimestampLayout) + 1
+
+func NewTimestampValue(gauge common.MemoryGauge, valueGetter func() int64) TimestampValue {
+	common.UseMemory(gauge, timestampMemoryUsage)
+	return NewUnmeteredTimestampValue(valueGetter())
+}
+
+func NewUnmeteredTimestampValue(value int64) TimestampValue {
+	return TimestampValue(value)
+}
+
+var _ Value = TimestampValue(0)
+var _ atree.Storable = TimestampValue(0)
+var _ EquatableValue = TimestampValue(0)
+var _ ComparableValue = TimestampValue(0)
+var _ HashableValue = TimestampValue(0)
+var _ MemberAccessibleValue = TimestampValue(0)
+
+func (TimestampValue) isValue() {}
+
+func (v TimestampValue) Accept(interpreter *Interpreter, visitor Visitor, _ LocationRange) {
+	visitor.VisitTimestampValue(interpreter, v)
+}
+
+func (TimestampValue) Walk(_ *Interpreter, _ func(Value), _ LocationRange) {
+	// NO-OP
+}
+
+func (TimestampValue) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeTimestamp)
+}
+
+func (TimestampValue) IsImportable(_ *Interpreter, _ LocationRange) bool {
+	return sema.TimestampType.Importable
+}
+
+func (v TimestampValue) String() string {
+	return format.Timestamp(int64(v))
+}
+
+func (v TimestampValue) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v TimestampValue) MeteredString(interpreter *Interpreter, _ SeenReferences, _ LocationRange) string {
+	common.UseMemory(interpreter, common.NewRawStringMemoryUsage(timestampStringLength))
+	return v.String()
+}
+
+// Plus returns the timestamp which is the given duration after this timestamp
+func (v TimestampValue) Plus(interpreter *Interpreter, other DurationValue, locationRange LocationRange) TimestampValue {
+	result := Int64Value(v).Plus(interpreter, Int64Value(other), locationRange)
+	return TimestampValue(result.(Int64Value))
+}
+
+// Minus returns the timestamp which is the given duration before this timestamp,
+// or the duration between this timestamp and the given timestamp
+func (v TimestampValue) Minus(interpreter *Interpreter, other Value, locationRange LocationRange) Value {
+	switch other := other.(type) {
+	case DurationValue:
+		result := Int64Value(v).Minus(interpreter, Int64Value(other), locationRange)
+		return TimestampValue(result.(Int64Value))
+
+	case TimestampValue:
+		result := Int64Value(v).Minus(interpreter, Int64Value(other), locationRange)
+		return DurationValue(result.(Int64Value))
+
+	default:
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationMinus,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+}
+
+func (v TimestampValue) Equal(_ *Interpreter, _ LocationRange, other Value) bool {
+	otherTimestamp, ok := other.(TimestampValue)
+	if !ok {
+		return false
+	}
+	return v == otherTimestamp
+}
+
+func (v TimestampValue) Less(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLess,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v < o)
+}
+
+func (v TimestampValue) LessEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationLessEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v <= o)
+}
+
+func (v TimestampValue) Greater(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreater,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v > o)
+}
+
+func (v TimestampValue) GreaterEqual(interpreter *Interpreter, other ComparableValue, locationRange LocationRange) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation:     ast.OperationGreaterEqual,
+			LeftType:      v.StaticType(interpreter),
+			RightType:     other.StaticType(interpreter),
+			LocationRange: locationRange,
+		})
+	}
+
+	return AsBoolValue(v >= o)
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeTimestamp (1 byte)
+// - int64 value encoded in big-endian (8 bytes)
+func (v TimestampValue) HashInput(_ *Interpreter, _ LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeTimestamp)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+// ConvertTimestamp converts the given number of seconds since the Unix epoch to a timestamp.
+// The number is converted like a Fix64, e.g. `Timestamp(getCurrentBlock().timestamp)`
+func ConvertTimestamp(memoryGauge common.MemoryGauge, value Value, locationRange LocationRange) TimestampValue {
+	seconds := ConvertFix64(memoryGauge, value, locationRange)
+	return NewUnmeteredTimestampValue(int64(seconds))
+}
+
+func TimestampFromString(invocation Invocation) Value {
+	argument, ok := invocation.Arguments[0].(*StringValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	value, ok := sema.ParseTimestamp(argument.Str)
+	if !ok {
+		return Nil
+	}
+
+	inter := invocation.Interpreter
+	return NewSomeValueNonCopying(
+		inter,
+		NewTimestampValue(
+			inter,
+			func() int64 {
+				return value
+			},
+		),
+	)
+}
+
+func (v TimestampValue) GetMember(interpreter *Interpreter, _ LocationRange, name string) Value {
+	switch name {
+	case sema.TimestampTypeUnixSecondsFieldName:
+		return NewFix64Value(
+			interpreter,
+			func() int64 {
+				return int64(v)
+			},
+		)
+
+	case sema.TimestampTypeToStringFunctionName:
+		return NewBoundHostFunctionValue(
+			interpreter,
+			v,
+			sema.TimestampTypeToStringFunctionType,
+			func(v TimestampValue, invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+
+				memoryUsage := common.NewStringMemoryUsage(timestampStringLength)
+
+				return NewStringValue(
+					interpreter,
+					memoryUsage,
+					v.String,
+				)
+			},
+		)
+	}
+
+	return nil
+}
+
+func (TimestampValue) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
+	// Timestamps have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) bool {
+	// Timestamps have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v TimestampValue) ConformsToStaticType(
+	_ *Interpreter,
+	_ LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (v TimestampValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (TimestampValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (TimestampValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v TimestampValue) Transfer(
+	interpreter *Interpreter,
+	_ LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+	_ map[atree.ValueID]struct{},
+	_ bool,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v TimestampValue) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (TimestampValue) DeepRemove(_ *Interpreter, _ bool) {
+	// NO-OP
+}
+
+func (v TimestampValue) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v TimestampValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (TimestampValue) ChildStorables() []atree.Storable {
+	return nil
+}
@@ -59,6 +59,11 @@ func (v *SimpleVariable) SetValue(interpreter *Interpreter, locationRange Locati
 	if existingValue != nil {
 		interpreter.checkResourceLoss(existingValue, locationRange)
 	}
+	if interpreter.inRevertibleBlock() {
+		interpreter.recordUndo(func(_ *stateRollback) {
+			v.value = existingValue
+		})
+	}
 	v.getter = nil
 	v.value = value
 }
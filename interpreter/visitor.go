@@ -48,8 +48,11 @@ type Visitor interface {
 	VisitWord256Value(interpreter *Interpreter, value Word256Value)
 	VisitFix64Value(interpreter *Interpreter, value Fix64Value)
 	VisitUFix64Value(interpreter *Interpreter, value UFix64Value)
+	VisitTimestampValue(interpreter *Interpreter, value TimestampValue)
+	VisitDurationValue(interpreter *Interpreter, value DurationValue)
 	VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool
 	VisitDictionaryValue(interpreter *Interpreter, value *DictionaryValue) bool
+	VisitSetValue(interpreter *Interpreter, value *SetValue) bool
 	VisitNilValue(interpreter *Interpreter, value NilValue)
 	VisitSomeValue(interpreter *Interpreter, value *SomeValue) bool
 	VisitStorageReferenceValue(interpreter *Interpreter, value *StorageReferenceValue)
@@ -95,8 +98,11 @@ type EmptyVisitor struct {
 	Word256ValueVisitor                     func(interpreter *Interpreter, value Word256Value)
 	Fix64ValueVisitor                       func(interpreter *Interpreter, value Fix64Value)
 	UFix64ValueVisitor                      func(interpreter *Interpreter, value UFix64Value)
+	TimestampValueVisitor                   func(interpreter *Interpreter, value TimestampValue)
+	DurationValueVisitor                    func(interpreter *Interpreter, value DurationValue)
 	CompositeValueVisitor                   func(interpreter *Interpreter, value *CompositeValue) bool
 	DictionaryValueVisitor                  func(interpreter *Interpreter, value *DictionaryValue) bool
+	SetValueVisitor                         func(interpreter *Interpreter, value *SetValue) bool
 	NilValueVisitor                         func(interpreter *Interpreter, value NilValue)
 	SomeValueVisitor                        func(interpreter *Interpreter, value *SomeValue) bool
 	StorageReferenceValueVisitor            func(interpreter *Interpreter, value *StorageReferenceValue)
@@ -317,6 +323,20 @@ func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Val
 	v.UFix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitTimestampValue(interpreter *Interpreter, value TimestampValue) {
+	if v.TimestampValueVisitor == nil {
+		return
+	}
+	v.TimestampValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitDurationValue(interpreter *Interpreter, value DurationValue) {
+	if v.DurationValueVisitor == nil {
+		return
+	}
+	v.DurationValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool {
 	if v.CompositeValueVisitor == nil {
 		return true
@@ -331,6 +351,13 @@ func (v EmptyVisitor) VisitDictionaryValue(interpreter *Interpreter, value *Dict
 	return v.DictionaryValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitSetValue(interpreter *Interpreter, value *SetValue) bool {
+	if v.SetValueVisitor == nil {
+		return true
+	}
+	return v.SetValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitNilValue(interpreter *Interpreter, value NilValue) {
 	if v.NilValueVisitor == nil {
 		return
@@ -409,6 +409,7 @@ func parseVariableDeclaration(
 		access,
 		isLet,
 		identifier,
+		nil,
 		typeAnnotation,
 		value,
 		transfer,
@@ -692,6 +693,7 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		p.memoryGauge,
 		identifiers,
 		location,
+		nil,
 		ast.NewRange(
 			p.memoryGauge,
 			startPosition,
@@ -818,6 +820,7 @@ func parseEventDeclaration(
 		common.CompositeKindEvent,
 		identifier,
 		nil,
+		nil,
 		members,
 		docString,
 		ast.NewRange(
@@ -1057,6 +1060,7 @@ func parseCompositeOrInterfaceDeclaration(
 			access,
 			compositeKind,
 			identifier,
+			nil,
 			conformances,
 			members,
 			docString,
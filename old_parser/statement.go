@@ -443,6 +443,7 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 	return ast.NewForStatement(
 		p.memoryGauge,
 		identifier,
+		nil,
 		index,
 		block,
 		expression,
@@ -166,6 +166,31 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 				}
 				return parseEntitlementOrMappingDeclaration(p, access, accessPos, docString)
 
+			case KeywordTypealias:
+				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindTypeAlias)
+				if err != nil {
+					return nil, err
+				}
+				if purity != ast.FunctionPurityUnspecified {
+					return nil, NewSyntaxError(*purityPos, "invalid view modifier for type alias")
+				}
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
+			case KeywordType:
+				// The `type` keyword is a soft keyword:
+				// it only introduces a distinct type declaration if an identifier follows
+				if !isDistinctTypeDeclarationStart(p) {
+					break
+				}
+				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindStructure)
+				if err != nil {
+					return nil, err
+				}
+				if purity != ast.FunctionPurityUnspecified {
+					return nil, NewSyntaxError(*purityPos, "invalid view modifier for distinct type")
+				}
+				return parseDistinctTypeDeclaration(p, access, accessPos, docString)
+
 			case KeywordAttachment:
 				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindAttachment)
 				if err != nil {
@@ -505,7 +530,7 @@ func parseAccess(p *parser) (ast.Access, error) {
 //	variableKind : 'var' | 'let'
 //
 //	variableDeclaration :
-//	    variableKind identifier ( ':' typeAnnotation )?
+//	    variableKind ( identifier | destructuringPattern ) ( ':' typeAnnotation )?
 //	    transfer expression
 //	    ( transfer expression )?
 func parseVariableDeclaration(
@@ -525,13 +550,26 @@ func parseVariableDeclaration(
 	// Skip the `let` or `var` keyword
 	p.nextSemanticToken()
 
-	identifier, err := p.nonReservedIdentifier("after start of variable declaration")
-	if err != nil {
-		return nil, err
-	}
+	var identifier ast.Identifier
+	var pattern *ast.DestructuringPattern
+	var err error
 
-	// Skip the identifier
-	p.nextSemanticToken()
+	if isDestructuringPatternStart(p.current) {
+		pattern, err = parseDestructuringPattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+	} else {
+		identifier, err = p.nonReservedIdentifier("after start of variable declaration")
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip the identifier
+		p.nextSemanticToken()
+	}
 
 	var typeAnnotation *ast.TypeAnnotation
 
@@ -561,6 +599,13 @@ func parseVariableDeclaration(
 	secondTransfer := parseTransfer(p)
 	var secondValue ast.Expression
 	if secondTransfer != nil {
+		if pattern != nil {
+			return nil, NewSyntaxError(
+				secondTransfer.Pos,
+				"invalid second transfer in destructuring declaration",
+			)
+		}
+
 		secondValue, err = parseExpression(p, lowestBindingPower)
 		if err != nil {
 			return nil, err
@@ -572,6 +617,7 @@ func parseVariableDeclaration(
 		access,
 		isLet,
 		identifier,
+		pattern,
 		typeAnnotation,
 		value,
 		transfer,
@@ -589,6 +635,162 @@ func parseVariableDeclaration(
 	return variableDeclaration, nil
 }
 
+func isDestructuringPatternStart(token lexer.Token) bool {
+	switch token.Type {
+	case lexer.TokenBracketOpen,
+		lexer.TokenBraceOpen,
+		lexer.TokenParenOpen:
+
+		return true
+	}
+
+	return false
+}
+
+// parseDestructuringPattern parses a destructuring pattern.
+//
+//	destructuringPattern : '[' arrayDestructuringElement ( ',' arrayDestructuringElement )* ']'
+//	                     | '{' dictionaryDestructuringElement ( ',' dictionaryDestructuringElement )* '}'
+//	                     | '(' compositeDestructuringElement ( ',' compositeDestructuringElement )* ')'
+func parseDestructuringPattern(p *parser) (*ast.DestructuringPattern, error) {
+	startToken := p.current
+
+	var kind ast.DestructuringPatternKind
+	var endTokenType lexer.TokenType
+
+	switch startToken.Type {
+	case lexer.TokenBracketOpen:
+		kind = ast.DestructuringPatternKindArray
+		endTokenType = lexer.TokenBracketClose
+
+	case lexer.TokenBraceOpen:
+		kind = ast.DestructuringPatternKindDictionary
+		endTokenType = lexer.TokenBraceClose
+
+	case lexer.TokenParenOpen:
+		kind = ast.DestructuringPatternKindComposite
+		endTokenType = lexer.TokenParenClose
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	// Skip the opening bracket, brace, or parenthesis
+	p.nextSemanticToken()
+
+	var elements []*ast.DestructuringElement
+
+	for {
+		element, err := parseDestructuringElement(p, kind)
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+
+		p.skipSpaceAndComments()
+
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+
+		// Skip the comma
+		p.nextSemanticToken()
+	}
+
+	endToken, err := p.mustOne(endTokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewDestructuringPattern(
+		p.memoryGauge,
+		kind,
+		elements,
+		ast.NewRange(
+			p.memoryGauge,
+			startToken.StartPos,
+			endToken.EndPos,
+		),
+	), nil
+}
+
+// parseDestructuringElement parses an element of a destructuring pattern.
+//
+//	arrayDestructuringElement : identifier
+//
+//	dictionaryDestructuringElement : expression ':' identifier
+//
+//	compositeDestructuringElement : identifier ( ':' identifier )?
+func parseDestructuringElement(
+	p *parser,
+	kind ast.DestructuringPatternKind,
+) (*ast.DestructuringElement, error) {
+
+	var key ast.Expression
+	var field *ast.Identifier
+
+	switch kind {
+	case ast.DestructuringPatternKindArray:
+		// The element is only an identifier
+
+	case ast.DestructuringPatternKindDictionary:
+		var err error
+		key, err = parseExpression(p, lowestBindingPower)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.mustOne(lexer.TokenColon)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+
+	case ast.DestructuringPatternKindComposite:
+		fieldIdentifier, err := p.nonReservedIdentifier("in destructuring pattern")
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip the field name
+		p.nextSemanticToken()
+
+		if !p.current.Is(lexer.TokenColon) {
+			return ast.NewDestructuringElement(
+				p.memoryGauge,
+				nil,
+				&fieldIdentifier,
+				fieldIdentifier,
+			), nil
+		}
+
+		// Skip the colon
+		p.nextSemanticToken()
+
+		field = &fieldIdentifier
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	identifier, err := p.nonReservedIdentifier("in destructuring pattern")
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the identifier
+	p.next()
+
+	return ast.NewDestructuringElement(
+		p.memoryGauge,
+		key,
+		field,
+		identifier,
+	), nil
+}
+
 // parseTransfer parses a transfer.
 //
 //	transfer : '=' | '<-' | '<-!'
@@ -647,6 +849,7 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 //	    'import'
 //	    ( identifier (',' identifier)* 'from' )?
 //	    ( string | hexadecimalLiteral | identifier )
+//	    ( 'hash' string )?
 func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 
 	startPosition := p.current.StartPos
@@ -851,10 +1054,30 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		)
 	}
 
+	var hash []byte
+	if isNextTokenHash(p) {
+		// Skip the `hash` keyword
+		p.nextSemanticToken()
+
+		if p.current.Type != lexer.TokenString {
+			return nil, p.syntaxError(
+				"unexpected token in import declaration: got %s, expected string with hexadecimal hash",
+				p.current.Type,
+			)
+		}
+
+		hash = parseImportHash(p)
+		endPos = p.current.EndPos
+
+		// Skip the hash
+		p.next()
+	}
+
 	return ast.NewImportDeclaration(
 		p.memoryGauge,
 		identifiers,
 		location,
+		hash,
 		ast.NewRange(
 			p.memoryGauge,
 			startPosition,
@@ -864,6 +1087,40 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 	), nil
 }
 
+// isNextTokenHash checks whether the next semantic token is the `hash` keyword.
+// If it is, the parser is advanced to it, otherwise the parser is left unchanged,
+// so that trivia like doc strings of the following declaration is preserved.
+func isNextTokenHash(p *parser) bool {
+	current := p.current
+	cursor := p.tokens.Cursor()
+
+	p.skipSpaceAndComments()
+
+	if p.isToken(p.current, lexer.TokenIdentifier, KeywordHash) {
+		return true
+	}
+
+	p.current = current
+	p.tokens.Revert(cursor)
+
+	return false
+}
+
+// parseImportHash parses the hash of an import declaration,
+// a string literal containing a non-empty hexadecimal encoding of the hash
+func parseImportHash(p *parser) []byte {
+	literal := p.currentTokenSource()
+	parsedString := parseStringLiteral(p, literal)
+
+	hash, err := hex.DecodeString(parsedString)
+	if err != nil || len(hash) == 0 {
+		p.reportSyntaxError("invalid import hash: expected non-empty hexadecimal string, got %q", parsedString)
+		return nil
+	}
+
+	return hash
+}
+
 // isNextTokenCommaOrFrom check whether the token to follow is a comma or a from token.
 func isNextTokenCommaOrFrom(p *parser) bool {
 	current := p.current
@@ -942,8 +1199,11 @@ func parseEventDeclaration(
 	p.next()
 
 	// if this is a `ResourceDestroyed` event (i.e., a default event declaration), parse default arguments
-	parseDefaultArguments := ast.IsResourceDestructionDefaultEvent(identifier.Identifier)
-	parameterList, err := parseParameterList(p, parseDefaultArguments)
+	defaultArguments := defaultArgumentsForbidden
+	if ast.IsResourceDestructionDefaultEvent(identifier.Identifier) {
+		defaultArguments = defaultArgumentsRequired
+	}
+	parameterList, err := parseParameterList(p, defaultArguments)
 	if err != nil {
 		return nil, err
 	}
@@ -980,6 +1240,7 @@ func parseEventDeclaration(
 		common.CompositeKindEvent,
 		identifier,
 		nil,
+		nil,
 		members,
 		docString,
 		ast.NewRange(
@@ -1264,6 +1525,177 @@ func parseEntitlementOrMappingDeclaration(
 	}
 }
 
+// parseTypeAliasDeclaration parses a type alias declaration.
+//
+//	typeAliasDeclaration :
+//	    access?
+//	    'typealias' identifier '=' typeAnnotation
+func parseTypeAliasDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (*ast.TypeAliasDeclaration, error) {
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `typealias` keyword
+	p.nextSemanticToken()
+
+	identifier, err := p.nonReservedIdentifier("following type alias declaration")
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the identifier
+	p.nextSemanticToken()
+
+	_, err = p.mustOne(lexer.TokenEqual)
+	if err != nil {
+		return nil, err
+	}
+
+	typeAnnotation, err := parseTypeAnnotation(p)
+	if err != nil {
+		return nil, err
+	}
+
+	declarationRange := ast.NewRange(
+		p.memoryGauge,
+		startPos,
+		typeAnnotation.EndPosition(p.memoryGauge),
+	)
+
+	return ast.NewTypeAliasDeclaration(
+		p.memoryGauge,
+		access,
+		identifier,
+		typeAnnotation,
+		docString,
+		declarationRange,
+	), nil
+}
+
+// isDistinctTypeDeclarationStart returns true if the current `type` keyword
+// is followed by an identifier, i.e. it starts a distinct type declaration.
+// The parser state is left unchanged.
+func isDistinctTypeDeclarationStart(p *parser) bool {
+	// save current stream state before looking ahead for the identifier
+	cursor := p.tokens.Cursor()
+	current := p.current
+
+	p.nextSemanticToken()
+	isIdentifier := p.current.Is(lexer.TokenIdentifier)
+
+	p.tokens.Revert(cursor)
+	p.current = current
+
+	return isIdentifier
+}
+
+// parseDistinctTypeDeclaration parses a distinct type declaration.
+//
+//	distinctTypeDeclaration :
+//	    'type' identifier '=' 'distinct' typeAnnotation
+//	    ( 'with' 'operations' )?
+func parseDistinctTypeDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (*ast.CompositeDeclaration, error) {
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `type` keyword
+	p.nextSemanticToken()
+
+	identifier, err := p.nonReservedIdentifier("following distinct type declaration")
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the identifier
+	p.nextSemanticToken()
+
+	_, err = p.mustOne(lexer.TokenEqual)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments()
+
+	if !p.isToken(p.current, lexer.TokenIdentifier, KeywordDistinct) {
+		return nil, p.syntaxError(
+			"expected %q in distinct type declaration, got %s",
+			KeywordDistinct,
+			p.current.Type,
+		)
+	}
+
+	// Skip the `distinct` keyword
+	p.nextSemanticToken()
+
+	underlyingType, err := parseTypeAnnotation(p)
+	if err != nil {
+		return nil, err
+	}
+
+	endPos := underlyingType.EndPosition(p.memoryGauge)
+
+	inheritsOperations := false
+
+	// save current stream state before looking ahead for the `with` keyword,
+	// as the declaration might be followed by an identifier on the next line
+	cursor := p.tokens.Cursor()
+	current := p.current
+
+	p.skipSpaceAndComments()
+
+	if p.isToken(p.current, lexer.TokenIdentifier, KeywordWith) {
+		// Skip the `with` keyword
+		p.nextSemanticToken()
+
+		if !p.isToken(p.current, lexer.TokenIdentifier, KeywordOperations) {
+			return nil, p.syntaxError(
+				"expected %q after %q in distinct type declaration, got %s",
+				KeywordOperations,
+				KeywordWith,
+				p.current.Type,
+			)
+		}
+
+		inheritsOperations = true
+		endPos = p.current.EndPos
+
+		// Skip the `operations` keyword
+		p.next()
+	} else {
+		p.tokens.Revert(cursor)
+		p.current = current
+	}
+
+	declarationRange := ast.NewRange(
+		p.memoryGauge,
+		startPos,
+		endPos,
+	)
+
+	return ast.NewDistinctTypeDeclaration(
+		p.memoryGauge,
+		access,
+		identifier,
+		underlyingType,
+		inheritsOperations,
+		docString,
+		declarationRange,
+	), nil
+}
+
 func parseConformances(p *parser) ([]*ast.NominalType, error) {
 	var conformances []*ast.NominalType
 	var err error
@@ -1355,6 +1787,16 @@ func parseCompositeOrInterfaceDeclaration(
 		}
 	}
 
+	var typeParameterList *ast.TypeParameterList
+
+	if !isInterface {
+		var err error
+		typeParameterList, err = parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	p.skipSpaceAndComments()
 
 	conformances, err := parseConformances(p)
@@ -1402,6 +1844,7 @@ func parseCompositeOrInterfaceDeclaration(
 			access,
 			compositeKind,
 			identifier,
+			typeParameterList,
 			conformances,
 			members,
 			docString,
@@ -1675,6 +2118,29 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 				}
 				return parseEntitlementOrMappingDeclaration(p, access, accessPos, docString)
 
+			case KeywordTypealias:
+				if purity != ast.FunctionPurityUnspecified {
+					return nil, NewSyntaxError(*purityPos, "invalid view modifier for type alias")
+				}
+				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindTypeAlias)
+				if err != nil {
+					return nil, err
+				}
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
+			case KeywordType:
+				if !isDistinctTypeDeclarationStart(p) {
+					break
+				}
+				if purity != ast.FunctionPurityUnspecified {
+					return nil, NewSyntaxError(*purityPos, "invalid view modifier for distinct type")
+				}
+				err := rejectStaticAndNativeModifiers(p, staticPos, nativePos, common.DeclarationKindStructure)
+				if err != nil {
+					return nil, err
+				}
+				return parseDistinctTypeDeclaration(p, access, accessPos, docString)
+
 			case KeywordEnum:
 				if purity != ast.FunctionPurityUnspecified {
 					return nil, NewSyntaxError(*purityPos, "invalid view modifier for enum")
@@ -1910,8 +2376,15 @@ func parseSpecialFunctionDeclaration(
 
 	startPos := ast.EarliestPosition(identifier.Pos, accessPos, purityPos, staticPos, nativePos)
 
+	// Only initializers may declare default arguments
+
+	defaultArguments := defaultArgumentsForbidden
+	if identifier.Identifier == KeywordInit {
+		defaultArguments = defaultArgumentsAllowed
+	}
+
 	parameterList, returnTypeAnnotation, functionBlock, err :=
-		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
+		parseFunctionParameterListAndRest(p, functionBlockIsOptional, defaultArguments)
 	if err != nil {
 		return nil, err
 	}
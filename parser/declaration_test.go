@@ -389,7 +389,7 @@ func TestParseParameterList(t *testing.T) {
 			nil,
 			[]byte(input),
 			func(p *parser) (*ast.ParameterList, error) {
-				return parseParameterList(p, false)
+				return parseParameterList(p, defaultArgumentsForbidden)
 			},
 			Config{},
 		)
@@ -579,6 +579,94 @@ func TestParseParameterList(t *testing.T) {
 	})
 }
 
+func TestParseParameterListLooseMode(t *testing.T) {
+
+	t.Parallel()
+
+	parse := func(input string, config Config) (*ast.ParameterList, []error) {
+		return Parse(
+			nil,
+			[]byte(input),
+			func(p *parser) (*ast.ParameterList, error) {
+				return parseParameterList(p, defaultArgumentsForbidden)
+			},
+			config,
+		)
+	}
+
+	t.Run("without type annotations", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := parse(
+			"( a, b c: Int, d )",
+			Config{
+				LooseModeEnabled: true,
+			},
+		)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.ParameterList{
+				Parameters: []*ast.Parameter{
+					{
+						Identifier: ast.Identifier{
+							Identifier: "a",
+							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+						},
+						StartPos: ast.Position{Line: 1, Column: 2, Offset: 2},
+					},
+					{
+						Label: "b",
+						Identifier: ast.Identifier{
+							Identifier: "c",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+						TypeAnnotation: &ast.TypeAnnotation{
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 10, Offset: 10},
+						},
+						StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+					{
+						Identifier: ast.Identifier{
+							Identifier: "d",
+							Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+						},
+						StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 17, Offset: 17},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("without type annotation, loose mode disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := parse("( a )", Config{})
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected ':' after parameter name, got ')'",
+					Pos:     ast.Position{Offset: 4, Line: 1, Column: 4},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseFunctionDeclaration(t *testing.T) {
 
 	t.Parallel()
@@ -1602,21 +1690,20 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
-	t.Run("with type parameters, disabled", func(t *testing.T) {
+	t.Run("with type parameters, not enabled", func(t *testing.T) {
 
 		t.Parallel()
 
-		_, errs := testParseDeclarations("fun foo<A>() {}")
+		// The deprecated option has no effect, type parameters are always enabled
 
-		utils.AssertEqualWithDiff(t,
-			[]error{
-				&SyntaxError{
-					Message: "expected '(' as start of parameter list, got '<'",
-					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
-				},
-			},
-			errs,
-		)
+		result, errs := testParseDeclarations("fun foo<A>() {}")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.FunctionDeclaration{}, result[0])
+		functionDeclaration := result[0].(*ast.FunctionDeclaration)
+		require.NotNil(t, functionDeclaration.TypeParameterList)
+		assert.Len(t, functionDeclaration.TypeParameterList.TypeParameters, 1)
 	})
 
 	t.Run("missing type parameter list end, enabled", func(t *testing.T) {
@@ -1664,6 +1751,69 @@ func TestParseFunctionDeclaration(t *testing.T) {
 		)
 	})
 
+	t.Run("with default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("fun foo (a: Int = 1) { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Access: ast.AccessNotSpecified,
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: []*ast.Parameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+								},
+								DefaultArgument: &ast.IntegerExpression{
+									PositiveLiteral: []byte("1"),
+									Value:           big.NewInt(1),
+									Base:            10,
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 18, Offset: 18},
+										EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+									},
+								},
+								StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+							EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+						},
+					},
+					Purity: ast.FunctionPurityUnspecified,
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+								EndPos:   ast.Position{Line: 1, Column: 23, Offset: 23},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseAccess(t *testing.T) {
@@ -2431,6 +2581,108 @@ func TestParseImportDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(` import foo from 0x42 hash "abCD"`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					Hash:        []byte{0xab, 0xcd},
+					LocationPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("hash, identifier location", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(` import foo hash "01"`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Location:    common.IdentifierLocation("foo"),
+					Hash:        []byte{0x1},
+					LocationPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("hash, invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(` import foo from 0x42 hash "xyz"`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected non-empty hexadecimal string, got \"xyz\"",
+					Pos:     ast.Position{Offset: 27, Line: 1, Column: 27},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, missing string", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(` import foo from 0x42 hash 1`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in import declaration: got decimal integer, expected string with hexadecimal hash",
+					Pos:     ast.Position{Offset: 27, Line: 1, Column: 27},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("no hash, following doc string", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          import foo from 0x42
+          /// hash
+          let hash = 1
+        `)
+		require.Empty(t, errs)
+		require.Len(t, result, 2)
+
+		assert.Nil(t, result[0].(*ast.ImportDeclaration).Hash)
+		assert.Equal(t, " hash", result[1].DeclarationDocString())
+	})
 }
 
 func TestParseEvent(t *testing.T) {
@@ -3231,6 +3483,65 @@ func TestParseCompositeDeclaration(t *testing.T) {
 		)
 	})
 
+	t.Run("resource, type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(" access(all) resource R<T: @AnyResource> : RI { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessAll,
+					CompositeKind: common.CompositeKindResource,
+					Identifier: ast.Identifier{
+						Identifier: "R",
+						Pos:        ast.Position{Line: 1, Column: 22, Offset: 22},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 24, Offset: 24},
+								},
+								TypeBound: &ast.TypeAnnotation{
+									IsResource: true,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "AnyResource",
+											Pos:        ast.Position{Line: 1, Column: 28, Offset: 28},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 27, Offset: 27},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 23, Offset: 23},
+							EndPos:   ast.Position{Line: 1, Column: 39, Offset: 39},
+						},
+					},
+					Conformances: []*ast.NominalType{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "RI",
+								Pos:        ast.Position{Line: 1, Column: 43, Offset: 43},
+							},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 48, Offset: 48},
+					},
+				},
+			},
+			result,
+		)
+	})
+
 	t.Run("struct, with fields, functions, and special functions", func(t *testing.T) {
 
 		t.Parallel()
@@ -7221,21 +7532,29 @@ func TestParseInvalidDefaultArgument(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("function declaration ", func(t *testing.T) {
+	t.Run("transaction parameter", func(t *testing.T) {
 
 		t.Parallel()
 
-		_, errs := testParseDeclarations(" access(all) fun foo ( a : Int = 3) { } ")
+		_, errs := testParseDeclarations(" transaction ( a : Int = 3) { } ")
 
 		utils.AssertEqualWithDiff(t, []error{
 			&SyntaxError{
-				Pos:     ast.Position{Line: 1, Column: 31, Offset: 31},
+				Pos:     ast.Position{Line: 1, Column: 23, Offset: 23},
 				Message: "cannot use a default argument for this function",
 			},
 		}, errs)
 	})
 
-	t.Run("function expression ", func(t *testing.T) {
+	t.Run("composite function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations(" struct S { fun foo ( a : Int = 3) { } } ")
+		require.Empty(t, errs)
+	})
+
+	t.Run("function expression ", func(t *testing.T) {
 
 		t.Parallel()
 
@@ -9804,3 +10123,547 @@ func TestParseKeywordsAsFieldNames(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypeAliasDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("basic", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(" access(all) typealias Foo = [Int] ")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessAll,
+					Identifier: ast.Identifier{
+						Identifier: "Foo",
+						Pos:        ast.Position{Line: 1, Column: 23, Offset: 23},
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						Type: &ast.VariableSizedType{
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 30, Offset: 30},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 29, Offset: 29},
+								EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 29, Offset: 29},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("resource, local", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("typealias R = @[R]")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessNotSpecified,
+					Identifier: ast.Identifier{
+						Identifier: "R",
+						Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+					},
+					TypeAnnotation: &ast.TypeAnnotation{
+						IsResource: true,
+						Type: &ast.VariableSizedType{
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "R",
+									Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+								EndPos:   ast.Position{Line: 1, Column: 17, Offset: 17},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 17, Offset: 17},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+            access(all) contract C {
+                access(all) typealias T = Int
+            }
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		typeAliases := result[0].DeclarationMembers().TypeAliases()
+		require.Len(t, typeAliases, 1)
+		assert.Equal(t, "T", typeAliases[0].Identifier.Identifier)
+	})
+
+	t.Run("missing equal sign", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("typealias Foo Int")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token '='",
+					Pos:     ast.Position{Offset: 14, Line: 1, Column: 14},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("view modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("view typealias Foo = Int")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid view modifier for type alias",
+					Pos:     ast.Position{Offset: 0, Line: 1, Column: 0},
+				},
+			},
+			errs,
+		)
+	})
+}
+
+func TestParseDistinctTypeDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("basic", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(" access(all) type PersonID = distinct String ")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				ast.NewDistinctTypeDeclaration(
+					nil,
+					ast.AccessAll,
+					ast.Identifier{
+						Identifier: "PersonID",
+						Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+					},
+					&ast.TypeAnnotation{
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "String",
+								Pos:        ast.Position{Line: 1, Column: 38, Offset: 38},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 38, Offset: 38},
+					},
+					false,
+					"",
+					ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 43, Offset: 43},
+					},
+				),
+			},
+			result,
+		)
+	})
+
+	t.Run("with operations", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("type Meters = distinct Int with operations")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				ast.NewDistinctTypeDeclaration(
+					nil,
+					ast.AccessNotSpecified,
+					ast.Identifier{
+						Identifier: "Meters",
+						Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+					},
+					&ast.TypeAnnotation{
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "Int",
+								Pos:        ast.Position{Line: 1, Column: 23, Offset: 23},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 23, Offset: 23},
+					},
+					true,
+					"",
+					ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 41, Offset: 41},
+					},
+				),
+			},
+			result,
+		)
+	})
+
+	t.Run("synthesized members", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("type PersonID = distinct String")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		declaration := result[0].(*ast.CompositeDeclaration)
+		assert.True(t, declaration.IsDistinctType())
+		assert.Equal(t, common.CompositeKindStructure, declaration.CompositeKind)
+
+		members := declaration.Members
+
+		fields := members.Fields()
+		require.Len(t, fields, 1)
+		assert.Equal(t,
+			ast.DistinctTypeUnderlyingValueFieldName,
+			fields[0].Identifier.Identifier,
+		)
+		assert.Equal(t, ast.AccessSelf, fields[0].Access)
+
+		require.Len(t, members.Initializers(), 1)
+
+		functions := members.Functions()
+		require.Len(t, functions, 1)
+		assert.Equal(t,
+			ast.DistinctTypeToUnderlyingFunctionName,
+			functions[0].Identifier.Identifier,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+            contract C {
+                access(all) type ID = distinct UInt64
+            }
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.CompositeDeclaration{}, result[0])
+
+		composites := result[0].DeclarationMembers().Composites()
+		require.Len(t, composites, 1)
+		assert.True(t, composites[0].IsDistinctType())
+		assert.Equal(t, "ID", composites[0].Identifier.Identifier)
+	})
+
+	t.Run("missing distinct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("type PersonID = String")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected \"distinct\" in distinct type declaration, got identifier",
+					Pos:     ast.Position{Offset: 16, Line: 1, Column: 16},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("missing operations", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("type Meters = distinct Int with")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected \"operations\" after \"with\" in distinct type declaration, got EOF",
+					Pos:     ast.Position{Offset: 31, Line: 1, Column: 31},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("view modifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("view type PersonID = distinct String")
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid view modifier for distinct type",
+					Pos:     ast.Position{Offset: 0, Line: 1, Column: 0},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("type as identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("type(1)")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.ExpressionStatement{}, result[0])
+	})
+}
+
+func TestParseDestructuringDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("let [a, _] = xs")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.VariableDeclaration{
+					Access:     ast.AccessNotSpecified,
+					IsConstant: true,
+					Pattern: &ast.DestructuringPattern{
+						Kind: ast.DestructuringPatternKindArray,
+						Elements: []*ast.DestructuringElement{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+							},
+							{
+								Identifier: ast.Identifier{
+									Identifier: "_",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "xs",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`let {"k": v} = d`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.VariableDeclaration{
+					Access:     ast.AccessNotSpecified,
+					IsConstant: true,
+					Pattern: &ast.DestructuringPattern{
+						Kind: ast.DestructuringPatternKindDictionary,
+						Elements: []*ast.DestructuringElement{
+							{
+								Key: &ast.StringExpression{
+									Value: "k",
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 5, Offset: 5},
+										EndPos:   ast.Position{Line: 1, Column: 7, Offset: 7},
+									},
+								},
+								Identifier: ast.Identifier{
+									Identifier: "v",
+									Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "d",
+							Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("var (x, y: z) <- p")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.VariableDeclaration{
+					Access:     ast.AccessNotSpecified,
+					IsConstant: false,
+					Pattern: &ast.DestructuringPattern{
+						Kind: ast.DestructuringPatternKindComposite,
+						Elements: []*ast.DestructuringElement{
+							{
+								Field: &ast.Identifier{
+									Identifier: "x",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+								Identifier: ast.Identifier{
+									Identifier: "x",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+							},
+							{
+								Field: &ast.Identifier{
+									Identifier: "y",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+								Identifier: ast.Identifier{
+									Identifier: "z",
+									Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 12, Offset: 12},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "p",
+							Pos:        ast.Position{Line: 1, Column: 17, Offset: 17},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationMove,
+						Pos:       ast.Position{Line: 1, Column: 14, Offset: 14},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations("let [a]: [Int; 1] = xs")
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		declaration := result[0].(*ast.VariableDeclaration)
+		require.NotNil(t, declaration.Pattern)
+		require.NotNil(t, declaration.TypeAnnotation)
+		assert.Equal(t, "let [a]: [Int; 1] = xs", declaration.String())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("let [] = xs")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected identifier in destructuring pattern, got ']'",
+					Pos:     ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("second transfer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseDeclarations("let [a] <- x <- y")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid second transfer in destructuring declaration",
+					Pos:     ast.Position{Line: 1, Column: 13, Offset: 13},
+				},
+			},
+			errs,
+		)
+	})
+}
@@ -508,6 +508,7 @@ func init() {
 	})
 
 	defineNestedExpression()
+	defineStringTemplateExpression()
 	defineInvocationExpression()
 	defineArrayExpression()
 	defineDictionaryExpression()
@@ -726,6 +727,18 @@ func defineGreaterThanOrBitwiseRightShiftExpression() {
 			// Skip the `>` token.
 			p.next()
 
+			// If a '>=' token appears immediately,
+			// then the operator is actually a bitwise right shift compound assignment operator (`>>=`),
+			// which is not part of the expression, but of an assignment statement.
+			// Replay the buffered tokens and stop.
+
+			if p.current.Is(lexer.TokenGreaterEqual) {
+				p.current = current
+				p.tokens.Revert(cursor)
+
+				return left, nil, true
+			}
+
 			// If another '>' token appears immediately,
 			// then the operator is actually a bitwise right shift operator
 
@@ -859,7 +872,7 @@ func defineIdentifierExpression() {
 
 func parseFunctionExpression(p *parser, token lexer.Token, purity ast.FunctionPurity) (*ast.FunctionExpression, error) {
 	parameterList, returnTypeAnnotation, functionBlock, err :=
-		parseFunctionParameterListAndRest(p, false)
+		parseFunctionParameterListAndRest(p, false, defaultArgumentsForbidden)
 	if err != nil {
 		return nil, err
 	}
@@ -1144,6 +1157,80 @@ func defineNestedExpression() {
 	)
 }
 
+func defineStringTemplateExpression() {
+	setExprNullDenotation(
+		lexer.TokenStringTemplateStart,
+		func(p *parser, startToken lexer.Token) (ast.Expression, error) {
+			// The start token consists of the opening quote,
+			// the first literal part, and the start of the first interpolation, `\(`
+			literal := p.tokenSource(startToken)
+			values := []string{
+				parseStringLiteralContent(p, literal[1:len(literal)-2]),
+			}
+
+			var expressions []ast.Expression
+
+			for {
+				expression, err := parseExpression(p, lowestBindingPower)
+				if err != nil {
+					return nil, err
+				}
+				expressions = append(expressions, expression)
+
+				p.skipSpaceAndComments()
+
+				switch p.current.Type {
+				case lexer.TokenStringTemplateMiddle:
+					// The middle token consists of the closing parenthesis of the previous interpolation,
+					// the next literal part, and the start of the next interpolation, `\(`
+					literal = p.currentTokenSource()
+					values = append(
+						values,
+						parseStringLiteralContent(p, literal[1:len(literal)-2]),
+					)
+					p.next()
+
+				case lexer.TokenStringTemplateEnd:
+					// The end token consists of the closing parenthesis of the last interpolation,
+					// the last literal part, and the closing quote
+					endToken := p.current
+					literal = p.currentTokenSource()
+					p.next()
+
+					endOffset := len(literal)
+					if endOffset < 2 || literal[endOffset-1] != '"' {
+						p.reportSyntaxError("invalid end of string literal: missing '\"'")
+					} else {
+						endOffset--
+					}
+
+					values = append(
+						values,
+						parseStringLiteralContent(p, literal[1:endOffset]),
+					)
+
+					return ast.NewStringTemplateExpression(
+						p.memoryGauge,
+						values,
+						expressions,
+						ast.NewRange(
+							p.memoryGauge,
+							startToken.StartPos,
+							endToken.EndPos,
+						),
+					), nil
+
+				default:
+					return nil, p.syntaxError(
+						"expected end of interpolated expression, got %s",
+						p.current.Type,
+					)
+				}
+			}
+		},
+	)
+}
+
 func defineArrayExpression() {
 	setExprNullDenotation(
 		lexer.TokenBracketOpen,
@@ -1320,12 +1407,37 @@ func definePathExpression() {
 				return nil, err
 			}
 
-			return ast.NewPathExpression(
+			pathExpression := ast.NewPathExpression(
 				p.memoryGauge,
 				domain,
 				identifier,
 				token.StartPos,
-			), nil
+			)
+
+			// Parse the components of an extended path, if any,
+			// e.g. `/storage/vault/\(tokenType)`
+
+			for p.current.Is(lexer.TokenPathInterpolationStart) {
+				// Skip the `/\(`
+				p.nextSemanticToken()
+
+				component, err := parseExpression(p, lowestBindingPower)
+				if err != nil {
+					return nil, err
+				}
+
+				p.skipSpaceAndComments()
+
+				endToken, err := p.mustOne(lexer.TokenParenClose)
+				if err != nil {
+					return nil, err
+				}
+
+				pathExpression.Components = append(pathExpression.Components, component)
+				pathExpression.EndPos = endToken.EndPos
+			}
+
+			return pathExpression, nil
 		},
 	)
 }
@@ -1067,6 +1067,79 @@ func TestParsePath(t *testing.T) {
 	)
 }
 
+func TestParseExtendedPath(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("components", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`/foo/bar/\(x)/\( 1 )`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.PathExpression{
+				Domain: ast.Identifier{
+					Identifier: "foo",
+					Pos:        ast.Position{Line: 1, Column: 1, Offset: 1},
+				},
+				Identifier: ast.Identifier{
+					Identifier: "bar",
+					Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+				Components: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+						},
+					},
+					&ast.IntegerExpression{
+						PositiveLiteral: []byte("1"),
+						Value:           big.NewInt(1),
+						Base:            10,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+							EndPos:   ast.Position{Line: 1, Column: 17, Offset: 17},
+						},
+					},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+			},
+			result,
+		)
+	})
+
+	t.Run("division", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`/foo/bar / x`)
+		require.Empty(t, errs)
+
+		require.IsType(t, &ast.BinaryExpression{}, result)
+	})
+
+	t.Run("missing end of component", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseExpression(`/foo/bar/\(x`)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected token ')'",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseString(t *testing.T) {
 
 	t.Parallel()
@@ -2890,6 +2963,42 @@ func TestParseCreate(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("type arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression("create T<Int>()")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.CreateExpression{
+				InvocationExpression: &ast.InvocationExpression{
+					InvokedExpression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "T",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					TypeArguments: []*ast.TypeAnnotation{
+						{
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "Int",
+									Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+								},
+							},
+							StartPos: ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					ArgumentsStartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+					EndPos:            ast.Position{Line: 1, Column: 14, Offset: 14},
+				},
+				StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseNil(t *testing.T) {
@@ -5986,6 +6095,126 @@ func TestParseExpression(t *testing.T) {
 	utils.AssertEqualWithDiff(t, expected, actual)
 }
 
+func TestParseStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("single interpolation", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`"a \(x) b\n"`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"a ", " b\n"},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("multiple interpolations", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`"\(1)\("x")"`)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"", "", ""},
+				Expressions: []ast.Expression{
+					&ast.IntegerExpression{
+						PositiveLiteral: []byte("1"),
+						Value:           big.NewInt(1),
+						Base:            10,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+							EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+						},
+					},
+					&ast.StringExpression{
+						Value: "x",
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, missing end", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseExpression(`"a\(x)`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid end of string literal: missing '\"'",
+					Pos:     ast.Position{Offset: 6, Line: 1, Column: 6},
+				},
+			},
+			errs,
+		)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.StringTemplateExpression{
+				Values: []string{"a", ""},
+				Expressions: []ast.Expression{
+					&ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid, missing expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseExpression(`"\()"`)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in expression: string template end",
+					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseStringEscapes(t *testing.T) {
 
 	t.Parallel()
@@ -32,7 +32,17 @@ func parsePurityAnnotation(p *parser) ast.FunctionPurity {
 	return ast.FunctionPurityUnspecified
 }
 
-func parseParameterList(p *parser, expectDefaultArguments bool) (*ast.ParameterList, error) {
+// defaultArguments determines if the parameters of a parameter list
+// must not, may, or must have default arguments
+type defaultArguments uint8
+
+const (
+	defaultArgumentsForbidden defaultArguments = iota
+	defaultArgumentsAllowed
+	defaultArgumentsRequired
+)
+
+func parseParameterList(p *parser, defaultArguments defaultArguments) (*ast.ParameterList, error) {
 	var parameters []*ast.Parameter
 
 	p.skipSpaceAndComments()
@@ -63,7 +73,7 @@ func parseParameterList(p *parser, expectDefaultArguments bool) (*ast.ParameterL
 					Pos: p.current.StartPos,
 				})
 			}
-			parameter, err := parseParameter(p, expectDefaultArguments)
+			parameter, err := parseParameter(p, defaultArguments)
 			if err != nil {
 				return nil, err
 			}
@@ -120,7 +130,7 @@ func parseParameterList(p *parser, expectDefaultArguments bool) (*ast.ParameterL
 	), nil
 }
 
-func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, error) {
+func parseParameter(p *parser, defaultArguments defaultArguments) (*ast.Parameter, error) {
 	p.skipSpaceAndComments()
 
 	startPos := p.current.StartPos
@@ -150,28 +160,36 @@ func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, erro
 		p.nextSemanticToken()
 	}
 
-	if !p.current.Is(lexer.TokenColon) {
-		return nil, p.syntaxError(
-			"expected %s after parameter name, got %s",
-			lexer.TokenColon,
-			p.current.Type,
-		)
-	}
+	// In the loose mode, the type annotation may be omitted
 
-	// Skip the colon
-	p.nextSemanticToken()
+	var typeAnnotation *ast.TypeAnnotation
 
-	typeAnnotation, err := parseTypeAnnotation(p)
+	if !p.config.LooseModeEnabled || p.current.Is(lexer.TokenColon) {
 
-	if err != nil {
-		return nil, err
-	}
+		if !p.current.Is(lexer.TokenColon) {
+			return nil, p.syntaxError(
+				"expected %s after parameter name, got %s",
+				lexer.TokenColon,
+				p.current.Type,
+			)
+		}
 
-	p.skipSpaceAndComments()
+		// Skip the colon
+		p.nextSemanticToken()
+
+		typeAnnotation, err = parseTypeAnnotation(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+	}
 
 	var defaultArgument ast.Expression
 
-	if expectDefaultArgument {
+	switch defaultArguments {
+	case defaultArgumentsRequired:
 		if !p.current.Is(lexer.TokenEqual) {
 			return nil, p.syntaxError(
 				"expected a default argument after type annotation, got %s",
@@ -179,6 +197,13 @@ func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, erro
 			)
 		}
 
+	case defaultArgumentsForbidden:
+		if p.current.Is(lexer.TokenEqual) {
+			return nil, p.syntaxError("cannot use a default argument for this function")
+		}
+	}
+
+	if p.current.Is(lexer.TokenEqual) {
 		// Skip the =
 		p.nextSemanticToken()
 
@@ -186,9 +211,6 @@ func parseParameter(p *parser, expectDefaultArgument bool) (*ast.Parameter, erro
 		if err != nil {
 			return nil, err
 		}
-
-	} else if p.current.Is(lexer.TokenEqual) {
-		return nil, p.syntaxError("cannot use a default argument for this function")
 	}
 
 	return ast.NewParameter(
@@ -343,18 +365,13 @@ func parseFunctionDeclaration(
 	// Skip the identifier
 	p.next()
 
-	var typeParameterList *ast.TypeParameterList
-
-	if p.config.TypeParametersEnabled {
-		var err error
-		typeParameterList, err = parseTypeParameterList(p)
-		if err != nil {
-			return nil, err
-		}
+	typeParameterList, err := parseTypeParameterList(p)
+	if err != nil {
+		return nil, err
 	}
 
 	parameterList, returnTypeAnnotation, functionBlock, err :=
-		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
+		parseFunctionParameterListAndRest(p, functionBlockIsOptional, defaultArgumentsAllowed)
 
 	if err != nil {
 		return nil, err
@@ -379,6 +396,7 @@ func parseFunctionDeclaration(
 func parseFunctionParameterListAndRest(
 	p *parser,
 	functionBlockIsOptional bool,
+	defaultArguments defaultArguments,
 ) (
 	parameterList *ast.ParameterList,
 	returnTypeAnnotation *ast.TypeAnnotation,
@@ -387,7 +405,7 @@ func parseFunctionParameterListAndRest(
 ) {
 	// Parameter list
 
-	parameterList, err = parseParameterList(p, false)
+	parameterList, err = parseParameterList(p, defaultArguments)
 	if err != nil {
 		return
 	}
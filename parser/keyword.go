@@ -92,6 +92,10 @@ const (
 	KeywordRepeat      = "repeat"
 	KeywordGuard       = "guard"
 	KeywordIs          = "is"
+	KeywordDistinct    = "distinct"
+	KeywordWith        = "with"
+	KeywordOperations  = "operations"
+	KeywordHash        = "hash"
 	// NOTE: ensure to update allKeywords when adding a new keyword
 )
 
@@ -166,6 +170,10 @@ var allKeywords = []string{
 	KeywordRepeat,
 	KeywordGuard,
 	KeywordIs,
+	KeywordDistinct,
+	KeywordWith,
+	KeywordOperations,
+	KeywordHash,
 }
 
 // SoftKeywords are keywords that can be used as identifiers anywhere,
@@ -179,6 +187,10 @@ var SoftKeywords = []string{
 	KeywordRemove,
 	KeywordTo,
 	KeywordType,
+	KeywordDistinct,
+	KeywordWith,
+	KeywordOperations,
+	KeywordHash,
 }
 
 var softKeywordsTable = mph.Build(SoftKeywords)
@@ -74,6 +74,9 @@ type lexer struct {
 	prev rune
 	// canBackup indicates whether stepping back is allowed
 	canBackup bool
+	// templateParenDepths contains, for each string template expression
+	// that is currently being scanned, the number of unclosed parentheses
+	templateParenDepths []int
 }
 
 var _ TokenStream = &lexer{}
@@ -130,6 +133,7 @@ func (l *lexer) clear() {
 	l.cursor = 0
 	l.tokens = l.tokens[:0]
 	l.tokenCount = 0
+	l.templateParenDepths = l.templateParenDepths[:0]
 }
 
 func (l *lexer) Reclaim() {
@@ -395,25 +399,60 @@ func (l *lexer) acceptWhile(f func(rune) bool) {
 	}
 }
 
-func (l *lexer) scanString(quote rune) {
+// scanString scans the remainder of a string literal, up to and including the closing quote.
+// It stops early and returns true if the start of an interpolated expression, \(, is found.
+func (l *lexer) scanString(quote rune) (interpolation bool) {
 	r := l.next()
 	for r != quote {
 		switch r {
 		case '\n', EOF:
 			// NOTE: invalid end of string handled by parser
 			l.backupOne()
-			return
+			return false
 		case '\\':
 			r = l.next()
 			switch r {
 			case '\n', EOF:
 				// NOTE: invalid end of string handled by parser
 				l.backupOne()
-				return
+				return false
+			case '(':
+				return true
 			}
 		}
 		r = l.next()
 	}
+	return false
+}
+
+// startTemplateExpression records that the expression of a string template is being scanned.
+func (l *lexer) startTemplateExpression() {
+	l.templateParenDepths = append(l.templateParenDepths, 0)
+}
+
+// openParen records an opening parenthesis in the innermost string template expression, if any.
+func (l *lexer) openParen() {
+	count := len(l.templateParenDepths)
+	if count == 0 {
+		return
+	}
+	l.templateParenDepths[count-1]++
+}
+
+// closeParen records a closing parenthesis, and returns true if it ends
+// the innermost string template expression.
+func (l *lexer) closeParen() (endsTemplateExpression bool) {
+	count := len(l.templateParenDepths)
+	if count == 0 {
+		return false
+	}
+	lastIndex := count - 1
+	if l.templateParenDepths[lastIndex] == 0 {
+		l.templateParenDepths = l.templateParenDepths[:lastIndex]
+		return true
+	}
+	l.templateParenDepths[lastIndex]--
+	return false
 }
 
 func (l *lexer) scanBinaryRemainder() {
@@ -41,23 +41,41 @@ func rootState(l *lexer) stateFn {
 		case EOF:
 			return nil
 		case '+':
-			l.emitType(TokenPlus)
+			if l.acceptOne('=') {
+				l.emitType(TokenPlusEqual)
+			} else {
+				l.emitType(TokenPlus)
+			}
 		case '-':
 			r = l.next()
 			switch r {
 			case '>':
 				l.emitType(TokenRightArrow)
+			case '=':
+				l.emitType(TokenMinusEqual)
 			default:
 				l.backupOne()
 				l.emitType(TokenMinus)
 			}
 		case '*':
-			l.emitType(TokenStar)
+			if l.acceptOne('=') {
+				l.emitType(TokenStarEqual)
+			} else {
+				l.emitType(TokenStar)
+			}
 		case '%':
-			l.emitType(TokenPercent)
+			if l.acceptOne('=') {
+				l.emitType(TokenPercentEqual)
+			} else {
+				l.emitType(TokenPercent)
+			}
 		case '(':
+			l.openParen()
 			l.emitType(TokenParenOpen)
 		case ')':
+			if l.closeParen() {
+				return stringTemplateRemainderState
+			}
 			l.emitType(TokenParenClose)
 		case '{':
 			l.emitType(TokenBraceOpen)
@@ -86,17 +104,31 @@ func rootState(l *lexer) stateFn {
 		case '#':
 			l.emitType(TokenPragma)
 		case '&':
-			if l.acceptOne('&') {
+			r = l.next()
+			switch r {
+			case '&':
 				l.emitType(TokenAmpersandAmpersand)
-			} else {
+			case '=':
+				l.emitType(TokenAmpersandEqual)
+			default:
+				l.backupOne()
 				l.emitType(TokenAmpersand)
 			}
 		case '^':
-			l.emitType(TokenCaret)
+			if l.acceptOne('=') {
+				l.emitType(TokenCaretEqual)
+			} else {
+				l.emitType(TokenCaret)
+			}
 		case '|':
-			if l.acceptOne('|') {
+			r = l.next()
+			switch r {
+			case '|':
 				l.emitType(TokenVerticalBarVerticalBar)
-			} else {
+			case '=':
+				l.emitType(TokenVerticalBarEqual)
+			default:
+				l.backupOne()
 				l.emitType(TokenVerticalBar)
 			}
 		case '>':
@@ -126,6 +158,15 @@ func rootState(l *lexer) stateFn {
 			case '*':
 				l.emitType(TokenBlockCommentStart)
 				return blockCommentState(0)
+			case '=':
+				l.emitType(TokenSlashEqual)
+			case '\\':
+				// The start of an interpolated path component, `/\(`
+				if !l.acceptOne('(') {
+					return l.error(fmt.Errorf("unrecognized character: %#U", r))
+				}
+				l.openParen()
+				l.emitType(TokenPathInterpolationStart)
 			default:
 				l.backupOne()
 				l.emitType(TokenSlash)
@@ -162,7 +203,11 @@ func rootState(l *lexer) stateFn {
 					l.emitType(TokenLeftArrow)
 				}
 			case '<':
-				l.emitType(TokenLessLess)
+				if l.acceptOne('=') {
+					l.emitType(TokenLessLessEqual)
+				} else {
+					l.emitType(TokenLessLess)
+				}
 			case '=':
 				l.emitType(TokenLessEqual)
 			default:
@@ -300,8 +345,24 @@ func identifierState(l *lexer) stateFn {
 }
 
 func stringState(l *lexer) stateFn {
-	l.scanString('"')
-	l.emitType(TokenString)
+	if l.scanString('"') {
+		l.startTemplateExpression()
+		l.emitType(TokenStringTemplateStart)
+	} else {
+		l.emitType(TokenString)
+	}
+	return rootState
+}
+
+// stringTemplateRemainderState scans the remainder of a string template,
+// after the closing parenthesis of an interpolated expression.
+func stringTemplateRemainderState(l *lexer) stateFn {
+	if l.scanString('"') {
+		l.startTemplateExpression()
+		l.emitType(TokenStringTemplateMiddle)
+	} else {
+		l.emitType(TokenStringTemplateEnd)
+	}
 	return rootState
 }
 
@@ -82,6 +82,19 @@ const (
 	TokenAsExclamationMark
 	TokenAsQuestionMark
 	TokenPragma
+	TokenPlusEqual
+	TokenMinusEqual
+	TokenStarEqual
+	TokenSlashEqual
+	TokenPercentEqual
+	TokenAmpersandEqual
+	TokenVerticalBarEqual
+	TokenCaretEqual
+	TokenLessLessEqual
+	TokenStringTemplateStart
+	TokenStringTemplateMiddle
+	TokenStringTemplateEnd
+	TokenPathInterpolationStart
 	// NOTE: not an actual token, must be last item
 	TokenMax
 )
@@ -205,6 +218,32 @@ func (t TokenType) String() string {
 		return `'as?'`
 	case TokenPragma:
 		return `'#'`
+	case TokenPlusEqual:
+		return `'+='`
+	case TokenMinusEqual:
+		return `'-='`
+	case TokenStarEqual:
+		return `'*='`
+	case TokenSlashEqual:
+		return `'/='`
+	case TokenPercentEqual:
+		return `'%='`
+	case TokenAmpersandEqual:
+		return `'&='`
+	case TokenVerticalBarEqual:
+		return `'|='`
+	case TokenCaretEqual:
+		return `'^='`
+	case TokenLessLessEqual:
+		return `'<<='`
+	case TokenStringTemplateStart:
+		return "string template start"
+	case TokenStringTemplateMiddle:
+		return "string template middle"
+	case TokenStringTemplateEnd:
+		return "string template end"
+	case TokenPathInterpolationStart:
+		return `'/\('`
 	default:
 		panic(errors.NewUnreachableError())
 	}
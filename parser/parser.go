@@ -55,8 +55,12 @@ type Config struct {
 	//
 	// This option exists so the old behaviour can be enabled to allow developers to update their code.
 	IgnoreLeadingIdentifierEnabled bool
-	// TypeParametersEnabled determines if type parameters are enabled
+	// Deprecated: TypeParametersEnabled has no effect.
+	// Type parameters are always enabled.
 	TypeParametersEnabled bool
+	// LooseModeEnabled determines if the type annotations of parameters may be omitted,
+	// e.g. `fun add(a, b) {}`
+	LooseModeEnabled bool
 }
 
 type parser struct {
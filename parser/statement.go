@@ -99,6 +99,8 @@ func parseStatement(p *parser) (ast.Statement, error) {
 			return parseEmitStatement(p)
 		case KeywordRemove:
 			return parseRemoveStatement(p)
+		case KeywordTry:
+			return parseTryStatement(p)
 
 		case KeywordView:
 			// save current stream state before looking ahead for the `fun` keyword
@@ -170,11 +172,102 @@ func parseStatement(p *parser) (ast.Statement, error) {
 
 		return ast.NewSwapStatement(p.memoryGauge, expression, right), nil
 
+	case lexer.TokenPlusEqual,
+		lexer.TokenMinusEqual,
+		lexer.TokenStarEqual,
+		lexer.TokenSlashEqual,
+		lexer.TokenPercentEqual,
+		lexer.TokenAmpersandEqual,
+		lexer.TokenVerticalBarEqual,
+		lexer.TokenCaretEqual,
+		lexer.TokenLessLessEqual:
+
+		operation := compoundAssignmentOperation(p.current.Type)
+		return parseCompoundAssignmentStatement(p, expression, operation)
+
+	case lexer.TokenGreater:
+		// The `>>=` operator consists of a `>` token and a `>=` token,
+		// instead of one dedicated `>>=` token, for the same reason as the `>>` operator,
+		// see defineGreaterThanOrBitwiseRightShiftExpression.
+
+		current := p.current
+		cursor := p.tokens.Cursor()
+
+		// Skip the `>` token
+		p.next()
+
+		if p.current.Is(lexer.TokenGreaterEqual) {
+			return parseCompoundAssignmentStatement(p, expression, ast.OperationBitwiseRightShift)
+		}
+
+		p.current = current
+		p.tokens.Revert(cursor)
+
+		return ast.NewExpressionStatement(p.memoryGauge, expression), nil
+
 	default:
 		return ast.NewExpressionStatement(p.memoryGauge, expression), nil
 	}
 }
 
+func compoundAssignmentOperation(tokenType lexer.TokenType) ast.Operation {
+	switch tokenType {
+	case lexer.TokenPlusEqual:
+		return ast.OperationPlus
+	case lexer.TokenMinusEqual:
+		return ast.OperationMinus
+	case lexer.TokenStarEqual:
+		return ast.OperationMul
+	case lexer.TokenSlashEqual:
+		return ast.OperationDiv
+	case lexer.TokenPercentEqual:
+		return ast.OperationMod
+	case lexer.TokenAmpersandEqual:
+		return ast.OperationBitwiseAnd
+	case lexer.TokenVerticalBarEqual:
+		return ast.OperationBitwiseOr
+	case lexer.TokenCaretEqual:
+		return ast.OperationBitwiseXor
+	case lexer.TokenLessLessEqual:
+		return ast.OperationBitwiseLeftShift
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+// parseCompoundAssignmentStatement parses a compound assignment statement,
+// e.g. `x += 1`, where the current token is the (last) token of the operator.
+func parseCompoundAssignmentStatement(
+	p *parser,
+	target ast.Expression,
+	operation ast.Operation,
+) (*ast.AssignmentStatement, error) {
+
+	// The implicit copy transfer is located at the `=` of the operator,
+	// i.e. the last character of the current token
+	transfer := ast.NewTransfer(
+		p.memoryGauge,
+		ast.TransferOperationCopy,
+		p.current.EndPos,
+	)
+
+	// Skip the operator
+	p.next()
+
+	value, err := parseExpression(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewCompoundAssignmentStatement(
+		p.memoryGauge,
+		target,
+		transfer,
+		operation,
+		value,
+	), nil
+}
+
 func parseFunctionDeclarationOrFunctionExpressionStatement(
 	p *parser,
 	purity ast.FunctionPurity,
@@ -194,18 +287,13 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(
 
 		p.next()
 
-		var typeParameterList *ast.TypeParameterList
-
-		if p.config.TypeParametersEnabled {
-			var err error
-			typeParameterList, err = parseTypeParameterList(p)
-			if err != nil {
-				return nil, err
-			}
+		typeParameterList, err := parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
 		}
 
 		parameterList, returnTypeAnnotation, functionBlock, err :=
-			parseFunctionParameterListAndRest(p, false)
+			parseFunctionParameterListAndRest(p, false, defaultArgumentsAllowed)
 
 		if err != nil {
 			return nil, err
@@ -227,7 +315,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(
 		), nil
 	} else {
 		parameterList, returnTypeAnnotation, functionBlock, err :=
-			parseFunctionParameterListAndRest(p, false)
+			parseFunctionParameterListAndRest(p, false, defaultArgumentsForbidden)
 		if err != nil {
 			return nil, err
 		}
@@ -413,6 +501,52 @@ func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 	return ast.NewWhileStatement(p.memoryGauge, expression, block, startPos), nil
 }
 
+// parseTryStatement parses a try statement, e.g. `try { ... } catch { ... }`
+func parseTryStatement(p *parser) (*ast.TryStatement, error) {
+
+	startPos := p.current.StartPos
+	p.nextSemanticToken()
+
+	block, err := parseBlock(p)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments()
+
+	if !p.current.Is(lexer.TokenIdentifier) {
+		return nil, p.syntaxError(
+			"expected keyword %q, got %s",
+			KeywordCatch,
+			p.current.Type,
+		)
+	}
+
+	keyword := p.currentTokenSource()
+	if string(keyword) != KeywordCatch {
+		return nil, p.syntaxError(
+			"expected keyword %q, got %q",
+			KeywordCatch,
+			keyword,
+		)
+	}
+
+	// Skip the `catch` keyword
+	p.nextSemanticToken()
+
+	catchBlock, err := parseBlock(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewTryStatement(
+		p.memoryGauge,
+		block,
+		catchBlock,
+		startPos,
+	), nil
+}
+
 func parseForStatement(p *parser) (*ast.ForStatement, error) {
 
 	startPos := p.current.StartPos
@@ -426,27 +560,43 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 		p.next()
 	}
 
-	firstValue, err := p.mustIdentifier()
-	if err != nil {
-		return nil, err
-	}
-
-	p.skipSpaceAndComments()
-
 	var index *ast.Identifier
 	var identifier ast.Identifier
+	var pattern *ast.DestructuringPattern
+	var err error
 
-	if p.current.Is(lexer.TokenComma) {
-		p.nextSemanticToken()
-		index = &firstValue
-		identifier, err = p.mustIdentifier()
+	if isDestructuringPatternStart(p.current) {
+		pattern, err = parseDestructuringPattern(p)
 		if err != nil {
 			return nil, err
 		}
 
 		p.skipSpaceAndComments()
 	} else {
-		identifier = firstValue
+		firstValue, err := p.mustIdentifier()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+
+		if p.current.Is(lexer.TokenComma) {
+			p.nextSemanticToken()
+			index = &firstValue
+
+			if isDestructuringPatternStart(p.current) {
+				pattern, err = parseDestructuringPattern(p)
+			} else {
+				identifier, err = p.mustIdentifier()
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			p.skipSpaceAndComments()
+		} else {
+			identifier = firstValue
+		}
 	}
 
 	if !p.isToken(p.current, lexer.TokenIdentifier, KeywordIn) {
@@ -472,6 +622,7 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 	return ast.NewForStatement(
 		p.memoryGauge,
 		identifier,
+		pattern,
 		index,
 		block,
 		expression,
@@ -761,7 +912,7 @@ func parseSwitchCases(p *parser) (cases []*ast.SwitchCase, err error) {
 // parseSwitchCase parses a switch case (hasExpression == true)
 // or default case (hasExpression == false)
 //
-//	switchCase : `case` expression `:` statements
+//	switchCase : `case` ( switchPattern | expression ) ( `where` expression )? `:` statements
 //	           | `default` `:` statements
 func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 
@@ -771,13 +922,33 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 	p.next()
 
 	var expression ast.Expression
+	var pattern *ast.SwitchPattern
+	var guard ast.Expression
 	var err error
 
 	if hasExpression {
-		expression, err = parseExpression(p, lowestBindingPower)
+		p.skipSpaceAndComments()
+
+		if isSwitchPatternStart(p) {
+			pattern, err = parseSwitchPattern(p)
+		} else {
+			expression, err = parseExpression(p, lowestBindingPower)
+		}
 		if err != nil {
 			return nil, err
 		}
+
+		p.skipSpaceAndComments()
+
+		if p.isToken(p.current, lexer.TokenIdentifier, KeywordWhere) {
+			// Skip the `where` keyword
+			p.next()
+
+			guard, err = parseExpression(p, lowestBindingPower)
+			if err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		p.skipSpaceAndComments()
 	}
@@ -824,6 +995,8 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 
 	return &ast.SwitchCase{
 		Expression: expression,
+		Pattern:    pattern,
+		Guard:      guard,
 		Statements: statements,
 		Range: ast.NewRange(
 			p.memoryGauge,
@@ -833,6 +1006,105 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 	}, nil
 }
 
+func isSwitchPatternStart(p *parser) bool {
+	return p.isToken(p.current, lexer.TokenIdentifier, KeywordLet) ||
+		p.isToken(p.current, lexer.TokenIdentifier, KeywordIn)
+}
+
+// parseSwitchPattern parses a pattern of a switch case.
+//
+//	switchPattern : `let` identifier `as` typeAnnotation
+//	              | `let` identifier `?`
+//	              | `in` expression
+func parseSwitchPattern(p *parser) (*ast.SwitchPattern, error) {
+
+	startPos := p.current.StartPos
+
+	if p.isToken(p.current, lexer.TokenIdentifier, KeywordIn) {
+		// Skip the `in` keyword
+		p.next()
+
+		expression, err := parseExpression(p, lowestBindingPower)
+		if err != nil {
+			return nil, err
+		}
+
+		return ast.NewSwitchPattern(
+			p.memoryGauge,
+			ast.SwitchPatternKindRange,
+			ast.Identifier{},
+			nil,
+			expression,
+			ast.NewRange(
+				p.memoryGauge,
+				startPos,
+				expression.EndPosition(p.memoryGauge),
+			),
+		), nil
+	}
+
+	// Skip the `let` keyword
+	p.nextSemanticToken()
+
+	identifier, err := p.mustIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments()
+
+	switch {
+	case p.current.Is(lexer.TokenQuestionMark):
+		endPos := p.current.EndPos
+
+		// Skip the `?`
+		p.next()
+
+		return ast.NewSwitchPattern(
+			p.memoryGauge,
+			ast.SwitchPatternKindOptional,
+			identifier,
+			nil,
+			nil,
+			ast.NewRange(
+				p.memoryGauge,
+				startPos,
+				endPos,
+			),
+		), nil
+
+	case p.isToken(p.current, lexer.TokenIdentifier, KeywordAs):
+		// Skip the `as` keyword
+		p.nextSemanticToken()
+
+		typeAnnotation, err := parseTypeAnnotation(p)
+		if err != nil {
+			return nil, err
+		}
+
+		return ast.NewSwitchPattern(
+			p.memoryGauge,
+			ast.SwitchPatternKindType,
+			identifier,
+			typeAnnotation,
+			nil,
+			ast.NewRange(
+				p.memoryGauge,
+				startPos,
+				typeAnnotation.EndPosition(p.memoryGauge),
+			),
+		), nil
+
+	default:
+		return nil, p.syntaxError(
+			"expected %q or %s in switch case pattern, got %s",
+			KeywordAs,
+			lexer.TokenQuestionMark,
+			p.current.Type,
+		)
+	}
+}
+
 func parseRemoveStatement(
 	p *parser,
 ) (*ast.RemoveStatement, error) {
@@ -612,6 +612,123 @@ func TestParseWhileStatement(t *testing.T) {
 	})
 }
 
+func TestParseTryStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("empty blocks", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("try { } catch { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TryStatement{
+					Block: &ast.Block{
+						Statements: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+					},
+					CatchBlock: &ast.Block{
+						Statements: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("statements", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("try { x } catch { y }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TryStatement{
+					Block: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.ExpressionStatement{
+								Expression: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "x",
+										Pos:        ast.Position{Line: 1, Column: 6, Offset: 6},
+									},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					CatchBlock: &ast.Block{
+						Statements: []ast.Statement{
+							&ast.ExpressionStatement{
+								Expression: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "y",
+										Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+									},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+							EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing catch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("try { }")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"catch\", got EOF",
+					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("invalid catch keyword", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("try { } else { }")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"catch\", got \"else\"",
+					Pos:     ast.Position{Offset: 8, Line: 1, Column: 8},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseAssignmentStatement(t *testing.T) {
 
 	t.Parallel()
@@ -757,6 +874,135 @@ func TestParseAssignmentStatement(t *testing.T) {
 	})
 }
 
+func TestParseCompoundAssignmentStatement(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(operator string, operation ast.Operation) {
+
+		t.Run(operator, func(t *testing.T) {
+
+			t.Parallel()
+
+			code := fmt.Sprintf(" x %s 1", operator)
+
+			result, errs := testParseStatements(code)
+			require.Empty(t, errs)
+
+			operatorLength := len(operator)
+			transferOffset := 3 + operatorLength - 1
+			valueOffset := 3 + operatorLength + 1
+
+			utils.AssertEqualWithDiff(t,
+				[]ast.Statement{
+					&ast.AssignmentStatement{
+						Target: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "x",
+								Pos:        ast.Position{Line: 1, Column: 1, Offset: 1},
+							},
+						},
+						Transfer: &ast.Transfer{
+							Operation: ast.TransferOperationCopy,
+							Pos: ast.Position{
+								Line:   1,
+								Column: transferOffset,
+								Offset: transferOffset,
+							},
+						},
+						Operation: operation,
+						Value: &ast.IntegerExpression{
+							PositiveLiteral: []byte("1"),
+							Value:           big.NewInt(1),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: valueOffset, Offset: valueOffset},
+								EndPos:   ast.Position{Line: 1, Column: valueOffset, Offset: valueOffset},
+							},
+						},
+					},
+				},
+				result,
+			)
+		})
+	}
+
+	for operator, operation := range map[string]ast.Operation{
+		"+=":  ast.OperationPlus,
+		"-=":  ast.OperationMinus,
+		"*=":  ast.OperationMul,
+		"/=":  ast.OperationDiv,
+		"%=":  ast.OperationMod,
+		"&=":  ast.OperationBitwiseAnd,
+		"|=":  ast.OperationBitwiseOr,
+		"^=":  ast.OperationBitwiseXor,
+		"<<=": ast.OperationBitwiseLeftShift,
+		">>=": ast.OperationBitwiseRightShift,
+	} {
+		test(operator, operation)
+	}
+
+	t.Run("member and index target", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("a.b[c]-=d")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.AssignmentStatement{
+					Target: &ast.IndexExpression{
+						TargetExpression: &ast.MemberExpression{
+							Expression: &ast.IdentifierExpression{
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+								},
+							},
+							AccessPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+							Identifier: ast.Identifier{
+								Identifier: "b",
+								Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+							},
+						},
+						IndexingExpression: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "c",
+								Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+							EndPos:   ast.Position{Line: 1, Column: 5, Offset: 5},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 7, Offset: 7},
+					},
+					Operation: ast.OperationMinus,
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "d",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("right shift, separated", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("x > >= 1")
+		require.NotEmpty(t, errs)
+	})
+}
+
 func TestParseSwapStatement(t *testing.T) {
 
 	t.Parallel()
@@ -829,6 +1075,115 @@ func TestParseForStatement(t *testing.T) {
 	})
 }
 
+func TestParseForStatementDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("for [a, b] in y { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Pattern: &ast.DestructuringPattern{
+						Kind: ast.DestructuringPatternKindArray,
+						Elements: []*ast.DestructuringElement{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+							},
+							{
+								Identifier: ast.Identifier{
+									Identifier: "b",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "y",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					Block: &ast.Block{
+						Statements: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+							EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("index and composite pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("for i, (x) in y { }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ForStatement{
+					Index: &ast.Identifier{
+						Identifier: "i",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+					Pattern: &ast.DestructuringPattern{
+						Kind: ast.DestructuringPatternKindComposite,
+						Elements: []*ast.DestructuringElement{
+							{
+								Field: &ast.Identifier{
+									Identifier: "x",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+								Identifier: ast.Identifier{
+									Identifier: "x",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "y",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					Block: &ast.Block{
+						Statements: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+							EndPos:   ast.Position{Line: 1, Column: 18, Offset: 18},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+}
+
 func TestParseForStatementIndexBinding(t *testing.T) {
 
 	t.Parallel()
@@ -1508,6 +1863,217 @@ func TestParseSwitchStatement(t *testing.T) {
 			errs[0].Error(),
 		)
 	})
+
+	t.Run("type pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("switch x { case let v as @R: a }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.SwitchPattern{
+								Kind: ast.SwitchPatternKindType,
+								Identifier: ast.Identifier{
+									Identifier: "v",
+									Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: true,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "R",
+											Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 25, Offset: 25},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+									EndPos:   ast.Position{Line: 1, Column: 26, Offset: 26},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 29, Offset: 29},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 29, Offset: 29},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 31, Offset: 31},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("optional pattern with guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("switch x { case let y? where y > 1: a }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.SwitchPattern{
+								Kind: ast.SwitchPatternKindOptional,
+								Identifier: ast.Identifier{
+									Identifier: "y",
+									Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+									EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+								},
+							},
+							Guard: &ast.BinaryExpression{
+								Operation: ast.OperationGreater,
+								Left: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "y",
+										Pos:        ast.Position{Line: 1, Column: 29, Offset: 29},
+									},
+								},
+								Right: &ast.IntegerExpression{
+									PositiveLiteral: []byte("1"),
+									Value:           big.NewInt(1),
+									Base:            10,
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 33, Offset: 33},
+										EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+									},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 36, Offset: 36},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 36, Offset: 36},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 38, Offset: 38},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("range pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements("switch x { case in r: a }")
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.SwitchPattern{
+								Kind: ast.SwitchPatternKindRange,
+								Expression: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "r",
+										Pos:        ast.Position{Line: 1, Column: 19, Offset: 19},
+									},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+									EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 22, Offset: 22},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid binding pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := testParseStatements("switch x { case let y: a }")
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: `expected "as" or '?' in switch case pattern, got ':'`,
+					Pos:     ast.Position{Offset: 21, Line: 1, Column: 21},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseIfStatementInFunctionDeclaration(t *testing.T) {
@@ -783,6 +783,28 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	}
 
 	p.skipSpaceAndComments()
+
+	// Parse optional type arguments, e.g. `create R<Int>()`.
+	// Unlike in a general invocation expression, there is no ambiguity with a less-than expression
+
+	var typeArguments []*ast.TypeAnnotation
+	if p.current.Is(lexer.TokenLess) {
+		// Skip the `<` token
+		p.nextSemanticToken()
+
+		typeArguments, err = parseCommaSeparatedTypeAnnotations(p, lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.mustOne(lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments()
+	}
+
 	parenOpenToken, err := p.mustOne(lexer.TokenParenOpen)
 	if err != nil {
 		return nil, err
@@ -812,7 +834,7 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	return ast.NewInvocationExpression(
 		p.memoryGauge,
 		invokedExpression,
-		nil,
+		typeArguments,
 		arguments,
 		argumentsStartPos,
 		endPos,
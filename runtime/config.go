@@ -25,6 +25,8 @@ import (
 // Config is a constant/read-only configuration of an environment.
 type Config struct {
 	Debugger *interpreter.Debugger
+	// Profiler collects computation and memory usage profiles
+	Profiler *interpreter.Profiler
 	// StackDepthLimit specifies the maximum depth for call stacks
 	StackDepthLimit uint64
 	// AtreeValidationEnabled configures if atree validation is enabled
@@ -41,4 +43,7 @@ type Config struct {
 	LegacyContractUpgradeEnabled bool
 	// ContractUpdateTypeRemovalEnabled specifies if type removal is enabled in contract updates
 	ContractUpdateTypeRemovalEnabled bool
+	// ExclusiveBorrowMode configures how overlapping mutable borrows of stored resources are handled.
+	// In the warning mode, overlapping borrows are reported through Interface.OverlappingBorrow
+	ExclusiveBorrowMode interpreter.ExclusiveBorrowMode
 }
@@ -130,6 +130,10 @@ func ExportMeteredType(
 			return cadence.BoolType
 		case sema.CharacterType:
 			return cadence.CharacterType
+		case sema.TimestampType:
+			return cadence.TimestampType
+		case sema.DurationType:
+			return cadence.DurationType
 		case sema.AnyType:
 			return cadence.AnyType
 		case sema.AnyStructType:
@@ -260,6 +264,8 @@ func ExportMeteredType(
 			return exportCapabilityType(gauge, t, results)
 		case *sema.InclusiveRangeType:
 			return exportInclusiveRangeType(gauge, t, results)
+		case *sema.SetType:
+			return exportSetType(gauge, t, results)
 		}
 
 		panic(fmt.Sprintf("cannot export type %s", t))
@@ -301,10 +307,14 @@ func exportCompositeType(
 	results map[sema.TypeID]cadence.Type,
 ) (result cadence.CompositeType) {
 
+	// NOTE: Get the members before the fields,
+	// as the members and fields of instances of generic composite types are initialized lazily
+	members := t.MemberMap()
+
 	fieldMembers := make([]*sema.Member, 0, len(t.Fields))
 
 	for _, identifier := range t.Fields {
-		member, ok := t.Members.Get(identifier)
+		member, ok := members.Get(identifier)
 
 		if !ok {
 			panic(errors.NewUnreachableError())
@@ -319,12 +329,14 @@ func exportCompositeType(
 
 	fields := make([]cadence.Field, len(fieldMembers))
 
+	qualifiedIdentifier := exportCompositeQualifiedIdentifier(t)
+
 	switch t.Kind {
 	case common.CompositeKindStructure:
 		result = cadence.NewMeteredStructType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			qualifiedIdentifier,
 			fields,
 			nil,
 		)
@@ -333,7 +345,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredResourceType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			qualifiedIdentifier,
 			fields,
 			nil,
 		)
@@ -342,7 +354,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredAttachmentType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			qualifiedIdentifier,
 			ExportMeteredType(gauge, t.GetBaseType(), results),
 			fields,
 			nil,
@@ -352,7 +364,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredEventType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			qualifiedIdentifier,
 			fields,
 			nil,
 		)
@@ -361,7 +373,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredContractType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			qualifiedIdentifier,
 			fields,
 			nil,
 		)
@@ -370,7 +382,7 @@ func exportCompositeType(
 		result = cadence.NewMeteredEnumType(
 			gauge,
 			t.Location,
-			t.QualifiedIdentifier(),
+			qualifiedIdentifier,
 			ExportMeteredType(gauge, t.EnumRawType, results),
 			fields,
 			nil,
@@ -396,6 +408,25 @@ func exportCompositeType(
 	return
 }
 
+// exportCompositeQualifiedIdentifier returns the qualified identifier of the given composite type.
+// For instances of generic composite types, the type arguments are included, e.g. `Box<Int>`,
+// so that the type ID of the exported type is the type ID of the instance.
+func exportCompositeQualifiedIdentifier(t *sema.CompositeType) string {
+	qualifiedIdentifier := t.QualifiedIdentifier()
+
+	typeArguments := t.TypeArguments()
+	if len(typeArguments) == 0 {
+		return qualifiedIdentifier
+	}
+
+	typeArgumentIDs := make([]string, len(typeArguments))
+	for i, typeArgument := range typeArguments {
+		typeArgumentIDs[i] = string(typeArgument.ID())
+	}
+
+	return sema.FormatCompositeInstanceTypeID(qualifiedIdentifier, typeArgumentIDs)
+}
+
 func exportInterfaceType(
 	gauge common.MemoryGauge,
 	t *sema.InterfaceType,
@@ -496,6 +527,19 @@ func exportInclusiveRangeType(
 	)
 }
 
+func exportSetType(
+	gauge common.MemoryGauge,
+	t *sema.SetType,
+	results map[sema.TypeID]cadence.Type,
+) *cadence.SetType {
+	convertedElementType := ExportMeteredType(gauge, t.ElementType, results)
+
+	return cadence.NewMeteredSetType(
+		gauge,
+		convertedElementType,
+	)
+}
+
 func exportFunctionType(
 	gauge common.MemoryGauge,
 	t *sema.FunctionType,
@@ -731,6 +775,11 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 			memoryGauge,
 			ImportType(memoryGauge, t.ElementType),
 		)
+	case *cadence.SetType:
+		return interpreter.NewSetStaticType(
+			memoryGauge,
+			ImportType(memoryGauge, t.ElementType),
+		)
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
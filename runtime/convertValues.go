@@ -203,6 +203,10 @@ func exportValueWithInterpreter(
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v), nil
+	case interpreter.TimestampValue:
+		return cadence.Timestamp(v), nil
+	case interpreter.DurationValue:
+		return cadence.Duration(v), nil
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(
 			v,
@@ -224,6 +228,13 @@ func exportValueWithInterpreter(
 			locationRange,
 			seenReferences,
 		)
+	case *interpreter.SetValue:
+		return exportSetValue(
+			v,
+			inter,
+			locationRange,
+			seenReferences,
+		)
 	case interpreter.AddressValue:
 		return cadence.NewMeteredAddress(inter, v), nil
 	case interpreter.PathValue:
@@ -618,6 +629,60 @@ func exportDictionaryValue(
 	return dictionary.WithType(exportType), err
 }
 
+func exportSetValue(
+	v *interpreter.SetValue,
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	seenReferences seenReferences,
+) (
+	cadence.Set,
+	error,
+) {
+	set, err := cadence.NewMeteredSet(
+		inter,
+		v.Count(),
+		func() ([]cadence.Value, error) {
+			var err error
+			values := make([]cadence.Value, 0, v.Count())
+
+			v.Iterate(
+				inter,
+				locationRange,
+				func(element interpreter.Value) (resume bool) {
+
+					var convertedElement cadence.Value
+					convertedElement, err = exportValueWithInterpreter(
+						element,
+						inter,
+						locationRange,
+						seenReferences,
+					)
+					if err != nil {
+						return false
+					}
+
+					values = append(values, convertedElement)
+
+					return true
+				},
+			)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return values, nil
+		},
+	)
+	if err != nil {
+		return cadence.Set{}, err
+	}
+
+	exportType := exportSetType(inter, v.SemaType(inter), map[sema.TypeID]cadence.Type{})
+
+	return set.WithType(exportType), err
+}
+
 func exportCompositeValueAsInclusiveRange(
 	v interpreter.Value,
 	inclusiveRangeType *sema.InclusiveRangeType,
@@ -676,11 +741,16 @@ func exportCompositeValueAsInclusiveRange(
 }
 
 func exportPathValue(gauge common.MemoryGauge, v interpreter.PathValue) (cadence.Path, error) {
-	return cadence.NewMeteredPath(
+	path, err := cadence.NewMeteredPath(
 		gauge,
 		v.Domain,
 		v.Identifier,
 	)
+	if err != nil {
+		return cadence.Path{}, err
+	}
+	path.Components = v.Components
+	return path, nil
 }
 
 func exportTypeValue(v interpreter.TypeValue, inter *interpreter.Interpreter) cadence.TypeValue {
@@ -875,12 +945,18 @@ func (i valueImporter) importValue(value cadence.Value, expectedType sema.Type)
 		return i.importFix64(v), nil
 	case cadence.UFix64:
 		return i.importUFix64(v), nil
+	case cadence.Timestamp:
+		return i.importTimestamp(v), nil
+	case cadence.Duration:
+		return i.importDuration(v), nil
 	case cadence.Path:
 		return i.importPathValue(v), nil
 	case cadence.Array:
 		return i.importArrayValue(v, expectedType)
 	case cadence.Dictionary:
 		return i.importDictionaryValue(v, expectedType)
+	case cadence.Set:
+		return i.importSetValue(v, expectedType)
 	case cadence.Struct:
 		return i.importCompositeValue(
 			common.CompositeKindStructure,
@@ -1139,6 +1215,24 @@ func (i valueImporter) importUFix64(v cadence.UFix64) interpreter.UFix64Value {
 	)
 }
 
+func (i valueImporter) importTimestamp(v cadence.Timestamp) interpreter.TimestampValue {
+	return interpreter.NewTimestampValue(
+		i.inter,
+		func() int64 {
+			return int64(v)
+		},
+	)
+}
+
+func (i valueImporter) importDuration(v cadence.Duration) interpreter.DurationValue {
+	return interpreter.NewDurationValue(
+		i.inter,
+		func() int64 {
+			return int64(v)
+		},
+	)
+}
+
 func (i valueImporter) importString(v cadence.String) *interpreter.StringValue {
 	memoryUsage := common.NewStringMemoryUsage(len(v))
 	return interpreter.NewStringValue(
@@ -1172,13 +1266,14 @@ func (i valueImporter) importAddress(v cadence.Address) interpreter.AddressValue
 func (i valueImporter) importPathValue(v cadence.Path) interpreter.PathValue {
 	inter := i.inter
 
-	// meter the Path's Identifier since path is just a container
-	common.UseMemory(inter, common.NewRawStringMemoryUsage(len(v.Identifier)))
+	// meter the Path's Identifier and Components since path is just a container
+	common.UseMemory(inter, common.NewRawStringMemoryUsage(len(v.Identifier)+len(v.Components.Encoded())))
 
-	return interpreter.NewPathValue(
+	return interpreter.NewExtendedPathValue(
 		inter,
 		v.Domain,
 		v.Identifier,
+		v.Components,
 	)
 }
 
@@ -1405,6 +1500,72 @@ func (i valueImporter) importDictionaryValue(
 	), nil
 }
 
+func (i valueImporter) importSetValue(
+	v cadence.Set,
+	expectedType sema.Type,
+) (
+	*interpreter.SetValue,
+	error,
+) {
+	values := make([]interpreter.Value, len(v.Values))
+
+	var elementType sema.Type
+	setType, ok := expectedType.(*sema.SetType)
+	if ok {
+		elementType = setType.ElementType
+	}
+
+	inter := i.inter
+	locationRange := i.locationRange
+
+	for elementIndex, element := range v.Values {
+		value, err := i.importValue(
+			element,
+			elementType,
+		)
+		if err != nil {
+			return nil, err
+		}
+		values[elementIndex] = value
+	}
+
+	var staticSetType *interpreter.SetStaticType
+	if setType != nil {
+		staticSetType = interpreter.ConvertSemaToStaticType(inter, setType).(*interpreter.SetStaticType)
+	} else {
+		types := make([]sema.Type, len(v.Values))
+
+		for i, value := range values {
+			typ, err := inter.ConvertStaticToSemaType(value.StaticType(inter))
+			if err != nil {
+				return nil, err
+			}
+			types[i] = typ
+		}
+
+		elementSuperType := sema.LeastCommonSuperType(types...)
+
+		if !sema.IsSubType(elementSuperType, sema.HashableStructType) {
+			return nil, errors.NewDefaultUserError(
+				"cannot import set: elements do not belong to the same hashable type",
+			)
+		}
+
+		staticSetType = interpreter.NewSetStaticType(
+			inter,
+			interpreter.ConvertSemaToStaticType(inter, elementSuperType),
+		)
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		locationRange,
+		staticSetType,
+		common.ZeroAddress,
+		values...,
+	), nil
+}
+
 func (i valueImporter) importInclusiveRangeValue(
 	v *cadence.InclusiveRange,
 	expectedType sema.Type,
@@ -232,6 +232,15 @@ func (EmptyRuntimeInterface) ResourceOwnerChanged(
 	panic("unexpected call to ResourceOwnerChanged")
 }
 
+func (EmptyRuntimeInterface) OverlappingBorrow(
+	_ *interpreter.Interpreter,
+	_ interpreter.LocationRange,
+	_ common.Address,
+	_ interpreter.PathValue,
+) {
+	panic("unexpected call to OverlappingBorrow")
+}
+
 func (EmptyRuntimeInterface) GenerateAccountID(_ common.Address) (uint64, error) {
 	panic("unexpected call to GenerateAccountID")
 }
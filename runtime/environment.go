@@ -187,6 +187,7 @@ func (e *interpreterEnvironment) newInterpreterConfig() *interpreter.Config {
 		// see interpreterEnvironment.CommitStorage
 		AtreeStorageValidationEnabled:             false,
 		Debugger:                                  e.config.Debugger,
+		Profiler:                                  e.config.Profiler,
 		OnStatement:                               e.newOnStatementHandler(),
 		OnMeterComputation:                        e.newOnMeterComputation(),
 		OnFunctionInvocation:                      e.newOnFunctionInvocationHandler(),
@@ -197,6 +198,8 @@ func (e *interpreterEnvironment) newInterpreterConfig() *interpreter.Config {
 		ContractUpdateTypeRemovalEnabled:          e.config.ContractUpdateTypeRemovalEnabled,
 		ValidateAccountCapabilitiesGetHandler:     e.newValidateAccountCapabilitiesGetHandler(),
 		ValidateAccountCapabilitiesPublishHandler: e.newValidateAccountCapabilitiesPublishHandler(),
+		ExclusiveBorrowMode:                       e.config.ExclusiveBorrowMode,
+		OnOverlappingBorrow:                       e.newOverlappingBorrowHandler(),
 	}
 }
 
@@ -208,6 +211,7 @@ func (e *interpreterEnvironment) newCheckerConfig() *sema.Config {
 		ValidTopLevelDeclarationsHandler: validTopLevelDeclarations,
 		LocationHandler:                  e.newLocationHandler(),
 		ImportHandler:                    e.resolveImport,
+		ImportHashHandler:                e.getImportHash,
 		CheckHandler:                     e.newCheckHandler(),
 		AttachmentsEnabled:               e.config.AttachmentsEnabled,
 	}
@@ -641,6 +645,10 @@ func (e *interpreterEnvironment) check(
 		return nil, err
 	}
 
+	// Pass the warnings on to the embedder,
+	// e.g. through the program returned by Runtime.ParseAndCheckProgram
+	elaboration.Warnings = checker.Warnings()
+
 	return elaboration, nil
 }
 
@@ -711,6 +719,30 @@ func (e *interpreterEnvironment) resolveImport(
 	}, nil
 }
 
+// getImportHash returns the hash of the code of the given imported location.
+// It is used to verify imports which are pinned to a specific hash.
+func (e *interpreterEnvironment) getImportHash(
+	_ *sema.Checker,
+	importedLocation common.Location,
+) (
+	hash []byte,
+	err error,
+) {
+	code, err := e.getCode(importedLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	errors.WrapPanic(func() {
+		hash, err = e.runtimeInterface.Hash(code, "", sema.HashAlgorithmSHA3_256)
+	})
+	if err != nil {
+		err = interpreter.WrappedExternalError(err)
+	}
+
+	return
+}
+
 func (e *interpreterEnvironment) GetProgram(
 	location Location,
 	storeProgram bool,
@@ -1278,6 +1310,28 @@ func (e *interpreterEnvironment) newResourceOwnerChangedHandler() interpreter.On
 	}
 }
 
+func (e *interpreterEnvironment) newOverlappingBorrowHandler() interpreter.OnOverlappingBorrowFunc {
+	if e.config.ExclusiveBorrowMode != interpreter.ExclusiveBorrowModeWarn {
+		return nil
+	}
+
+	return func(
+		interpreter *interpreter.Interpreter,
+		locationRange interpreter.LocationRange,
+		address common.Address,
+		path interpreter.PathValue,
+	) {
+		errors.WrapPanic(func() {
+			e.runtimeInterface.OverlappingBorrow(
+				interpreter,
+				locationRange,
+				address,
+				path,
+			)
+		})
+	}
+}
+
 func (e *interpreterEnvironment) CommitStorage(inter *interpreter.Interpreter) error {
 	const commitContractUpdates = true
 	err := e.storage.Commit(inter, commitContractUpdates)
@@ -87,10 +87,14 @@ func EmitEventFields(
 		panic(err)
 	}
 
-	errors.WrapPanic(func() {
-		err = emitEvent(exportedEvent)
+	// Events emitted in a try block are only emitted if the block succeeds
+	inter.WhenCommitted(func() {
+		var err error
+		errors.WrapPanic(func() {
+			err = emitEvent(exportedEvent)
+		})
+		if err != nil {
+			panic(interpreter.WrappedExternalError(err))
+		}
 	})
-	if err != nil {
-		panic(interpreter.WrappedExternalError(err))
-	}
 }
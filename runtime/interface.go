@@ -143,6 +143,14 @@ type Interface interface {
 		oldOwner common.Address,
 		newOwner common.Address,
 	)
+	// OverlappingBorrow gets called when a mutable borrow of a stored resource
+	// overlaps with an active mutable borrow of the same resource (if enabled)
+	OverlappingBorrow(
+		interpreter *interpreter.Interpreter,
+		locationRange interpreter.LocationRange,
+		address common.Address,
+		path interpreter.PathValue,
+	)
 	// GenerateAccountID generates a new, *non-zero*, unique ID for the given account.
 	GenerateAccountID(address common.Address) (uint64, error)
 	RecoverProgram(program *ast.Program, location common.Location) ([]byte, error)
@@ -152,6 +152,11 @@ func pathLiteralValue(
 		return nil, LiteralExpressionTypeError
 	}
 
+	// The components of extended paths are expressions, not literals
+	if len(pathExpression.Components) > 0 {
+		return nil, InvalidLiteralError
+	}
+
 	pathDomain := pathExpression.Domain.Identifier
 	pathIdentifier := pathExpression.Identifier.Identifier
 
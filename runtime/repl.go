@@ -41,13 +41,24 @@ type REPL struct {
 	checker          *sema.Checker
 	inter            *interpreter.Interpreter
 	OnError          func(err error, location Location, codes map[Location][]byte)
+	OnWarning        func(warning error, location Location, codes map[Location][]byte)
 	OnExpressionType func(sema.Type)
 	OnResult         func(interpreter.Value)
 	codes            map[Location][]byte
 	parserConfig     parser.Config
 }
 
+type REPLConfig struct {
+	// LooseModeEnabled determines if the loose mode is enabled,
+	// which allows quick scripts without full type annotations
+	LooseModeEnabled bool
+}
+
 func NewREPL() (*REPL, error) {
+	return NewREPLWithConfig(REPLConfig{})
+}
+
+func NewREPLWithConfig(config REPLConfig) (*REPL, error) {
 
 	// Prepare checkers
 
@@ -59,6 +70,7 @@ func NewREPL() (*REPL, error) {
 
 	checkerConfig := cmd.DefaultCheckerConfig(checkers, codes, standardLibraryValues)
 	checkerConfig.AccessCheckMode = sema.AccessCheckModeNotSpecifiedUnrestricted
+	checkerConfig.LooseModeEnabled = config.LooseModeEnabled
 
 	checker, err := sema.NewChecker(
 		nil,
@@ -109,6 +121,9 @@ func NewREPL() (*REPL, error) {
 		checker: checker,
 		inter:   inter,
 		codes:   codes,
+		parserConfig: parser.Config{
+			LooseModeEnabled: config.LooseModeEnabled,
+		},
 	}
 	return repl, nil
 }
@@ -121,6 +136,14 @@ func (r *REPL) onError(err error, location common.Location, codes map[Location][
 	onError(err, location, codes)
 }
 
+func (r *REPL) onWarning(warning error, location common.Location, codes map[Location][]byte) {
+	onWarning := r.OnWarning
+	if onWarning == nil {
+		return
+	}
+	onWarning(warning, location, codes)
+}
+
 func (r *REPL) onExpressionType(expressionType sema.Type) {
 	onExpressionType := r.OnExpressionType
 	if onExpressionType == nil {
@@ -130,6 +153,11 @@ func (r *REPL) onExpressionType(expressionType sema.Type) {
 }
 
 func (r *REPL) handleCheckerError() error {
+	for _, warning := range r.checker.Warnings() {
+		r.onWarning(warning, r.checker.Location, r.codes)
+	}
+	r.checker.ResetWarnings()
+
 	err := r.checker.CheckerError()
 	if err == nil {
 		return nil
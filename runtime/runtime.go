@@ -167,6 +167,7 @@ var validTopLevelDeclarationsInTransaction = common.NewDeclarationKindSet(
 	common.DeclarationKindImport,
 	common.DeclarationKindFunction,
 	common.DeclarationKindTransaction,
+	common.DeclarationKindTypeAlias,
 )
 
 var validTopLevelDeclarationsInAccountCode = common.NewDeclarationKindSet(
@@ -611,9 +612,8 @@ func (r *interpreterRuntime) ReadStored(
 	pathValue := valueImporter{inter: inter}.importPathValue(path)
 
 	domain := pathValue.Domain.Identifier()
-	identifier := pathValue.Identifier
 
-	storageMapKey := interpreter.StringStorageMapKey(identifier)
+	storageMapKey := pathValue.StorageMapKey()
 
 	value := inter.ReadStored(address, domain, storageMapKey)
 
@@ -25,13 +25,19 @@ import (
 )
 
 func (checker *Checker) VisitAssignmentStatement(assignment *ast.AssignmentStatement) (_ struct{}) {
-	targetType, valueType := checker.checkAssignment(
-		assignment,
-		assignment.Target,
-		assignment.Value,
-		assignment.Transfer,
-		false,
-	)
+	var targetType, valueType Type
+
+	if assignment.IsCompound() {
+		targetType, valueType = checker.checkCompoundAssignment(assignment)
+	} else {
+		targetType, valueType = checker.checkAssignment(
+			assignment,
+			assignment.Target,
+			assignment.Value,
+			assignment.Transfer,
+			false,
+		)
+	}
 
 	checker.Elaboration.SetAssignmentStatementTypes(
 		assignment,
@@ -134,6 +140,83 @@ func (checker *Checker) checkAssignment(
 	return
 }
 
+// checkCompoundAssignment checks a compound assignment, e.g. `x += 1`,
+// using the same rules as for the binary operation, e.g. `x + 1`.
+func (checker *Checker) checkCompoundAssignment(
+	assignment *ast.AssignmentStatement,
+) (targetType, valueType Type) {
+
+	operation := assignment.Operation
+	operationKind := binaryOperationKind(operation)
+
+	switch operationKind {
+	case BinaryOperationKindArithmetic,
+		BinaryOperationKindBitwise:
+		break
+
+	default:
+		panic(&unsupportedOperation{
+			kind:      common.OperationKindBinary,
+			operation: operation,
+			Range:     ast.NewRangeFromPositioned(checker.memoryGauge, assignment),
+		})
+	}
+
+	target := assignment.Target
+	value := assignment.Value
+
+	targetType = checker.visitAssignmentValueType(target)
+
+	// Like for the binary operation, expect the right side to have the type of the left side,
+	// but do not check for compatibility, as it is checked for the operands below
+
+	expectedValueType := targetType
+	if rightExpectedType := timeArithmeticRightExpectedType(operation, targetType); rightExpectedType != nil {
+		expectedValueType = rightExpectedType
+	}
+
+	valueType = checker.VisitExpressionWithForceType(
+		value,
+		assignment,
+		expectedValueType,
+		false,
+	)
+
+	targetIsInvalid := targetType.IsInvalidType()
+	valueIsInvalid := valueType.IsInvalidType()
+	anyInvalid := targetIsInvalid || valueIsInvalid
+
+	// The result type of an arithmetic or bitwise operation is usually the type of the left operand,
+	// i.e. the target type. However, e.g. subtracting timestamps results in a duration
+
+	resultType := checker.checkBinaryExpressionArithmeticOrBitwise(
+		assignment, target, value,
+		operation, operationKind,
+		targetType, valueType,
+		targetIsInvalid, valueIsInvalid, anyInvalid,
+	)
+
+	if !anyInvalid &&
+		!resultType.IsInvalidType() &&
+		!IsSubType(resultType, targetType) {
+
+		checker.report(
+			&TypeMismatchError{
+				ExpectedType: targetType,
+				ActualType:   resultType,
+				Expression:   value,
+				Range:        ast.NewRangeFromPositioned(checker.memoryGauge, assignment),
+			},
+		)
+	}
+
+	checker.checkTransfer(assignment.Transfer, targetType)
+
+	checker.enforceViewAssignment(assignment, target)
+
+	return
+}
+
 func (checker *Checker) rootOfAccessChain(target ast.Expression) (baseVariable *Variable, accessChain []Type) {
 	var inAccessChain = true
 
@@ -128,6 +128,10 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 			expectedType = leftType
 		}
 
+		if rightExpectedType := timeArithmeticRightExpectedType(operation, leftType); rightExpectedType != nil {
+			expectedType = rightExpectedType
+		}
+
 		rightType = checker.VisitExpressionWithForceType(
 			expression.Right,
 			expression,
@@ -139,12 +143,26 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 
 		anyInvalid := leftIsInvalid || rightIsInvalid
 
+		if checker.Config.LooseModeEnabled &&
+			!anyInvalid &&
+			operationKind != BinaryOperationKindEquality {
+
+			var deferred bool
+			resultType, deferred = checker.deferBinaryOperationCheck(expression, operationKind, leftType, rightType)
+			if deferred {
+				return resultType
+			}
+
+			leftType, rightType = checker.deferBinaryOperandTypeCheck(expression, leftType, rightType)
+		}
+
 		switch operationKind {
 		case BinaryOperationKindArithmetic,
 			BinaryOperationKindBitwise:
 
 			resultType = checker.checkBinaryExpressionArithmeticOrBitwise(
-				expression, operation, operationKind,
+				expression, expression.Left, expression.Right,
+				operation, operationKind,
 				leftType, rightType,
 				leftIsInvalid, rightIsInvalid, anyInvalid,
 			)
@@ -221,13 +239,69 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 	}
 }
 
+// checkBinaryExpressionArithmeticOrBitwise checks the operands of an arithmetic or bitwise operation.
+// It is used for binary expressions (e.g. `a + b`) and compound assignments (e.g. `a += b`).
 func (checker *Checker) checkBinaryExpressionArithmeticOrBitwise(
-	expression *ast.BinaryExpression,
+	expression ast.HasPosition,
+	left, right ast.HasPosition,
 	operation ast.Operation,
 	operationKind BinaryOperationKind,
 	leftType, rightType Type,
 	leftIsInvalid, rightIsInvalid, anyInvalid bool,
 ) Type {
+	// Timestamps and durations only support a restricted set of arithmetic operations
+
+	if isTimeType(leftType) || isTimeType(rightType) {
+		resultType := timeArithmeticResultType(operation, leftType, rightType)
+		if resultType == nil {
+			if !anyInvalid {
+				checker.report(
+					&InvalidBinaryOperandsError{
+						Operation: operation,
+						LeftType:  leftType,
+						RightType: rightType,
+						Range:     ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+					},
+				)
+			}
+
+			return leftType
+		}
+
+		return resultType
+	}
+
+	// Distinct types which opted in to the operations of their underlying type
+	// support the operations of the underlying type, if both operands have the same type.
+	// The result has the distinct type
+
+	if underlyingType := DistinctOperandType(leftType); underlyingType != nil {
+
+		if !leftType.Equal(rightType) {
+			if !anyInvalid {
+				checker.report(
+					&InvalidBinaryOperandsError{
+						Operation: operation,
+						LeftType:  leftType,
+						RightType: rightType,
+						Range:     ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+					},
+				)
+			}
+
+			return leftType
+		}
+
+		checker.checkBinaryExpressionArithmeticOrBitwise(
+			expression, left, right,
+			operation, operationKind,
+			underlyingType, underlyingType,
+			leftIsInvalid, rightIsInvalid, anyInvalid,
+		)
+
+		return leftType
+	}
+
 	// check both types are number/integer subtypes
 
 	var expectedSuperType Type
@@ -268,7 +342,7 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrBitwise(
 					Side:         common.OperandSideLeft,
 					ExpectedType: expectedSuperType,
 					ActualType:   leftType,
-					Range:        ast.NewRangeFromPositioned(checker.memoryGauge, expression.Left),
+					Range:        ast.NewRangeFromPositioned(checker.memoryGauge, left),
 				},
 			)
 		}
@@ -280,7 +354,7 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrBitwise(
 					Side:         common.OperandSideRight,
 					ExpectedType: expectedSuperType,
 					ActualType:   rightType,
-					Range:        ast.NewRangeFromPositioned(checker.memoryGauge, expression.Right),
+					Range:        ast.NewRangeFromPositioned(checker.memoryGauge, right),
 				},
 			)
 		}
@@ -470,3 +544,135 @@ func (checker *Checker) checkBinaryExpressionNilCoalescing(
 
 	return checker.leastCommonSuperType(expression, leftOptional.Type, rightType)
 }
+
+func isTimeType(ty Type) bool {
+	return ty == TimestampType || ty == DurationType
+}
+
+// timeArithmeticRightExpectedType returns the expected type of the right operand
+// of the given arithmetic operation, if it differs from the type of the left operand.
+// Durations are multiplied and divided by integers, e.g. `Duration.day * 7`
+func timeArithmeticRightExpectedType(operation ast.Operation, leftType Type) Type {
+	if leftType == DurationType {
+		switch operation {
+		case ast.OperationMul, ast.OperationDiv:
+			return Int64Type
+		}
+	}
+
+	return nil
+}
+
+// timeArithmeticResultType returns the result type of the given arithmetic operation
+// on timestamps and durations, or nil if the operation is not supported:
+//
+//   - Timestamp + Duration: Timestamp
+//   - Timestamp - Duration: Timestamp
+//   - Timestamp - Timestamp: Duration
+//   - Duration + Duration: Duration
+//   - Duration - Duration: Duration
+//   - Duration * Int64: Duration
+//   - Duration / Int64: Duration
+func timeArithmeticResultType(operation ast.Operation, leftType, rightType Type) Type {
+	switch leftType {
+	case TimestampType:
+		switch operation {
+		case ast.OperationPlus:
+			if rightType == DurationType {
+				return TimestampType
+			}
+
+		case ast.OperationMinus:
+			switch rightType {
+			case DurationType:
+				return TimestampType
+			case TimestampType:
+				return DurationType
+			}
+		}
+
+	case DurationType:
+		switch operation {
+		case ast.OperationPlus, ast.OperationMinus:
+			if rightType == DurationType {
+				return DurationType
+			}
+
+		case ast.OperationMul, ast.OperationDiv:
+			if rightType == Int64Type {
+				return DurationType
+			}
+		}
+	}
+
+	return nil
+}
+
+// deferBinaryOperandTypeCheck defers the check that an operand of type `AnyStruct`
+// has the type of the other operand from checking to run-time.
+// For example, in the loose mode, a parameter `x` without a type annotation
+// may be used in the expression `x + 1`
+func (checker *Checker) deferBinaryOperandTypeCheck(
+	expression *ast.BinaryExpression,
+	leftType Type,
+	rightType Type,
+) (Type, Type) {
+	switch {
+	case leftType == AnyStructType && rightType != AnyStructType:
+		errorRange := checker.expressionRange(expression.Left)
+		if checker.deferTypeCheck(expression.Left, rightType, leftType, errorRange) {
+			leftType = rightType
+		}
+
+	case rightType == AnyStructType && leftType != AnyStructType:
+		errorRange := checker.expressionRange(expression.Right)
+		if checker.deferTypeCheck(expression.Right, leftType, rightType, errorRange) {
+			rightType = leftType
+		}
+	}
+
+	return leftType, rightType
+}
+
+// deferBinaryOperationCheck defers the check of an operation on two operands of type `AnyStruct`
+// from checking to run-time, and returns the result type of the operation.
+// For example, in the loose mode, parameters `a` and `b` without a type annotation
+// may be used in the expression `a + b`.
+//
+// The interpreter performs operations based on the values of the operands,
+// so operations on invalid operands, e.g. `1 + "2"`, are rejected at run-time.
+//
+// Returns true if the check was deferred
+func (checker *Checker) deferBinaryOperationCheck(
+	expression *ast.BinaryExpression,
+	operationKind BinaryOperationKind,
+	leftType Type,
+	rightType Type,
+) (Type, bool) {
+	if leftType != AnyStructType || rightType != AnyStructType {
+		return nil, false
+	}
+
+	var resultType Type
+	switch operationKind {
+	case BinaryOperationKindArithmetic,
+		BinaryOperationKindBitwise:
+
+		resultType = AnyStructType
+
+	case BinaryOperationKindNonEqualityComparison:
+		resultType = BoolType
+
+	default:
+		return nil, false
+	}
+
+	checker.reportWarning(
+		&RuntimeOperandsCheckWarning{
+			Operation: expression.Operation,
+			Range:     ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+		},
+	)
+
+	return resultType, true
+}
@@ -21,6 +21,10 @@ package sema
 import "github.com/onflow/cadence/ast"
 
 func (checker *Checker) checkBlock(block *ast.Block) {
+	// NOTE: blocks may declare local type aliases
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(block.EndPosition)
+
 	checker.enterValueScope()
 	defer checker.leaveValueScope(block.EndPosition, true)
 
@@ -73,10 +77,10 @@ func (checker *Checker) checkValidStatement(statement ast.Statement) bool {
 		return true
 	}
 
-	// Only function and variable declarations are allowed locally
+	// Only function, variable, and type alias declarations are allowed locally
 
 	switch declaration.(type) {
-	case *ast.FunctionDeclaration, *ast.VariableDeclaration:
+	case *ast.FunctionDeclaration, *ast.VariableDeclaration, *ast.TypeAliasDeclaration:
 		return true
 	}
 
@@ -48,11 +48,16 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 
 	hasErrors := len(checker.errors) > beforeErrors
 
+	convertsNumber := expression.Operation != ast.OperationCast &&
+		checker.Config.NumberConversionCastsEnabled &&
+		CastConvertsNumber(leftHandType, rightHandType)
+
 	checker.Elaboration.SetCastingExpressionTypes(
 		expression,
 		CastingExpressionTypes{
 			StaticValueType: leftHandType,
 			TargetType:      rightHandType,
+			ConvertsNumber:  convertsNumber,
 		},
 	)
 
@@ -263,3 +268,41 @@ func FailableCastCanSucceed(subType, superType Type) bool {
 
 	return true
 }
+
+// CastConvertsNumber returns true if a failable or force cast
+// from the given static value type to the given target type is a number conversion,
+// i.e. the target type is a concrete number type, and the value is a number of a different type,
+// e.g. `x as? UInt8` where `x` has the static type `Int`.
+//
+// Values with an abstract static type, e.g. `Integer`, `Number`, or `AnyStruct`,
+// may be numbers of a different type at run-time, so they are converted the same way
+// as values with a concrete number static type.
+//
+// The failable cast results in nil, and the force cast aborts,
+// if the value is not in the range of the target type.
+// Like for the conversion functions, e.g. `UInt8(x)`,
+// the fractional part of fixed-point numbers is truncated when converting to integers.
+//
+// Optional values are unwrapped, and references are cast, like for all failable and force casts,
+// e.g. `x as? UInt8` where `x` has the static type `Int?`.
+//
+// Number conversions are only performed if enabled in the checker configuration,
+// see Config.NumberConversionCastsEnabled.
+func CastConvertsNumber(valueType, targetType Type) bool {
+	valueType = UnwrapOptionalType(valueType)
+	targetType = UnwrapOptionalType(targetType)
+
+	if valueType.Equal(targetType) ||
+		!isConcreteNumberType(targetType) {
+
+		return false
+	}
+
+	return IsSubType(valueType, NumberType) ||
+		IsSubType(NumberType, valueType)
+}
+
+func isConcreteNumberType(ty Type) bool {
+	rangedType, ok := ty.(IntegerRangedType)
+	return ok && !rangedType.IsSuperType()
+}
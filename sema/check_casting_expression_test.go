@@ -0,0 +1,53 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCastConvertsNumber(t *testing.T) {
+
+	t.Parallel()
+
+	assert.True(t, CastConvertsNumber(IntType, UInt8Type))
+	assert.True(t, CastConvertsNumber(Word8Type, IntType))
+	assert.True(t, CastConvertsNumber(&OptionalType{Type: IntType}, UInt8Type))
+	assert.True(t, CastConvertsNumber(UFix64Type, &OptionalType{Type: Fix64Type}))
+
+	// Values with an abstract static type may be numbers of a different type at run-time
+
+	assert.True(t, CastConvertsNumber(IntegerType, UInt8Type))
+	assert.True(t, CastConvertsNumber(NumberType, UInt8Type))
+	assert.True(t, CastConvertsNumber(FixedPointType, UFix64Type))
+	assert.True(t, CastConvertsNumber(AnyStructType, UInt8Type))
+	assert.True(t, CastConvertsNumber(&OptionalType{Type: AnyStructType}, UInt8Type))
+
+	// Casts to abstract or the same types are no conversions
+
+	assert.False(t, CastConvertsNumber(IntType, IntegerType))
+	assert.False(t, CastConvertsNumber(IntType, IntType))
+	assert.False(t, CastConvertsNumber(&OptionalType{Type: IntType}, IntType))
+
+	assert.False(t, CastConvertsNumber(StringType, UInt8Type))
+	assert.False(t, CastConvertsNumber(AnyStructType, StringType))
+	assert.False(t, CastConvertsNumber(IntType, StringType))
+}
@@ -19,6 +19,8 @@
 package sema
 
 import (
+	"golang.org/x/exp/slices"
+
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/common/orderedmap"
@@ -188,6 +190,19 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 		true,
 	)
 
+	// Declare the type parameters (if any), so they can be used in the members.
+	// Errors were already reported when the members were declared.
+
+	if compositeType.IsGeneric() {
+		leave := checker.enterTypeParameterScope(
+			declaration.(*ast.CompositeDeclaration).TypeParameterList,
+			compositeType.typeParameters,
+			declaration.EndPosition,
+			func(error) {},
+		)
+		defer leave()
+	}
+
 	members := declaration.DeclarationMembers()
 
 	// NOTE: functions are checked separately
@@ -282,9 +297,13 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 		})
 	}
 
-	// NOTE: visit entitlements, then interfaces, then composites
+	// NOTE: visit type aliases, then entitlements, then interfaces, then composites
 	// DON'T use `nestedDeclarations`, because of non-deterministic order
 
+	for _, nestedTypeAlias := range members.TypeAliases() {
+		ast.AcceptDeclaration[struct{}](nestedTypeAlias, checker)
+	}
+
 	for _, nestedEntitlement := range members.Entitlements() {
 		ast.AcceptDeclaration[struct{}](nestedEntitlement, checker)
 	}
@@ -314,13 +333,19 @@ func availableDefaultFunctions(compositeType *CompositeType) map[string]struct{}
 	defaultFunctions := make(map[string]struct{})
 
 	for _, conformance := range compositeType.EffectiveInterfaceConformances() {
-		conformance.InterfaceType.Members.Foreach(func(name string, interfaceMember *Member) {
+		conformance.InterfaceType.Members.Foreach(func(_ string, interfaceMember *Member) {
 			if interfaceMember.DeclarationKind != common.DeclarationKindFunction {
 				return
 			}
 
 			if interfaceMember.HasImplementation {
-				defaultFunctions[name] = struct{}{}
+				// Functions may be overloaded by their argument labels,
+				// so they are identified by their function overload name
+				overloadName := FunctionOverloadName(
+					interfaceMember.Identifier.Identifier,
+					interfaceMember.ArgumentLabels,
+				)
+				defaultFunctions[overloadName] = struct{}{}
 			}
 		})
 	}
@@ -337,6 +362,9 @@ func availableDefaultFunctions(compositeType *CompositeType) map[string]struct{}
 //
 // It assumes the types were previously added to the elaboration in `CompositeNestedDeclarations`,
 // and the type for the declaration was added to the elaboration in `CompositeDeclarationTypes`.
+//
+// Finally, it declares the type aliases nested in the composite,
+// which are only visible inside of the composite.
 func (checker *Checker) declareCompositeLikeNestedTypes(
 	declaration ast.CompositeLikeDeclaration,
 	declareConstructors bool,
@@ -407,10 +435,18 @@ func (checker *Checker) declareCompositeLikeNestedTypes(
 						nestedConstructorType,
 						nestedConstructorArgumentLabels,
 					)
+					checker.declareCompositeLikeConstructorOverloads(
+						nestedCompositeDeclaration,
+						nestedCompositeType,
+					)
 				}
 			}
 		}
 	})
+
+	typeAliases := declaration.DeclarationMembers().TypeAliases()
+	checker.declareTypeAliases(typeAliases)
+	checker.declareNestedTypeAliases(compositeType, typeAliases)
 }
 
 func (checker *Checker) declareNestedDeclarations(
@@ -421,6 +457,7 @@ func (checker *Checker) declareNestedDeclarations(
 	nestedInterfaceDeclarations []*ast.InterfaceDeclaration,
 	nestedEntitlementDeclarations []*ast.EntitlementDeclaration,
 	nestedEntitlementMappingDeclarations []*ast.EntitlementMappingDeclaration,
+	nestedTypeAliasDeclarations []*ast.TypeAliasDeclaration,
 ) (
 	nestedDeclarations map[string]ast.Declaration,
 	nestedInterfaceTypes []*InterfaceType,
@@ -498,6 +535,14 @@ func (checker *Checker) declareNestedDeclarations(
 				firstNestedAttachmentDeclaration.Identifier,
 			)
 
+		} else if len(nestedTypeAliasDeclarations) > 0 {
+
+			firstNestedTypeAliasDeclaration := nestedTypeAliasDeclarations[0]
+
+			reportInvalidNesting(
+				firstNestedTypeAliasDeclaration.DeclarationKind(),
+				firstNestedTypeAliasDeclaration.Identifier,
+			)
 		}
 
 		// NOTE: don't return, so nested declarations / types are still declared
@@ -679,10 +724,18 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 		)
 	}
 
+	// Convert type parameters (if any)
+
+	if compositeDeclaration, ok := declaration.(*ast.CompositeDeclaration); ok {
+		compositeType.typeParameters = checker.compositeTypeParameters(compositeDeclaration)
+	}
+
 	// Resolve conformances
 
 	if declaration.Kind() == common.CompositeKindEnum {
-		compositeType.EnumRawType = checker.enumRawType(declaration.(*ast.CompositeDeclaration))
+		compositeDeclaration := declaration.(*ast.CompositeDeclaration)
+		compositeType.EnumRawType = checker.enumRawType(compositeDeclaration)
+		compositeType.EnumCases = enumCaseNames(compositeDeclaration)
 	} else {
 		compositeType.ExplicitInterfaceConformances =
 			checker.explicitInterfaceConformances(declaration, compositeType)
@@ -714,6 +767,7 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 			members.Interfaces(),
 			members.Entitlements(),
 			members.EntitlementMaps(),
+			members.TypeAliases(),
 		)
 
 	checker.Elaboration.SetCompositeNestedDeclarations(declaration, nestedDeclarations)
@@ -749,6 +803,37 @@ func (checker *Checker) declareCompositeType(declaration ast.CompositeLikeDeclar
 	return compositeType
 }
 
+// compositeTypeParameters converts the type parameters of the given composite declaration.
+// Only structures and resources may have type parameters.
+func (checker *Checker) compositeTypeParameters(declaration *ast.CompositeDeclaration) []*TypeParameter {
+	typeParameterList := declaration.TypeParameterList
+	if typeParameterList.IsEmpty() {
+		return nil
+	}
+
+	switch declaration.Kind() {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource:
+		break
+
+	default:
+		checker.report(
+			&InvalidTypeParameterizedCompositeError{
+				CompositeKind: declaration.Kind(),
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					typeParameterList,
+				),
+			},
+		)
+		return nil
+	}
+
+	typeParameters := checker.typeParameters(typeParameterList)
+	checker.checkTypeParameterBounds(typeParameterList, typeParameters)
+	return typeParameters
+}
+
 func (checker *Checker) declareAttachmentMembersAndValue(declaration *ast.AttachmentDeclaration) {
 	checker.declareCompositeLikeMembersAndValue(declaration)
 }
@@ -776,6 +861,18 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 	declarationMembers := orderedmap.New[StringMemberOrderedMap](len(nestedComposites) + len(nestedAttachments))
 
 	(func() {
+		// Declare the type parameters (if any), so they can be used in the members
+
+		if compositeType.IsGeneric() {
+			leave := checker.enterTypeParameterScope(
+				declaration.(*ast.CompositeDeclaration).TypeParameterList,
+				compositeType.typeParameters,
+				declaration.EndPosition,
+				checker.report,
+			)
+			defer leave()
+		}
+
 		// Activate new scopes for nested types
 
 		checker.typeActivations.Enter()
@@ -801,8 +898,9 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 		// and after declaring nested types as the initializer may use nested type in parameters
 
 		initializers := members.Initializers()
-		compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		compositeType.ConstructorParameters = checker.initializerParameters(initializers, true)
 		compositeType.ConstructorPurity = checker.initializerPurity(compositeKind, initializers)
+		compositeType.ConstructorOverloads = checker.initializerOverloads(compositeType, initializers)
 
 		// Declare nested declarations' members
 
@@ -860,6 +958,40 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 					DocString:             nestedCompositeDeclaration.DeclarationDocString(),
 				},
 			)
+
+			// Declare the nested composites' constructor overloads, if any, as members of the containing composite
+
+			nestedCompositeType := checker.Elaboration.CompositeDeclarationType(nestedCompositeDeclaration)
+			if nestedCompositeType == nil {
+				return
+			}
+
+			for _, constructorType := range nestedCompositeType.ConstructorOverloads {
+				overloadName := FunctionOverloadName(
+					identifier.Identifier,
+					constructorType.ArgumentLabels(),
+				)
+
+				overloadVariable := checker.valueActivations.Find(overloadName)
+				if overloadVariable == nil {
+					continue
+				}
+
+				declarationMembers.Set(
+					overloadName,
+					&Member{
+						Identifier:            identifier,
+						Access:                checker.accessFromAstAccess(nestedCompositeDeclaration.DeclarationAccess()),
+						ContainerType:         compositeType,
+						TypeAnnotation:        NewTypeAnnotation(overloadVariable.Type),
+						DeclarationKind:       overloadVariable.DeclarationKind,
+						VariableKind:          ast.VariableKindConstant,
+						ArgumentLabels:        overloadVariable.ArgumentLabels,
+						IgnoreInSerialization: true,
+						DocString:             nestedCompositeDeclaration.DeclarationDocString(),
+					},
+				)
+			}
 		}
 
 		for _, nestedCompositeDeclaration := range nestedComposites {
@@ -926,6 +1058,12 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 		if checker.PositionInfo != nil {
 			checker.PositionInfo.recordMemberOrigins(compositeType, origins)
 		}
+
+		if compositeDeclaration, ok := declaration.(*ast.CompositeDeclaration); ok &&
+			compositeDeclaration.IsDistinctType() {
+
+			checker.declareDistinctType(compositeDeclaration, compositeType)
+		}
 	})()
 
 	// Always determine composite constructor type
@@ -964,6 +1102,38 @@ func (checker *Checker) declareCompositeLikeMembersAndValue(
 			constructorType,
 			constructorArgumentLabels,
 		)
+		checker.declareCompositeLikeConstructorOverloads(
+			declaration,
+			compositeType,
+		)
+	}
+}
+
+// declareDistinctType records the underlying type of a distinct type,
+// which is the type of the synthesized field holding the underlying value.
+func (checker *Checker) declareDistinctType(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	member, ok := compositeType.Members.Get(ast.DistinctTypeUnderlyingValueFieldName)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	underlyingType := member.TypeAnnotation.Type
+
+	compositeType.DistinctUnderlyingType = underlyingType
+	compositeType.DistinctInheritsOperations = declaration.InheritsOperations
+
+	// Distinct types are structures, so they cannot wrap resources
+
+	if underlyingType.IsResourceType() {
+		checker.report(
+			&InvalidDistinctTypeUnderlyingTypeError{
+				Type:  underlyingType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, declaration.UnderlyingType),
+			},
+		)
 	}
 }
 
@@ -998,6 +1168,36 @@ func (checker *Checker) declareCompositeLikeConstructor(
 	checker.report(err)
 }
 
+// declareCompositeLikeConstructorOverloads declares the constructors
+// for the overloaded initializers of the given composite type.
+// Each constructor is declared under its overload name, see FunctionOverloadName.
+func (checker *Checker) declareCompositeLikeConstructorOverloads(
+	declaration ast.CompositeLikeDeclaration,
+	compositeType *CompositeType,
+) {
+	identifier := declaration.DeclarationIdentifier()
+
+	for _, constructorType := range compositeType.ConstructorOverloads {
+		argumentLabels := constructorType.ArgumentLabels()
+
+		overloadName := FunctionOverloadName(identifier.Identifier, argumentLabels)
+		checker.recordFunctionOverloadName(identifier.Identifier, overloadName)
+
+		_, err := checker.valueActivations.declare(variableDeclaration{
+			identifier:               overloadName,
+			ty:                       constructorType,
+			docString:                declaration.DeclarationDocString(),
+			access:                   checker.accessFromAstAccess(declaration.DeclarationAccess()),
+			kind:                     declaration.DeclarationKind(),
+			pos:                      identifier.Pos,
+			isConstant:               true,
+			argumentLabels:           argumentLabels,
+			allowOuterScopeShadowing: false,
+		})
+		checker.report(err)
+	}
+}
+
 func (checker *Checker) declareContractValue(
 	declaration *ast.CompositeDeclaration,
 	compositeType *CompositeType,
@@ -1155,8 +1355,13 @@ func (checker *Checker) initializerPurity(
 	return FunctionPurityView
 }
 
-func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctionDeclaration) []Parameter {
-	// TODO: support multiple overloaded initializers
+// initializerParameters returns the parameters of the first initializer.
+// If overloads are not allowed, further initializers are reported as redeclarations.
+// Overloads are checked separately, see initializerOverloads.
+func (checker *Checker) initializerParameters(
+	initializers []*ast.SpecialFunctionDeclaration,
+	allowOverloads bool,
+) []Parameter {
 	var parameters []Parameter
 
 	initializerCount := len(initializers)
@@ -1166,7 +1371,7 @@ func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctio
 
 		parameters = checker.parameters(firstInitializer.FunctionDeclaration.ParameterList)
 
-		if initializerCount > 1 {
+		if initializerCount > 1 && !allowOverloads {
 
 			secondInitializer := initializers[1]
 
@@ -1187,6 +1392,105 @@ func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctio
 	return parameters
 }
 
+// initializerOverloads returns the constructor function types
+// of all initializers of the given composite type, except the first.
+//
+// Initializers may be overloaded by their argument labels,
+// but only in structures and resources.
+// Initializers with the same argument labels are reported as redeclarations.
+func (checker *Checker) initializerOverloads(
+	compositeType *CompositeType,
+	initializers []*ast.SpecialFunctionDeclaration,
+) []*FunctionType {
+	if len(initializers) < 2 {
+		return nil
+	}
+
+	initializerFunctions := make([]*ast.FunctionDeclaration, 0, len(initializers))
+	for _, initializer := range initializers {
+		initializerFunctions = append(initializerFunctions, initializer.FunctionDeclaration)
+	}
+	checker.checkAmbiguousFunctionOverloads(initializerFunctions)
+
+	firstInitializer := initializers[0]
+	previousArgumentLabels := [][]string{
+		firstInitializer.FunctionDeclaration.ParameterList.EffectiveArgumentLabels(),
+	}
+
+	var overloads []*FunctionType
+
+	for i, initializer := range initializers[1:] {
+		argumentLabels := initializer.FunctionDeclaration.ParameterList.EffectiveArgumentLabels()
+
+		previousIndex := slices.IndexFunc(
+			previousArgumentLabels,
+			func(labels []string) bool {
+				return slices.Equal(labels, argumentLabels)
+			},
+		)
+		previousArgumentLabels = append(previousArgumentLabels, argumentLabels)
+
+		if previousIndex >= 0 {
+			previousPos := initializers[previousIndex].StartPosition()
+
+			checker.report(
+				&RedeclarationError{
+					Kind:        common.DeclarationKindInitializer,
+					Name:        common.DeclarationKindInitializer.Keywords(),
+					PreviousPos: &previousPos,
+					Pos:         initializer.StartPosition(),
+				},
+			)
+			continue
+		}
+
+		switch compositeType.Kind {
+		case common.CompositeKindStructure,
+			common.CompositeKindResource:
+			break
+
+		default:
+			checker.report(
+				&UnsupportedOverloadingError{
+					DeclarationKind: common.DeclarationKindInitializer,
+					Range: ast.NewRangeFromPositioned(
+						checker.memoryGauge,
+						initializers[i+1],
+					),
+				},
+			)
+			continue
+		}
+
+		parameters := checker.parameters(initializer.FunctionDeclaration.ParameterList)
+
+		overloads = append(
+			overloads,
+			&FunctionType{
+				Purity:               PurityFromAnnotation(initializer.FunctionDeclaration.Purity),
+				IsConstructor:        true,
+				TypeParameters:       compositeType.typeParameters,
+				Parameters:           parameters,
+				ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
+				Arity:                defaultArgumentsArity(initializer.FunctionDeclaration.ParameterList),
+			},
+		)
+
+		// NOTE: The initializer itself has a `Void` return type.
+
+		checker.Elaboration.SetConstructorFunctionType(
+			initializer,
+			&FunctionType{
+				IsConstructor:        true,
+				Parameters:           parameters,
+				ReturnTypeAnnotation: VoidTypeAnnotation,
+			},
+		)
+	}
+
+	return overloads
+}
+
 func (checker *Checker) explicitInterfaceConformances(
 	conformingDeclaration ast.ConformingDeclaration,
 	compositeKindedType CompositeKindedType,
@@ -1283,6 +1587,17 @@ func (checker *Checker) enumRawType(declaration *ast.CompositeDeclaration) Type
 	return rawType
 }
 
+func enumCaseNames(declaration *ast.CompositeDeclaration) []string {
+	enumCases := declaration.Members.EnumCases()
+
+	names := make([]string, 0, len(enumCases))
+	for _, enumCase := range enumCases {
+		names = append(names, enumCase.Identifier.Identifier)
+	}
+
+	return names
+}
+
 type compositeConformanceCheckOptions struct {
 	checkMissingMembers bool
 }
@@ -1312,9 +1627,8 @@ func (checker *Checker) checkCompositeLikeConformance(
 	// to conform to a resource interface
 	checker.checkConformanceKindMatch(compositeDeclaration, compositeType, conformance)
 
-	// Check initializer requirement
-
-	// TODO: add support for overloaded initializers
+	// Check initializer requirement.
+	// The requirement is satisfied by the first initializer, or by any of its overloads
 
 	if conformance.InitializerParameters != nil {
 
@@ -1329,8 +1643,23 @@ func (checker *Checker) checkCompositeLikeConformance(
 			VoidTypeAnnotation,
 		)
 
+		satisfiesInitializerRequirement := initializerType.Equal(interfaceInitializerType)
+
+		for _, constructorOverload := range compositeType.ConstructorOverloads {
+			if satisfiesInitializerRequirement {
+				break
+			}
+
+			overloadInitializerType := NewSimpleFunctionType(
+				constructorOverload.Purity,
+				constructorOverload.Parameters,
+				VoidTypeAnnotation,
+			)
+			satisfiesInitializerRequirement = overloadInitializerType.Equal(interfaceInitializerType)
+		}
+
 		// TODO: subtype?
-		if !initializerType.Equal(interfaceInitializerType) {
+		if !satisfiesInitializerRequirement {
 			initializerMismatch = &InitializerMismatch{
 				CompositePurity:     compositeType.ConstructorPurity,
 				InterfacePurity:     conformance.InitializerPurity,
@@ -1351,7 +1680,20 @@ func (checker *Checker) checkCompositeLikeConformance(
 			return
 		}
 
-		compositeMember, ok := compositeType.Members.Get(name)
+		// Functions may be overloaded by their argument labels,
+		// so they are identified by their function overload name
+
+		memberKey := name
+
+		var compositeMember *Member
+		var ok bool
+		if interfaceMember.DeclarationKind == common.DeclarationKindFunction {
+			memberKey = FunctionOverloadName(interfaceMember.Identifier.Identifier, interfaceMember.ArgumentLabels)
+			compositeMember, ok = lookupFunctionOverload(compositeType.Members, interfaceMember)
+		} else {
+			compositeMember, ok = compositeType.Members.Get(name)
+		}
+
 		if ok {
 
 			// If the composite member exists, check if it satisfies the mem
@@ -1373,7 +1715,7 @@ func (checker *Checker) checkCompositeLikeConformance(
 			// may provide a default function.
 
 			if interfaceMember.DeclarationKind == common.DeclarationKindFunction {
-				existingMembers, ok := inheritedMembers[name]
+				existingMembers, ok := inheritedMembers[memberKey]
 				if ok {
 					hasConflicts := checker.checkMemberConflicts(
 						compositeDeclaration,
@@ -1388,11 +1730,11 @@ func (checker *Checker) checkCompositeLikeConformance(
 				}
 
 				existingMembers = append(existingMembers, interfaceMember)
-				inheritedMembers[name] = existingMembers
+				inheritedMembers[memberKey] = existingMembers
 
 			}
 
-			if _, ok := defaultFunctions[name]; !ok {
+			if _, ok := defaultFunctions[memberKey]; !ok {
 				missingMembers = append(missingMembers, interfaceMember)
 			}
 		}
@@ -1553,6 +1895,16 @@ func (checker *Checker) memberSatisfied(
 				return false
 			}
 
+			// Functions must have the same number of required parameters,
+			// as arguments for parameters with default arguments may be omitted
+
+			parameterCount := len(compositeMemberFunctionType.Parameters)
+			if compositeMemberFunctionType.Arity.MinCount(parameterCount) !=
+				interfaceMemberFunctionType.Arity.MinCount(parameterCount) {
+
+				return false
+			}
+
 			// Functions are covariant in their purity
 			if compositeMemberFunctionType.Purity != interfaceMemberFunctionType.Purity &&
 				compositeMemberFunctionType.Purity != FunctionPurityView {
@@ -1619,9 +1971,13 @@ func CompositeLikeConstructorType(
 	argumentLabels []string,
 ) {
 
+	// NOTE: The constructor of a generic composite type has the same type parameters,
+	// so the instance is inferred from the arguments, e.g. `Box(value: 1)` has type `Box<Int>`
+
 	constructorFunctionType = &FunctionType{
 		Purity:               compositeType.ConstructorPurity,
 		IsConstructor:        true,
+		TypeParameters:       compositeType.typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
@@ -1638,6 +1994,13 @@ func CompositeLikeConstructorType(
 
 		constructorFunctionType.Parameters = compositeType.ConstructorParameters
 
+		// The default arguments of events are not user-provided,
+		// so they do not affect the arity of the constructor
+
+		if compositeType.Kind != common.CompositeKindEvent {
+			constructorFunctionType.Arity = defaultArgumentsArity(firstInitializer.FunctionDeclaration.ParameterList)
+		}
+
 		// NOTE: Don't use `constructorFunctionType`, as it has a return type.
 		//   The initializer itself has a `Void` return type.
 
@@ -1667,6 +2030,8 @@ func (checker *Checker) defaultMembersAndOrigins(
 	fields := allMembers.Fields()
 	functions := allMembers.Functions()
 
+	checker.checkAmbiguousFunctionOverloads(functions)
+
 	// Enum cases are invalid
 	enumCases := allMembers.EnumCases()
 	if len(enumCases) > 0 && containerDeclarationKind != common.DeclarationKindUnknown {
@@ -1807,6 +2172,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 			function.TypeParameterList,
 			function.ParameterList,
 			function.ReturnTypeAnnotation,
+			function.FunctionBlock,
 		)
 
 		checker.Elaboration.SetFunctionDeclarationFunctionType(function, functionType)
@@ -1835,8 +2201,19 @@ func (checker *Checker) defaultMembersAndOrigins(
 		hasImplementation := function.FunctionBlock.HasStatements()
 		hasConditions := function.FunctionBlock.HasConditions()
 
+		// Functions may be overloaded by their argument labels.
+		// Overloads are declared under their function overload name
+
+		name := identifier
+		if previousMember, ok := members.Get(identifier); ok &&
+			isFunctionOverload(previousMember, argumentLabels) {
+
+			name = FunctionOverloadName(identifier, argumentLabels)
+			checker.Elaboration.SetFunctionDeclarationOverloadName(function, name)
+		}
+
 		members.Set(
-			identifier,
+			name,
 			&Member{
 				ContainerType:     containerType,
 				Access:            functionAccess,
@@ -1851,7 +2228,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 			})
 
 		if checker.PositionInfo != nil && origins != nil {
-			origins[identifier] = checker.recordFunctionDeclarationOrigin(function, functionType)
+			origins[name] = checker.recordFunctionDeclarationOrigin(function, functionType)
 		}
 	}
 
@@ -2175,9 +2552,6 @@ func (checker *Checker) checkInitializers(
 		return
 	}
 
-	// TODO: check all initializers:
-	//  parameter initializerParameterTypeAnnotations needs to be a slice
-
 	initializer := initializers[0]
 	checker.checkSpecialFunction(
 		initializer,
@@ -2200,6 +2574,36 @@ func (checker *Checker) checkInitializers(
 			initializerParameters,
 		)
 	}
+
+	// Check the overloads of the initializer, if any.
+	// Each overload must initialize all fields on its own
+
+	for _, overload := range initializers[1:] {
+		overloadType := checker.Elaboration.ConstructorFunctionType(overload)
+		if overloadType == nil {
+			// Overloading is not supported for the container,
+			// the overload was already reported
+			continue
+		}
+
+		var overloadInitializationInfo *InitializationInfo
+		if initializationInfo != nil {
+			overloadInitializationInfo = NewInitializationInfo(
+				initializationInfo.ContainerType,
+				initializationInfo.FieldMembers,
+			)
+		}
+
+		checker.checkSpecialFunction(
+			overload,
+			containerType,
+			containerDocString,
+			PurityFromAnnotation(overload.FunctionDeclaration.Purity),
+			overloadType.Parameters,
+			containerKind,
+			overloadInitializationInfo,
+		)
+	}
 }
 
 // checkNoInitializerNoFields checks that if there are no initializers,
@@ -2273,6 +2677,11 @@ func (checker *Checker) checkSpecialFunction(
 		VoidTypeAnnotation,
 	)
 
+	// The default arguments of events are checked separately,
+	// see checkDefaultDestroyEvent
+
+	checkDefaultArguments := containerType.GetCompositeKind() != common.CompositeKindEvent
+
 	checker.checkFunction(
 		specialFunction.FunctionDeclaration.ParameterList,
 		nil,
@@ -2282,6 +2691,7 @@ func (checker *Checker) checkSpecialFunction(
 		true,
 		initializationInfo,
 		checkResourceLoss,
+		checkDefaultArguments,
 	)
 
 	if containerKind == ContainerKindComposite {
@@ -2318,6 +2728,8 @@ func (checker *Checker) checkCompositeFunctions(
 			checker.enterValueScope()
 			defer checker.leaveValueScope(function.EndPosition, true)
 
+			defer checker.enterHashingFunction(selfType, function)()
+
 			fnAccess := checker.effectiveMemberAccess(checker.accessFromAstAccess(function.Access), ContainerKindComposite)
 			// all non-entitlement functions produce unauthorized references in attachments
 			if fnAccess.IsPrimitiveAccess() {
@@ -2407,8 +2819,13 @@ func (checker *Checker) declareBaseValue(fnAccess Access, baseType Type, attachm
 
 // checkNestedIdentifiers checks that nested identifiers, i.e. fields, functions,
 // and nested interfaces and composites, are unique and aren't named `init` or `destroy`
+//
+// Functions may be overloaded by their argument labels,
+// so a function is only a redeclaration of a previously declared function
+// if the argument labels are the same.
 func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 	positions := map[string]ast.Position{}
+	functionArgumentLabels := map[string][]string{}
 
 	for _, declaration := range members.Declarations() {
 
@@ -2421,8 +2838,24 @@ func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 			continue
 		}
 
+		name := identifier.Identifier
+
+		if function, ok := declaration.(*ast.FunctionDeclaration); ok {
+			argumentLabels := function.ParameterList.EffectiveArgumentLabels()
+
+			previousArgumentLabels, ok := functionArgumentLabels[name]
+			if ok {
+				if !slices.Equal(previousArgumentLabels, argumentLabels) {
+					name = FunctionOverloadName(name, argumentLabels)
+				}
+			} else if _, ok := positions[name]; !ok {
+				functionArgumentLabels[name] = argumentLabels
+			}
+		}
+
 		checker.checkNestedIdentifier(
 			*identifier,
+			name,
 			declaration.DeclarationKind(),
 			positions,
 		)
@@ -2430,18 +2863,20 @@ func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 }
 
 // checkNestedIdentifier checks that the nested identifier is unique
-// and isn't named `init` or `destroy`
+// and isn't named `init` or `destroy`.
+// The given name is the name under which the declaration is declared,
+// which is the function overload name for overloaded functions.
 func (checker *Checker) checkNestedIdentifier(
 	identifier ast.Identifier,
+	name string,
 	kind common.DeclarationKind,
 	positions map[string]ast.Position,
 ) {
-	name := identifier.Identifier
 	pos := identifier.Pos
 
 	// TODO: provide a more helpful error
 
-	switch name {
+	switch identifier.Identifier {
 	case common.DeclarationKindInitializer.Keywords():
 
 		checker.report(
@@ -2455,7 +2890,7 @@ func (checker *Checker) checkNestedIdentifier(
 	if previousPos, ok := positions[name]; ok {
 		checker.report(
 			&RedeclarationError{
-				Name:        name,
+				Name:        identifier.Identifier,
 				Pos:         pos,
 				Kind:        kind,
 				PreviousPos: &previousPos,
@@ -0,0 +1,264 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+)
+
+// checkDestructuringPattern checks that a value of the given type can be destructured
+// using the given pattern, and returns the types of the pattern's elements.
+func (checker *Checker) checkDestructuringPattern(
+	pattern *ast.DestructuringPattern,
+	valueType Type,
+	parent ast.Element,
+) []Type {
+
+	elementTypes := make([]Type, len(pattern.Elements))
+	for i := range elementTypes {
+		elementTypes[i] = InvalidType
+	}
+
+	switch pattern.Kind {
+	case ast.DestructuringPatternKindArray:
+		checker.checkArrayDestructuringPattern(pattern, valueType, elementTypes)
+
+	case ast.DestructuringPatternKindDictionary:
+		checker.checkDictionaryDestructuringPattern(pattern, valueType, parent, elementTypes)
+
+	case ast.DestructuringPatternKindComposite:
+		checker.checkCompositeDestructuringPattern(pattern, valueType, elementTypes)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+
+	checker.Elaboration.SetDestructuringPatternTypes(
+		pattern,
+		DestructuringPatternTypes{
+			ValueType:    valueType,
+			ElementTypes: elementTypes,
+		},
+	)
+
+	return elementTypes
+}
+
+func (checker *Checker) reportInvalidDestructuringType(
+	pattern *ast.DestructuringPattern,
+	valueType Type,
+) {
+	if valueType.IsInvalidType() {
+		return
+	}
+
+	checker.report(
+		&InvalidDestructuringTypeError{
+			ActualType: valueType,
+			Kind:       pattern.Kind,
+			Range:      pattern.Range,
+		},
+	)
+}
+
+func (checker *Checker) checkArrayDestructuringPattern(
+	pattern *ast.DestructuringPattern,
+	valueType Type,
+	elementTypes []Type,
+) {
+	arrayType, ok := valueType.(ArrayType)
+	if !ok {
+		checker.reportInvalidDestructuringType(pattern, valueType)
+		return
+	}
+
+	// The number of elements of a constant-sized array is known statically.
+	// The number of elements of a variable-sized array is checked at run-time.
+
+	elementCount := len(pattern.Elements)
+
+	if constantSizedType, ok := arrayType.(*ConstantSizedType); ok &&
+		constantSizedType.Size != int64(elementCount) {
+
+		checker.report(
+			&DestructuringLengthMismatchError{
+				ExpectedLength: int(constantSizedType.Size),
+				ActualLength:   elementCount,
+				Range:          pattern.Range,
+			},
+		)
+	}
+
+	elementType := arrayType.ElementType(false)
+	for i := range elementTypes {
+		elementTypes[i] = elementType
+	}
+}
+
+func (checker *Checker) checkDictionaryDestructuringPattern(
+	pattern *ast.DestructuringPattern,
+	valueType Type,
+	parent ast.Element,
+	elementTypes []Type,
+) {
+	// Destructuring a dictionary of resources would lose the remaining entries
+
+	dictionaryType, ok := valueType.(*DictionaryType)
+	if !ok || dictionaryType.ValueType.IsResourceType() {
+		checker.reportInvalidDestructuringType(pattern, valueType)
+
+		for _, element := range pattern.Elements {
+			checker.VisitExpression(element.Key, parent, nil)
+		}
+		return
+	}
+
+	elementType := &OptionalType{
+		Type: dictionaryType.ValueType,
+	}
+
+	for i, element := range pattern.Elements {
+		checker.VisitExpression(element.Key, parent, dictionaryType.KeyType)
+		elementTypes[i] = elementType
+	}
+}
+
+func (checker *Checker) checkCompositeDestructuringPattern(
+	pattern *ast.DestructuringPattern,
+	valueType Type,
+	elementTypes []Type,
+) {
+	// Fields cannot be moved out of resources
+
+	compositeType, ok := valueType.(*CompositeType)
+	if !ok || compositeType.IsResourceType() {
+		checker.reportInvalidDestructuringType(pattern, valueType)
+		return
+	}
+
+	for i, element := range pattern.Elements {
+		field := element.Field
+		name := field.Identifier
+
+		fieldRange := ast.NewRangeFromPositioned(checker.memoryGauge, field)
+
+		member, ok := compositeType.MemberMap().Get(name)
+		if !ok {
+			checker.report(
+				&NotDeclaredMemberError{
+					Type:          compositeType,
+					Name:          name,
+					suggestMember: checker.Config.SuggestionsEnabled,
+					Range:         fieldRange,
+				},
+			)
+			continue
+		}
+
+		if _, isMappedAccess := member.Access.(*EntitlementMapAccess); isMappedAccess ||
+			member.DeclarationKind != common.DeclarationKindField {
+
+			checker.report(
+				&InvalidDestructuringMemberError{
+					Name:            name,
+					DeclarationKind: member.DeclarationKind,
+					Range:           fieldRange,
+				},
+			)
+			continue
+		}
+
+		fieldType := member.TypeAnnotation.Type
+
+		isReadable, possessedAccess := checker.isReadableMember(
+			compositeType,
+			member,
+			fieldType,
+			func() ast.Range {
+				return fieldRange
+			},
+		)
+		if !isReadable {
+			checker.report(
+				&InvalidAccessError{
+					Name:                name,
+					RestrictingAccess:   member.Access,
+					PossessedAccess:     possessedAccess,
+					DeclarationKind:     member.DeclarationKind,
+					suggestEntitlements: checker.Config.SuggestionsEnabled,
+					Range:               fieldRange,
+				},
+			)
+		}
+
+		elementTypes[i] = fieldType
+	}
+}
+
+// declareDestructuringPattern declares a variable for each element of the given pattern,
+// based on the given declaration, and returns the declared variables.
+//
+// Elements which discard the value (`_`) do not declare a variable,
+// and must not discard a resource.
+func (checker *Checker) declareDestructuringPattern(
+	pattern *ast.DestructuringPattern,
+	elementTypes []Type,
+	declaration variableDeclaration,
+) []*Variable {
+
+	var variables []*Variable
+
+	for i, element := range pattern.Elements {
+		elementType := elementTypes[i]
+
+		if element.IsDiscard() {
+			if elementType.IsResourceType() {
+				checker.report(
+					&ResourceLossError{
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, element),
+					},
+				)
+			}
+			continue
+		}
+
+		identifier := element.Identifier.Identifier
+
+		declaration.identifier = identifier
+		declaration.ty = elementType
+		declaration.pos = element.Identifier.Pos
+
+		variable, err := checker.valueActivations.declare(declaration)
+		checker.report(err)
+
+		if variable == nil {
+			continue
+		}
+
+		if checker.PositionInfo != nil {
+			checker.recordVariableDeclarationOccurrence(identifier, variable)
+		}
+
+		variables = append(variables, variable)
+	}
+
+	return variables
+}
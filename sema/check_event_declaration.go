@@ -38,13 +38,21 @@ func (checker *Checker) checkEventParameters(
 		if !parameterType.IsInvalidType() &&
 			!IsValidEventParameterType(parameterType, parameterTypeValidationResults) {
 
+			// In the loose mode, the type annotation may be omitted
+			var endPos ast.Position
+			if parameter.TypeAnnotation != nil {
+				endPos = parameter.TypeAnnotation.EndPosition(checker.memoryGauge)
+			} else {
+				endPos = parameter.Identifier.EndPosition(checker.memoryGauge)
+			}
+
 			checker.report(
 				&InvalidEventParameterTypeError{
 					Type: parameterType,
 					Range: ast.NewRange(
 						checker.memoryGauge,
 						parameter.StartPos,
-						parameter.TypeAnnotation.EndPosition(checker.memoryGauge),
+						endPos,
 					),
 				},
 			)
@@ -94,7 +102,7 @@ func IsValidEventParameterType(t Type, results map[*Member]bool) bool {
 
 	default:
 		switch t {
-		case MetaType, BoolType, CharacterType, StringType:
+		case MetaType, BoolType, CharacterType, StringType, TimestampType, DurationType:
 			return true
 		}
 
@@ -25,6 +25,15 @@ import (
 
 func (checker *Checker) VisitIdentifierExpression(expression *ast.IdentifierExpression) Type {
 	identifier := expression.Identifier
+
+	// If the identifier refers to an overloaded function and is not invoked,
+	// resolve the overload based on the expected type.
+	// Invoked overloaded functions are resolved based on the argument labels of the invocation
+
+	if _, ok := checker.invokingExpression(expression); !ok {
+		checker.resolveIdentifierFunctionReference(expression)
+	}
+
 	variable := checker.findAndCheckValueVariable(expression, true)
 	if variable == nil {
 		return InvalidType
@@ -42,6 +51,8 @@ func (checker *Checker) VisitIdentifierExpression(expression *ast.IdentifierExpr
 
 	checker.checkReferenceValidity(variable, expression)
 
+	checker.checkHashingFunctionVariableAccess(variable, expression)
+
 	if checker.inInvocation {
 		checker.Elaboration.SetIdentifierInInvocationType(expression, valueType)
 	}
@@ -308,9 +319,14 @@ func (checker *Checker) VisitStringExpression(expression *ast.StringExpression)
 
 	var actualType Type = StringType
 
-	if IsSameTypeKind(expectedType, CharacterType) {
+	switch {
+	case IsSameTypeKind(expectedType, CharacterType):
 		checker.checkCharacterLiteral(expression)
 		actualType = expectedType
+
+	case IsSameTypeKind(expectedType, TimestampType):
+		checker.checkTimestampLiteral(expression)
+		actualType = expectedType
 	}
 
 	checker.Elaboration.SetStringExpressionType(expression, actualType)
@@ -433,10 +449,40 @@ func (checker *Checker) visitIndexExpression(
 		return elementType
 	}
 
+	if checker.Config.LooseModeEnabled &&
+		!isAssignment &&
+		targetType == AnyStructType {
+
+		return checker.deferIndexExpressionCheck(indexExpression)
+	}
+
 	reportNonIndexable(targetType)
 	return InvalidType
 }
 
+// deferIndexExpressionCheck defers the check of indexing into a value of type `AnyStruct`
+// from checking to run-time, and returns the type of the element, `AnyStruct`.
+// For example, in the loose mode, a parameter `xs` without a type annotation
+// may be used in the expression `xs[0]`.
+func (checker *Checker) deferIndexExpressionCheck(indexExpression *ast.IndexExpression) Type {
+
+	checker.VisitExpression(
+		indexExpression.IndexingExpression,
+		indexExpression,
+		nil,
+	)
+
+	checker.reportWarning(
+		&RuntimeIndexingCheckWarning{
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, indexExpression.TargetExpression),
+		},
+	)
+
+	checker.Elaboration.SetRuntimeCheckedIndexExpression(indexExpression)
+
+	return AnyStructType
+}
+
 func (checker *Checker) checkTypeIndexingExpression(
 	targetType TypeIndexableType,
 	indexExpression *ast.IndexExpression,
@@ -49,21 +49,41 @@ func (checker *Checker) VisitForStatement(statement *ast.ForStatement) (_ struct
 	// leading to an additional `ResourceLossError`.
 	loopVariableType := checker.loopVariableType(valueType, valueExpression)
 
-	identifier := statement.Identifier.Identifier
-
-	variable, err := checker.valueActivations.declare(variableDeclaration{
-		identifier:               identifier,
-		ty:                       loopVariableType,
+	loopVariableDeclaration := variableDeclaration{
 		kind:                     common.DeclarationKindConstant,
-		pos:                      statement.Identifier.Pos,
 		isConstant:               true,
 		argumentLabels:           nil,
 		allowOuterScopeShadowing: false,
 		access:                   PrimitiveAccess(ast.AccessNotSpecified),
-	})
-	checker.report(err)
-	if checker.PositionInfo != nil && variable != nil {
-		checker.recordVariableDeclarationOccurrence(identifier, variable)
+	}
+
+	if statement.Pattern != nil {
+		// The loop variable is destructured,
+		// declare a variable for each element of the pattern
+
+		elementTypes := checker.checkDestructuringPattern(
+			statement.Pattern,
+			loopVariableType,
+			statement,
+		)
+
+		checker.declareDestructuringPattern(
+			statement.Pattern,
+			elementTypes,
+			loopVariableDeclaration,
+		)
+	} else {
+		identifier := statement.Identifier.Identifier
+
+		loopVariableDeclaration.identifier = identifier
+		loopVariableDeclaration.ty = loopVariableType
+		loopVariableDeclaration.pos = statement.Identifier.Pos
+
+		variable, err := checker.valueActivations.declare(loopVariableDeclaration)
+		checker.report(err)
+		if checker.PositionInfo != nil && variable != nil {
+			checker.recordVariableDeclarationOccurrence(identifier, variable)
+		}
 	}
 
 	var indexType Type
@@ -159,6 +179,8 @@ func (checker *Checker) iterableElementType(valueType Type, hasPosition ast.HasP
 		return valueType.ElementType(false)
 	case *InclusiveRangeType:
 		return valueType.MemberType
+	case *SetType:
+		return valueType.ElementType
 	}
 
 	if valueType == StringType {
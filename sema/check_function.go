@@ -108,10 +108,15 @@ func (checker *Checker) visitFunctionDeclaration(
 			declaration.TypeParameterList,
 			declaration.ParameterList,
 			declaration.ReturnTypeAnnotation,
+			declaration.FunctionBlock,
 		)
 
 		if options.declareFunction {
-			checker.declareFunctionDeclaration(declaration, functionType)
+			checker.declareFunctionDeclaration(
+				declaration,
+				declaration.Identifier.Identifier,
+				functionType,
+			)
 		}
 	}
 
@@ -126,6 +131,21 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	checker.Elaboration.SetFunctionDeclarationFunctionType(declaration, functionType)
 
+	// Declare the type parameters (if any), so they can be used in the function body.
+	// Errors were already reported when the function type was determined.
+
+	if typeParameterList := declaration.TypeParameterList; !typeParameterList.IsEmpty() &&
+		len(functionType.TypeParameters) == len(typeParameterList.TypeParameters) {
+
+		leave := checker.enterTypeParameterScope(
+			typeParameterList,
+			functionType.TypeParameters,
+			declaration.EndPosition,
+			func(error) {},
+		)
+		defer leave()
+	}
+
 	checker.checkFunction(
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
@@ -135,17 +155,19 @@ func (checker *Checker) visitFunctionDeclaration(
 		options.mustExit,
 		nil,
 		options.checkResourceLoss,
+		true,
 	)
 }
 
 func (checker *Checker) declareFunctionDeclaration(
 	declaration *ast.FunctionDeclaration,
+	identifier string,
 	functionType *FunctionType,
 ) {
 	argumentLabels := declaration.ParameterList.EffectiveArgumentLabels()
 
 	_, err := checker.valueActivations.declare(variableDeclaration{
-		identifier:               declaration.Identifier.Identifier,
+		identifier:               identifier,
 		ty:                       functionType,
 		docString:                declaration.DocString,
 		access:                   checker.accessFromAstAccess(declaration.Access),
@@ -171,14 +193,23 @@ func (checker *Checker) checkFunction(
 	mustExit bool,
 	initializationInfo *InitializationInfo,
 	checkResourceLoss bool,
+	checkDefaultArguments bool,
 ) {
 	// check argument labels
 	checker.checkArgumentLabels(parameterList)
 
 	checker.checkParameters(parameterList, functionType.Parameters)
 
+	// In the loose mode, the return type may be inferred (see Checker.functionType),
+	// so there might not be a return type annotation
+
+	var returnTypePos ast.HasPosition = parameterList
+	if returnTypeAnnotation != nil {
+		returnTypePos = returnTypeAnnotation
+	}
+
 	if functionType.ReturnTypeAnnotation.Type != nil {
-		checker.checkTypeAnnotation(functionType.ReturnTypeAnnotation, returnTypeAnnotation)
+		checker.checkTypeAnnotation(functionType.ReturnTypeAnnotation, returnTypePos)
 	}
 
 	// NOTE: Always declare the function parameters, even if the function body is empty.
@@ -205,10 +236,13 @@ func (checker *Checker) checkFunction(
 				checker.leaveValueScope(endPosGetter, checkResourceLoss)
 			}()
 
-			checker.declareParameters(parameterList, functionType.Parameters)
+			// NOTE: set the initialization info before declaring the parameters,
+			// so default arguments cannot access uninitialized fields
 
 			functionActivation.InitializationInfo = initializationInfo
 
+			checker.declareParameters(parameterList, functionType.Parameters, checkDefaultArguments)
+
 			if functionBlock != nil {
 				func() {
 					oldMappedAccess := checker.entitlementMappingInScope
@@ -223,7 +257,7 @@ func (checker *Checker) checkFunction(
 						checker.visitFunctionBlock(
 							functionBlock,
 							functionType.ReturnTypeAnnotation.Type,
-							returnTypeAnnotation,
+							returnTypePos,
 							checkResourceLoss,
 						)
 					})
@@ -277,6 +311,12 @@ func (checker *Checker) checkFunctionExits(functionBlock *ast.FunctionBlock, ret
 
 func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parameters []Parameter) {
 	for i, parameter := range parameterList.Parameters {
+		// Parameters without a type annotation have type `AnyStruct`,
+		// see Checker.parameters
+		if parameter.TypeAnnotation == nil {
+			continue
+		}
+
 		parameterTypeAnnotation := parameters[i].TypeAnnotation
 
 		checker.checkTypeAnnotation(
@@ -315,16 +355,36 @@ func (checker *Checker) checkArgumentLabels(parameterList *ast.ParameterList) {
 }
 
 // declareParameters declares a constant for each parameter,
-// ensuring names are unique and constants don't already exist
+// ensuring names are unique and constants don't already exist.
+//
+// If default arguments should be checked, the default argument of each parameter
+// is checked before the parameter is declared, so it may only refer to preceding parameters.
 func (checker *Checker) declareParameters(
 	parameterList *ast.ParameterList,
 	parameters []Parameter,
+	checkDefaultArguments bool,
 ) {
 	depth := checker.valueActivations.Depth()
 
+	var hasDefaultArgument bool
+
 	for i, parameter := range parameterList.Parameters {
 		identifier := parameter.Identifier
 
+		if checkDefaultArguments {
+			if parameter.HasDefaultArgument() {
+				hasDefaultArgument = true
+				checker.checkDefaultArgument(parameter, parameters[i].TypeAnnotation.Type)
+			} else if hasDefaultArgument {
+				checker.report(
+					&MissingDefaultArgumentError{
+						ParameterName: identifier.Identifier,
+						Range:         ast.NewRangeFromPositioned(checker.memoryGauge, parameter),
+					},
+				)
+			}
+		}
+
 		// check if variable with this identifier is already declared in the current scope
 		existingVariable := checker.valueActivations.Find(identifier.Identifier)
 		if existingVariable != nil && existingVariable.ActivationDepth == depth {
@@ -358,6 +418,60 @@ func (checker *Checker) declareParameters(
 	}
 }
 
+// checkDefaultArgument checks the default argument of the given parameter.
+// Default arguments are evaluated when the function is invoked,
+// so they must be pure, and they must not be resources.
+func (checker *Checker) checkDefaultArgument(parameter *ast.Parameter, parameterType Type) {
+
+	if parameterType.IsResourceType() {
+		checker.report(
+			&InvalidResourceDefaultArgumentError{
+				ParameterType: parameterType,
+				Range:         ast.NewRangeFromPositioned(checker.memoryGauge, parameter.DefaultArgument),
+			},
+		)
+	}
+
+	var valueType Type
+	checker.InNewPurityScope(true, func() {
+		valueType = checker.VisitExpression(parameter.DefaultArgument, nil, parameterType)
+	})
+
+	checker.Elaboration.SetDefaultArgumentTypes(
+		parameter,
+		DefaultArgumentTypes{
+			ValueType:     valueType,
+			ParameterType: parameterType,
+		},
+	)
+}
+
+// defaultArgumentsArity returns the arity of a function with the given parameters,
+// or nil if no trailing parameters have default arguments.
+// Arguments for trailing parameters with default arguments may be omitted.
+func defaultArgumentsArity(parameterList *ast.ParameterList) *Arity {
+	if parameterList == nil {
+		return nil
+	}
+
+	parameters := parameterList.Parameters
+	parameterCount := len(parameters)
+
+	requiredCount := parameterCount
+	for requiredCount > 0 && parameters[requiredCount-1].HasDefaultArgument() {
+		requiredCount--
+	}
+
+	if requiredCount == parameterCount {
+		return nil
+	}
+
+	return &Arity{
+		Min: requiredCount,
+		Max: parameterCount,
+	}
+}
+
 func (checker *Checker) visitWithPostConditions(
 	postConditions *ast.Conditions,
 	returnType Type,
@@ -472,6 +586,10 @@ func (checker *Checker) visitFunctionBlock(
 	returnTypePos ast.HasPosition,
 	checkResourceLoss bool,
 ) {
+	// NOTE: function blocks may declare local type aliases
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(functionBlock.EndPosition)
+
 	checker.enterValueScope()
 	defer checker.leaveValueScope(functionBlock.EndPosition, checkResourceLoss)
 
@@ -546,6 +664,7 @@ func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpressi
 		nil,
 		expression.ParameterList,
 		expression.ReturnTypeAnnotation,
+		expression.FunctionBlock,
 	)
 
 	checker.Elaboration.SetFunctionExpressionFunctionType(expression, functionType)
@@ -559,6 +678,7 @@ func (checker *Checker) VisitFunctionExpression(expression *ast.FunctionExpressi
 		true,
 		nil,
 		true,
+		false,
 	)
 
 	// function expressions are not allowed in conditions
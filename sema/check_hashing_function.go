@@ -0,0 +1,152 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+// isHashingFunction returns true if the function with the given name,
+// declared in the given container type, is the `equals` function of an equatable structure
+// or structure interface, or the `hashValue` function of a hashable one.
+//
+// Stored dictionary keys and set elements are looked up using these functions,
+// so they may only depend on values which cannot change after the value was stored.
+func isHashingFunction(containerType Type, name string) bool {
+	switch name {
+	case EquatableTypeEqualsFunctionName:
+		switch containerType := containerType.(type) {
+		case *CompositeType:
+			return containerType.Kind == common.CompositeKindStructure &&
+				containerType.IsEquatable()
+		case *InterfaceType:
+			return containerType.IsEquatable()
+		}
+
+	case HashableTypeHashValueFunctionName:
+		switch containerType.(type) {
+		case *CompositeType, *InterfaceType:
+			return IsHashableStructType(containerType)
+		}
+	}
+
+	return false
+}
+
+// enterHashingFunction restricts the accessible values while checking
+// the given function of the given container type, if it is a hashing function.
+// The returned function ends the restriction.
+func (checker *Checker) enterHashingFunction(containerType Type, function *ast.FunctionDeclaration) func() {
+	if !isHashingFunction(containerType, function.Identifier.Identifier) {
+		return func() {}
+	}
+
+	previousInHashingFunction := checker.inHashingFunction
+	previousDepth := checker.hashingFunctionValueActivationDepth
+
+	checker.inHashingFunction = true
+	checker.hashingFunctionValueActivationDepth = checker.valueActivations.Depth()
+
+	return func() {
+		checker.inHashingFunction = previousInHashingFunction
+		checker.hashingFunctionValueActivationDepth = previousDepth
+	}
+}
+
+// checkHashingFunctionVariableAccess reports an error if the given variable
+// is accessed in a hashing function, and is neither declared in the function,
+// nor a built-in value, e.g. a conversion function.
+func (checker *Checker) checkHashingFunctionVariableAccess(
+	variable *Variable,
+	expression *ast.IdentifierExpression,
+) {
+	if !checker.inHashingFunction {
+		return
+	}
+
+	if variable.ActivationDepth > checker.hashingFunctionValueActivationDepth {
+		return
+	}
+
+	if BaseValueActivation.Find(variable.Identifier) == variable {
+		return
+	}
+
+	checker.report(
+		&InvalidHashingFunctionAccessError{
+			Name:  variable.Identifier,
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+		},
+	)
+}
+
+// checkHashingFunctionMemberAccess reports an error if the given member
+// of a user-defined composite or interface is accessed in a hashing function,
+// and it is neither a constant field, nor a built-in or hashing function.
+func (checker *Checker) checkHashingFunctionMemberAccess(
+	member *Member,
+	expression *ast.MemberExpression,
+) {
+	if !checker.inHashingFunction {
+		return
+	}
+
+	var location common.Location
+	switch containerType := member.ContainerType.(type) {
+	case *CompositeType:
+		location = containerType.Location
+	case *InterfaceType:
+		location = containerType.Location
+	}
+
+	// Members of built-in types are allowed
+	if location == nil {
+		return
+	}
+
+	switch member.DeclarationKind {
+	case common.DeclarationKindField:
+		if member.VariableKind == ast.VariableKindConstant {
+			return
+		}
+
+	case common.DeclarationKindFunction:
+		identifier := member.Identifier.Identifier
+		switch identifier {
+		case IsInstanceFunctionName,
+			GetTypeFunctionName,
+			CompositeForEachAttachmentFunctionName:
+
+			// Built-in functions
+			return
+		}
+
+		if isHashingFunction(member.ContainerType, identifier) {
+			return
+		}
+	}
+
+	checker.report(
+		&InvalidHashingFunctionAccessError{
+			Name:  member.Identifier.Identifier,
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+		},
+	)
+}
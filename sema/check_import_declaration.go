@@ -19,6 +19,9 @@
 package sema
 
 import (
+	"bytes"
+	"strings"
+
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 )
@@ -88,11 +91,63 @@ func (checker *Checker) declareImportDeclaration(declaration *ast.ImportDeclarat
 
 	checker.Elaboration.SetImportDeclarationsResolvedLocations(declaration, resolvedLocations)
 
+	// A hash pins the code of a single location.
+	// If the import resolves to multiple locations, e.g. `import A, B from 0x1 hash "..."`,
+	// it is unclear which code the hash refers to
+
+	checkHash := declaration.Hash != nil
+	if checkHash && len(resolvedLocations) > 1 {
+		checker.report(
+			&AmbiguousImportHashError{
+				Location: declaration.Location,
+				Range:    locationRange,
+			},
+		)
+		checkHash = false
+	}
+
 	for _, resolvedLocation := range resolvedLocations {
+		if checkHash {
+			checker.checkImportHash(resolvedLocation.Location, declaration.Hash, locationRange)
+		}
+
 		checker.importResolvedLocation(resolvedLocation, locationRange)
 	}
 }
 
+// checkImportHash verifies that the code of the imported location has the expected hash.
+func (checker *Checker) checkImportHash(location common.Location, expectedHash []byte, locationRange ast.Range) {
+	importHashHandler := checker.Config.ImportHashHandler
+	if importHashHandler == nil {
+		// The hash cannot be verified.
+		// Reject the import instead of silently ignoring the pin
+		checker.report(
+			&UnverifiedImportHashError{
+				Location: location,
+				Range:    locationRange,
+			},
+		)
+		return
+	}
+
+	actualHash, err := importHashHandler(checker, location)
+	if err != nil {
+		checker.report(err)
+		return
+	}
+
+	if !bytes.Equal(actualHash, expectedHash) {
+		checker.report(
+			&ImportHashMismatchError{
+				Location:     location,
+				ExpectedHash: expectedHash,
+				ActualHash:   actualHash,
+				Range:        locationRange,
+			},
+		)
+	}
+}
+
 func (checker *Checker) resolveLocation(identifiers []ast.Identifier, location common.Location) ([]ResolvedLocation, error) {
 
 	// If no location handler is available,
@@ -329,6 +384,18 @@ func (checker *Checker) importElements(
 			elements.Set(name, element)
 			found[identifier] = true
 			explicitlyImported[name] = identifier
+
+			// Also import the overloads of the function or constructor, if any.
+			// They are declared under their function overload name, e.g. `foo(bar:)`
+
+			if importValues {
+				overloadPrefix := name + "("
+				availableElements.Foreach(func(availableName string, element ImportElement) {
+					if strings.HasPrefix(availableName, overloadPrefix) {
+						elements.Set(availableName, element)
+					}
+				})
+			}
 		}
 	} else {
 		elements = availableElements
@@ -377,6 +444,12 @@ func (checker *Checker) importElements(
 				allowOuterScopeShadowing: false,
 			})
 			checker.report(err)
+
+			if importValues {
+				if index := strings.IndexByte(name, '('); index > 0 {
+					checker.recordFunctionOverloadName(name[:index], name)
+				}
+			}
 		})
 	}
 
@@ -147,9 +147,13 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 		})
 	}
 
-	// NOTE: visit entitlements, then interfaces, then composites
+	// NOTE: visit type aliases, then entitlements, then interfaces, then composites
 	// DON'T use `nestedDeclarations`, because of non-deterministic order
 
+	for _, nestedTypeAlias := range declaration.Members.TypeAliases() {
+		ast.AcceptDeclaration[struct{}](nestedTypeAlias, checker)
+	}
+
 	for _, nestedEntitlement := range declaration.Members.Entitlements() {
 		ast.AcceptDeclaration[struct{}](nestedEntitlement, checker)
 	}
@@ -206,6 +210,10 @@ func (checker *Checker) declareInterfaceNestedTypes(
 		})
 		checker.report(err)
 	})
+
+	typeAliases := declaration.Members.TypeAliases()
+	checker.declareTypeAliases(typeAliases)
+	checker.declareNestedTypeAliases(interfaceType, typeAliases)
 }
 
 func (checker *Checker) checkInterfaceFunctions(
@@ -224,6 +232,8 @@ func (checker *Checker) checkInterfaceFunctions(
 			checker.enterValueScope()
 			defer checker.leaveValueScope(function.EndPosition, false)
 
+			defer checker.enterHashingFunction(selfType, function)()
+
 			fnAccess := checker.effectiveMemberAccess(checker.accessFromAstAccess(function.Access), ContainerKindInterface)
 
 			checker.declareSelfValue(fnAccess, selfType, selfDocString)
@@ -327,6 +337,7 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 			declaration.Members.Interfaces(),
 			declaration.Members.Entitlements(),
 			declaration.Members.EntitlementMaps(),
+			declaration.Members.TypeAliases(),
 		)
 
 	checker.Elaboration.SetInterfaceNestedDeclarations(declaration, nestedDeclarations)
@@ -445,7 +456,7 @@ func (checker *Checker) declareInterfaceMembersAndValue(declaration *ast.Interfa
 		// and after declaring nested types as the initializer may use nested type in parameters
 
 		initializers := declarationMembers.Initializers()
-		interfaceType.InitializerParameters = checker.initializerParameters(initializers)
+		interfaceType.InitializerParameters = checker.initializerParameters(initializers, false)
 		interfaceType.InitializerPurity = checker.initializerPurity(compositeKind, initializers)
 
 		// Declare nested declarations' members
@@ -625,10 +636,26 @@ func (checker *Checker) checkInterfaceConformance(
 
 		var isDuplicate bool
 
+		// Functions may be overloaded by their argument labels,
+		// so functions with the same identifier but different argument labels do not conflict
+
+		isFunction := conformanceMember.DeclarationKind == common.DeclarationKindFunction
+
+		isOverload := func(member *Member) bool {
+			return isFunction &&
+				isFunctionOverload(member, conformanceMember.ArgumentLabels)
+		}
+
+		identifier := conformanceMember.Identifier.Identifier
+
 		// Check if the members coming from other conformances (siblings) have conflicts.
-		inheritedMembers, ok := inheritedMembersByName[name]
+		inheritedMembers, ok := inheritedMembersByName[identifier]
 		if ok {
 			for _, conflictingMember := range inheritedMembers {
+				if isOverload(conflictingMember) {
+					continue
+				}
+
 				conflictingInterface := conflictingMember.ContainerType.(*InterfaceType)
 				isDuplicate = checker.checkDuplicateInterfaceMember(
 					conformance,
@@ -642,8 +669,13 @@ func (checker *Checker) checkInterfaceConformance(
 		}
 
 		// Check if the members coming from the current declaration have conflicts.
-		declarationMember, ok := interfaceType.Members.Get(name)
-		if ok {
+		var declarationMember *Member
+		if isFunction {
+			declarationMember, ok = lookupFunctionOverload(interfaceType.Members, conformanceMember)
+		} else {
+			declarationMember, ok = interfaceType.Members.Get(name)
+		}
+		if ok && !isOverload(declarationMember) {
 			isDuplicate = isDuplicate || checker.checkDuplicateInterfaceMember(
 				interfaceType,
 				declarationMember,
@@ -657,7 +689,7 @@ func (checker *Checker) checkInterfaceConformance(
 		// Add to the inherited members list, only if it's not a duplicated, to avoid redundant errors.
 		if !isDuplicate {
 			inheritedMembers = append(inheritedMembers, conformanceMember)
-			inheritedMembersByName[name] = inheritedMembers
+			inheritedMembersByName[identifier] = inheritedMembers
 		}
 	})
 }
@@ -19,6 +19,8 @@
 package sema
 
 import (
+	"golang.org/x/exp/slices"
+
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
 )
@@ -89,6 +91,14 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 	// check the invoked expression can be invoked
 
 	invokedExpression := invocationExpression.InvokedExpression
+
+	// If the invoked expression is an identifier which refers to an overloaded function,
+	// resolve the overload based on the argument labels of the invocation
+
+	if identifierExpression, ok := invokedExpression.(*ast.IdentifierExpression); ok {
+		checker.resolveIdentifierFunctionOverload(invocationExpression, identifierExpression)
+	}
+
 	expressionType := checker.VisitExpression(invokedExpression, invocationExpression, nil)
 
 	// `inInvocation` should be reset before visiting arguments
@@ -440,6 +450,18 @@ func (checker *Checker) checkInvocation(
 		typeArguments,
 	)
 
+	// The function type might refer to type parameters which are in scope,
+	// e.g. the type parameters of an enclosing generic function or composite type.
+	// Bind them to themselves, so they are left as-is when unifying and resolving.
+	//
+	// NOTE: The bindings are removed again before the type arguments are recorded,
+	// so the recorded type arguments only contain the function's own type parameters
+
+	boundTypeParametersInScope := checker.bindTypeParametersInScope(
+		functionType.TypeParameters,
+		typeArguments,
+	)
+
 	// Check that the invocation's argument count matches the function's parameter count
 
 	argumentCount := len(invocationExpression.Arguments)
@@ -507,6 +529,10 @@ func (checker *Checker) checkInvocation(
 		returnType = InvalidType
 	}
 
+	for _, typeParameter := range boundTypeParametersInScope {
+		typeArguments.Delete(typeParameter)
+	}
+
 	// Check all type parameters have been bound to a type.
 
 	checker.checkTypeParameterInference(
@@ -542,6 +568,46 @@ func (checker *Checker) checkInvocation(
 	return argumentTypes, returnType
 }
 
+// bindTypeParametersInScope binds all type parameters which are in scope to themselves,
+// unless they are the given function type parameters or are already bound.
+// It returns the bound type parameters.
+func (checker *Checker) bindTypeParametersInScope(
+	functionTypeParameters []*TypeParameter,
+	typeArguments *TypeParameterTypeOrderedMap,
+) (boundTypeParameters []*TypeParameter) {
+	for _, typeParameter := range checker.typeParametersInScope {
+		if slices.Contains(functionTypeParameters, typeParameter) ||
+			typeArguments.Contains(typeParameter) {
+
+			continue
+		}
+
+		typeArguments.Set(
+			typeParameter,
+			&GenericType{
+				TypeParameter: typeParameter,
+			},
+		)
+
+		boundTypeParameters = append(boundTypeParameters, typeParameter)
+	}
+
+	return
+}
+
+// allTypeParametersBound returns true if all given type parameters are bound to a type
+func allTypeParametersBound(
+	typeParameters []*TypeParameter,
+	typeArguments *TypeParameterTypeOrderedMap,
+) bool {
+	for _, typeParameter := range typeParameters {
+		if !typeArguments.Contains(typeParameter) {
+			return false
+		}
+	}
+	return true
+}
+
 // checkTypeParameterInference checks that all type parameters
 // of the given generic function type have been assigned a type.
 func (checker *Checker) checkTypeParameterInference(
@@ -591,7 +657,7 @@ func (checker *Checker) checkInvocationRequiredArgument(
 	// If all type parameters have been bound to a type,
 	// then resolve the parameter type with the type arguments,
 	// and propose the parameter type as the expected type for the argument.
-	if typeParameters.Len() == typeParameterCount {
+	if allTypeParametersBound(functionType.TypeParameters, typeParameters) {
 
 		// Optimization: only resolve if there are type parameters.
 		// This avoids unnecessary work for non-generic functions.
@@ -796,13 +862,17 @@ func (checker *Checker) checkInvocationArgumentParameterTypeCompatibility(
 
 	if !checker.checkTypeCompatibility(argument, argumentType, parameterType) {
 
-		checker.report(
-			&TypeMismatchError{
-				ExpectedType: parameterType,
-				ActualType:   argumentType,
-				Range:        ast.NewRangeFromPositioned(checker.memoryGauge, argument),
-			},
-		)
+		errorRange := ast.NewRangeFromPositioned(checker.memoryGauge, argument)
+
+		if !checker.deferTypeCheck(argument, parameterType, argumentType, errorRange) {
+			checker.report(
+				&TypeMismatchError{
+					ExpectedType: parameterType,
+					ActualType:   argumentType,
+					Range:        errorRange,
+				},
+			)
+		}
 	}
 }
 
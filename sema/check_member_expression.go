@@ -143,6 +143,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 	}
 
 	returnReference := false
+	var overloadName string
 
 	defer func() {
 		checker.Elaboration.SetMemberExpressionMemberAccessInfo(
@@ -151,6 +152,7 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 				AccessedType:    accessedType,
 				ResultingType:   resultingType,
 				Member:          member,
+				OverloadName:    overloadName,
 				IsOptional:      isOptional,
 				ReturnReference: returnReference,
 			},
@@ -209,7 +211,32 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 	// However, for some types (e.g. reference types) this depends on what type is referenced
 
 	getMemberForType := func(expressionType Type) {
-		resolver, ok := expressionType.GetMembers()[identifier]
+		members := expressionType.GetMembers()
+
+		// If the function is overloaded, resolve the overload based on the argument labels
+		// of the invocation, or, if the member is not invoked, based on the expected type
+
+		var resolvedName string
+		if invocationExpression, ok := checker.invokingExpression(expression); ok {
+			resolvedName = checker.resolveMemberFunctionOverload(members, identifier, invocationExpression)
+		} else {
+			resolvedName = checker.resolveMemberFunctionReference(members, identifier, expression)
+		}
+
+		if resolvedName != identifier {
+			resolver := members[resolvedName]
+			member = resolver.Resolve(
+				checker.memoryGauge,
+				identifier,
+				expression.Expression,
+				checker.report,
+			)
+			resultingType = member.TypeAnnotation.Type
+			overloadName = resolvedName
+			return
+		}
+
+		resolver, ok := members[identifier]
 		if !ok {
 			return
 		}
@@ -299,6 +326,20 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 		)
 	}
 
+	// Functions declared in interfaces are dispatched dynamically,
+	// and the conforming composite type may declare the function as an overload.
+	// Look up such functions by their argument labels.
+	// Built-in functions have no argument labels and are never overloaded
+
+	if overloadName == "" &&
+		member.DeclarationKind == common.DeclarationKindFunction &&
+		member.ArgumentLabels != nil {
+
+		if _, ok := member.ContainerType.(*InterfaceType); ok {
+			overloadName = FunctionOverloadName(identifier, member.ArgumentLabels)
+		}
+	}
+
 	// Check access and report if inaccessible
 	accessRange := func() ast.Range { return ast.NewRangeFromPositioned(checker.memoryGauge, expression) }
 	isReadable, resultingAuthorization := checker.isReadableMember(accessedType, member, resultingType, accessRange)
@@ -324,6 +365,8 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression, isAssignme
 		)
 	}
 
+	checker.checkHashingFunctionMemberAccess(member, expression)
+
 	// the resulting authorization was mapped through an entitlement map, so we need to substitute this new authorization into the resulting type
 	// i.e. if the field was declared with `access(M) let x: auth(M) &T?`, and we computed that the output of the map would give entitlement `E`,
 	// we substitute this entitlement in for the "variable" `M` to produce `auth(E) &T?`, the access with which the type is actually produced.
@@ -38,9 +38,35 @@ func (checker *Checker) VisitPathExpression(expression *ast.PathExpression) Type
 
 	checker.report(err)
 
+	for _, component := range expression.Components {
+		checker.checkPathComponent(expression, component)
+	}
+
 	return ty
 }
 
+// checkPathComponent checks the component of an extended path.
+// Only values which have a canonical encoding can be embedded in paths,
+// see common.PathComponent
+func (checker *Checker) checkPathComponent(expression *ast.PathExpression, component ast.Expression) {
+	componentType := checker.VisitExpression(component, expression, nil)
+
+	if componentType.IsInvalidType() ||
+		IsSubType(componentType, TheAddressType) ||
+		IsSubType(componentType, IntegerType) ||
+		IsSubType(componentType, MetaType) {
+
+		return
+	}
+
+	checker.report(
+		&InvalidPathComponentTypeError{
+			Type:  componentType,
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, component),
+		},
+	)
+}
+
 var isValidIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`).MatchString
 
 func CheckPathLiteral(
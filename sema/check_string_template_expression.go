@@ -0,0 +1,83 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+func (checker *Checker) VisitStringTemplateExpression(expression *ast.StringTemplateExpression) Type {
+
+	for _, interpolatedExpression := range expression.Expressions {
+		interpolatedType := checker.VisitExpression(interpolatedExpression, expression, nil)
+
+		if interpolatedType.IsInvalidType() ||
+			checker.isStringTemplateInterpolatableType(interpolatedType, interpolatedExpression) {
+
+			continue
+		}
+
+		checker.report(
+			&InvalidStringTemplateInterpolationError{
+				Type: interpolatedType,
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					interpolatedExpression,
+				),
+			},
+		)
+	}
+
+	return StringType
+}
+
+// isStringTemplateInterpolatableType returns true if values of the given type
+// can be interpolated into a string template:
+// Strings and characters are interpolated as-is,
+// and all other non-resource types must have a public `toString` function.
+func (checker *Checker) isStringTemplateInterpolatableType(ty Type, pos ast.HasPosition) bool {
+	if ty.IsResourceType() {
+		return false
+	}
+
+	switch ty {
+	case StringType, CharacterType:
+		return true
+	}
+
+	resolver, ok := ty.GetMembers()[ToStringFunctionName]
+	if !ok {
+		return false
+	}
+
+	member := resolver.Resolve(checker.memoryGauge, ToStringFunctionName, pos, checker.report)
+	if member == nil ||
+		member.DeclarationKind != common.DeclarationKindFunction ||
+		!checker.Config.AccessCheckMode.IsReadableAccess(member.Access) {
+
+		return false
+	}
+
+	functionType, ok := member.TypeAnnotation.Type.(*FunctionType)
+	return ok &&
+		len(functionType.TypeParameters) == 0 &&
+		len(functionType.Parameters) == 0 &&
+		functionType.ReturnTypeAnnotation.Type.Equal(StringType)
+}
@@ -20,6 +20,8 @@ package sema
 
 import (
 	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_ struct{}) {
@@ -28,9 +30,13 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_
 
 	testTypeIsValid := !testType.IsInvalidType()
 
-	// The test expression must be equatable
+	// The test expression must be equatable,
+	// if it is compared to the expressions of the cases
+
+	if testTypeIsValid &&
+		switchRequiresEquatableTest(statement.Cases) &&
+		!testType.IsEquatable() {
 
-	if testTypeIsValid && !testType.IsEquatable() {
 		checker.report(
 			&NotEquatableTypeError{
 				Type:  testType,
@@ -39,6 +45,16 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_
 		)
 	}
 
+	// A resource must be moved into the switch statement,
+	// and may only be matched by type patterns, which take ownership of it
+
+	isResource := testTypeIsValid && testType.IsResourceType()
+
+	if isResource {
+		checker.checkResourceMoveOperation(statement.Expression, testType)
+		checker.checkResourceSwitchCases(statement.Cases)
+	}
+
 	// Check all cases
 
 	checker.functionActivations.Current().WithSwitch(func() {
@@ -50,9 +66,176 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_
 		)
 	})
 
+	if isResource {
+		checker.checkResourceSwitchExhaustiveness(statement, testType)
+	}
+
+	if testTypeIsValid {
+		checker.checkEnumSwitchExhaustiveness(statement, testType)
+	}
+
 	return
 }
 
+// switchRequiresEquatableTest returns true if the test value of a switch statement
+// with the given cases must be equatable.
+// Switch statements without patterns always compare the test value using equality.
+// Switch statements with patterns only do if they have expression cases other than `nil`,
+// as any optional value can be compared to `nil`.
+func switchRequiresEquatableTest(cases []*ast.SwitchCase) bool {
+	hasPatterns := false
+
+	for _, switchCase := range cases {
+		if switchCase.Pattern != nil {
+			hasPatterns = true
+			continue
+		}
+
+		switch switchCase.Expression.(type) {
+		case nil, *ast.NilExpression:
+			continue
+		default:
+			return true
+		}
+	}
+
+	return !hasPatterns
+}
+
+func (checker *Checker) checkResourceSwitchCases(cases []*ast.SwitchCase) {
+	for _, switchCase := range cases {
+		pattern := switchCase.Pattern
+		if pattern == nil ||
+			pattern.Kind != ast.SwitchPatternKindType ||
+			switchCase.Guard != nil {
+
+			checker.report(
+				&InvalidResourceSwitchCaseError{
+					Range: switchCase.Range,
+				},
+			)
+		}
+	}
+}
+
+// checkResourceSwitchExhaustiveness checks that a switch over a resource
+// is guaranteed to take one of its cases, i.e. that the last case matches any value,
+// as the resource would otherwise be lost
+func (checker *Checker) checkResourceSwitchExhaustiveness(statement *ast.SwitchStatement, testType Type) {
+	caseCount := len(statement.Cases)
+	if caseCount > 0 {
+		pattern := statement.Cases[caseCount-1].Pattern
+		if pattern == nil || pattern.Kind != ast.SwitchPatternKindType {
+			// Already reported as an invalid case
+			return
+		}
+
+		targetType := checker.Elaboration.SwitchPatternTypes(pattern).TargetType
+		if targetType == nil ||
+			targetType.IsInvalidType() ||
+			IsSubType(testType, targetType) {
+
+			return
+		}
+	}
+
+	checker.report(
+		&ResourceLossError{
+			Range: ast.NewRangeFromPositioned(checker.memoryGauge, statement.Expression),
+		},
+	)
+}
+
+// checkEnumSwitchExhaustiveness reports a warning if the switch is over an enum,
+// has no default case, and does not cover all cases of the enum
+func (checker *Checker) checkEnumSwitchExhaustiveness(statement *ast.SwitchStatement, testType Type) {
+	enumType, ok := testType.(*CompositeType)
+	if !ok ||
+		enumType.Kind != common.CompositeKindEnum ||
+		len(enumType.EnumCases) == 0 {
+
+		return
+	}
+
+	coveredCases := map[string]struct{}{}
+
+	for _, switchCase := range statement.Cases {
+		if switchCase.IsDefault() {
+			return
+		}
+
+		// A case with a guard might not match, even if its pattern does
+		if switchCase.Guard != nil {
+			continue
+		}
+
+		// A type pattern for the enum type matches all cases
+		if pattern := switchCase.Pattern; pattern != nil {
+			if pattern.Kind == ast.SwitchPatternKindType {
+				targetType := checker.Elaboration.SwitchPatternTypes(pattern).TargetType
+				if targetType != nil && IsSubType(enumType, targetType) {
+					return
+				}
+			}
+			continue
+		}
+
+		caseName, ok := checker.enumCaseName(switchCase.Expression, enumType)
+		if ok {
+			coveredCases[caseName] = struct{}{}
+		}
+	}
+
+	var missingCases []string
+	for _, caseName := range enumType.EnumCases {
+		if _, ok := coveredCases[caseName]; !ok {
+			missingCases = append(missingCases, caseName)
+		}
+	}
+
+	if len(missingCases) == 0 {
+		return
+	}
+
+	checker.reportWarning(
+		&NonExhaustiveSwitchWarning{
+			Type:         enumType,
+			MissingCases: missingCases,
+			Range:        ast.NewRangeFromPositioned(checker.memoryGauge, statement.Expression),
+		},
+	)
+}
+
+// enumCaseName returns the name of the enum case
+// if the given expression is a member expression that refers to a case of the given enum,
+// e.g. `E.a`
+func (checker *Checker) enumCaseName(expression ast.Expression, enumType *CompositeType) (string, bool) {
+	memberExpression, ok := expression.(*ast.MemberExpression)
+	if !ok {
+		return "", false
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberAccessInfo(memberExpression)
+	if !ok || memberInfo.Member == nil {
+		return "", false
+	}
+
+	// Enum cases are members of the enum's constructor function
+
+	member := memberInfo.Member
+
+	constructorType, ok := member.ContainerType.(*FunctionType)
+	if !ok ||
+		!constructorType.IsConstructor ||
+		member.DeclarationKind != common.DeclarationKindField ||
+		!member.TypeAnnotation.Type.Equal(enumType) {
+
+		return "", false
+	}
+
+	return member.Identifier.Identifier, true
+}
+
 func (checker *Checker) checkSwitchCaseExpression(
 	statement *ast.SwitchStatement,
 	caseExpression ast.Expression,
@@ -109,10 +292,8 @@ func (checker *Checker) checkSwitchCasesStatements(
 
 	switchCase := remainingCases[0]
 
-	caseExpression := switchCase.Expression
-
-	// If the case has no expression, it is a default case
-	if caseExpression == nil {
+	// If the case has neither an expression nor a pattern, it is a default case
+	if switchCase.IsDefault() {
 
 		// Only one default case is allowed, as the last case
 		defaultAllowed := remainingCaseCount == 1
@@ -130,17 +311,46 @@ func (checker *Checker) checkSwitchCasesStatements(
 		return
 	}
 
-	checker.checkSwitchCaseExpression(
-		statement,
-		caseExpression,
-		testType,
-		testTypeIsValid,
-	)
+	// The variable bound by the pattern of the case, if any
+	var bindingType Type
+
+	if switchCase.Pattern != nil {
+		bindingType = checker.checkSwitchPattern(
+			statement,
+			switchCase.Pattern,
+			testType,
+			testTypeIsValid,
+		)
+	} else {
+		checker.checkSwitchCaseExpression(
+			statement,
+			switchCase.Expression,
+			testType,
+			testTypeIsValid,
+		)
+	}
+
+	// NOTE: The guard is checked before the branches, as it is evaluated
+	// regardless of whether the case is taken or not
+
+	if switchCase.Guard != nil {
+		checker.checkSwitchCaseGuard(statement, switchCase, bindingType)
+	}
 
 	_, _ = checker.checkConditionalBranches(
 		func() Type {
 
 			currentFunctionActivation.ReturnInfo.WithNewJumpTarget(func() {
+				if bindingType == nil {
+					checker.checkSwitchCaseStatements(switchCase)
+					return
+				}
+
+				checker.enterValueScope()
+				defer checker.leaveValueScope(switchCase.EndPosition, true)
+
+				checker.declareSwitchPatternVariable(switchCase.Pattern, bindingType, true)
+
 				checker.checkSwitchCaseStatements(switchCase)
 			})
 
@@ -161,6 +371,191 @@ func (checker *Checker) checkSwitchCasesStatements(
 	)
 }
 
+// checkSwitchPattern checks the pattern of a switch case,
+// and returns the type of the variable bound by the pattern, if any
+func (checker *Checker) checkSwitchPattern(
+	statement *ast.SwitchStatement,
+	pattern *ast.SwitchPattern,
+	testType Type,
+	testTypeIsValid bool,
+) Type {
+
+	switch pattern.Kind {
+	case ast.SwitchPatternKindType:
+		targetTypeAnnotation := checker.ConvertTypeAnnotation(pattern.TypeAnnotation)
+		checker.checkTypeAnnotation(targetTypeAnnotation, pattern.TypeAnnotation)
+
+		targetType := targetTypeAnnotation.Type
+
+		if testTypeIsValid && !targetType.IsInvalidType() {
+			checker.checkSwitchTypePattern(pattern, testType, targetType)
+		}
+
+		checker.Elaboration.SetSwitchPatternTypes(
+			pattern,
+			SwitchPatternTypes{
+				ValueType:  testType,
+				TargetType: targetType,
+			},
+		)
+
+		return targetType
+
+	case ast.SwitchPatternKindOptional:
+		if !testTypeIsValid {
+			return InvalidType
+		}
+
+		optionalType, ok := testType.(*OptionalType)
+		if !ok {
+			checker.report(
+				&TypeMismatchWithDescriptionError{
+					ExpectedTypeDescription: "optional",
+					ActualType:              testType,
+					Range:                   pattern.Range,
+				},
+			)
+
+			return InvalidType
+		}
+
+		innerType := optionalType.Type
+
+		checker.Elaboration.SetSwitchPatternTypes(
+			pattern,
+			SwitchPatternTypes{
+				ValueType:  testType,
+				TargetType: innerType,
+			},
+		)
+
+		return innerType
+
+	case ast.SwitchPatternKindRange:
+		var expectedType Type
+
+		if testTypeIsValid {
+			if IsSubType(testType, IntegerType) {
+				expectedType = &InclusiveRangeType{
+					MemberType: testType,
+				}
+			} else {
+				checker.report(
+					&TypeMismatchWithDescriptionError{
+						ExpectedTypeDescription: "integer",
+						ActualType:              testType,
+						Range:                   pattern.Range,
+					},
+				)
+			}
+		}
+
+		rangeType := checker.VisitExpression(pattern.Expression, statement, expectedType)
+
+		checker.Elaboration.SetSwitchPatternTypes(
+			pattern,
+			SwitchPatternTypes{
+				ValueType:  testType,
+				TargetType: rangeType,
+			},
+		)
+
+		// Range patterns do not bind a variable
+		return nil
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+// checkSwitchTypePattern checks that a type pattern may match the test value,
+// similar to a failable cast
+func (checker *Checker) checkSwitchTypePattern(
+	pattern *ast.SwitchPattern,
+	testType Type,
+	targetType Type,
+) {
+	typeAnnotationRange := ast.NewRangeFromPositioned(checker.memoryGauge, pattern.TypeAnnotation)
+
+	if testType.IsResourceType() {
+		if !targetType.IsResourceType() {
+			checker.report(
+				&AlwaysFailingNonResourceCastingTypeError{
+					ValueType:  testType,
+					TargetType: targetType,
+					Range:      typeAnnotationRange,
+				},
+			)
+			return
+		}
+	} else {
+		if targetType.IsResourceType() {
+			checker.report(
+				&AlwaysFailingResourceCastingTypeError{
+					ValueType:  testType,
+					TargetType: targetType,
+					Range:      typeAnnotationRange,
+				},
+			)
+			return
+		}
+	}
+
+	if !FailableCastCanSucceed(testType, targetType) {
+		checker.report(
+			&TypeMismatchError{
+				ActualType:   testType,
+				ExpectedType: targetType,
+				Range:        typeAnnotationRange,
+			},
+		)
+	}
+}
+
+func (checker *Checker) checkSwitchCaseGuard(
+	statement *ast.SwitchStatement,
+	switchCase *ast.SwitchCase,
+	bindingType Type,
+) {
+	if bindingType == nil {
+		checker.VisitExpression(switchCase.Guard, statement, BoolType)
+		return
+	}
+
+	// The variable bound by the pattern is available in the guard.
+	// It is declared again for the statements of the case
+
+	checker.enterValueScope()
+	defer checker.leaveValueScope(switchCase.Guard.EndPosition, false)
+
+	checker.declareSwitchPatternVariable(switchCase.Pattern, bindingType, false)
+
+	checker.VisitExpression(switchCase.Guard, statement, BoolType)
+}
+
+func (checker *Checker) declareSwitchPatternVariable(
+	pattern *ast.SwitchPattern,
+	ty Type,
+	recordOccurrence bool,
+) {
+	identifier := pattern.Identifier.Identifier
+
+	variable, err := checker.valueActivations.declare(variableDeclaration{
+		identifier:               identifier,
+		ty:                       ty,
+		kind:                     common.DeclarationKindConstant,
+		pos:                      pattern.Identifier.Pos,
+		isConstant:               true,
+		argumentLabels:           nil,
+		allowOuterScopeShadowing: true,
+		access:                   PrimitiveAccess(ast.AccessNotSpecified),
+	})
+	checker.report(err)
+
+	if recordOccurrence && checker.PositionInfo != nil && variable != nil {
+		checker.recordVariableDeclarationOccurrence(identifier, variable)
+	}
+}
+
 func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 
 	// Switch-cases must have at least one statement.
@@ -88,7 +88,7 @@ func (checker *Checker) VisitTransactionDeclaration(declaration *ast.Transaction
 func (checker *Checker) checkTransactionParameters(declaration *ast.TransactionDeclaration, parameters []Parameter) {
 	checker.checkArgumentLabels(declaration.ParameterList)
 	checker.checkParameters(declaration.ParameterList, parameters)
-	checker.declareParameters(declaration.ParameterList, parameters)
+	checker.declareParameters(declaration.ParameterList, parameters, false)
 
 	// Check parameter types
 
@@ -193,6 +193,7 @@ func (checker *Checker) visitTransactionPrepareFunction(
 		true,
 		initializationInfo,
 		true,
+		false,
 	)
 
 	checker.checkTransactionPrepareFunctionParameters(
@@ -212,10 +213,18 @@ func (checker *Checker) checkTransactionPrepareFunctionParameters(
 		if !parameterType.IsInvalidType() &&
 			!IsSubType(parameterType, AccountReferenceType) {
 
+			// In the loose mode, the type annotation may be omitted
+			var errorRange ast.Range
+			if parameter.TypeAnnotation != nil {
+				errorRange = ast.NewRangeFromPositioned(checker.memoryGauge, parameter.TypeAnnotation)
+			} else {
+				errorRange = ast.NewRangeFromPositioned(checker.memoryGauge, parameter)
+			}
+
 			checker.report(
 				&InvalidTransactionPrepareParameterTypeError{
 					Type:  parameterType,
-					Range: ast.NewRangeFromPositioned(checker.memoryGauge, parameter.TypeAnnotation),
+					Range: errorRange,
 				},
 			)
 		}
@@ -243,6 +252,7 @@ func (checker *Checker) visitTransactionExecuteFunction(
 		true,
 		nil,
 		true,
+		false,
 	)
 }
 
@@ -0,0 +1,44 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/ast"
+)
+
+func (checker *Checker) VisitTryStatement(statement *ast.TryStatement) (_ struct{}) {
+
+	// Either the try block completes successfully,
+	// or it fails, all its effects are reverted, and the catch block is evaluated.
+	// The catch block hence starts from the state before the try block,
+	// just like the branches of a conditional.
+
+	checker.checkConditionalBranches(
+		func() Type {
+			checker.checkBlock(statement.Block)
+			return nil
+		},
+		func() Type {
+			checker.checkBlock(statement.CatchBlock)
+			return nil
+		},
+	)
+
+	return
+}
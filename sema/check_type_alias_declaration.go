@@ -0,0 +1,248 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import "github.com/onflow/cadence/ast"
+
+type typeAliasResolutionState uint8
+
+const (
+	typeAliasUnresolved typeAliasResolutionState = iota
+	typeAliasResolving
+	typeAliasResolved
+)
+
+// declareTypeAliases declares the given type alias declarations in the current type scope.
+//
+// Type aliases may refer to other type aliases of the same scope, independent of the order
+// in which they are declared, so the aliased types are resolved on demand.
+// Type aliases which refer to themselves, directly or indirectly, are reported
+// and declared as invalid.
+//
+// Type aliases which were already resolved before, e.g. when the nested types
+// of a composite are declared again when it is checked, are just re-declared.
+func (checker *Checker) declareTypeAliases(declarations []*ast.TypeAliasDeclaration) {
+	if len(declarations) == 0 {
+		return
+	}
+
+	declarationsByName := make(map[string]*ast.TypeAliasDeclaration, len(declarations))
+	states := make(map[*ast.TypeAliasDeclaration]typeAliasResolutionState, len(declarations))
+
+	for _, declaration := range declarations {
+		name := declaration.Identifier.Identifier
+		if _, ok := declarationsByName[name]; !ok {
+			declarationsByName[name] = declaration
+		}
+	}
+
+	var resolve func(declaration *ast.TypeAliasDeclaration)
+	resolve = func(declaration *ast.TypeAliasDeclaration) {
+		switch states[declaration] {
+		case typeAliasResolved:
+			return
+
+		case typeAliasResolving:
+			checker.report(
+				&CyclicTypeAliasError{
+					Name: declaration.Identifier.Identifier,
+					Range: ast.NewRangeFromPositioned(
+						checker.memoryGauge,
+						declaration.Identifier,
+					),
+				},
+			)
+			checker.declareTypeAlias(declaration, InvalidType)
+			states[declaration] = typeAliasResolved
+			return
+		}
+
+		states[declaration] = typeAliasResolving
+
+		// Resolve the type aliases of the same scope which the aliased type refers to first
+
+		forEachTypeNominalIdentifier(
+			declaration.TypeAnnotation.Type,
+			func(identifier ast.Identifier) {
+				dependency, ok := declarationsByName[identifier.Identifier]
+				if !ok {
+					return
+				}
+				resolve(dependency)
+			},
+		)
+
+		// The type alias might have been resolved while resolving its dependencies,
+		// i.e. if it is part of a cycle
+
+		if states[declaration] == typeAliasResolved {
+			return
+		}
+
+		typeAnnotation := checker.ConvertTypeAnnotation(declaration.TypeAnnotation)
+		checker.checkTypeAnnotation(typeAnnotation, declaration.TypeAnnotation)
+
+		checker.declareTypeAlias(declaration, typeAnnotation.Type)
+		states[declaration] = typeAliasResolved
+	}
+
+	for _, declaration := range declarations {
+
+		aliasedType := checker.Elaboration.TypeAliasDeclarationType(declaration)
+		if aliasedType != nil {
+
+			// NOTE: We allow the shadowing of types here, because the type alias was already previously
+			// declared without allowing shadowing before. This avoids a duplicate error message.
+
+			_, _ = checker.typeActivations.declareType(typeDeclaration{
+				identifier:               declaration.Identifier,
+				ty:                       aliasedType,
+				declarationKind:          declaration.DeclarationKind(),
+				access:                   checker.accessFromAstAccess(declaration.Access),
+				docString:                declaration.DocString,
+				allowOuterScopeShadowing: true,
+			})
+
+			states[declaration] = typeAliasResolved
+			continue
+		}
+
+		resolve(declaration)
+	}
+}
+
+func (checker *Checker) declareTypeAlias(declaration *ast.TypeAliasDeclaration, aliasedType Type) {
+	identifier := declaration.Identifier
+
+	variable, err := checker.typeActivations.declareType(typeDeclaration{
+		identifier:               identifier,
+		ty:                       aliasedType,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   checker.accessFromAstAccess(declaration.Access),
+		docString:                declaration.DocString,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+	if checker.PositionInfo != nil && variable != nil {
+		checker.recordVariableDeclarationOccurrence(
+			identifier.Identifier,
+			variable,
+		)
+	}
+
+	checker.Elaboration.SetTypeAliasDeclarationType(declaration, aliasedType)
+}
+
+// declareNestedTypeAliases declares the given, already declared type aliases of a composite or interface
+// in the composite or interface, so they can be referred to from outside of it, e.g. `C.Alias`.
+//
+// Like other nested type declarations, type aliases of composites and interfaces must be public.
+// Type aliases which have the same name as a nested type declaration were already reported as redeclarations
+func (checker *Checker) declareNestedTypeAliases(
+	containerType TypeAliasContainerType,
+	declarations []*ast.TypeAliasDeclaration,
+) {
+	for _, declaration := range declarations {
+		name := declaration.Identifier.Identifier
+
+		nestedTypes := containerType.GetNestedTypes()
+		if nestedTypes != nil {
+			if _, ok := nestedTypes.Get(name); ok {
+				continue
+			}
+		}
+
+		aliasedType := checker.Elaboration.TypeAliasDeclarationType(declaration)
+		if aliasedType == nil {
+			continue
+		}
+
+		containerType.SetNestedTypeAlias(name, aliasedType)
+	}
+}
+
+// forEachTypeNominalIdentifier calls the given function
+// for the leading identifier of each nominal type in the given type
+func forEachTypeNominalIdentifier(ty ast.Type, f func(identifier ast.Identifier)) {
+	switch ty := ty.(type) {
+	case *ast.NominalType:
+		f(ty.Identifier)
+
+	case *ast.OptionalType:
+		forEachTypeNominalIdentifier(ty.Type, f)
+
+	case *ast.VariableSizedType:
+		forEachTypeNominalIdentifier(ty.Type, f)
+
+	case *ast.ConstantSizedType:
+		forEachTypeNominalIdentifier(ty.Type, f)
+
+	case *ast.DictionaryType:
+		forEachTypeNominalIdentifier(ty.KeyType, f)
+		forEachTypeNominalIdentifier(ty.ValueType, f)
+
+	case *ast.FunctionType:
+		for _, parameterTypeAnnotation := range ty.ParameterTypeAnnotations {
+			forEachTypeNominalIdentifier(parameterTypeAnnotation.Type, f)
+		}
+		if ty.ReturnTypeAnnotation != nil {
+			forEachTypeNominalIdentifier(ty.ReturnTypeAnnotation.Type, f)
+		}
+
+	case *ast.ReferenceType:
+		forEachTypeNominalIdentifier(ty.Type, f)
+
+	case *ast.IntersectionType:
+		if ty.LegacyRestrictedType != nil {
+			forEachTypeNominalIdentifier(ty.LegacyRestrictedType, f)
+		}
+		for _, intersectedType := range ty.Types {
+			forEachTypeNominalIdentifier(intersectedType, f)
+		}
+
+	case *ast.InstantiationType:
+		forEachTypeNominalIdentifier(ty.Type, f)
+		for _, typeArgument := range ty.TypeArguments {
+			forEachTypeNominalIdentifier(typeArgument.Type, f)
+		}
+	}
+}
+
+func (checker *Checker) VisitTypeAliasDeclaration(declaration *ast.TypeAliasDeclaration) (_ struct{}) {
+
+	// Type aliases of programs and composites were already declared before,
+	// local type aliases are declared when they are visited
+
+	aliasedType := checker.Elaboration.TypeAliasDeclarationType(declaration)
+	if aliasedType == nil {
+		checker.declareTypeAliases([]*ast.TypeAliasDeclaration{declaration})
+		aliasedType = checker.Elaboration.TypeAliasDeclarationType(declaration)
+	}
+
+	checker.checkDeclarationAccessModifier(
+		checker.accessFromAstAccess(declaration.Access),
+		declaration.DeclarationKind(),
+		aliasedType,
+		nil,
+		declaration.StartPos,
+		true,
+	)
+
+	return
+}
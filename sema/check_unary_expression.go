@@ -49,8 +49,15 @@ func (checker *Checker) VisitUnaryExpression(expression *ast.UnaryExpression) Ty
 	}
 
 	checkExpectedType := func(valueType, expectedType Type) Type {
-		if !valueType.IsInvalidType() &&
-			!IsSameTypeKind(valueType, expectedType) {
+		// Distinct types which opted in to the operations of their underlying type
+		// support the operations of the underlying type
+		operandType := valueType
+		if underlyingType := DistinctOperandType(valueType); underlyingType != nil {
+			operandType = underlyingType
+		}
+
+		if !operandType.IsInvalidType() &&
+			!IsSameTypeKind(operandType, expectedType) {
 
 			reportInvalidUnaryOperator(expectedType)
 			return InvalidType
@@ -64,6 +71,11 @@ func (checker *Checker) VisitUnaryExpression(expression *ast.UnaryExpression) Ty
 		return checkExpectedType(valueType, BoolType)
 
 	case ast.OperationMinus:
+		// Durations can be negated
+		if valueType == DurationType {
+			return valueType
+		}
+
 		return checkExpectedType(valueType, SignedNumberType)
 
 	case ast.OperationMul:
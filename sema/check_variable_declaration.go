@@ -32,6 +32,19 @@ func (checker *Checker) VisitVariableDeclaration(declaration *ast.VariableDeclar
 
 func (checker *Checker) visitVariableDeclarationValues(declaration *ast.VariableDeclaration, isOptionalBinding bool) Type {
 
+	// Destructuring is only supported for local variable declarations,
+	// i.e. not for global variable declarations and optional bindings (`if let`)
+
+	if declaration.Pattern != nil &&
+		(isOptionalBinding || !checker.functionActivations.IsLocal()) {
+
+		checker.report(
+			&UnsupportedDestructuringError{
+				Range: declaration.Pattern.Range,
+			},
+		)
+	}
+
 	// Determine the type of the initial value of the variable declaration
 	// and save it in the elaboration
 
@@ -218,6 +231,11 @@ func (checker *Checker) visitVariableDeclarationValues(declaration *ast.Variable
 }
 
 func (checker *Checker) declareVariableDeclaration(declaration *ast.VariableDeclaration, declarationType Type) {
+	if declaration.Pattern != nil {
+		checker.declareVariableDeclarationPattern(declaration, declarationType)
+		return
+	}
+
 	// Finally, declare the variable in the current value activation
 
 	identifier := declaration.Identifier.Identifier
@@ -243,6 +261,35 @@ func (checker *Checker) declareVariableDeclaration(declaration *ast.VariableDecl
 	checker.recordReference(variable, declaration.Value)
 }
 
+func (checker *Checker) declareVariableDeclarationPattern(declaration *ast.VariableDeclaration, declarationType Type) {
+	// Declare a variable for each element of the pattern in the current value activation
+
+	pattern := declaration.Pattern
+
+	elementTypes := checker.checkDestructuringPattern(pattern, declarationType, declaration)
+
+	variables := checker.declareDestructuringPattern(
+		pattern,
+		elementTypes,
+		variableDeclaration{
+			docString:                declaration.DocString,
+			access:                   checker.accessFromAstAccess(declaration.Access),
+			kind:                     declaration.DeclarationKind(),
+			isConstant:               declaration.IsConstant,
+			argumentLabels:           nil,
+			allowOuterScopeShadowing: true,
+		},
+	)
+
+	for _, variable := range variables {
+		if checker.PositionInfo != nil {
+			checker.recordVariableDeclarationRange(declaration, variable.Identifier, variable.Type)
+		}
+
+		checker.recordReference(variable, declaration.Value)
+	}
+}
+
 func (checker *Checker) recordVariableDeclarationRange(
 	declaration *ast.VariableDeclaration,
 	identifier string,
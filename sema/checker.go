@@ -24,6 +24,7 @@ import (
 	"math/big"
 
 	"github.com/rivo/uniseg"
+	"golang.org/x/exp/slices"
 
 	"github.com/onflow/cadence/ast"
 	"github.com/onflow/cadence/common"
@@ -82,6 +83,8 @@ type LocationHandlerFunc func(identifiers []ast.Identifier, location common.Loca
 
 type ImportHandlerFunc func(checker *Checker, importedLocation common.Location, importRange ast.Range) (Import, error)
 
+type ImportHashHandlerFunc func(checker *Checker, importedLocation common.Location) ([]byte, error)
+
 type MemberAccountAccessHandlerFunc func(checker *Checker, memberLocation common.Location) bool
 
 type PurityCheckScope struct {
@@ -112,12 +115,17 @@ type Checker struct {
 	PositionInfo            *PositionInfo
 	Config                  *Config
 	Elaboration             *Elaboration
+	// functionOverloadNames are the function overload names of the overloaded functions and constructors,
+	// declared as values, by identifier
+	functionOverloadNames map[string][]string
 	// initialized lazily. use beforeExtractor()
 	_beforeExtractor                   *BeforeExtractor
 	errors                             []error
+	warnings                           []error
 	functionActivations                *FunctionActivations
 	purityCheckScopes                  []PurityCheckScope
 	entitlementMappingInScope          *EntitlementMapType
+	typeParametersInScope              []*TypeParameter
 	inCondition                        bool
 	inInterface                        bool
 	allowSelfResourceFieldInvalidation bool
@@ -125,7 +133,13 @@ type Checker struct {
 	inCreate                           bool
 	isChecked                          bool
 	inAssignment                       bool
-	parent                             ast.Element
+	// inHashingFunction is true while checking the `equals` or `hashValue` function
+	// of an equatable or hashable structure.
+	// Only the values declared deeper than hashingFunctionValueActivationDepth,
+	// i.e. `self`, the parameters, and the local values, may be accessed
+	inHashingFunction                   bool
+	hashingFunctionValueActivationDepth int
+	parent                              ast.Element
 }
 
 var _ ast.DeclarationVisitor[struct{}] = &Checker{}
@@ -178,6 +192,7 @@ func NewChecker(
 	)
 
 	elaboration := NewElaboration(memoryGauge)
+	elaboration.IsLooseModeChecked = config.LooseModeEnabled
 
 	checker := &Checker{
 		Program:             program,
@@ -344,6 +359,20 @@ func (checker *Checker) report(err error) {
 	}
 }
 
+// Warnings returns the warnings reported during checking.
+// Unlike errors, warnings do not cause checking to fail.
+func (checker *Checker) Warnings() []error {
+	return checker.warnings
+}
+
+func (checker *Checker) reportWarning(warning error) {
+	if warning == nil {
+		return
+	}
+
+	checker.warnings = append(checker.warnings, warning)
+}
+
 func (checker *Checker) CheckProgram(program *ast.Program) {
 
 	for _, declaration := range program.ImportDeclarations() {
@@ -421,6 +450,11 @@ func (checker *Checker) CheckProgram(program *ast.Program) {
 		VisitThisAndNested(compositeType, registerInElaboration)
 	}
 
+	// Declare type aliases.
+	// NOTE: after all nominal types were declared, as type aliases may refer to them
+
+	checker.declareTypeAliases(program.TypeAliasDeclarations())
+
 	// Declare interfaces' and composites' members
 
 	for _, declaration := range program.InterfaceDeclarations() {
@@ -437,7 +471,11 @@ func (checker *Checker) CheckProgram(program *ast.Program) {
 
 	// Declare events, functions, and transactions
 
-	for _, declaration := range program.FunctionDeclarations() {
+	functionDeclarations := program.FunctionDeclarations()
+
+	checker.checkAmbiguousFunctionOverloads(functionDeclarations)
+
+	for _, declaration := range functionDeclarations {
 		checker.declareGlobalFunctionDeclaration(declaration)
 	}
 
@@ -517,9 +555,29 @@ func (checker *Checker) declareGlobalFunctionDeclaration(declaration *ast.Functi
 		declaration.TypeParameterList,
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
+		declaration.FunctionBlock,
 	)
 	checker.Elaboration.SetFunctionDeclarationFunctionType(declaration, functionType)
-	checker.declareFunctionDeclaration(declaration, functionType)
+
+	// Global functions may be overloaded by their argument labels.
+	// Overloads are declared under their function overload name
+
+	identifier := declaration.Identifier.Identifier
+	argumentLabels := declaration.ParameterList.EffectiveArgumentLabels()
+
+	previousVariable := checker.valueActivations.Find(identifier)
+	if previousVariable != nil &&
+		previousVariable.ActivationDepth == checker.valueActivations.Depth() &&
+		previousVariable.DeclarationKind == common.DeclarationKindFunction &&
+		!slices.Equal(previousVariable.ArgumentLabels, argumentLabels) {
+
+		overloadName := FunctionOverloadName(identifier, argumentLabels)
+		checker.Elaboration.SetFunctionDeclarationOverloadName(declaration, overloadName)
+		checker.recordFunctionOverloadName(identifier, overloadName)
+		identifier = overloadName
+	}
+
+	checker.declareFunctionDeclaration(declaration, identifier, functionType)
 }
 
 func (checker *Checker) checkTransfer(transfer *ast.Transfer, valueType Type) {
@@ -619,6 +677,12 @@ func (checker *Checker) checkTypeCompatibility(expression ast.Expression, valueT
 
 			return true
 		}
+
+		if IsSameTypeKind(unwrappedTargetType, TimestampType) {
+			checker.checkTimestampLiteral(typedExpression)
+
+			return true
+		}
 	}
 
 	return IsSubType(valueType, targetType)
@@ -780,6 +844,27 @@ func (checker *Checker) declareGlobalDeclaration(declaration ast.Declaration) {
 		return
 	}
 	name := identifier.Identifier
+
+	switch declaration := declaration.(type) {
+	case *ast.FunctionDeclaration:
+		// Overloads of functions are declared under their function overload name
+		if overloadName, ok := checker.Elaboration.FunctionDeclarationOverloadName(declaration); ok {
+			checker.declareGlobalValue(overloadName)
+			return
+		}
+
+	case ast.CompositeLikeDeclaration:
+		// The constructors for the overloads of the initializer
+		// are declared under their function overload name
+		if compositeType := checker.Elaboration.CompositeDeclarationType(declaration); compositeType != nil {
+			for _, constructorType := range compositeType.ConstructorOverloads {
+				checker.declareGlobalValue(
+					FunctionOverloadName(name, constructorType.ArgumentLabels()),
+				)
+			}
+		}
+	}
+
 	checker.declareGlobalValue(name)
 	checker.declareGlobalType(name)
 }
@@ -832,7 +917,13 @@ func (checker *Checker) inSwitch() bool {
 
 func (checker *Checker) findAndCheckValueVariable(identifierExpression *ast.IdentifierExpression, recordOccurrence bool) *Variable {
 	identifier := identifierExpression.Identifier
-	variable := checker.valueActivations.Find(identifier.Identifier)
+
+	name := identifier.Identifier
+	if overloadName, ok := checker.Elaboration.IdentifierExpressionOverloadName(identifierExpression); ok {
+		name = overloadName
+	}
+
+	variable := checker.valueActivations.Find(name)
 	if variable == nil {
 		checker.report(
 			&NotDeclaredError{
@@ -860,7 +951,9 @@ func (checker *Checker) findAndCheckValueVariable(identifierExpression *ast.Iden
 func (checker *Checker) ConvertType(t ast.Type) Type {
 	switch t := t.(type) {
 	case *ast.NominalType:
-		return checker.convertNominalType(t)
+		ty := checker.convertNominalType(t)
+		checker.checkGenericCompositeTypeInstantiated(ty, t)
+		return ty
 
 	case *ast.VariableSizedType:
 		return checker.convertVariableSizedType(t)
@@ -954,6 +1047,14 @@ func CheckIntersectionType(
 
 					previousMemberType := prevMemberType.TypeAnnotation.Type
 
+					// Functions with different argument labels are overloads, not clashes
+
+					if member.DeclarationKind == common.DeclarationKindFunction &&
+						isFunctionOverload(prevMemberType, member.ArgumentLabels) {
+
+						return
+					}
+
 					if !memberType.IsInvalidType() &&
 						!previousMemberType.IsInvalidType() &&
 						!memberType.Equal(previousMemberType) {
@@ -1233,7 +1334,14 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 
 	for _, identifier := range t.NestedIdentifiers {
 		if containerType, ok := ty.(ContainerType); ok && containerType.IsContainerType() {
-			ty, _ = containerType.GetNestedTypes().Get(identifier.Identifier)
+			var found bool
+			ty, found = containerType.GetNestedTypes().Get(identifier.Identifier)
+			if !found {
+				// Type aliases declared in the container type are not nested types
+				if aliasContainerType, ok := containerType.(TypeAliasContainerType); ok {
+					ty, _ = aliasContainerType.GetNestedTypeAlias(identifier.Identifier)
+				}
+			}
 		} else {
 			if !ty.IsInvalidType() {
 				checker.report(
@@ -1272,6 +1380,24 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 	return ty
 }
 
+// checkGenericCompositeTypeInstantiated checks that the given type, referred to by the given nominal type,
+// is not a generic composite type, which must be instantiated with type arguments, e.g. `Box<Int>`.
+func (checker *Checker) checkGenericCompositeTypeInstantiated(ty Type, nominalType *ast.NominalType) {
+	compositeType, ok := ty.(*CompositeType)
+	if !ok || !compositeType.IsGeneric() {
+		return
+	}
+
+	for _, typeParameter := range compositeType.typeParameters {
+		checker.report(
+			&MissingTypeArgumentError{
+				TypeArgumentName: typeParameter.Name,
+				Range:            ast.NewRangeFromPositioned(checker.memoryGauge, nominalType),
+			},
+		)
+	}
+}
+
 // ConvertTypeAnnotation converts an AST type annotation representation
 // to a sema type annotation
 //
@@ -1291,6 +1417,7 @@ func (checker *Checker) functionType(
 	typeParameterList *ast.TypeParameterList,
 	parameterList *ast.ParameterList,
 	returnTypeAnnotation *ast.TypeAnnotation,
+	functionBlock *ast.FunctionBlock,
 ) *FunctionType {
 
 	oldMappedAccess := checker.entitlementMappingInScope
@@ -1306,16 +1433,6 @@ func (checker *Checker) functionType(
 	var convertedTypeParameters []*TypeParameter
 	if typeParameterList != nil {
 
-		// Only native functions may have type parameters at the moment
-		if !isNative && !typeParameterList.IsEmpty() {
-			checker.report(&InvalidTypeParameterizedNonNativeFunctionError{
-				Range: ast.NewRangeFromPositioned(
-					checker.memoryGauge,
-					typeParameterList,
-				),
-			})
-		}
-
 		checker.typeActivations.Enter()
 		defer checker.typeActivations.Leave(func(gauge common.MemoryGauge) ast.Position {
 			if returnTypeAnnotation != nil {
@@ -1330,22 +1447,18 @@ func (checker *Checker) functionType(
 
 		convertedTypeParameters = checker.typeParameters(typeParameterList)
 
-		for typeParameterIndex, typeParameter := range typeParameterList.TypeParameters {
-			convertedTypeParameter := convertedTypeParameters[typeParameterIndex]
-
-			genericType := &GenericType{
-				TypeParameter: convertedTypeParameter,
-			}
-
-			_, err := checker.typeActivations.declareType(typeDeclaration{
-				identifier:               typeParameter.Identifier,
-				ty:                       genericType,
-				declarationKind:          common.DeclarationKindTypeParameter,
-				allowOuterScopeShadowing: false,
-			})
-			checker.report(err)
+		// The type parameters of non-native functions are implemented by the program,
+		// so it must be statically known if type arguments are resources or not
 
+		if !isNative {
+			checker.checkTypeParameterBounds(typeParameterList, convertedTypeParameters)
 		}
+
+		checker.declareTypeParameters(
+			typeParameterList,
+			convertedTypeParameters,
+			checker.report,
+		)
 	}
 
 	// Convert parameters
@@ -1358,6 +1471,14 @@ func (checker *Checker) functionType(
 	if returnTypeAnnotation != nil {
 		convertedReturnTypeAnnotation =
 			checker.ConvertTypeAnnotation(returnTypeAnnotation)
+	} else if checker.Config.LooseModeEnabled &&
+		functionBlock != nil &&
+		functionBlock.ReturnsValue() {
+
+		// In the loose mode, the return type of a function without a return type annotation
+		// is inferred: If the function returns a value, the return type is `AnyStruct`
+
+		convertedReturnTypeAnnotation = AnyStructTypeAnnotation
 	}
 
 	return &FunctionType{
@@ -1365,6 +1486,7 @@ func (checker *Checker) functionType(
 		TypeParameters:       convertedTypeParameters,
 		Parameters:           convertedParameters,
 		ReturnTypeAnnotation: convertedReturnTypeAnnotation,
+		Arity:                defaultArgumentsArity(parameterList),
 	}
 }
 
@@ -1396,6 +1518,84 @@ func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList)
 	return typeParameters
 }
 
+// checkTypeParameterBounds checks the bounds of the type parameters
+// of a user-defined generic function or composite type.
+//
+// Type parameters without a bound are implicitly bound to `AnyStruct`.
+// Bounds must be either a subtype of `AnyStruct` or of `AnyResource`.
+func (checker *Checker) checkTypeParameterBounds(
+	typeParameterList *ast.TypeParameterList,
+	typeParameters []*TypeParameter,
+) {
+	for i, typeParameter := range typeParameters {
+		typeBound := typeParameter.TypeBound
+		if typeBound == nil {
+			typeParameter.TypeBound = AnyStructType
+			continue
+		}
+
+		if typeBound.IsInvalidType() ||
+			IsSubType(typeBound, AnyStructType) ||
+			IsSubType(typeBound, AnyResourceType) {
+
+			continue
+		}
+
+		checker.report(
+			&InvalidTypeParameterBoundError{
+				TypeParameter: typeParameter,
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					typeParameterList.TypeParameters[i].TypeBound,
+				),
+			},
+		)
+	}
+}
+
+// enterTypeParameterScope declares the given type parameters in a new type activation,
+// and keeps track of them as being in scope, until the returned function is called.
+func (checker *Checker) enterTypeParameterScope(
+	typeParameterList *ast.TypeParameterList,
+	typeParameters []*TypeParameter,
+	getEndPosition EndPositionGetter,
+	report func(error),
+) (leave func()) {
+	checker.typeActivations.Enter()
+
+	checker.declareTypeParameters(typeParameterList, typeParameters, report)
+
+	typeParameterCount := len(checker.typeParametersInScope)
+	checker.typeParametersInScope = append(checker.typeParametersInScope, typeParameters...)
+
+	return func() {
+		checker.typeParametersInScope = checker.typeParametersInScope[:typeParameterCount]
+		checker.typeActivations.Leave(getEndPosition)
+	}
+}
+
+// declareTypeParameters declares the given type parameters
+// as generic types in the current type activation
+func (checker *Checker) declareTypeParameters(
+	typeParameterList *ast.TypeParameterList,
+	typeParameters []*TypeParameter,
+	report func(error),
+) {
+	for i, typeParameter := range typeParameterList.TypeParameters {
+		genericType := &GenericType{
+			TypeParameter: typeParameters[i],
+		}
+
+		_, err := checker.typeActivations.declareType(typeDeclaration{
+			identifier:               typeParameter.Identifier,
+			ty:                       genericType,
+			declarationKind:          common.DeclarationKindTypeParameter,
+			allowOuterScopeShadowing: false,
+		})
+		report(err)
+	}
+}
+
 func (checker *Checker) parameters(parameterList *ast.ParameterList) []Parameter {
 
 	// TODO: required for initializer conformance checking at the moment, optimize/refactor
@@ -1404,6 +1604,27 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []Parameter
 	if len(parameterList.Parameters) > 0 {
 
 		for i, parameter := range parameterList.Parameters {
+
+			// In the loose mode, parameters without a type annotation have type `AnyStruct`
+
+			if parameter.TypeAnnotation == nil {
+				if !checker.Config.LooseModeEnabled {
+					checker.report(
+						&MissingTypeAnnotationError{
+							Range: ast.NewRangeFromPositioned(checker.memoryGauge, parameter.Identifier),
+						},
+					)
+				}
+
+				parameters[i] = Parameter{
+					Label:          parameter.Label,
+					Identifier:     parameter.Identifier.Identifier,
+					TypeAnnotation: AnyStructTypeAnnotation,
+				}
+
+				continue
+			}
+
 			convertedParameterType := checker.ConvertType(parameter.TypeAnnotation.Type)
 
 			// NOTE: copying resource annotation from source type annotation as-is,
@@ -1821,6 +2042,10 @@ func (checker *Checker) ResetErrors() {
 	checker.errors = nil
 }
 
+func (checker *Checker) ResetWarnings() {
+	checker.warnings = nil
+}
+
 const invalidTypeDeclarationAccessModifierExplanation = "type declarations must be public"
 
 func (checker *Checker) checkDeclarationAccessModifier(
@@ -2400,7 +2625,15 @@ func (checker *Checker) EffectiveCompositeMemberAccess(access Access) Access {
 
 func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type {
 
-	ty := checker.ConvertType(t.Type)
+	// NOTE: A generic composite type must not be instantiated when it is referred to by name,
+	// it is instantiated with the type arguments below
+
+	var ty Type
+	if nominalType, ok := t.Type.(*ast.NominalType); ok {
+		ty = checker.convertNominalType(nominalType)
+	} else {
+		ty = checker.ConvertType(t.Type)
+	}
 
 	// Always convert (check) the type arguments,
 	// even if the instantiated type is invalid
@@ -2418,6 +2651,16 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	}
 
 	parameterizedType, ok := ty.(ParameterizedType)
+
+	// Only generic composite types are parameterized,
+	// all other composite types are not
+
+	if compositeType, isCompositeType := ty.(*CompositeType); isCompositeType &&
+		!compositeType.IsGeneric() {
+
+		ok = false
+	}
+
 	if !ok {
 
 		// The type is not parameterized,
@@ -2560,14 +2803,18 @@ func (checker *Checker) VisitExpressionWithReferenceCheck(expr ast.Expression, p
 		!visibleType.IsInvalidType() &&
 		!IsSubType(visibleType, targetType) {
 
-		checker.report(
-			&TypeMismatchError{
-				ExpectedType: targetType,
-				ActualType:   visibleType,
-				Expression:   expr,
-				Range:        checker.expressionRange(expr),
-			},
-		)
+		errorRange := checker.expressionRange(expr)
+
+		if !checker.deferTypeCheck(expr, targetType, visibleType, errorRange) {
+			checker.report(
+				&TypeMismatchError{
+					ExpectedType: targetType,
+					ActualType:   visibleType,
+					Expression:   expr,
+					Range:        errorRange,
+				},
+			)
+		}
 	}
 
 	return visibleType
@@ -2627,14 +2874,18 @@ func (checker *Checker) visitExpressionWithForceType(
 		actualType != InvalidType &&
 		!IsSubType(actualType, expectedType) {
 
-		checker.report(
-			&TypeMismatchError{
-				ExpectedType: expectedType,
-				ActualType:   actualType,
-				Expression:   expr,
-				Range:        checker.expressionRange(expr),
-			},
-		)
+		errorRange := checker.expressionRange(expr)
+
+		if !checker.deferTypeCheck(expr, expectedType, actualType, errorRange) {
+			checker.report(
+				&TypeMismatchError{
+					ExpectedType: expectedType,
+					ActualType:   actualType,
+					Expression:   expr,
+					Range:        errorRange,
+				},
+			)
+		}
 
 		// If there are type mismatch errors, return the expected type as the visible-type of the expression.
 		// This is done to avoid the same error getting delegated up.
@@ -2645,6 +2896,37 @@ func (checker *Checker) visitExpressionWithForceType(
 	return actualType, actualType
 }
 
+// deferTypeCheck defers the check that the value of the given expression has the expected type
+// from checking to run-time, if the loose mode is enabled,
+// and the value may have the expected type at run-time, i.e. the expected type is a subtype of the actual type.
+// The type mismatch is then only reported as a warning.
+//
+// Returns true if the check was deferred
+func (checker *Checker) deferTypeCheck(
+	expression ast.Expression,
+	expectedType Type,
+	actualType Type,
+	errorRange ast.Range,
+) bool {
+	if !checker.Config.LooseModeEnabled ||
+		!IsSubType(expectedType, actualType) {
+
+		return false
+	}
+
+	checker.reportWarning(
+		&RuntimeTypeCheckWarning{
+			ExpectedType: expectedType,
+			ActualType:   actualType,
+			Range:        errorRange,
+		},
+	)
+
+	checker.Elaboration.SetRuntimeCheckedType(expression, expectedType)
+
+	return true
+}
+
 func (checker *Checker) expressionRange(expression ast.Expression) ast.Range {
 	if indexExpr, ok := expression.(*ast.IndexExpression); ok {
 		return ast.NewRange(
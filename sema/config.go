@@ -30,6 +30,10 @@ type Config struct {
 	BaseValueActivationHandler       ActivationHandlerFunc
 	// ImportHandler is used to resolve unresolved imports
 	ImportHandler ImportHandlerFunc
+	// ImportHashHandler is used to get the hash of the code of an imported location,
+	// to verify imports which are pinned to a specific hash.
+	// If no handler is provided, pinned imports are rejected, as their hashes cannot be verified
+	ImportHashHandler ImportHashHandlerFunc
 	// CheckHandler is the function which is used for the checking of a program
 	CheckHandler CheckHandlerFunc
 	// LocationHandler is used to resolve locations
@@ -55,4 +59,15 @@ type Config struct {
 	AllowStaticDeclarations bool
 	// AttachmentsEnabled determines if attachments are enabled
 	AttachmentsEnabled bool
+	// LooseModeEnabled determines if the loose checking mode is enabled.
+	// In the loose mode, parameters without a type annotation have type `AnyStruct`,
+	// the return type of functions without a return type annotation is inferred,
+	// and type mismatches which may not occur at run-time, i.e. implicit downcasts,
+	// are reported as warnings and the types of the values are checked at run-time
+	LooseModeEnabled bool
+	// NumberConversionCastsEnabled determines if failable and force casts convert numbers,
+	// e.g. `x as? UInt8` where `x` is an `Int`, see CastConvertsNumber.
+	// When disabled (the default), the casts only succeed if the run-time type of the value
+	// is a subtype of the target type, like for all other values
+	NumberConversionCastsEnabled bool
 }
@@ -0,0 +1,115 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+//go:generate go run ./gen duration.cdc duration.gen.go
+
+import (
+	"github.com/onflow/cadence/errors"
+)
+
+var DurationTypeAnnotation = NewTypeAnnotation(DurationType)
+
+var DurationConversionFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: NumberTypeAnnotation,
+		},
+	},
+	ReturnTypeAnnotation:     DurationTypeAnnotation,
+	ArgumentExpressionsCheck: fix64ArgumentExpressionsCheck,
+}
+
+// DurationConstant is a built-in duration constant,
+// declared as a member of the duration conversion function, e.g. `Duration.day`
+type DurationConstant struct {
+	Name    string
+	Seconds int64
+}
+
+const DurationSecondFieldName = "second"
+const DurationMinuteFieldName = "minute"
+const DurationHourFieldName = "hour"
+const DurationDayFieldName = "day"
+const DurationYearFieldName = "year"
+
+// DurationConstants are the built-in duration constants.
+// A year is 365 days, leap years and leap seconds are not taken into account.
+var DurationConstants = []DurationConstant{
+	{
+		Name:    DurationSecondFieldName,
+		Seconds: 1,
+	},
+	{
+		Name:    DurationMinuteFieldName,
+		Seconds: 60,
+	},
+	{
+		Name:    DurationHourFieldName,
+		Seconds: 60 * 60,
+	},
+	{
+		Name:    DurationDayFieldName,
+		Seconds: 24 * 60 * 60,
+	},
+	{
+		Name:    DurationYearFieldName,
+		Seconds: 365 * 24 * 60 * 60,
+	},
+}
+
+func init() {
+	// Declare a conversion function for the duration type
+
+	// Check that the function is not accidentally redeclared
+
+	typeName := DurationTypeName
+
+	if BaseValueActivation.Find(typeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	functionType := DurationConversionFunctionType
+
+	functionType.Members = &StringMemberOrderedMap{}
+	for _, constant := range DurationConstants {
+		functionType.Members.Set(
+			constant.Name,
+			NewUnmeteredPublicConstantFieldMember(
+				functionType,
+				constant.Name,
+				DurationType,
+				"The duration of one "+constant.Name,
+			),
+		)
+	}
+
+	BaseValueActivation.Set(
+		typeName,
+		baseFunctionVariable(
+			typeName,
+			functionType,
+			"Converts the given number of seconds to a duration. "+
+				numberConversionFunctionDocStringSuffix,
+		),
+	)
+}
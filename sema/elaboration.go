@@ -27,9 +27,13 @@ import (
 )
 
 type MemberAccessInfo struct {
-	AccessedType    Type
-	ResultingType   Type
-	Member          *Member
+	AccessedType  Type
+	ResultingType Type
+	Member        *Member
+	// OverloadName is the function overload name of the accessed function,
+	// if the function must be looked up by its argument labels,
+	// e.g. because it is an overload, or because it is accessed through an interface
+	OverloadName    string
 	IsOptional      bool
 	ReturnReference bool
 }
@@ -62,6 +66,16 @@ type VariableDeclarationTypes struct {
 	TargetType      Type
 }
 
+type DestructuringPatternTypes struct {
+	ValueType    Type
+	ElementTypes []Type
+}
+
+type SwitchPatternTypes struct {
+	ValueType  Type
+	TargetType Type
+}
+
 type AssignmentStatementTypes struct {
 	ValueType  Type
 	TargetType Type
@@ -104,6 +118,9 @@ type NumberConversionArgumentTypes struct {
 type CastingExpressionTypes struct {
 	StaticValueType Type
 	TargetType      Type
+	// ConvertsNumber is true if the failable or force cast converts the number value
+	// to the target type, see CastConvertsNumber
+	ConvertsNumber bool
 }
 
 type ExpressionTypes struct {
@@ -111,6 +128,11 @@ type ExpressionTypes struct {
 	ExpectedType Type
 }
 
+type DefaultArgumentTypes struct {
+	ValueType     Type
+	ParameterType Type
+}
+
 type ForStatementTypes struct {
 	IndexVariableType Type
 	ValueVariableType Type
@@ -143,6 +165,9 @@ type Elaboration struct {
 	returnStatementTypes              map[*ast.ReturnStatement]ReturnStatementTypes
 	functionDeclarationFunctionTypes  map[*ast.FunctionDeclaration]*FunctionType
 	variableDeclarationTypes          map[*ast.VariableDeclaration]VariableDeclarationTypes
+	typeAliasDeclarationTypes         map[*ast.TypeAliasDeclaration]Type
+	destructuringPatternTypes         map[*ast.DestructuringPattern]DestructuringPatternTypes
+	switchPatternTypes                map[*ast.SwitchPattern]SwitchPatternTypes
 	// nestedResourceMoveExpressions indicates the index or member expression
 	// is implicitly moving a resource out of the container, e.g. in a shift or swap statement.
 	nestedResourceMoveExpressions       map[ast.Expression]struct{}
@@ -156,11 +181,16 @@ type Elaboration struct {
 	entitlementTypes                    map[TypeID]*EntitlementType
 	entitlementMapTypes                 map[TypeID]*EntitlementMapType
 	identifierInInvocationTypes         map[*ast.IdentifierExpression]Type
+	identifierExpressionOverloadNames   map[*ast.IdentifierExpression]string
+	functionDeclarationOverloadNames    map[*ast.FunctionDeclaration]string
+	defaultArgumentTypes                map[*ast.Parameter]DefaultArgumentTypes
 	importDeclarationsResolvedLocations map[*ast.ImportDeclaration][]ResolvedLocation
 	globalValues                        *StringVariableOrderedMap
 	globalTypes                         *StringVariableOrderedMap
 	numberConversionArgumentTypes       map[ast.Expression]NumberConversionArgumentTypes
 	runtimeCastTypes                    map[*ast.CastingExpression]RuntimeCastTypes
+	runtimeCheckedTypes                 map[ast.Expression]Type
+	runtimeCheckedIndexExpressions      map[*ast.IndexExpression]struct{}
 	referenceExpressionBorrowTypes      map[*ast.ReferenceExpression]Type
 	indexExpressionTypes                map[*ast.IndexExpression]IndexExpressionTypes
 	attachmentAccessTypes               map[*ast.IndexExpression]Type
@@ -174,6 +204,12 @@ type Elaboration struct {
 	isChecking                          bool
 	// IsRecovered is true if the program was recovered (see runtime.Interface.RecoverProgram)
 	IsRecovered bool
+	// IsLooseModeChecked is true if the program was checked in the loose mode (see Config.LooseModeEnabled),
+	// i.e. if the checker may have deferred type checks to run-time
+	IsLooseModeChecked bool
+	// Warnings are the warnings reported by the checker (see Checker.Warnings),
+	// if the program was checked by the runtime
+	Warnings []error
 }
 
 func NewElaboration(gauge common.MemoryGauge) *Elaboration {
@@ -235,6 +271,23 @@ func (e *Elaboration) SetFunctionDeclarationFunctionType(
 	e.functionDeclarationFunctionTypes[declaration] = functionType
 }
 
+func (e *Elaboration) TypeAliasDeclarationType(declaration *ast.TypeAliasDeclaration) Type {
+	if e.typeAliasDeclarationTypes == nil {
+		return nil
+	}
+	return e.typeAliasDeclarationTypes[declaration]
+}
+
+func (e *Elaboration) SetTypeAliasDeclarationType(
+	declaration *ast.TypeAliasDeclaration,
+	ty Type,
+) {
+	if e.typeAliasDeclarationTypes == nil {
+		e.typeAliasDeclarationTypes = map[*ast.TypeAliasDeclaration]Type{}
+	}
+	e.typeAliasDeclarationTypes[declaration] = ty
+}
+
 func (e *Elaboration) VariableDeclarationTypes(declaration *ast.VariableDeclaration) (types VariableDeclarationTypes) {
 	if e.variableDeclarationTypes == nil {
 		return
@@ -252,6 +305,40 @@ func (e *Elaboration) SetVariableDeclarationTypes(
 	e.variableDeclarationTypes[declaration] = types
 }
 
+func (e *Elaboration) DestructuringPatternTypes(pattern *ast.DestructuringPattern) (types DestructuringPatternTypes) {
+	if e.destructuringPatternTypes == nil {
+		return
+	}
+	return e.destructuringPatternTypes[pattern]
+}
+
+func (e *Elaboration) SetDestructuringPatternTypes(
+	pattern *ast.DestructuringPattern,
+	types DestructuringPatternTypes,
+) {
+	if e.destructuringPatternTypes == nil {
+		e.destructuringPatternTypes = map[*ast.DestructuringPattern]DestructuringPatternTypes{}
+	}
+	e.destructuringPatternTypes[pattern] = types
+}
+
+func (e *Elaboration) SwitchPatternTypes(pattern *ast.SwitchPattern) (types SwitchPatternTypes) {
+	if e.switchPatternTypes == nil {
+		return
+	}
+	return e.switchPatternTypes[pattern]
+}
+
+func (e *Elaboration) SetSwitchPatternTypes(
+	pattern *ast.SwitchPattern,
+	types SwitchPatternTypes,
+) {
+	if e.switchPatternTypes == nil {
+		e.switchPatternTypes = map[*ast.SwitchPattern]SwitchPatternTypes{}
+	}
+	e.switchPatternTypes[pattern] = types
+}
+
 func (e *Elaboration) VariableDeclarationTypesCount() int {
 	return len(e.variableDeclarationTypes)
 }
@@ -853,6 +940,54 @@ func (e *Elaboration) SetIdentifierInInvocationType(expression *ast.IdentifierEx
 	e.identifierInInvocationTypes[expression] = valueType
 }
 
+// IdentifierExpressionOverloadName returns the function overload name
+// that the given invoked identifier expression refers to, if any.
+func (e *Elaboration) IdentifierExpressionOverloadName(expression *ast.IdentifierExpression) (string, bool) {
+	if e.identifierExpressionOverloadNames == nil {
+		return "", false
+	}
+	overloadName, ok := e.identifierExpressionOverloadNames[expression]
+	return overloadName, ok
+}
+
+func (e *Elaboration) SetIdentifierExpressionOverloadName(expression *ast.IdentifierExpression, overloadName string) {
+	if e.identifierExpressionOverloadNames == nil {
+		e.identifierExpressionOverloadNames = map[*ast.IdentifierExpression]string{}
+	}
+	e.identifierExpressionOverloadNames[expression] = overloadName
+}
+
+// FunctionDeclarationOverloadName returns the function overload name
+// under which the given function declaration is declared, if it is an overload.
+func (e *Elaboration) FunctionDeclarationOverloadName(declaration *ast.FunctionDeclaration) (string, bool) {
+	if e.functionDeclarationOverloadNames == nil {
+		return "", false
+	}
+	overloadName, ok := e.functionDeclarationOverloadNames[declaration]
+	return overloadName, ok
+}
+
+func (e *Elaboration) SetFunctionDeclarationOverloadName(declaration *ast.FunctionDeclaration, overloadName string) {
+	if e.functionDeclarationOverloadNames == nil {
+		e.functionDeclarationOverloadNames = map[*ast.FunctionDeclaration]string{}
+	}
+	e.functionDeclarationOverloadNames[declaration] = overloadName
+}
+
+func (e *Elaboration) DefaultArgumentTypes(parameter *ast.Parameter) (types DefaultArgumentTypes) {
+	if e.defaultArgumentTypes == nil {
+		return
+	}
+	return e.defaultArgumentTypes[parameter]
+}
+
+func (e *Elaboration) SetDefaultArgumentTypes(parameter *ast.Parameter, types DefaultArgumentTypes) {
+	if e.defaultArgumentTypes == nil {
+		e.defaultArgumentTypes = map[*ast.Parameter]DefaultArgumentTypes{}
+	}
+	e.defaultArgumentTypes[parameter] = types
+}
+
 func (e *Elaboration) ImportDeclarationsResolvedLocations(declaration *ast.ImportDeclaration) []ResolvedLocation {
 	if e.importDeclarationsResolvedLocations == nil {
 		return nil
@@ -946,6 +1081,40 @@ func (e *Elaboration) SetRuntimeCastTypes(expression *ast.CastingExpression, typ
 	e.runtimeCastTypes[expression] = types
 }
 
+// RuntimeCheckedType returns the type that the value of the given expression
+// must have at run-time, if the type check was deferred to run-time in the loose mode.
+// Returns nil if the expression does not need to be checked at run-time
+func (e *Elaboration) RuntimeCheckedType(expression ast.Expression) Type {
+	if e.runtimeCheckedTypes == nil {
+		return nil
+	}
+	return e.runtimeCheckedTypes[expression]
+}
+
+func (e *Elaboration) SetRuntimeCheckedType(expression ast.Expression, ty Type) {
+	if e.runtimeCheckedTypes == nil {
+		e.runtimeCheckedTypes = map[ast.Expression]Type{}
+	}
+	e.runtimeCheckedTypes[expression] = ty
+}
+
+// IsRuntimeCheckedIndexExpression returns true if the check of the given index expression
+// was deferred to run-time in the loose mode, i.e. the indexed value has type `AnyStruct`
+func (e *Elaboration) IsRuntimeCheckedIndexExpression(expression *ast.IndexExpression) bool {
+	if e.runtimeCheckedIndexExpressions == nil {
+		return false
+	}
+	_, ok := e.runtimeCheckedIndexExpressions[expression]
+	return ok
+}
+
+func (e *Elaboration) SetRuntimeCheckedIndexExpression(expression *ast.IndexExpression) {
+	if e.runtimeCheckedIndexExpressions == nil {
+		e.runtimeCheckedIndexExpressions = map[*ast.IndexExpression]struct{}{}
+	}
+	e.runtimeCheckedIndexExpressions[expression] = struct{}{}
+}
+
 func (e *Elaboration) NumberConversionArgumentTypes(
 	expression ast.Expression,
 ) (
@@ -0,0 +1,72 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/common"
+)
+
+const EquatableTypeName = "Equatable"
+
+const EquatableTypeEqualsFunctionName = "equals"
+
+var EquatableTypeEqualsFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "other",
+			TypeAnnotation: NewTypeAnnotation(AnyStructType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+}
+
+const equatableTypeEqualsFunctionDocString = `
+Returns true if this value is equal to the given other value.
+
+Only called by the equality operator when both values have the same type.
+The function may only access the constant fields of the structure.
+`
+
+// EquatableType represents the built-in `Equatable` struct interface.
+//
+// Structures conforming to it can be compared using the equality operators,
+// which call the structure's implementation of the `equals` function.
+var EquatableType = func() *InterfaceType {
+	interfaceType := &InterfaceType{
+		Identifier:    EquatableTypeName,
+		CompositeKind: common.CompositeKindStructure,
+		NestedTypes:   &StringTypeOrderedMap{},
+	}
+
+	members := []*Member{
+		NewUnmeteredPublicFunctionMember(
+			interfaceType,
+			EquatableTypeEqualsFunctionName,
+			EquatableTypeEqualsFunctionType,
+			equatableTypeEqualsFunctionDocString,
+		),
+	}
+
+	interfaceType.Members = MembersAsMap(members)
+	interfaceType.Fields = MembersFieldNames(members)
+
+	return interfaceType
+}()
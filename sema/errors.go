@@ -19,6 +19,7 @@
 package sema
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sort"
@@ -1398,6 +1399,32 @@ func (e *InvalidEnumRawTypeError) SecondaryError() string {
 	return "only integer types are currently supported for enums"
 }
 
+// InvalidDistinctTypeUnderlyingTypeError
+
+type InvalidDistinctTypeUnderlyingTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidDistinctTypeUnderlyingTypeError{}
+var _ errors.UserError = &InvalidDistinctTypeUnderlyingTypeError{}
+var _ errors.SecondaryError = &InvalidDistinctTypeUnderlyingTypeError{}
+
+func (*InvalidDistinctTypeUnderlyingTypeError) isSemanticError() {}
+
+func (*InvalidDistinctTypeUnderlyingTypeError) IsUserError() {}
+
+func (e *InvalidDistinctTypeUnderlyingTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid distinct type underlying type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidDistinctTypeUnderlyingTypeError) SecondaryError() string {
+	return "distinct types cannot be derived from resource types"
+}
+
 // MissingEnumRawTypeError
 
 type MissingEnumRawTypeError struct {
@@ -1605,6 +1632,27 @@ func (e CyclicConformanceError) Error() string {
 	)
 }
 
+// CyclicTypeAliasError
+
+type CyclicTypeAliasError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &CyclicTypeAliasError{}
+var _ errors.UserError = &CyclicTypeAliasError{}
+
+func (*CyclicTypeAliasError) isSemanticError() {}
+
+func (*CyclicTypeAliasError) IsUserError() {}
+
+func (e *CyclicTypeAliasError) Error() string {
+	return fmt.Sprintf(
+		"type alias `%s` refers to itself",
+		e.Name,
+	)
+}
+
 // MultipleInterfaceDefaultImplementationsError
 type MultipleInterfaceDefaultImplementationsError struct {
 	CompositeKindedType CompositeKindedType
@@ -1876,6 +1924,62 @@ func (e *UnsupportedOverloadingError) Error() string {
 	)
 }
 
+// AmbiguousFunctionOverloadError is reported when more than one overload of a function or initializer
+// accepts the same argument labels, as the arguments for trailing parameters with default arguments may be omitted
+
+type AmbiguousFunctionOverloadError struct {
+	Name           string
+	ArgumentLabels []string
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousFunctionOverloadError{}
+var _ errors.UserError = &AmbiguousFunctionOverloadError{}
+var _ errors.SecondaryError = &AmbiguousFunctionOverloadError{}
+
+func (*AmbiguousFunctionOverloadError) isSemanticError() {}
+
+func (*AmbiguousFunctionOverloadError) IsUserError() {}
+
+func (e *AmbiguousFunctionOverloadError) Error() string {
+	return fmt.Sprintf(
+		"ambiguous overloads of `%s`: more than one overload can be invoked as `%s`",
+		e.Name,
+		FunctionOverloadName(e.Name, e.ArgumentLabels),
+	)
+}
+
+func (e *AmbiguousFunctionOverloadError) SecondaryError() string {
+	return "arguments for trailing parameters with default arguments may be omitted"
+}
+
+// AmbiguousFunctionReferenceError is reported when an overloaded function is referenced without invoking it,
+// and the expected type does not determine the overload
+
+type AmbiguousFunctionReferenceError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousFunctionReferenceError{}
+var _ errors.UserError = &AmbiguousFunctionReferenceError{}
+var _ errors.SecondaryError = &AmbiguousFunctionReferenceError{}
+
+func (*AmbiguousFunctionReferenceError) isSemanticError() {}
+
+func (*AmbiguousFunctionReferenceError) IsUserError() {}
+
+func (e *AmbiguousFunctionReferenceError) Error() string {
+	return fmt.Sprintf(
+		"ambiguous reference to overloaded function `%s`",
+		e.Name,
+	)
+}
+
+func (e *AmbiguousFunctionReferenceError) SecondaryError() string {
+	return "declare the function type of the expected overload, e.g. in a type annotation"
+}
+
 // CompositeKindMismatchError
 
 type CompositeKindMismatchError struct {
@@ -2067,6 +2171,24 @@ func (e *MissingResourceAnnotationError) Error() string {
 	)
 }
 
+// MissingTypeAnnotationError is reported when the type annotation of a parameter is omitted,
+// which is only allowed in the loose mode
+
+type MissingTypeAnnotationError struct {
+	ast.Range
+}
+
+var _ SemanticError = &MissingTypeAnnotationError{}
+var _ errors.UserError = &MissingTypeAnnotationError{}
+
+func (*MissingTypeAnnotationError) isSemanticError() {}
+
+func (*MissingTypeAnnotationError) IsUserError() {}
+
+func (e *MissingTypeAnnotationError) Error() string {
+	return "missing type annotation"
+}
+
 // InvalidNestedResourceMoveError
 
 type InvalidNestedResourceMoveError struct {
@@ -3187,6 +3309,29 @@ func (e *InvalidCharacterLiteralError) SecondaryError() string {
 	)
 }
 
+// InvalidTimestampLiteralError
+
+type InvalidTimestampLiteralError struct {
+	Literal string
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTimestampLiteralError{}
+var _ errors.UserError = &InvalidTimestampLiteralError{}
+var _ errors.SecondaryError = &InvalidTimestampLiteralError{}
+
+func (*InvalidTimestampLiteralError) isSemanticError() {}
+
+func (*InvalidTimestampLiteralError) IsUserError() {}
+
+func (e *InvalidTimestampLiteralError) Error() string {
+	return fmt.Sprintf("invalid timestamp literal: %q", e.Literal)
+}
+
+func (e *InvalidTimestampLiteralError) SecondaryError() string {
+	return "expected an ISO-8601 timestamp in the UTC timezone, e.g. `2020-03-13T19:52:43Z`"
+}
+
 // InvalidFailableResourceDowncastOutsideOptionalBindingError
 
 type InvalidFailableResourceDowncastOutsideOptionalBindingError struct {
@@ -3792,6 +3937,32 @@ func (e *InvalidPathDomainError) SecondaryError() string {
 	)
 }
 
+// InvalidPathComponentTypeError
+
+type InvalidPathComponentTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidPathComponentTypeError{}
+var _ errors.UserError = &InvalidPathComponentTypeError{}
+var _ errors.SecondaryError = &InvalidPathComponentTypeError{}
+
+func (*InvalidPathComponentTypeError) isSemanticError() {}
+
+func (*InvalidPathComponentTypeError) IsUserError() {}
+
+func (e *InvalidPathComponentTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot embed value of type `%s` in path",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidPathComponentTypeError) SecondaryError() string {
+	return "only addresses, integers, and types can be embedded in paths"
+}
+
 // InvalidTypeArgumentCountError
 
 type InvalidTypeArgumentCountError struct {
@@ -3957,6 +4128,226 @@ func (e *UnsupportedResourceForLoopError) Error() string {
 	return "cannot loop over resources"
 }
 
+// UnsupportedDestructuringError
+
+type UnsupportedDestructuringError struct {
+	ast.Range
+}
+
+var _ SemanticError = &UnsupportedDestructuringError{}
+var _ errors.UserError = &UnsupportedDestructuringError{}
+
+func (*UnsupportedDestructuringError) isSemanticError() {}
+
+func (*UnsupportedDestructuringError) IsUserError() {}
+
+func (e *UnsupportedDestructuringError) Error() string {
+	return "destructuring is only supported in local variable declarations and for-loops"
+}
+
+// InvalidDestructuringTypeError
+
+type InvalidDestructuringTypeError struct {
+	ActualType Type
+	ast.Range
+	Kind ast.DestructuringPatternKind
+}
+
+var _ SemanticError = &InvalidDestructuringTypeError{}
+var _ errors.UserError = &InvalidDestructuringTypeError{}
+
+func (*InvalidDestructuringTypeError) isSemanticError() {}
+
+func (*InvalidDestructuringTypeError) IsUserError() {}
+
+func (e *InvalidDestructuringTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure value of type `%s` with %s pattern",
+		e.ActualType.QualifiedString(),
+		e.Kind.Name(),
+	)
+}
+
+// DestructuringLengthMismatchError
+
+type DestructuringLengthMismatchError struct {
+	ast.Range
+	ExpectedLength int
+	ActualLength   int
+}
+
+var _ SemanticError = &DestructuringLengthMismatchError{}
+var _ errors.UserError = &DestructuringLengthMismatchError{}
+
+func (*DestructuringLengthMismatchError) isSemanticError() {}
+
+func (*DestructuringLengthMismatchError) IsUserError() {}
+
+func (e *DestructuringLengthMismatchError) Error() string {
+	return fmt.Sprintf(
+		"incorrect number of elements in array pattern: expected %d, got %d",
+		e.ExpectedLength,
+		e.ActualLength,
+	)
+}
+
+// InvalidDestructuringMemberError
+
+type InvalidDestructuringMemberError struct {
+	Name string
+	ast.Range
+	DeclarationKind common.DeclarationKind
+}
+
+var _ SemanticError = &InvalidDestructuringMemberError{}
+var _ errors.UserError = &InvalidDestructuringMemberError{}
+var _ errors.SecondaryError = &InvalidDestructuringMemberError{}
+
+func (*InvalidDestructuringMemberError) isSemanticError() {}
+
+func (*InvalidDestructuringMemberError) IsUserError() {}
+
+func (e *InvalidDestructuringMemberError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure %s `%s`",
+		e.DeclarationKind.Name(),
+		e.Name,
+	)
+}
+
+func (*InvalidDestructuringMemberError) SecondaryError() string {
+	return "only fields without entitlement-mapped access can be destructured"
+}
+
+// InvalidResourceSwitchCaseError
+
+type InvalidResourceSwitchCaseError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidResourceSwitchCaseError{}
+var _ errors.UserError = &InvalidResourceSwitchCaseError{}
+var _ errors.SecondaryError = &InvalidResourceSwitchCaseError{}
+
+func (*InvalidResourceSwitchCaseError) isSemanticError() {}
+
+func (*InvalidResourceSwitchCaseError) IsUserError() {}
+
+func (e *InvalidResourceSwitchCaseError) Error() string {
+	return "invalid switch case for resource"
+}
+
+func (*InvalidResourceSwitchCaseError) SecondaryError() string {
+	return "resources can only be matched by type patterns without guards, e.g. `case let r as @R:`"
+}
+
+// NonExhaustiveSwitchWarning is reported when a switch over an enum
+// has no default case and does not cover all cases of the enum
+
+type NonExhaustiveSwitchWarning struct {
+	Type         Type
+	MissingCases []string
+	ast.Range
+}
+
+var _ errors.SecondaryError = &NonExhaustiveSwitchWarning{}
+var _ errors.HasPrefix = &NonExhaustiveSwitchWarning{}
+
+func (*NonExhaustiveSwitchWarning) Prefix() string {
+	return "warning"
+}
+
+func (e *NonExhaustiveSwitchWarning) Error() string {
+	return fmt.Sprintf(
+		"switch over enum `%s` is not exhaustive",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *NonExhaustiveSwitchWarning) SecondaryError() string {
+	var builder strings.Builder
+	builder.WriteString("missing cases: ")
+	for i, name := range e.MissingCases {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteByte('`')
+		builder.WriteString(name)
+		builder.WriteByte('`')
+	}
+	return builder.String()
+}
+
+// RuntimeTypeCheckWarning is reported in the loose mode
+// when the type of an expression is not a subtype of the expected type,
+// but the value of the expression may have the expected type at run-time.
+// The type of the value is checked at run-time
+
+type RuntimeTypeCheckWarning struct {
+	ExpectedType Type
+	ActualType   Type
+	ast.Range
+}
+
+var _ errors.SecondaryError = &RuntimeTypeCheckWarning{}
+
+func (e *RuntimeTypeCheckWarning) Error() string {
+	return "mismatched types, checked at run-time"
+}
+
+func (e *RuntimeTypeCheckWarning) SecondaryError() string {
+	expected, actual := ErrorMessageExpectedActualTypes(
+		e.ExpectedType,
+		e.ActualType,
+	)
+
+	return fmt.Sprintf(
+		"expected `%s`, got `%s`",
+		expected,
+		actual,
+	)
+}
+
+// RuntimeOperandsCheckWarning is reported in the loose mode
+// when both operands of a binary operation have type `AnyStruct`.
+// The operation is checked at run-time
+
+type RuntimeOperandsCheckWarning struct {
+	Operation ast.Operation
+	ast.Range
+}
+
+var _ errors.SecondaryError = &RuntimeOperandsCheckWarning{}
+
+func (e *RuntimeOperandsCheckWarning) Error() string {
+	return fmt.Sprintf(
+		"cannot check operands of `%s`, checked at run-time",
+		e.Operation.Symbol(),
+	)
+}
+
+func (e *RuntimeOperandsCheckWarning) SecondaryError() string {
+	return fmt.Sprintf("both operands have type `%s`", AnyStructType)
+}
+
+// RuntimeIndexingCheckWarning is reported in the loose mode
+// when a value of type `AnyStruct` is indexed.
+// The indexing is checked at run-time
+
+type RuntimeIndexingCheckWarning struct {
+	ast.Range
+}
+
+var _ errors.SecondaryError = &RuntimeIndexingCheckWarning{}
+
+func (e *RuntimeIndexingCheckWarning) Error() string {
+	return "cannot check indexing, checked at run-time"
+}
+
+func (e *RuntimeIndexingCheckWarning) SecondaryError() string {
+	return fmt.Sprintf("indexed value has type `%s`", AnyStructType)
+}
+
 // TypeParameterTypeMismatchError
 
 type TypeParameterTypeMismatchError struct {
@@ -4068,6 +4459,84 @@ func (e *CyclicImportsError) Error() string {
 	return fmt.Sprintf("cyclic import of `%s`", e.Location)
 }
 
+// ImportHashMismatchError
+
+type ImportHashMismatchError struct {
+	Location     common.Location
+	ExpectedHash []byte
+	ActualHash   []byte
+	ast.Range
+}
+
+var _ SemanticError = &ImportHashMismatchError{}
+var _ errors.UserError = &ImportHashMismatchError{}
+var _ errors.SecondaryError = &ImportHashMismatchError{}
+
+func (*ImportHashMismatchError) isSemanticError() {}
+
+func (*ImportHashMismatchError) IsUserError() {}
+
+func (e *ImportHashMismatchError) Error() string {
+	return fmt.Sprintf("hash of imported code of `%s` does not match", e.Location)
+}
+
+func (e *ImportHashMismatchError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected hash %s, got %s",
+		hex.EncodeToString(e.ExpectedHash),
+		hex.EncodeToString(e.ActualHash),
+	)
+}
+
+// UnverifiedImportHashError
+
+type UnverifiedImportHashError struct {
+	Location common.Location
+	ast.Range
+}
+
+var _ SemanticError = &UnverifiedImportHashError{}
+var _ errors.UserError = &UnverifiedImportHashError{}
+var _ errors.SecondaryError = &UnverifiedImportHashError{}
+
+func (*UnverifiedImportHashError) isSemanticError() {}
+
+func (*UnverifiedImportHashError) IsUserError() {}
+
+func (e *UnverifiedImportHashError) Error() string {
+	return fmt.Sprintf("cannot verify hash of imported code of `%s`", e.Location)
+}
+
+func (e *UnverifiedImportHashError) SecondaryError() string {
+	return "pinned imports are not supported in this environment"
+}
+
+// AmbiguousImportHashError
+
+type AmbiguousImportHashError struct {
+	Location common.Location
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousImportHashError{}
+var _ errors.UserError = &AmbiguousImportHashError{}
+var _ errors.SecondaryError = &AmbiguousImportHashError{}
+
+func (*AmbiguousImportHashError) isSemanticError() {}
+
+func (*AmbiguousImportHashError) IsUserError() {}
+
+func (e *AmbiguousImportHashError) Error() string {
+	return fmt.Sprintf(
+		"cannot pin import of `%s`: the import resolves to multiple locations",
+		e.Location,
+	)
+}
+
+func (e *AmbiguousImportHashError) SecondaryError() string {
+	return "a hash can only pin the code of a single location; import each declaration separately"
+}
+
 // SwitchDefaultPositionError
 
 type SwitchDefaultPositionError struct {
@@ -4156,6 +4625,36 @@ func (*PurityError) IsUserError() {}
 
 func (*PurityError) isSemanticError() {}
 
+// InvalidHashingFunctionAccessError is reported when the `equals` or `hashValue` function
+// of an equatable or hashable structure accesses a value that may change after the structure was stored,
+// e.g. a variable field, a global, or a user-defined function
+
+type InvalidHashingFunctionAccessError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &InvalidHashingFunctionAccessError{}
+var _ errors.UserError = &InvalidHashingFunctionAccessError{}
+var _ errors.SecondaryError = &InvalidHashingFunctionAccessError{}
+
+func (*InvalidHashingFunctionAccessError) isSemanticError() {}
+
+func (*InvalidHashingFunctionAccessError) IsUserError() {}
+
+func (e *InvalidHashingFunctionAccessError) Error() string {
+	return fmt.Sprintf(
+		"cannot access `%s` in `%s` or `%s` function",
+		e.Name,
+		EquatableTypeEqualsFunctionName,
+		HashableTypeHashValueFunctionName,
+	)
+}
+
+func (*InvalidHashingFunctionAccessError) SecondaryError() string {
+	return "only parameters, local values, constant fields, and built-in functions may be accessed"
+}
+
 // InvalidatedResourceReferenceError
 
 type InvalidatedResourceReferenceError struct {
@@ -4776,21 +5275,130 @@ func (e *DefaultDestroyInvalidParameterError) Error() string {
 	return fmt.Sprintf("`%s` is not a valid parameter type for a default destroy event", e.ParamType.QualifiedString())
 }
 
-// InvalidTypeParameterizedNonNativeFunctionError
+// MissingDefaultArgumentError
+
+type MissingDefaultArgumentError struct {
+	ParameterName string
+	ast.Range
+}
+
+var _ SemanticError = &MissingDefaultArgumentError{}
+var _ errors.UserError = &MissingDefaultArgumentError{}
+var _ errors.SecondaryError = &MissingDefaultArgumentError{}
+
+func (*MissingDefaultArgumentError) isSemanticError() {}
+
+func (*MissingDefaultArgumentError) IsUserError() {}
+
+func (e *MissingDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"missing default argument for parameter `%s`",
+		e.ParameterName,
+	)
+}
+
+func (*MissingDefaultArgumentError) SecondaryError() string {
+	return "parameters following a parameter with a default argument must also have a default argument"
+}
+
+// InvalidResourceDefaultArgumentError
+
+type InvalidResourceDefaultArgumentError struct {
+	ParameterType Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidResourceDefaultArgumentError{}
+var _ errors.UserError = &InvalidResourceDefaultArgumentError{}
+
+func (*InvalidResourceDefaultArgumentError) isSemanticError() {}
+
+func (*InvalidResourceDefaultArgumentError) IsUserError() {}
+
+func (e *InvalidResourceDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"cannot use a default argument for a parameter of resource type `%s`",
+		e.ParameterType.QualifiedString(),
+	)
+}
+
+// InvalidStringTemplateInterpolationError
+
+type InvalidStringTemplateInterpolationError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidStringTemplateInterpolationError{}
+var _ errors.UserError = &InvalidStringTemplateInterpolationError{}
+var _ errors.SecondaryError = &InvalidStringTemplateInterpolationError{}
+
+func (*InvalidStringTemplateInterpolationError) isSemanticError() {}
 
-type InvalidTypeParameterizedNonNativeFunctionError struct {
+func (*InvalidStringTemplateInterpolationError) IsUserError() {}
+
+func (e *InvalidStringTemplateInterpolationError) Error() string {
+	return fmt.Sprintf(
+		"cannot interpolate value of type `%s` into string",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidStringTemplateInterpolationError) SecondaryError() string {
+	return "only strings, characters, and non-resource values with a `toString` function can be interpolated"
+}
+
+// InvalidTypeParameterBoundError
+
+type InvalidTypeParameterBoundError struct {
+	TypeParameter *TypeParameter
 	ast.Range
 }
 
-var _ SemanticError = &InvalidTypeParameterizedNonNativeFunctionError{}
-var _ errors.UserError = &InvalidTypeParameterizedNonNativeFunctionError{}
+var _ SemanticError = &InvalidTypeParameterBoundError{}
+var _ errors.UserError = &InvalidTypeParameterBoundError{}
+var _ errors.SecondaryError = &InvalidTypeParameterBoundError{}
 
-func (*InvalidTypeParameterizedNonNativeFunctionError) isSemanticError() {}
+func (*InvalidTypeParameterBoundError) isSemanticError() {}
 
-func (*InvalidTypeParameterizedNonNativeFunctionError) IsUserError() {}
+func (*InvalidTypeParameterBoundError) IsUserError() {}
+
+func (e *InvalidTypeParameterBoundError) Error() string {
+	return fmt.Sprintf(
+		"invalid bound for type parameter `%s`: `%s`",
+		e.TypeParameter.Name,
+		e.TypeParameter.TypeBound.QualifiedString(),
+	)
+}
+
+func (e *InvalidTypeParameterBoundError) SecondaryError() string {
+	return "the bound must be a subtype of either `AnyStruct` or `AnyResource`"
+}
+
+// InvalidTypeParameterizedCompositeError
+
+type InvalidTypeParameterizedCompositeError struct {
+	CompositeKind common.CompositeKind
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTypeParameterizedCompositeError{}
+var _ errors.UserError = &InvalidTypeParameterizedCompositeError{}
+var _ errors.SecondaryError = &InvalidTypeParameterizedCompositeError{}
+
+func (*InvalidTypeParameterizedCompositeError) isSemanticError() {}
+
+func (*InvalidTypeParameterizedCompositeError) IsUserError() {}
+
+func (e *InvalidTypeParameterizedCompositeError) Error() string {
+	return fmt.Sprintf(
+		"invalid type parameters in %s declaration",
+		e.CompositeKind.Name(),
+	)
+}
 
-func (e *InvalidTypeParameterizedNonNativeFunctionError) Error() string {
-	return "invalid type parameters in non-native function"
+func (e *InvalidTypeParameterizedCompositeError) SecondaryError() string {
+	return "only structures and resources may have type parameters"
 }
 
 // NestedReferenceError
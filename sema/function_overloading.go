@@ -0,0 +1,520 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+// FunctionOverloadName returns the name under which an overload of a function,
+// or of the initializer of a composite, is declared.
+//
+// Functions and initializers may be overloaded by their argument labels.
+// The first declaration is declared under its identifier,
+// all further overloads are declared under a name which consists of
+// the identifier and the argument labels, e.g. `foo(bar:_:)`.
+func FunctionOverloadName(identifier string, argumentLabels []string) string {
+	var builder strings.Builder
+	builder.WriteString(identifier)
+	builder.WriteByte('(')
+	for _, argumentLabel := range argumentLabels {
+		builder.WriteString(argumentLabel)
+		builder.WriteByte(':')
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// invocationArgumentLabels returns the argument labels of the given invocation.
+// Unlabeled arguments have the label `_`, which matches parameters that require no argument label.
+func invocationArgumentLabels(invocationExpression *ast.InvocationExpression) []string {
+	argumentLabels := make([]string, len(invocationExpression.Arguments))
+	for i, argument := range invocationExpression.Arguments {
+		label := argument.Label
+		if label == "" {
+			label = ArgumentLabelNotRequired
+		}
+		argumentLabels[i] = label
+	}
+	return argumentLabels
+}
+
+// invokingExpression returns the parent invocation expression,
+// if the given expression is its invoked expression.
+func (checker *Checker) invokingExpression(expression ast.Expression) (*ast.InvocationExpression, bool) {
+	invocationExpression, ok := checker.parent.(*ast.InvocationExpression)
+	if !ok || invocationExpression.InvokedExpression != expression {
+		return nil, false
+	}
+
+	return invocationExpression, true
+}
+
+// isFunctionOverload returns true if the given function, with the given argument labels,
+// is an overload of a previously declared function with the same identifier,
+// i.e. the previous declaration is a function that has different argument labels.
+func isFunctionOverload(previousMember *Member, argumentLabels []string) bool {
+	return previousMember.DeclarationKind == common.DeclarationKindFunction &&
+		!slices.Equal(previousMember.ArgumentLabels, argumentLabels)
+}
+
+// lookupFunctionOverload returns the member of the given members
+// which corresponds to the given function member,
+// i.e. the function with the same identifier and the same argument labels.
+//
+// If there is no such overload, the member with the same identifier is returned,
+// unless the given function has an implementation and the member is a function
+// with different argument labels, i.e. the given function can be inherited as an overload.
+func lookupFunctionOverload(members *StringMemberOrderedMap, function *Member) (*Member, bool) {
+	identifier := function.Identifier.Identifier
+
+	overloadName := FunctionOverloadName(identifier, function.ArgumentLabels)
+	member, ok := members.Get(overloadName)
+	if ok {
+		return member, true
+	}
+
+	member, ok = members.Get(identifier)
+	if !ok {
+		return nil, false
+	}
+
+	if function.HasImplementation &&
+		isFunctionOverload(member, function.ArgumentLabels) {
+
+		return nil, false
+	}
+
+	return member, true
+}
+
+// addInheritedFunctionOverloads adds the inherited functions of the given members
+// to the given member resolvers, which are overloads of an already declared function
+// with the same identifier, under their function overload names.
+func addInheritedFunctionOverloads(
+	memberResolvers map[string]MemberResolver,
+	inheritedMembers *StringMemberOrderedMap,
+) {
+	inheritedMembers.Foreach(func(name string, inheritedMember *Member) {
+		if inheritedMember.DeclarationKind != common.DeclarationKindFunction {
+			return
+		}
+
+		resolver, ok := memberResolvers[name]
+		if !ok || resolver.Kind != common.DeclarationKindFunction {
+			return
+		}
+
+		identifier := inheritedMember.Identifier.Identifier
+		overloadName := FunctionOverloadName(identifier, inheritedMember.ArgumentLabels)
+		if _, ok := memberResolvers[overloadName]; ok {
+			return
+		}
+
+		declaredMember := resolver.Resolve(nil, name, ast.EmptyRange, func(error) {})
+		if declaredMember == nil ||
+			!isFunctionOverload(declaredMember, inheritedMember.ArgumentLabels) {
+
+			return
+		}
+
+		memberResolvers[overloadName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(_ common.MemoryGauge, _ string, _ ast.HasPosition, _ func(error)) *Member {
+				return inheritedMember
+			},
+		}
+	})
+}
+
+// recordFunctionOverloadName records that the function or constructor with the given identifier
+// has an overload which is declared as a value under the given function overload name.
+func (checker *Checker) recordFunctionOverloadName(identifier string, overloadName string) {
+	if checker.functionOverloadNames == nil {
+		checker.functionOverloadNames = map[string][]string{}
+	}
+
+	overloadNames := checker.functionOverloadNames[identifier]
+	if slices.Contains(overloadNames, overloadName) {
+		return
+	}
+	checker.functionOverloadNames[identifier] = append(overloadNames, overloadName)
+}
+
+// acceptsArgumentLabels returns true if a function with parameters with the given argument labels,
+// of which the given number of leading parameters are required, can be invoked with arguments
+// with the given argument labels.
+//
+// Arguments for trailing parameters with default arguments may be omitted.
+func acceptsArgumentLabels(parameterArgumentLabels []string, requiredCount int, argumentLabels []string) bool {
+	argumentCount := len(argumentLabels)
+	return argumentCount >= requiredCount &&
+		argumentCount <= len(parameterArgumentLabels) &&
+		slices.Equal(parameterArgumentLabels[:argumentCount], argumentLabels)
+}
+
+// functionTypeAcceptsArgumentLabels returns true if the given type is a function type
+// which can be invoked with arguments with the given argument labels.
+func functionTypeAcceptsArgumentLabels(ty Type, argumentLabels []string) bool {
+	functionType, ok := ty.(*FunctionType)
+	if !ok {
+		return false
+	}
+
+	parameterArgumentLabels := functionType.ArgumentLabels()
+	requiredCount := functionType.Arity.MinCount(len(parameterArgumentLabels))
+
+	return acceptsArgumentLabels(parameterArgumentLabels, requiredCount, argumentLabels)
+}
+
+// checkAmbiguousFunctionOverloads reports an error for each of the given function declarations
+// which overloads a preceding declaration with the same identifier,
+// if both functions can be invoked with the same argument labels,
+// i.e. if the argument labels only differ in trailing parameters with default arguments.
+func (checker *Checker) checkAmbiguousFunctionOverloads(declarations []*ast.FunctionDeclaration) {
+	if len(declarations) < 2 {
+		return
+	}
+
+	previousDeclarations := map[string][]*ast.FunctionDeclaration{}
+
+	for _, declaration := range declarations {
+		if declaration.ParameterList == nil {
+			continue
+		}
+
+		identifier := declaration.Identifier.Identifier
+
+		argumentLabels := declaration.ParameterList.EffectiveArgumentLabels()
+		requiredCount := defaultArgumentsArity(declaration.ParameterList).MinCount(len(argumentLabels))
+
+		for _, previousDeclaration := range previousDeclarations[identifier] {
+			previousArgumentLabels := previousDeclaration.ParameterList.EffectiveArgumentLabels()
+
+			// Declarations with the same argument labels are redeclarations, not overloads
+
+			if slices.Equal(previousArgumentLabels, argumentLabels) {
+				continue
+			}
+
+			previousRequiredCount := defaultArgumentsArity(previousDeclaration.ParameterList).
+				MinCount(len(previousArgumentLabels))
+
+			// Both functions can be invoked with the argument labels of the shortest invocation
+			// of the overload with more required parameters, if the other overload accepts them
+
+			commonArgumentCount := max(requiredCount, previousRequiredCount)
+			if commonArgumentCount > len(argumentLabels) {
+				continue
+			}
+
+			commonArgumentLabels := argumentLabels[:commonArgumentCount]
+			if !acceptsArgumentLabels(argumentLabels, requiredCount, commonArgumentLabels) ||
+				!acceptsArgumentLabels(previousArgumentLabels, previousRequiredCount, commonArgumentLabels) {
+
+				continue
+			}
+
+			checker.report(
+				&AmbiguousFunctionOverloadError{
+					Name:           identifier,
+					ArgumentLabels: commonArgumentLabels,
+					Range:          ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+				},
+			)
+			break
+		}
+
+		previousDeclarations[identifier] = append(previousDeclarations[identifier], declaration)
+	}
+}
+
+// identifierFunctionOverloadNames returns the names of the overloads of the function or constructor
+// with the given identifier, including the identifier itself, if it is overloaded.
+// The variables of the overloads are returned in the same order.
+//
+// An overload is only used if it is not shadowed by a declaration of the identifier
+// in an inner scope.
+func (checker *Checker) identifierFunctionOverloadNames(identifier string) ([]string, []*Variable) {
+	variable := checker.valueActivations.Find(identifier)
+	if variable == nil {
+		return nil, nil
+	}
+
+	overloadNames := checker.functionOverloadNames[identifier]
+	if len(overloadNames) == 0 {
+		return nil, nil
+	}
+
+	names := []string{identifier}
+	variables := []*Variable{variable}
+
+	for _, overloadName := range overloadNames {
+		overloadVariable := checker.valueActivations.Find(overloadName)
+		if overloadVariable == nil ||
+			overloadVariable.ActivationDepth < variable.ActivationDepth {
+
+			continue
+		}
+
+		names = append(names, overloadName)
+		variables = append(variables, overloadVariable)
+	}
+
+	return names, variables
+}
+
+// resolveIdentifierFunctionOverload resolves the function overload which is invoked
+// by the given invocation of an identifier, based on the argument labels of the invocation.
+//
+// The overload is the function or constructor which can be invoked with the argument labels,
+// where arguments for trailing parameters with default arguments may be omitted.
+// If more than one overload can be invoked with the argument labels, an error is reported.
+func (checker *Checker) resolveIdentifierFunctionOverload(
+	invocationExpression *ast.InvocationExpression,
+	identifierExpression *ast.IdentifierExpression,
+) {
+	identifier := identifierExpression.Identifier.Identifier
+
+	names, variables := checker.identifierFunctionOverloadNames(identifier)
+	if len(names) < 2 {
+		return
+	}
+
+	argumentLabels := invocationArgumentLabels(invocationExpression)
+
+	var resolvedName string
+	var candidateCount int
+
+	for i, name := range names {
+		if !functionTypeAcceptsArgumentLabels(variables[i].Type, argumentLabels) {
+			continue
+		}
+
+		resolvedName = name
+		candidateCount++
+	}
+
+	switch {
+	case candidateCount > 1:
+		checker.report(
+			&AmbiguousFunctionOverloadError{
+				Name:           identifier,
+				ArgumentLabels: argumentLabels,
+				Range:          ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression),
+			},
+		)
+
+	case candidateCount == 1 && resolvedName != identifier:
+		checker.Elaboration.SetIdentifierExpressionOverloadName(identifierExpression, resolvedName)
+	}
+}
+
+// resolveIdentifierFunctionReference resolves the function overload which is referenced,
+// but not invoked, by the given identifier, e.g. `let f = foo`, based on the expected type.
+//
+// Exactly one overload must be a subtype of the expected type,
+// otherwise the reference is ambiguous and an error is reported.
+func (checker *Checker) resolveIdentifierFunctionReference(identifierExpression *ast.IdentifierExpression) {
+	identifier := identifierExpression.Identifier.Identifier
+
+	names, variables := checker.identifierFunctionOverloadNames(identifier)
+	if len(names) < 2 {
+		return
+	}
+
+	types := make([]Type, len(variables))
+	for i, variable := range variables {
+		types[i] = variable.Type
+	}
+
+	resolvedName, ok := checker.resolveFunctionReference(names, types)
+	if !ok {
+		checker.report(
+			&AmbiguousFunctionReferenceError{
+				Name:  identifier,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, identifierExpression),
+			},
+		)
+		return
+	}
+
+	if resolvedName != identifier {
+		checker.Elaboration.SetIdentifierExpressionOverloadName(identifierExpression, resolvedName)
+	}
+}
+
+// resolveFunctionReference returns the name of the only overload,
+// given by the names and types of all overloads, which is a subtype of the expected type.
+func (checker *Checker) resolveFunctionReference(names []string, types []Type) (string, bool) {
+	expectedType := checker.expectedType
+	if expectedType == nil || expectedType.IsInvalidType() {
+		return "", false
+	}
+
+	var resolvedName string
+	var candidateCount int
+
+	for i, name := range names {
+		if !IsSubType(types[i], expectedType) {
+			continue
+		}
+
+		resolvedName = name
+		candidateCount++
+	}
+
+	return resolvedName, candidateCount == 1
+}
+
+// memberFunctionOverloadNames returns the names of the overloads of the member function
+// with the given identifier, sorted, excluding the identifier itself.
+func memberFunctionOverloadNames(members map[string]MemberResolver, identifier string) []string {
+	overloadPrefix := identifier + "("
+
+	var overloadNames []string
+	for name := range members { //nolint:maprange
+		if strings.HasPrefix(name, overloadPrefix) {
+			overloadNames = append(overloadNames, name)
+		}
+	}
+
+	// Sort the overload names, so the resolution is deterministic
+	slices.Sort(overloadNames)
+
+	return overloadNames
+}
+
+// resolveMemberFunctionOverload resolves the function overload which is invoked
+// by the given invocation of a member, based on the argument labels of the invocation,
+// like resolveIdentifierFunctionOverload.
+//
+// The name of the member is returned, which is either the identifier,
+// or the function overload name of the overload.
+func (checker *Checker) resolveMemberFunctionOverload(
+	members map[string]MemberResolver,
+	identifier string,
+	invocationExpression *ast.InvocationExpression,
+) string {
+	overloadNames := memberFunctionOverloadNames(members, identifier)
+	if len(overloadNames) == 0 {
+		return identifier
+	}
+
+	argumentLabels := invocationArgumentLabels(invocationExpression)
+
+	resolvedName := identifier
+	var candidateCount int
+
+	accepts := func(name string) bool {
+		member := checker.resolveMemberFunction(members, identifier, name)
+		return member != nil &&
+			functionTypeAcceptsArgumentLabels(member.TypeAnnotation.Type, argumentLabels)
+	}
+
+	if accepts(identifier) {
+		candidateCount++
+	}
+
+	for _, overloadName := range overloadNames {
+		if !accepts(overloadName) {
+			continue
+		}
+		resolvedName = overloadName
+		candidateCount++
+	}
+
+	if candidateCount > 1 {
+		checker.report(
+			&AmbiguousFunctionOverloadError{
+				Name:           identifier,
+				ArgumentLabels: argumentLabels,
+				Range:          ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression),
+			},
+		)
+		return identifier
+	}
+
+	return resolvedName
+}
+
+// resolveMemberFunctionReference resolves the function overload which is referenced,
+// but not invoked, by the given member expression, e.g. `let f = s.foo`,
+// based on the expected type, like resolveIdentifierFunctionReference.
+//
+// The name of the member is returned, which is either the identifier,
+// or the function overload name of the overload.
+func (checker *Checker) resolveMemberFunctionReference(
+	members map[string]MemberResolver,
+	identifier string,
+	memberExpression *ast.MemberExpression,
+) string {
+	overloadNames := memberFunctionOverloadNames(members, identifier)
+	if len(overloadNames) == 0 {
+		return identifier
+	}
+
+	var names []string
+	var types []Type
+
+	for _, name := range append([]string{identifier}, overloadNames...) {
+		member := checker.resolveMemberFunction(members, identifier, name)
+		if member == nil {
+			continue
+		}
+		names = append(names, name)
+		types = append(types, member.TypeAnnotation.Type)
+	}
+
+	resolvedName, ok := checker.resolveFunctionReference(names, types)
+	if !ok {
+		checker.report(
+			&AmbiguousFunctionReferenceError{
+				Name: identifier,
+				Range: ast.NewRange(
+					checker.memoryGauge,
+					memberExpression.Identifier.StartPosition(),
+					memberExpression.Identifier.EndPosition(checker.memoryGauge),
+				),
+			},
+		)
+		return identifier
+	}
+
+	return resolvedName
+}
+
+// resolveMemberFunction returns the member with the given name,
+// which is either the identifier of the function, or the function overload name of an overload.
+func (checker *Checker) resolveMemberFunction(
+	members map[string]MemberResolver,
+	identifier string,
+	name string,
+) *Member {
+	resolver, ok := members[name]
+	if !ok {
+		return nil
+	}
+	return resolver.Resolve(checker.memoryGauge, identifier, ast.EmptyRange, func(error) {})
+}
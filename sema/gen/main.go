@@ -748,6 +748,10 @@ func (*generator) VisitTransactionDeclaration(_ *ast.TransactionDeclaration) str
 	panic("transaction declarations are not supported")
 }
 
+func (*generator) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) struct{} {
+	panic("type alias declarations are not supported")
+}
+
 func (g *generator) VisitEntitlementDeclaration(decl *ast.EntitlementDeclaration) (_ struct{}) {
 	entitlementName := decl.Identifier.Identifier
 	typeVarName := typeVarName(entitlementName)
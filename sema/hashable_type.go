@@ -0,0 +1,71 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/common"
+)
+
+const HashableTypeName = "Hashable"
+
+const HashableTypeHashValueFunctionName = "hashValue"
+
+var HashableTypeHashValueFunctionType = &FunctionType{
+	Purity:               FunctionPurityView,
+	ReturnTypeAnnotation: NewTypeAnnotation(UInt64Type),
+}
+
+const hashableTypeHashValueFunctionDocString = `
+Returns the hash value of this value.
+
+Values which are equal according to the ` + "`equals`" + ` function must have the same hash value.
+
+Stored dictionary keys and set elements depend on this function and the ` + "`equals`" + ` function,
+so contract updates cannot change them,
+and they may only access the constant fields of the structure.
+`
+
+// HashableType represents the built-in `Hashable` struct interface.
+//
+// Structures conforming to it are also equatable,
+// and can be used as dictionary keys and set elements.
+var HashableType = func() *InterfaceType {
+	interfaceType := &InterfaceType{
+		Identifier:    HashableTypeName,
+		CompositeKind: common.CompositeKindStructure,
+		NestedTypes:   &StringTypeOrderedMap{},
+		ExplicitInterfaceConformances: []*InterfaceType{
+			EquatableType,
+		},
+	}
+
+	members := []*Member{
+		NewUnmeteredPublicFunctionMember(
+			interfaceType,
+			HashableTypeHashValueFunctionName,
+			HashableTypeHashValueFunctionType,
+			hashableTypeHashValueFunctionDocString,
+		),
+	}
+
+	interfaceType.Members = MembersAsMap(members)
+	interfaceType.Fields = MembersFieldNames(members)
+
+	return interfaceType
+}()
@@ -0,0 +1,155 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+//go:generate go run ./gen timestamp.cdc timestamp.gen.go
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/errors"
+)
+
+var TimestampTypeAnnotation = NewTypeAnnotation(TimestampType)
+
+var TimestampConversionFunctionType = &FunctionType{
+	Purity: FunctionPurityView,
+	Parameters: []Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: NumberTypeAnnotation,
+		},
+	},
+	ReturnTypeAnnotation:     TimestampTypeAnnotation,
+	ArgumentExpressionsCheck: fix64ArgumentExpressionsCheck,
+}
+
+const TimestampTypeFromStringFunctionName = "fromString"
+const TimestampTypeFromStringFunctionDocString = `
+Attempts to parse a Timestamp from the given ISO-8601 string in the UTC timezone,
+e.g. ` + "`2020-03-13T19:52:43Z`" + `. Returns nil on invalid input.
+`
+
+var TimestampTypeFromStringFunctionType = FromStringFunctionType(TimestampType)
+
+func init() {
+	// Declare a conversion function for the timestamp type
+
+	// Check that the function is not accidentally redeclared
+
+	typeName := TimestampTypeName
+
+	if BaseValueActivation.Find(typeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	functionType := TimestampConversionFunctionType
+
+	functionType.Members = &StringMemberOrderedMap{}
+	functionType.Members.Set(
+		TimestampTypeFromStringFunctionName,
+		NewUnmeteredPublicFunctionMember(
+			functionType,
+			TimestampTypeFromStringFunctionName,
+			TimestampTypeFromStringFunctionType,
+			TimestampTypeFromStringFunctionDocString,
+		),
+	)
+
+	BaseValueActivation.Set(
+		typeName,
+		baseFunctionVariable(
+			typeName,
+			functionType,
+			"Converts the given number of seconds since the Unix epoch to a timestamp. "+
+				numberConversionFunctionDocStringSuffix,
+		),
+	)
+}
+
+// fix64ArgumentExpressionsCheck checks that number literals passed
+// to the timestamp and duration conversion functions fit into the range of Fix64,
+// which is the representation of both timestamps and durations
+func fix64ArgumentExpressionsCheck(checker *Checker, arguments []ast.Expression, _ ast.HasPosition) {
+	if len(arguments) < 1 {
+		return
+	}
+
+	switch argument := arguments[0].(type) {
+	case *ast.IntegerExpression:
+		CheckIntegerLiteral(nil, argument, Fix64Type, checker.report)
+
+	case *ast.FixedPointExpression:
+		CheckFixedPointLiteral(nil, argument, Fix64Type, checker.report)
+	}
+}
+
+// ParseTimestamp parses the given ISO-8601 string in the UTC timezone,
+// e.g. `2020-03-13T19:52:43Z`, and returns the number of seconds since the Unix epoch
+// as a fixed-point number with the scale of Fix64.
+//
+// The trailing `Z` is optional, other timezones are not allowed.
+// Fractional seconds may have at most Fix64Scale digits.
+func ParseTimestamp(s string) (int64, bool) {
+	s = strings.TrimSuffix(s, "Z")
+
+	// NOTE: the layout has no fractional seconds,
+	// but parsing accepts them after the seconds field
+
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		return 0, false
+	}
+
+	const nanosecondsPerUnit = int64(time.Second) / Fix64Factor
+
+	nanoseconds := int64(t.Nanosecond())
+	if nanoseconds%nanosecondsPerUnit != 0 {
+		return 0, false
+	}
+
+	fraction := nanoseconds / nanosecondsPerUnit
+
+	seconds := t.Unix()
+	if seconds < Fix64TypeMinInt ||
+		seconds > Fix64TypeMaxInt ||
+		(seconds == Fix64TypeMaxInt && fraction > math.MaxInt64%Fix64Factor) {
+
+		return 0, false
+	}
+
+	return seconds*Fix64Factor + fraction, true
+}
+
+func (checker *Checker) checkTimestampLiteral(expression *ast.StringExpression) {
+	if _, ok := ParseTimestamp(expression.Value); ok {
+		return
+	}
+
+	checker.report(
+		&InvalidTimestampLiteralError{
+			Literal: expression.Value,
+			Range:   ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+		},
+	)
+}
@@ -225,6 +225,12 @@ type ValueIndexableType interface {
 	IndexingType() Type
 }
 
+// IsValueIndexableType returns true if the given type can be indexed into using a value
+func IsValueIndexableType(ty Type) bool {
+	valueIndexableType, ok := ty.(ValueIndexableType)
+	return ok && valueIndexableType.isValueIndexableType()
+}
+
 // TypeIndexableType is a type which can be indexed into using a type
 type TypeIndexableType interface {
 	Type
@@ -282,6 +288,17 @@ func VisitThisAndNested(t Type, visit func(ty Type)) {
 	})
 }
 
+// TypeAliasContainerType is a container type which may declare type aliases,
+// which can be referred to from outside of it, e.g. `C.Alias`.
+//
+// Type aliases are not nested types, i.e. they are not included in the nested types of the container type,
+// and are only considered when resolving nominal types
+type TypeAliasContainerType interface {
+	ContainerType
+	SetNestedTypeAlias(name string, aliasedType Type)
+	GetNestedTypeAlias(name string) (Type, bool)
+}
+
 func TypeActivationNestedType(typeActivation *VariableActivation, qualifiedIdentifier string) Type {
 
 	typeIDComponents := strings.Split(qualifiedIdentifier, string(TypeIDSeparator))
@@ -948,8 +965,13 @@ func (t *GenericType) Equal(other Type) bool {
 	return t.TypeParameter == otherType.TypeParameter
 }
 
-func (*GenericType) IsResourceType() bool {
-	return false
+// NOTE: A generic type has the properties of its type bound, if any,
+// as every type argument must be a subtype of the type bound.
+
+func (t *GenericType) IsResourceType() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsResourceType()
 }
 
 func (*GenericType) IsPrimitiveType() bool {
@@ -960,28 +982,40 @@ func (*GenericType) IsInvalidType() bool {
 	return false
 }
 
-func (*GenericType) IsOrContainsReferenceType() bool {
-	return false
+func (t *GenericType) IsOrContainsReferenceType() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsOrContainsReferenceType()
 }
 
-func (*GenericType) IsStorable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsStorable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsStorable(results)
 }
 
-func (*GenericType) IsExportable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsExportable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsExportable(results)
 }
 
-func (t *GenericType) IsImportable(_ map[*Member]bool) bool {
-	return false
+func (t *GenericType) IsImportable(results map[*Member]bool) bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsImportable(results)
 }
 
-func (*GenericType) IsEquatable() bool {
-	return false
+func (t *GenericType) IsEquatable() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsEquatable()
 }
 
-func (*GenericType) IsComparable() bool {
-	return false
+func (t *GenericType) IsComparable() bool {
+	typeBound := t.TypeParameter.TypeBound
+	return typeBound != nil &&
+		typeBound.IsComparable()
 }
 
 func (t *GenericType) ContainFieldsOrElements() bool {
@@ -1052,11 +1086,20 @@ func (t *GenericType) Map(_ common.MemoryGauge, typeParamMap map[*TypeParameter]
 			TypeParameter: param,
 		})
 	}
-	panic(errors.NewUnreachableError())
+	// The type parameter is bound outside the mapped type,
+	// e.g. by an enclosing generic function or composite
+	return f(t)
 }
 
 func (t *GenericType) GetMembers() map[string]MemberResolver {
-	return withBuiltinMembers(t, nil)
+	var memberResolvers map[string]MemberResolver
+	if typeBound := t.TypeParameter.TypeBound; typeBound != nil {
+		memberResolvers = make(map[string]MemberResolver)
+		for name, resolver := range typeBound.GetMembers() { //nolint:maprange
+			memberResolvers[name] = resolver
+		}
+	}
+	return withBuiltinMembers(t, memberResolvers)
 }
 
 func (t *GenericType) CheckInstantiated(pos ast.HasPosition, memoryGauge common.MemoryGauge, report func(err error)) {
@@ -1178,6 +1221,253 @@ type SaturatingArithmeticSupport struct {
 	Divide   bool
 }
 
+const NumericTypeCheckedAddFunctionName = "checkedAdd"
+const numericTypeCheckedAddFunctionDocString = `
+self + other, or nil if the result overflows.
+`
+
+const NumericTypeCheckedSubtractFunctionName = "checkedSubtract"
+const numericTypeCheckedSubtractFunctionDocString = `
+self - other, or nil if the result overflows.
+`
+
+const NumericTypeCheckedMultiplyFunctionName = "checkedMultiply"
+const numericTypeCheckedMultiplyFunctionDocString = `
+self * other, or nil if the result overflows.
+`
+
+const NumericTypeCheckedDivideFunctionName = "checkedDivide"
+const numericTypeCheckedDivideFunctionDocString = `
+self / other, or nil if the result overflows or other is zero.
+`
+
+const NumericTypeWrappingAddFunctionName = "wrappingAdd"
+const numericTypeWrappingAddFunctionDocString = `
+self + other, wrapping around at the numeric bounds instead of overflowing.
+`
+
+const NumericTypeWrappingSubtractFunctionName = "wrappingSubtract"
+const numericTypeWrappingSubtractFunctionDocString = `
+self - other, wrapping around at the numeric bounds instead of overflowing.
+`
+
+const NumericTypeWrappingMultiplyFunctionName = "wrappingMultiply"
+const numericTypeWrappingMultiplyFunctionDocString = `
+self * other, wrapping around at the numeric bounds instead of overflowing.
+`
+
+const NumericTypePowFunctionName = "pow"
+const numericTypePowFunctionDocString = `
+self raised to the power of the given exponent.
+The result is computed by exponentiation by squaring:
+self is squared repeatedly, and the squares which correspond to the set bits of the exponent are multiplied.
+Each multiplication is rounded like the multiplication operator,
+so for fixed-point types the result may differ from repeated multiplication of self in the last decimal places.
+If the result is outside the bounds of this type, the program aborts.
+`
+
+const NumericTypeAbsFunctionName = "abs"
+const numericTypeAbsFunctionDocString = `
+The absolute value of self.
+If the result is outside the bounds of this type, the program aborts.
+`
+
+const NumericTypeMinFunctionName = "min"
+const numericTypeMinFunctionDocString = `
+The smaller of self and other.
+`
+
+const NumericTypeMaxFunctionName = "max"
+const numericTypeMaxFunctionDocString = `
+The larger of self and other.
+`
+
+const NumericTypeSqrtFunctionName = "sqrt"
+const numericTypeSqrtFunctionDocString = `
+The square root of self, rounded towards zero.
+If self is negative, the program aborts.
+`
+
+// CheckedArithmeticTypeFunctionTypes are the types of the checked arithmetic functions,
+// e.g. `fun checkedAdd(_ other: T): T?`
+var CheckedArithmeticTypeFunctionTypes = map[Type]*FunctionType{}
+
+// NumericTypeBinaryFunctionTypes are the types of the wrapping arithmetic functions
+// and of the functions `min` and `max`, e.g. `fun min(_ other: T): T`
+var NumericTypeBinaryFunctionTypes = map[Type]*FunctionType{}
+
+// NumericTypeUnaryFunctionTypes are the types of the functions `abs` and `sqrt`,
+// i.e. `fun abs(): T`
+var NumericTypeUnaryFunctionTypes = map[Type]*FunctionType{}
+
+// NumericTypePowFunctionTypes are the types of the function `pow`,
+// i.e. `fun pow(_ exponent: UInt32): T`
+var NumericTypePowFunctionTypes = map[Type]*FunctionType{}
+
+func registerNumericFunctionTypes(t Type) {
+	otherParameters := []Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "other",
+			TypeAnnotation: NewTypeAnnotation(t),
+		},
+	}
+
+	CheckedArithmeticTypeFunctionTypes[t] = NewSimpleFunctionType(
+		FunctionPurityView,
+		otherParameters,
+		NewTypeAnnotation(
+			&OptionalType{
+				Type: t,
+			},
+		),
+	)
+
+	NumericTypeBinaryFunctionTypes[t] = NewSimpleFunctionType(
+		FunctionPurityView,
+		otherParameters,
+		NewTypeAnnotation(t),
+	)
+
+	NumericTypeUnaryFunctionTypes[t] = NewSimpleFunctionType(
+		FunctionPurityView,
+		nil,
+		NewTypeAnnotation(t),
+	)
+
+	NumericTypePowFunctionTypes[t] = NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "exponent",
+				TypeAnnotation: UInt32TypeAnnotation,
+			},
+		},
+		NewTypeAnnotation(t),
+	)
+}
+
+func init() {
+	for _, numberType := range AllNumberTypes {
+		if numberType.(IntegerRangedType).IsSuperType() {
+			continue
+		}
+
+		registerNumericFunctionTypes(numberType)
+	}
+}
+
+// addNumericFunctions adds the checked and wrapping arithmetic functions,
+// and the functions `pow`, `abs`, `min`, `max`, and `sqrt`.
+//
+// Checked arithmetic functions are available for all number types,
+// wrapping arithmetic functions only for fixed-size integer types,
+// and `abs` only for signed number types.
+func addNumericFunctions(t IntegerRangedType, members map[string]MemberResolver) {
+	if t.IsSuperType() {
+		return
+	}
+
+	addFunction := func(name string, functionType *FunctionType, docString string) {
+		members[name] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(memoryGauge common.MemoryGauge, _ string, _ ast.HasPosition, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					memoryGauge,
+					t,
+					name,
+					functionType,
+					docString,
+				)
+			},
+		}
+	}
+
+	checkedFunctionType := CheckedArithmeticTypeFunctionTypes[t]
+
+	addFunction(
+		NumericTypeCheckedAddFunctionName,
+		checkedFunctionType,
+		numericTypeCheckedAddFunctionDocString,
+	)
+	addFunction(
+		NumericTypeCheckedSubtractFunctionName,
+		checkedFunctionType,
+		numericTypeCheckedSubtractFunctionDocString,
+	)
+	addFunction(
+		NumericTypeCheckedMultiplyFunctionName,
+		checkedFunctionType,
+		numericTypeCheckedMultiplyFunctionDocString,
+	)
+	addFunction(
+		NumericTypeCheckedDivideFunctionName,
+		checkedFunctionType,
+		numericTypeCheckedDivideFunctionDocString,
+	)
+
+	binaryFunctionType := NumericTypeBinaryFunctionTypes[t]
+
+	if IsFixedSizeIntegerType(t) {
+		addFunction(
+			NumericTypeWrappingAddFunctionName,
+			binaryFunctionType,
+			numericTypeWrappingAddFunctionDocString,
+		)
+		addFunction(
+			NumericTypeWrappingSubtractFunctionName,
+			binaryFunctionType,
+			numericTypeWrappingSubtractFunctionDocString,
+		)
+		addFunction(
+			NumericTypeWrappingMultiplyFunctionName,
+			binaryFunctionType,
+			numericTypeWrappingMultiplyFunctionDocString,
+		)
+	}
+
+	addFunction(
+		NumericTypeMinFunctionName,
+		binaryFunctionType,
+		numericTypeMinFunctionDocString,
+	)
+	addFunction(
+		NumericTypeMaxFunctionName,
+		binaryFunctionType,
+		numericTypeMaxFunctionDocString,
+	)
+
+	unaryFunctionType := NumericTypeUnaryFunctionTypes[t]
+
+	if IsSubType(t, SignedNumberType) {
+		addFunction(
+			NumericTypeAbsFunctionName,
+			unaryFunctionType,
+			numericTypeAbsFunctionDocString,
+		)
+	}
+
+	addFunction(
+		NumericTypeSqrtFunctionName,
+		unaryFunctionType,
+		numericTypeSqrtFunctionDocString,
+	)
+
+	addFunction(
+		NumericTypePowFunctionName,
+		NumericTypePowFunctionTypes[t],
+		numericTypePowFunctionDocString,
+	)
+}
+
+// IsFixedSizeIntegerType returns true if the given type is
+// an integer type with a fixed size, e.g. `Int8` or `Word64`
+func IsFixedSizeIntegerType(t Type) bool {
+	numericType, ok := t.(*NumericType)
+	return ok && numericType.ByteSize() > 0
+}
+
 // NumericType represent all the types in the integer range
 // and non-fractional ranged types.
 type NumericType struct {
@@ -1357,6 +1647,7 @@ func (t *NumericType) initializeMemberResolvers() {
 		members := map[string]MemberResolver{}
 
 		addSaturatingArithmeticFunctions(t, members)
+		addNumericFunctions(t, members)
 
 		t.memberResolvers = withBuiltinMembers(t, members)
 	})
@@ -1576,6 +1867,7 @@ func (t *FixedPointNumericType) initializeMemberResolvers() {
 		members := map[string]MemberResolver{}
 
 		addSaturatingArithmeticFunctions(t, members)
+		addNumericFunctions(t, members)
 
 		t.memberResolvers = withBuiltinMembers(t, members)
 	})
@@ -2226,8 +2518,6 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 					)
 				}
 
-				// TODO: implement Equatable interface: https://github.com/dapperlabs/bamboo-node/issues/78
-
 				if !elementType.IsEquatable() {
 					report(
 						&NotEquatableTypeError{
@@ -2282,8 +2572,6 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 					)
 				}
 
-				// TODO: implement Equatable interface
-
 				if !elementType.IsEquatable() {
 					report(
 						&NotEquatableTypeError{
@@ -4080,10 +4368,18 @@ func (t *FunctionType) Map(gauge common.MemoryGauge, typeParamMap map[*TypeParam
 			}
 
 			newTypeParameterTypeBound := parameter.TypeBound.Map(gauge, typeParamMap, f)
-			newParam := &TypeParameter{
-				Name:      parameter.Name,
-				Optional:  parameter.Optional,
-				TypeBound: newTypeParameterTypeBound,
+
+			// Only create a new type parameter if the type bound changed.
+			// This keeps the identity of the type parameters of e.g. the functions of generic composite types,
+			// whose types are mapped when the composite type is instantiated.
+
+			newParam := parameter
+			if newTypeParameterTypeBound != parameter.TypeBound {
+				newParam = &TypeParameter{
+					Name:      parameter.Name,
+					Optional:  parameter.Optional,
+					TypeBound: newTypeParameterTypeBound,
+				}
 			}
 			typeParamMap[parameter] = newParam
 
@@ -4191,6 +4487,8 @@ func init() {
 			BoolType,
 			CharacterType,
 			StringType,
+			TimestampType,
+			DurationType,
 			TheAddressType,
 			AccountType,
 			PathType,
@@ -4209,7 +4507,10 @@ func init() {
 			AccountCapabilityControllerType,
 			DeploymentResultType,
 			HashableStructType,
+			EquatableType,
+			HashableType,
 			&InclusiveRangeType{},
+			&SetType{},
 		},
 	)
 
@@ -4750,10 +5051,28 @@ type Conformance struct {
 }
 
 type CompositeType struct {
-	Location      common.Location
-	EnumRawType   Type
-	containerType Type
-	NestedTypes   *StringTypeOrderedMap
+	Location    common.Location
+	EnumRawType Type
+	// EnumCases are the names of the cases of an enum, in declaration order
+	EnumCases []string
+	// DistinctUnderlyingType is the type a distinct type is derived from,
+	// and is nil for all other composite types
+	DistinctUnderlyingType Type
+	containerType          Type
+	NestedTypes            *StringTypeOrderedMap
+	// nestedTypeAliases are the type aliases declared in the composite type
+	nestedTypeAliases map[string]Type
+
+	// typeParameters are the type parameters of a generic composite type, e.g. `T` of `struct Box<T>`.
+	// In its declaration, the generic composite type stands for the instance
+	// which has its own type parameters as type arguments, e.g. `Box<T>`
+	typeParameters []*TypeParameter
+	// typeArguments are the type arguments of an instance of a generic composite type,
+	// e.g. `Int` of `Box<Int>`
+	typeArguments []Type
+	// genericType is the generic composite type of an instance
+	genericType *CompositeType
+	instances   map[TypeID]*CompositeType
 
 	// in a language with support for algebraic data types,
 	// we would implement this as an argument to the CompositeKind type constructor.
@@ -4772,6 +5091,9 @@ type CompositeType struct {
 	Identifier            string
 	Fields                []string
 	ConstructorParameters []Parameter
+	// ConstructorOverloads are the constructor function types
+	// of the initializers overloading the first initializer, if any
+	ConstructorOverloads []*FunctionType
 	// an internal set of field `effectiveInterfaceConformances`
 	effectiveInterfaceConformanceSet     *InterfaceSet
 	effectiveInterfaceConformances       []Conformance
@@ -4783,14 +5105,21 @@ type CompositeType struct {
 	memberResolversOnce                  sync.Once
 	ConstructorPurity                    FunctionPurity
 	HasComputedMembers                   bool
+	// DistinctInheritsOperations is true if the distinct type
+	// opted in to the operations of its underlying type
+	DistinctInheritsOperations bool
 	// Only applicable for native composite types
 	ImportableBuiltin         bool
 	supportedEntitlementsOnce sync.Once
 	supportedEntitlements     *EntitlementSet
+	instancesLock             sync.Mutex
+	instanceMembersOnce       sync.Once
 }
 
 var _ Type = &CompositeType{}
+var _ ParameterizedType = &CompositeType{}
 var _ ContainerType = &CompositeType{}
+var _ TypeAliasContainerType = &CompositeType{}
 var _ ContainedType = &CompositeType{}
 var _ LocatedType = &CompositeType{}
 var _ CompositeKindedType = &CompositeType{}
@@ -4830,13 +5159,55 @@ func (t *CompositeType) EffectiveInterfaceConformances() []Conformance {
 func (*CompositeType) IsType() {}
 
 func (t *CompositeType) String() string {
+	if t.genericType != nil {
+		return formatCompositeInstanceType(
+			t.Identifier,
+			", ",
+			typeStrings(t.typeArguments, Type.String),
+		)
+	}
 	return t.Identifier
 }
 
 func (t *CompositeType) QualifiedString() string {
+	if t.genericType != nil {
+		return formatCompositeInstanceType(
+			t.QualifiedIdentifier(),
+			", ",
+			typeStrings(t.typeArguments, Type.QualifiedString),
+		)
+	}
 	return t.QualifiedIdentifier()
 }
 
+func typeStrings(types []Type, typeFormatter func(Type) string) []string {
+	result := make([]string, len(types))
+	for i, ty := range types {
+		result[i] = typeFormatter(ty)
+	}
+	return result
+}
+
+func formatCompositeInstanceType[T ~string](genericType T, separator string, typeArguments []T) string {
+	var builder strings.Builder
+	builder.WriteString(string(genericType))
+	builder.WriteByte('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(string(typeArgument))
+	}
+	builder.WriteByte('>')
+	return builder.String()
+}
+
+// FormatCompositeInstanceTypeID returns the type ID of an instance of a generic composite type,
+// e.g. `S.test.Box<Int>`
+func FormatCompositeInstanceTypeID[T ~string](genericTypeID T, typeArgumentIDs []T) T {
+	return T(formatCompositeInstanceType(genericTypeID, ",", typeArgumentIDs))
+}
+
 func (t *CompositeType) GetContainerType() Type {
 	return t.containerType
 }
@@ -4899,10 +5270,31 @@ func IsHashableStructType(t Type) bool {
 	case *AddressType:
 		return true
 	case *CompositeType:
-		return typ.Kind == common.CompositeKindEnum
+		switch typ.Kind {
+		case common.CompositeKindEnum:
+			return true
+		case common.CompositeKindStructure:
+			if typ.DistinctUnderlyingType != nil {
+				return IsHashableStructType(typ.DistinctUnderlyingType)
+			}
+			return typ.EffectiveInterfaceConformanceSet().Contains(HashableType)
+		default:
+			return false
+		}
+	case *InterfaceType:
+		return typ == HashableType ||
+			typ.EffectiveInterfaceConformanceSet().Contains(HashableType)
+	case *IntersectionType:
+		for _, interfaceType := range typ.Types {
+			if IsHashableStructType(interfaceType) {
+				return true
+			}
+		}
+		return false
 	default:
 		switch typ {
-		case NeverType, BoolType, CharacterType, StringType, MetaType, HashableStructType:
+		case NeverType, BoolType, CharacterType, StringType, MetaType, HashableStructType,
+			TimestampType, DurationType:
 			return true
 		default:
 			return IsSubType(typ, NumberType) ||
@@ -4970,6 +5362,7 @@ func (t *CompositeType) Equal(other Type) bool {
 }
 
 func (t *CompositeType) MemberMap() *StringMemberOrderedMap {
+	t.initializeInstanceMembers()
 	return t.Members
 }
 
@@ -5090,6 +5483,16 @@ func (t *CompositeType) IsStorable(results map[*Member]bool) bool {
 		return false
 	}
 
+	// An instance of a generic composite type is storable
+	// if the generic composite type and all type arguments are storable
+
+	if t.genericType != nil {
+		return t.genericType.IsStorable(results) &&
+			allTypes(t.typeArguments, func(typeArgument Type) bool {
+				return typeArgument.IsStorable(results)
+			})
+	}
+
 	// If this composite type has a member which is non-storable,
 	// then the composite type is not storable.
 
@@ -5121,6 +5524,16 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 		return false
 	}
 
+	// An instance of a generic composite type is importable
+	// if the generic composite type and all type arguments are importable
+
+	if t.genericType != nil {
+		return t.genericType.IsImportable(results) &&
+			allTypes(t.typeArguments, func(typeArgument Type) bool {
+				return typeArgument.IsImportable(results)
+			})
+	}
+
 	// If this composite type has a member which is not importable,
 	// then the composite type is not importable.
 
@@ -5146,6 +5559,16 @@ func (t *CompositeType) IsExportable(results map[*Member]bool) bool {
 		return false
 	}
 
+	// An instance of a generic composite type is exportable
+	// if the generic composite type and all type arguments are exportable
+
+	if t.genericType != nil {
+		return t.genericType.IsExportable(results) &&
+			allTypes(t.typeArguments, func(typeArgument Type) bool {
+				return typeArgument.IsExportable(results)
+			})
+	}
+
 	// If this composite type has a member which is not exportable,
 	// then the composite type is not exportable.
 
@@ -5160,11 +5583,46 @@ func (t *CompositeType) IsExportable(results map[*Member]bool) bool {
 
 func (t *CompositeType) IsEquatable() bool {
 	// TODO: add support for more composite kinds
-	return t.Kind == common.CompositeKindEnum
+	switch t.Kind {
+	case common.CompositeKindEnum:
+		return true
+	case common.CompositeKindStructure:
+		if t.DistinctUnderlyingType != nil {
+			return t.DistinctUnderlyingType.IsEquatable()
+		}
+		return t.EffectiveInterfaceConformanceSet().Contains(EquatableType)
+	default:
+		return false
+	}
 }
 
-func (*CompositeType) IsComparable() bool {
-	return false
+func (t *CompositeType) IsComparable() bool {
+	underlyingType := DistinctOperandType(t)
+	return underlyingType != nil &&
+		underlyingType.IsComparable()
+}
+
+// IsDistinctType returns true if the composite type is a distinct type,
+// e.g. declared as `type PersonID = distinct String`
+func (t *CompositeType) IsDistinctType() bool {
+	return t.DistinctUnderlyingType != nil
+}
+
+// DistinctOperandType returns the underlying type of the given type
+// if it is a distinct type which opted in to the operations of its underlying type,
+// and nil otherwise.
+//
+// Operations on such distinct types are checked using the underlying type.
+func DistinctOperandType(ty Type) Type {
+	compositeType, ok := ty.(*CompositeType)
+	if !ok ||
+		!compositeType.IsDistinctType() ||
+		!compositeType.DistinctInheritsOperations {
+
+		return nil
+	}
+
+	return compositeType.DistinctUnderlyingType
 }
 
 func (t *CompositeType) ContainFieldsOrElements() bool {
@@ -5182,56 +5640,318 @@ func (t *CompositeType) RewriteWithIntersectionTypes() (result Type, rewritten b
 	return t, false
 }
 
-func (*CompositeType) Unify(
-	_ Type,
-	_ *TypeParameterTypeOrderedMap,
-	_ func(err error),
-	_ common.MemoryGauge,
-	_ ast.HasPosition,
+func (t *CompositeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
 ) bool {
-	// TODO:
-	return false
-}
 
-func (t *CompositeType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	return t
-}
+	// Only instances of the same generic composite type can be unified,
+	// by unifying their type arguments
 
-func (t *CompositeType) IsContainerType() bool {
-	return t.NestedTypes != nil
+	typeArguments := t.genericTypeArguments()
+	if len(typeArguments) == 0 {
+		return false
+	}
+
+	otherComposite, ok := other.(*CompositeType)
+	if !ok || otherComposite.GenericCompositeType() != t.GenericCompositeType() {
+		return false
+	}
+
+	otherTypeArguments := otherComposite.genericTypeArguments()
+
+	result := false
+
+	for i, typeArgument := range typeArguments {
+		typeArgumentUnified := typeArgument.Unify(
+			otherTypeArguments[i],
+			typeParameters,
+			report,
+			memoryGauge,
+			outerRange,
+		)
+		result = result || typeArgumentUnified
+	}
+
+	return result
 }
 
-func (t *CompositeType) GetNestedTypes() *StringTypeOrderedMap {
-	return t.NestedTypes
+func (t *CompositeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	genericTypeArguments := t.genericTypeArguments()
+	if len(genericTypeArguments) == 0 {
+		return t
+	}
+
+	resolvedTypeArguments := make([]Type, len(genericTypeArguments))
+
+	for i, typeArgument := range genericTypeArguments {
+		resolvedTypeArgument := typeArgument.Resolve(typeArguments)
+		if resolvedTypeArgument == nil {
+			return nil
+		}
+		resolvedTypeArguments[i] = resolvedTypeArgument
+	}
+
+	return t.GenericCompositeType().instantiate(resolvedTypeArguments)
 }
 
-func (t *CompositeType) isTypeIndexableType() bool {
-	// resources and structs only can be indexed for attachments
-	return t.Kind.SupportsAttachments()
+// IsGeneric returns true if the composite type has type parameters,
+// e.g. `struct Box<T>`
+func (t *CompositeType) IsGeneric() bool {
+	return len(t.typeParameters) > 0
 }
 
-func (t *CompositeType) TypeIndexingElementType(indexingType Type, _ func() ast.Range) (Type, error) {
-	var access Access = UnauthorizedAccess
-	switch attachment := indexingType.(type) {
-	case *CompositeType:
-		// when accessed on an owned value, the produced attachment reference is entitled to all the
-		// entitlements it supports
-		access = attachment.SupportedEntitlements().Access()
+// GenericCompositeType returns the generic composite type of an instance,
+// e.g. `Box` for `Box<Int>`, and the composite type itself otherwise.
+func (t *CompositeType) GenericCompositeType() *CompositeType {
+	if t.genericType != nil {
+		return t.genericType
 	}
+	return t
+}
 
-	return &OptionalType{
-		Type: &ReferenceType{
-			Type:          indexingType,
-			Authorization: access,
-		},
-	}, nil
+func (t *CompositeType) TypeParameters() []*TypeParameter {
+	return t.typeParameters
 }
 
-func (t *CompositeType) IsValidIndexingType(ty Type) bool {
-	attachmentType, isComposite := ty.(*CompositeType)
-	return isComposite &&
-		IsSubType(t, attachmentType.baseType) &&
-		attachmentType.IsResourceType() == t.IsResourceType()
+func (t *CompositeType) TypeArguments() []Type {
+	return t.typeArguments
+}
+
+func (t *CompositeType) BaseType() Type {
+	if t.genericType == nil {
+		return nil
+	}
+	return t.genericType
+}
+
+func (t *CompositeType) Instantiate(
+	_ common.MemoryGauge,
+	typeArguments []Type,
+	_ []*ast.TypeAnnotation,
+	_ func(err error),
+) Type {
+	// The checker already reported an invalid number of type arguments
+	if len(typeArguments) != len(t.typeParameters) {
+		return t
+	}
+
+	return t.instantiate(typeArguments)
+}
+
+// genericTypeArguments returns the type arguments of an instance,
+// and the type parameters as generic types for a generic composite type
+func (t *CompositeType) genericTypeArguments() []Type {
+	if t.genericType != nil {
+		return t.typeArguments
+	}
+
+	typeParameterCount := len(t.typeParameters)
+	if typeParameterCount == 0 {
+		return nil
+	}
+
+	typeArguments := make([]Type, typeParameterCount)
+	for i, typeParameter := range t.typeParameters {
+		typeArguments[i] = &GenericType{
+			TypeParameter: typeParameter,
+		}
+	}
+	return typeArguments
+}
+
+// instantiate returns the instance of the generic composite type
+// for the given type arguments.
+//
+// Instances are cached, and their members are only declared when needed,
+// as the members of an instance may refer to other instances.
+func (t *CompositeType) instantiate(typeArguments []Type) *CompositeType {
+
+	// Instantiating a generic composite type with its own type parameters,
+	// e.g. `Box<T>` in the declaration of `Box`, results in the generic composite type itself
+
+	isOwnTypeParameters := true
+	for i, typeArgument := range typeArguments {
+		genericType, ok := typeArgument.(*GenericType)
+		if !ok || genericType.TypeParameter != t.typeParameters[i] {
+			isOwnTypeParameters = false
+			break
+		}
+	}
+	if isOwnTypeParameters {
+		return t
+	}
+
+	typeArgumentIDs := make([]TypeID, len(typeArguments))
+	for i, typeArgument := range typeArguments {
+		typeArgumentIDs[i] = typeArgument.ID()
+	}
+	typeID := FormatCompositeInstanceTypeID(t.ID(), typeArgumentIDs)
+
+	t.instancesLock.Lock()
+	defer t.instancesLock.Unlock()
+
+	// NOTE: the type IDs of generic types are not unique,
+	// so also check that the type arguments of the cached instance are equal
+
+	if instance, ok := t.instances[typeID]; ok &&
+		slices.EqualFunc(instance.typeArguments, typeArguments, Type.Equal) {
+
+		return instance
+	}
+
+	instance := &CompositeType{
+		Location:                      t.Location,
+		Identifier:                    t.Identifier,
+		Kind:                          t.Kind,
+		containerType:                 t.containerType,
+		NestedTypes:                   t.NestedTypes,
+		ExplicitInterfaceConformances: t.ExplicitInterfaceConformances,
+		Members:                       &StringMemberOrderedMap{},
+		typeArguments:                 typeArguments,
+		genericType:                   t,
+		cachedIdentifiers: &struct {
+			TypeID              TypeID
+			QualifiedIdentifier string
+		}{
+			TypeID:              typeID,
+			QualifiedIdentifier: t.QualifiedIdentifier(),
+		},
+	}
+
+	if t.instances == nil {
+		t.instances = map[TypeID]*CompositeType{}
+	}
+	t.instances[typeID] = instance
+
+	return instance
+}
+
+// initializeInstanceMembers declares the members of an instance of a generic composite type,
+// by substituting the type arguments for the type parameters in the members of the generic composite type.
+func (t *CompositeType) initializeInstanceMembers() {
+	genericType := t.genericType
+	if genericType == nil {
+		return
+	}
+
+	t.instanceMembersOnce.Do(func() {
+		typeArguments := &TypeParameterTypeOrderedMap{}
+		for i, typeParameter := range genericType.typeParameters {
+			typeArguments.Set(typeParameter, t.typeArguments[i])
+		}
+
+		substitute := func(typeAnnotation TypeAnnotation) TypeAnnotation {
+			return TypeAnnotation{
+				IsResource: typeAnnotation.IsResource,
+				Type:       SubstituteTypeArguments(nil, typeAnnotation.Type, typeArguments),
+			}
+		}
+
+		members := &StringMemberOrderedMap{}
+		genericType.Members.Foreach(func(name string, member *Member) {
+			instanceMember := *member
+			instanceMember.TypeAnnotation = substitute(member.TypeAnnotation)
+			members.Set(name, &instanceMember)
+		})
+
+		var constructorParameters []Parameter
+		if genericType.ConstructorParameters != nil {
+			constructorParameters = make([]Parameter, len(genericType.ConstructorParameters))
+			for i, parameter := range genericType.ConstructorParameters {
+				parameter.TypeAnnotation = substitute(parameter.TypeAnnotation)
+				constructorParameters[i] = parameter
+			}
+		}
+
+		t.Members = members
+		t.Fields = genericType.Fields
+		t.ConstructorParameters = constructorParameters
+		t.ConstructorPurity = genericType.ConstructorPurity
+		t.HasComputedMembers = genericType.HasComputedMembers
+		t.DefaultDestroyEvent = genericType.DefaultDestroyEvent
+	})
+}
+
+// SubstituteTypeArguments returns the given type,
+// with all generic types replaced by their type argument, if any.
+//
+// Unlike Resolve, generic types without a type argument are left as-is.
+func SubstituteTypeArguments(
+	memoryGauge common.MemoryGauge,
+	ty Type,
+	typeArguments *TypeParameterTypeOrderedMap,
+) Type {
+	if typeArguments == nil || typeArguments.Len() == 0 {
+		return ty
+	}
+
+	return ty.Map(
+		memoryGauge,
+		make(map[*TypeParameter]*TypeParameter),
+		func(ty Type) Type {
+			genericType, ok := ty.(*GenericType)
+			if !ok {
+				return ty
+			}
+
+			typeArgument, ok := typeArguments.Get(genericType.TypeParameter)
+			if !ok || typeArgument == nil {
+				return ty
+			}
+
+			return typeArgument
+		},
+	)
+}
+
+func allTypes(types []Type, f func(Type) bool) bool {
+	for _, ty := range types {
+		if !f(ty) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *CompositeType) IsContainerType() bool {
+	return t.NestedTypes != nil
+}
+
+func (t *CompositeType) GetNestedTypes() *StringTypeOrderedMap {
+	return t.NestedTypes
+}
+
+func (t *CompositeType) isTypeIndexableType() bool {
+	// resources and structs only can be indexed for attachments
+	return t.Kind.SupportsAttachments()
+}
+
+func (t *CompositeType) TypeIndexingElementType(indexingType Type, _ func() ast.Range) (Type, error) {
+	var access Access = UnauthorizedAccess
+	switch attachment := indexingType.(type) {
+	case *CompositeType:
+		// when accessed on an owned value, the produced attachment reference is entitled to all the
+		// entitlements it supports
+		access = attachment.SupportedEntitlements().Access()
+	}
+
+	return &OptionalType{
+		Type: &ReferenceType{
+			Type:          indexingType,
+			Authorization: access,
+		},
+	}, nil
+}
+
+func (t *CompositeType) IsValidIndexingType(ty Type) bool {
+	attachmentType, isComposite := ty.(*CompositeType)
+	return isComposite &&
+		IsSubType(t, attachmentType.baseType) &&
+		attachmentType.IsResourceType() == t.IsResourceType()
 }
 
 const CompositeForEachAttachmentFunctionName = "forEachAttachment"
@@ -5273,8 +5993,18 @@ func CompositeForEachAttachmentFunctionType(t common.CompositeKind) *FunctionTyp
 	}
 }
 
-func (t *CompositeType) Map(_ common.MemoryGauge, _ map[*TypeParameter]*TypeParameter, f func(Type) Type) Type {
-	return f(t)
+func (t *CompositeType) Map(gauge common.MemoryGauge, typeParamMap map[*TypeParameter]*TypeParameter, f func(Type) Type) Type {
+	genericTypeArguments := t.genericTypeArguments()
+	if len(genericTypeArguments) == 0 {
+		return f(t)
+	}
+
+	typeArguments := make([]Type, len(genericTypeArguments))
+	for i, typeArgument := range genericTypeArguments {
+		typeArguments[i] = typeArgument.Map(gauge, typeParamMap, f)
+	}
+
+	return f(t.GenericCompositeType().instantiate(typeArguments))
 }
 
 func (t *CompositeType) GetMembers() map[string]MemberResolver {
@@ -5288,7 +6018,7 @@ func (t *CompositeType) initializeMemberResolvers() {
 
 func (t *CompositeType) initializerMemberResolversFunc() func() {
 	return func() {
-		memberResolvers := MembersMapAsResolvers(t.Members)
+		memberResolvers := MembersMapAsResolvers(t.MemberMap())
 
 		// Check conformances.
 		// If this composite type results from a normal composite declaration,
@@ -5303,6 +6033,8 @@ func (t *CompositeType) initializerMemberResolversFunc() func() {
 						memberResolvers[name] = resolver
 					}
 				}
+
+				addInheritedFunctionOverloads(memberResolvers, conformance.Members)
 			})
 
 		// resource and struct composites have the ability to iterate over their attachments
@@ -5358,6 +6090,20 @@ func (t *CompositeType) SetNestedType(name string, nestedType ContainedType) {
 	nestedType.SetContainerType(t)
 }
 
+// SetNestedTypeAlias declares a type alias in this type.
+// The aliased type is not contained in this type, so its container type is not set
+func (t *CompositeType) SetNestedTypeAlias(name string, aliasedType Type) {
+	if t.nestedTypeAliases == nil {
+		t.nestedTypeAliases = map[string]Type{}
+	}
+	t.nestedTypeAliases[name] = aliasedType
+}
+
+func (t *CompositeType) GetNestedTypeAlias(name string) (Type, bool) {
+	aliasedType, ok := t.nestedTypeAliases[name]
+	return aliasedType, ok
+}
+
 func (t *CompositeType) ConstructorFunctionType() *FunctionType {
 	return &FunctionType{
 		IsConstructor:        true,
@@ -5722,10 +6468,14 @@ type InterfaceType struct {
 	supportedEntitlements            *EntitlementSet
 
 	DefaultDestroyEvent *CompositeType
+
+	// nestedTypeAliases are the type aliases declared in the interface type
+	nestedTypeAliases map[string]Type
 }
 
 var _ Type = &InterfaceType{}
 var _ ContainerType = &InterfaceType{}
+var _ TypeAliasContainerType = &InterfaceType{}
 var _ ContainedType = &InterfaceType{}
 var _ LocatedType = &InterfaceType{}
 var _ CompositeKindedType = &InterfaceType{}
@@ -5859,6 +6609,7 @@ func (t *InterfaceType) initializeMemberResolvers() {
 				}
 			}
 
+			addInheritedFunctionOverloads(members, conformance.InterfaceType.Members)
 		}
 
 		t.memberResolvers = withBuiltinMembers(t, members)
@@ -5930,9 +6681,9 @@ func (t *InterfaceType) IsImportable(results map[*Member]bool) bool {
 	return true
 }
 
-func (*InterfaceType) IsEquatable() bool {
-	// TODO:
-	return false
+func (t *InterfaceType) IsEquatable() bool {
+	return t == EquatableType ||
+		t.EffectiveInterfaceConformanceSet().Contains(EquatableType)
 }
 
 func (*InterfaceType) IsComparable() bool {
@@ -5976,6 +6727,20 @@ func (t *InterfaceType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.NestedTypes
 }
 
+// SetNestedTypeAlias declares a type alias in this type.
+// The aliased type is not contained in this type, so its container type is not set
+func (t *InterfaceType) SetNestedTypeAlias(name string, aliasedType Type) {
+	if t.nestedTypeAliases == nil {
+		t.nestedTypeAliases = map[string]Type{}
+	}
+	t.nestedTypeAliases[name] = aliasedType
+}
+
+func (t *InterfaceType) GetNestedTypeAlias(name string) (Type, bool) {
+	aliasedType, ok := t.nestedTypeAliases[name]
+	return aliasedType, ok
+}
+
 func (t *InterfaceType) FieldPosition(name string, declaration *ast.InterfaceDeclaration) ast.Position {
 	return declaration.Members.FieldPosition(name, declaration.CompositeKind)
 }
@@ -6966,6 +7731,488 @@ func (t *InclusiveRangeType) Map(
 	return f(NewInclusiveRangeType(gauge, mappedMemberType))
 }
 
+// SetType is the type of sets, written `Set<T>`.
+//
+// Unlike arrays and dictionaries, sets have no dedicated type syntax:
+// The braces syntax `{T}` already denotes intersection types,
+// e.g. `{FungibleToken.Receiver}`, so `Set<T>` is a parameterized type, like `InclusiveRange<T>`.
+// The elements of a set must be hashable, and therefore are never resources.
+//
+// Sets are created with the built-in `Set` function, which takes an array of elements,
+// e.g. `Set<Int>([1, 2, 2])` is the set of 1 and 2.
+// Elements are added and removed with `insert` and `remove`, checked with `contains`,
+// and sets are combined with `union`, `intersection`, and `difference`.
+
+const SetTypeName = "Set"
+
+type SetType struct {
+	ElementType         Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+var _ Type = &SetType{}
+var _ ParameterizedType = &SetType{}
+var _ EntitlementSupportingType = &SetType{}
+
+func NewSetType(memoryGauge common.MemoryGauge, elementType Type) *SetType {
+	common.UseMemory(memoryGauge, common.SetSemaTypeMemoryUsage)
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetType) IsType() {}
+
+func (*SetType) Tag() TypeTag {
+	return SetTypeTag
+}
+
+func (t *SetType) String() string {
+	elementString := ""
+	if t.ElementType != nil {
+		elementString = fmt.Sprintf("<%s>", t.ElementType.String())
+	}
+	return fmt.Sprintf(
+		"Set%s",
+		elementString,
+	)
+}
+
+func (t *SetType) QualifiedString() string {
+	elementString := ""
+	if t.ElementType != nil {
+		elementString = fmt.Sprintf("<%s>", t.ElementType.QualifiedString())
+	}
+	return fmt.Sprintf(
+		"Set%s",
+		elementString,
+	)
+}
+
+func SetTypeID(elementTypeID string) TypeID {
+	if elementTypeID != "" {
+		elementTypeID = fmt.Sprintf("<%s>", elementTypeID)
+	}
+	return TypeID(fmt.Sprintf(
+		"Set%s",
+		elementTypeID,
+	))
+}
+
+func (t *SetType) ID() TypeID {
+	var elementTypeID string
+	if t.ElementType != nil {
+		elementTypeID = string(t.ElementType.ID())
+	}
+	return SetTypeID(elementTypeID)
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+	if otherSet.ElementType == nil {
+		return t.ElementType == nil
+	}
+
+	return otherSet.ElementType.Equal(t.ElementType)
+}
+
+func (*SetType) IsResourceType() bool {
+	return false
+}
+
+func (t *SetType) IsInvalidType() bool {
+	return t.ElementType != nil && t.ElementType.IsInvalidType()
+}
+
+func (t *SetType) IsOrContainsReferenceType() bool {
+	return t.ElementType != nil && t.ElementType.IsOrContainsReferenceType()
+}
+
+func (t *SetType) IsStorable(results map[*Member]bool) bool {
+	return t.ElementType != nil && t.ElementType.IsStorable(results)
+}
+
+func (t *SetType) IsExportable(results map[*Member]bool) bool {
+	return t.ElementType != nil && t.ElementType.IsExportable(results)
+}
+
+func (t *SetType) IsImportable(results map[*Member]bool) bool {
+	return t.ElementType != nil && t.ElementType.IsImportable(results)
+}
+
+func (t *SetType) IsEquatable() bool {
+	return t.ElementType != nil && t.ElementType.IsEquatable()
+}
+
+func (*SetType) IsComparable() bool {
+	return false
+}
+
+func (t *SetType) TypeAnnotationState() TypeAnnotationState {
+	if t.ElementType == nil {
+		return TypeAnnotationStateValid
+	}
+
+	return t.ElementType.TypeAnnotationState()
+}
+
+func (t *SetType) RewriteWithIntersectionTypes() (Type, bool) {
+	if t.ElementType == nil {
+		return t, false
+	}
+	rewrittenElementType, rewritten := t.ElementType.RewriteWithIntersectionTypes()
+	if rewritten {
+		return &SetType{
+			ElementType: rewrittenElementType,
+		}, true
+	}
+	return t, false
+}
+
+func (t *SetType) BaseType() Type {
+	if t.ElementType == nil {
+		return nil
+	}
+	return &SetType{}
+}
+
+func (t *SetType) Instantiate(
+	memoryGauge common.MemoryGauge,
+	typeArguments []Type,
+	astTypeArguments []*ast.TypeAnnotation,
+	report func(err error),
+) Type {
+
+	const typeParameterCount = 1
+
+	typeArgumentCount := len(typeArguments)
+
+	var elementType Type
+	if typeArgumentCount == typeParameterCount {
+		elementType = typeArguments[0]
+	} else {
+		var argumentsRange ast.Range
+		if len(astTypeArguments) > 0 {
+			argumentsRange = ast.NewRangeFromPositioned(memoryGauge, astTypeArguments[0])
+		}
+
+		report(&InvalidTypeArgumentCountError{
+			TypeParameterCount: typeParameterCount,
+			TypeArgumentCount:  typeArgumentCount,
+			Range:              argumentsRange,
+		})
+	}
+
+	// NOTE: the element type is checked against the type bound of the type parameter
+	// (a hashable struct type) by the checker
+
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (t *SetType) TypeArguments() []Type {
+	return []Type{
+		t.ElementType,
+	}
+}
+
+func (t *SetType) CheckInstantiated(pos ast.HasPosition, memoryGauge common.MemoryGauge, report func(err error)) {
+	CheckParameterizedTypeInstantiated(t, pos, memoryGauge, report)
+}
+
+var setTypeParameter = &TypeParameter{
+	Name:      "T",
+	TypeBound: HashableStructType,
+}
+
+func (*SetType) TypeParameters() []*TypeParameter {
+	return []*TypeParameter{
+		setTypeParameter,
+	}
+}
+
+func (t *SetType) SupportedEntitlements() *EntitlementSet {
+	return arrayDictionaryEntitlements
+}
+
+const SetTypeLengthFieldName = "length"
+
+const setTypeLengthFieldDocString = `
+The number of elements in the set
+`
+
+const SetTypeContainsFunctionName = "contains"
+
+const setTypeContainsFunctionDocString = `
+Returns true if the given element is in the set
+`
+
+const SetTypeInsertFunctionName = "insert"
+
+const setTypeInsertFunctionDocString = `
+Inserts the given element into the set.
+
+Returns true if the element was inserted, or false if the set already contained the element
+`
+
+const SetTypeRemoveFunctionName = "remove"
+
+const setTypeRemoveFunctionDocString = `
+Removes the given element from the set.
+
+Returns true if the element was removed, or false if the set did not contain the element
+`
+
+const SetTypeUnionFunctionName = "union"
+
+const setTypeUnionFunctionDocString = `
+Returns a new set containing all elements which are in this set, in the given set, or in both
+`
+
+const SetTypeIntersectionFunctionName = "intersection"
+
+const setTypeIntersectionFunctionDocString = `
+Returns a new set containing all elements which are both in this set and in the given set
+`
+
+const SetTypeDifferenceFunctionName = "difference"
+
+const setTypeDifferenceFunctionDocString = `
+Returns a new set containing all elements which are in this set, but not in the given set
+`
+
+const SetTypeToArrayFunctionName = "toArray"
+
+const setTypeToArrayFunctionDocString = `
+Returns a new array containing all elements of the set.
+
+The order of the elements is undefined
+`
+
+func (t *SetType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+// SetElementFunctionType returns the type of a set function
+// which has a single element parameter and returns a boolean,
+// e.g. `contains`, `insert`, and `remove`
+func SetElementFunctionType(t *SetType, purity FunctionPurity) *FunctionType {
+	return NewSimpleFunctionType(
+		purity,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
+			},
+		},
+		BoolTypeAnnotation,
+	)
+}
+
+// SetOperationFunctionType returns the type of a set function
+// which has a single set parameter and returns a new set,
+// e.g. `union`, `intersection`, and `difference`
+func SetOperationFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		[]Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(t),
+			},
+		},
+		NewTypeAnnotation(t),
+	)
+}
+
+func SetToArrayFunctionType(t *SetType) *FunctionType {
+	return NewSimpleFunctionType(
+		FunctionPurityView,
+		nil,
+		NewTypeAnnotation(
+			&VariableSizedType{
+				Type: t.ElementType,
+			},
+		),
+	)
+}
+
+func (t *SetType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+
+		newPublicFunctionMemberResolver := func(
+			functionType func() *FunctionType,
+			docString string,
+		) MemberResolver {
+			return MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(
+					memoryGauge common.MemoryGauge,
+					identifier string,
+					_ ast.HasPosition,
+					_ func(error),
+				) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						functionType(),
+						docString,
+					)
+				},
+			}
+		}
+
+		setOperationFunctionType := func() *FunctionType {
+			return SetOperationFunctionType(t)
+		}
+
+		t.memberResolvers = withBuiltinMembers(
+			t,
+			map[string]MemberResolver{
+				SetTypeLengthFieldName: {
+					Kind: common.DeclarationKindField,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewPublicConstantFieldMember(
+							memoryGauge,
+							t,
+							identifier,
+							IntType,
+							setTypeLengthFieldDocString,
+						)
+					},
+				},
+				SetTypeContainsFunctionName: newPublicFunctionMemberResolver(
+					func() *FunctionType {
+						return SetElementFunctionType(t, FunctionPurityView)
+					},
+					setTypeContainsFunctionDocString,
+				),
+				SetTypeInsertFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewFunctionMember(
+							memoryGauge,
+							t,
+							insertMutateEntitledAccess,
+							identifier,
+							SetElementFunctionType(t, FunctionPurityImpure),
+							setTypeInsertFunctionDocString,
+						)
+					},
+				},
+				SetTypeRemoveFunctionName: {
+					Kind: common.DeclarationKindFunction,
+					Resolve: func(
+						memoryGauge common.MemoryGauge,
+						identifier string,
+						_ ast.HasPosition,
+						_ func(error),
+					) *Member {
+						return NewFunctionMember(
+							memoryGauge,
+							t,
+							removeMutateEntitledAccess,
+							identifier,
+							SetElementFunctionType(t, FunctionPurityImpure),
+							setTypeRemoveFunctionDocString,
+						)
+					},
+				},
+				SetTypeUnionFunctionName: newPublicFunctionMemberResolver(
+					setOperationFunctionType,
+					setTypeUnionFunctionDocString,
+				),
+				SetTypeIntersectionFunctionName: newPublicFunctionMemberResolver(
+					setOperationFunctionType,
+					setTypeIntersectionFunctionDocString,
+				),
+				SetTypeDifferenceFunctionName: newPublicFunctionMemberResolver(
+					setOperationFunctionType,
+					setTypeDifferenceFunctionDocString,
+				),
+				SetTypeToArrayFunctionName: newPublicFunctionMemberResolver(
+					func() *FunctionType {
+						return SetToArrayFunctionType(t)
+					},
+					setTypeToArrayFunctionDocString,
+				),
+			},
+		)
+	})
+}
+
+func (*SetType) AllowsValueIndexingAssignment() bool {
+	return false
+}
+
+func (t *SetType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	memoryGauge common.MemoryGauge,
+	outerRange ast.HasPosition,
+) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Unify(
+		otherSet.ElementType,
+		typeParameters,
+		report,
+		memoryGauge,
+		outerRange,
+	)
+}
+
+func (t *SetType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	elementType := t.ElementType.Resolve(typeArguments)
+	if elementType == nil {
+		return nil
+	}
+
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (t *SetType) IsPrimitiveType() bool {
+	return false
+}
+
+func (t *SetType) ContainFieldsOrElements() bool {
+	return true
+}
+
+func (t *SetType) Map(
+	gauge common.MemoryGauge,
+	typeParamMap map[*TypeParameter]*TypeParameter,
+	f func(Type) Type,
+) Type {
+	mappedElementType := t.ElementType.Map(gauge, typeParamMap, f)
+	return f(NewSetType(gauge, mappedElementType))
+}
+
 // ReferenceType represents the reference to a value
 type ReferenceType struct {
 	Type          Type
@@ -7472,6 +8719,14 @@ func IsSameTypeKind(subType Type, superType Type) bool {
 		return false
 	}
 
+	// A generic type is not of the same kind as its bound,
+	// e.g. a literal cannot have the type `T`, even if `T` is bound by `Integer`,
+	// as the type argument may be any subtype of the bound.
+
+	if _, ok := subType.(*GenericType); ok {
+		return false
+	}
+
 	return IsSubType(subType, superType)
 }
 
@@ -7500,6 +8755,22 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		return true
 	}
 
+	// A generic type `T` is a subtype of a type `V`
+	// if the type bound of `T` is a subtype of `V`,
+	// as every type argument must be a subtype of the type bound.
+	// Otherwise, e.g. for optional super-types, continue with the checks below
+
+	if genericSubType, ok := subType.(*GenericType); ok {
+		if superType == AnyType {
+			return true
+		}
+
+		typeBound := genericSubType.TypeParameter.TypeBound
+		if typeBound != nil && IsSubType(typeBound, superType) {
+			return true
+		}
+	}
+
 	switch superType {
 	case AnyType:
 		return true
@@ -8395,8 +9666,12 @@ func (t *IntersectionType) IsImportable(results map[*Member]bool) bool {
 	return true
 }
 
-func (*IntersectionType) IsEquatable() bool {
-	// TODO:
+func (t *IntersectionType) IsEquatable() bool {
+	for _, interfaceType := range t.Types {
+		if interfaceType.IsEquatable() {
+			return true
+		}
+	}
 	return false
 }
 
@@ -9546,3 +10821,16 @@ func init() {
 		})
 	}
 }
+
+var NativeInterfaceTypes = map[string]*InterfaceType{}
+
+func init() {
+	interfaceTypes := []*InterfaceType{
+		EquatableType,
+		HashableType,
+	}
+
+	for _, interfaceType := range interfaceTypes {
+		NativeInterfaceTypes[interfaceType.QualifiedIdentifier()] = interfaceType
+	}
+}
@@ -228,6 +228,9 @@ const (
 	functionTypeMask
 	hashableStructMask
 	inclusiveRangeTypeMask
+	setTypeMask
+	timestampTypeMask
+	durationTypeMask
 
 	invalidTypeMask
 )
@@ -347,6 +350,9 @@ var (
 	IntersectionTypeTag                = newTypeTagFromUpperMask(intersectionTypeMask)
 	CapabilityTypeTag                  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InclusiveRangeTypeTag              = newTypeTagFromUpperMask(inclusiveRangeTypeMask)
+	SetTypeTag                         = newTypeTagFromUpperMask(setTypeMask)
+	TimestampTypeTag                   = newTypeTagFromUpperMask(timestampTypeMask)
+	DurationTypeTag                    = newTypeTagFromUpperMask(durationTypeMask)
 	InvalidTypeTag                     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag                 = newTypeTagFromUpperMask(transactionTypeMask)
 	AnyResourceAttachmentTypeTag       = newTypeTagFromUpperMask(anyResourceAttachmentMask)
@@ -362,7 +368,9 @@ var (
 				Or(StringTypeTag).
 				Or(MetaTypeTag).
 				Or(NumberTypeTag).
-				Or(PathTypeTag)
+				Or(PathTypeTag).
+				Or(TimestampTypeTag).
+				Or(DurationTypeTag)
 
 	// AnyStructTypeTag only includes the types that are pre-known
 	// to belong to AnyStruct type. This is more of an optimization.
@@ -389,7 +397,8 @@ var (
 				Or(StorageCapabilityControllerTypeTag).
 				Or(AccountCapabilityControllerTypeTag).
 				Or(HashableStructTypeTag).
-				Or(InclusiveRangeTypeTag)
+				Or(InclusiveRangeTypeTag).
+				Or(SetTypeTag)
 
 	AnyResourceTypeTag = newTypeTagFromLowerMask(anyResourceTypeMask).
 				Or(AnyResourceAttachmentTypeTag)
@@ -694,7 +703,8 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 		transactionTypeMask,
 		interfaceTypeMask,
 		functionTypeMask,
-		inclusiveRangeTypeMask:
+		inclusiveRangeTypeMask,
+		setTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
 
 	case hashableStructMask:
@@ -712,6 +722,12 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 	case accountCapabilityControllerTypeMask:
 		return AccountCapabilityControllerType
 
+	case timestampTypeMask:
+		return TimestampType
+
+	case durationTypeMask:
+		return DurationType
+
 	case fixedSizeUnsignedIntegerTypeMask:
 		return FixedSizeUnsignedIntegerType
 
@@ -272,6 +272,28 @@ type variableDeclaration struct {
 	allowOuterScopeShadowing bool
 }
 
+// shadowableBuiltinNames are the names of built-ins which may be shadowed by declarations.
+//
+// Built-ins which were introduced after programs could already declare the same name,
+// e.g. the built-in `Set`, `Timestamp` and `Duration` types and constructor functions,
+// and the built-in `Equatable` and `Hashable` interfaces, must be shadowable,
+// so that these existing programs remain valid.
+var shadowableBuiltinNames = map[string]struct{}{
+	SetTypeName:       {},
+	TimestampTypeName: {},
+	DurationTypeName:  {},
+	EquatableTypeName: {},
+	HashableTypeName:  {},
+}
+
+func isShadowableBuiltin(variable *Variable) bool {
+	if variable.ActivationDepth != 0 {
+		return false
+	}
+	_, ok := shadowableBuiltinNames[variable.Identifier]
+	return ok
+}
+
 func (a *VariableActivations) declare(declaration variableDeclaration) (*Variable, error) {
 
 	depth := a.Depth()
@@ -279,10 +301,11 @@ func (a *VariableActivations) declare(declaration variableDeclaration) (*Variabl
 	// Check if a variable with this name is already declared.
 	// Report an error if shadowing variables of outer scopes is not allowed,
 	// or the existing variable is declared in the current scope,
-	// or the existing variable is a built-in.
+	// or the existing variable is a built-in which is not shadowable.
 
 	existingVariable := a.Find(declaration.identifier)
 	if existingVariable != nil &&
+		!isShadowableBuiltin(existingVariable) &&
 		(!declaration.allowOuterScopeShadowing ||
 			existingVariable.ActivationDepth == depth ||
 			existingVariable.ActivationDepth == 0) {
@@ -125,6 +125,8 @@ func NewAccountConstructor(creator AccountCreator) StandardLibraryValue {
 			inter := invocation.Interpreter
 			locationRange := invocation.LocationRange
 
+			inter.EnforceRevertibleOperation("create account", locationRange)
+
 			inter.ExpectType(
 				payer,
 				sema.AccountReferenceType,
@@ -618,6 +620,8 @@ func newAccountKeysAddFunction(
 				inter := invocation.Interpreter
 				locationRange := invocation.LocationRange
 
+				inter.EnforceRevertibleOperation("add account key", locationRange)
+
 				publicKey, err := NewPublicKeyFromValue(inter, locationRange, publicKeyValue)
 				if err != nil {
 					panic(err)
@@ -894,6 +898,9 @@ func newAccountKeysRevokeFunction(
 					panic(errors.NewUnreachableError())
 				}
 				locationRange := invocation.LocationRange
+
+				invocation.Interpreter.EnforceRevertibleOperation("revoke account key", locationRange)
+
 				index := indexValue.ToUint32(locationRange)
 
 				var err error
@@ -1500,6 +1507,8 @@ func changeAccountContracts(
 
 	locationRange := invocation.LocationRange
 
+	invocation.Interpreter.EnforceRevertibleOperation("change account contracts", locationRange)
+
 	const requiredArgumentCount = 2
 
 	nameValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
@@ -1728,7 +1737,7 @@ func changeAccountContracts(
 				handler,
 				oldProgram,
 				program.Program,
-			)
+			).WithNewProgramElaboration(program.Elaboration)
 		}
 
 		validator = validator.WithTypeRemovalEnabled(contractUpdateTypeRemovalEnabled)
@@ -1799,6 +1808,12 @@ func newAccountContractsTryUpdateFunction(
 			accountContracts,
 			functionType,
 			func(_ interpreter.MemberAccessibleValue, invocation interpreter.Invocation) (deploymentResult interpreter.Value) {
+				// Checked before recovering, as the error must not be ignored
+				invocation.Interpreter.EnforceRevertibleOperation(
+					"change account contracts",
+					invocation.LocationRange,
+				)
+
 				var deployedContract interpreter.Value
 
 				defer func() {
@@ -2105,6 +2120,9 @@ func newAccountContractsRemoveFunction(
 			func(_ interpreter.MemberAccessibleValue, invocation interpreter.Invocation) interpreter.Value {
 
 				inter := invocation.Interpreter
+
+				inter.EnforceRevertibleOperation("remove account contract", invocation.LocationRange)
+
 				nameValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
@@ -3251,9 +3269,7 @@ func recordStorageCapabilityController(
 		inter.SharedState.MutationDuringCapabilityControllerIteration = true
 	}
 
-	identifier := targetPathValue.Identifier
-
-	storageMapKey := interpreter.StringStorageMapKey(identifier)
+	storageMapKey := targetPathValue.StorageMapKey()
 
 	storageMap := inter.Storage().GetStorageMap(
 		address,
@@ -3293,9 +3309,7 @@ func getPathCapabilityIDSet(
 		panic(errors.NewUnreachableError())
 	}
 
-	identifier := targetPathValue.Identifier
-
-	storageMapKey := interpreter.StringStorageMapKey(identifier)
+	storageMapKey := targetPathValue.StorageMapKey()
 
 	storageMap := inter.Storage().GetStorageMap(
 		address,
@@ -3356,9 +3370,7 @@ func unrecordStorageCapabilityController(
 			panic(errors.NewUnreachableError())
 		}
 
-		identifier := targetPathValue.Identifier
-
-		storageMapKey := interpreter.StringStorageMapKey(identifier)
+		storageMapKey := targetPathValue.StorageMapKey()
 
 		if !storageMap.RemoveValue(inter, storageMapKey) {
 			panic(errors.NewUnreachableError())
@@ -3535,7 +3547,6 @@ func newAccountCapabilitiesPublishFunction(
 				}
 
 				domain := pathValue.Domain.Identifier()
-				identifier := pathValue.Identifier
 
 				capabilityType, ok := capabilityValue.StaticType(inter).(*interpreter.CapabilityStaticType)
 				if !ok {
@@ -3576,7 +3587,7 @@ func newAccountCapabilitiesPublishFunction(
 
 				// Prevent an overwrite
 
-				storageMapKey := interpreter.StringStorageMapKey(identifier)
+				storageMapKey := pathValue.StorageMapKey()
 
 				if inter.StoredValueExists(
 					accountAddress,
@@ -3654,11 +3665,10 @@ func newAccountCapabilitiesUnpublishFunction(
 				}
 
 				domain := pathValue.Domain.Identifier()
-				identifier := pathValue.Identifier
 
 				// Read/remove capability
 
-				storageMapKey := interpreter.StringStorageMapKey(identifier)
+				storageMapKey := pathValue.StorageMapKey()
 
 				readValue := inter.ReadStored(address, domain, storageMapKey)
 				if readValue == nil {
@@ -3855,6 +3865,8 @@ func BorrowCapabilityController(
 		return nil
 	}
 
+	inter.RecordBorrow(referenceValue, *referencedValue, locationRange)
+
 	return referenceValue
 }
 
@@ -3928,7 +3940,6 @@ func newAccountCapabilitiesGetFunction(
 				}
 
 				domain := pathValue.Domain.Identifier()
-				identifier := pathValue.Identifier
 
 				// Get borrow type type argument
 
@@ -3965,7 +3976,7 @@ func newAccountCapabilitiesGetFunction(
 
 				// Read stored capability, if any
 
-				storageMapKey := interpreter.StringStorageMapKey(identifier)
+				storageMapKey := pathValue.StorageMapKey()
 
 				readValue := inter.ReadStored(address, domain, storageMapKey)
 				if readValue == nil {
@@ -4113,11 +4124,10 @@ func newAccountCapabilitiesExistsFunction(
 				}
 
 				domain := pathValue.Domain.Identifier()
-				identifier := pathValue.Identifier
 
 				// Read stored capability, if any
 
-				storageMapKey := interpreter.StringStorageMapKey(identifier)
+				storageMapKey := pathValue.StorageMapKey()
 
 				return interpreter.AsBoolValue(
 					inter.StoredValueExists(address, domain, storageMapKey),
@@ -45,6 +45,7 @@ func DefaultStandardLibraryValues(handler StandardLibraryHandler) []StandardLibr
 		SignatureAlgorithmConstructor,
 		RLPContract,
 		InclusiveRangeConstructorFunction,
+		SetConstructorFunction,
 		NewLogFunction(handler),
 		NewRevertibleRandomFunction(handler),
 		NewGetBlockFunction(handler),
@@ -57,7 +57,7 @@ func NewCadenceV042ToV1ContractUpdateValidator(
 		provider,
 		oldProgram,
 		newProgram.Program,
-	)
+	).WithNewProgramElaboration(newProgram.Elaboration)
 
 	// Also add the elaboration of the current program.
 	newElaborations[location] = newProgram.Elaboration
@@ -93,6 +93,12 @@ func (validator *CadenceV042ToV1ContractUpdateValidator) WithTypeRemovalEnabled(
 	return validator
 }
 
+func (validator *CadenceV042ToV1ContractUpdateValidator) newCompositeDeclarationType(
+	declaration *ast.CompositeDeclaration,
+) *sema.CompositeType {
+	return validator.underlyingUpdateValidator.newCompositeDeclarationType(declaration)
+}
+
 func (validator *CadenceV042ToV1ContractUpdateValidator) getCurrentDeclaration() ast.Declaration {
 	return validator.underlyingUpdateValidator.getCurrentDeclaration()
 }
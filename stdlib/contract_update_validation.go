@@ -26,6 +26,7 @@ import (
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/common/orderedmap"
 	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/sema"
 )
 
 const typeRemovalPragmaName = "removedType"
@@ -56,6 +57,8 @@ type UpdateValidator interface {
 
 	isTypeRemovalEnabled() bool
 	WithTypeRemovalEnabled(enabled bool) UpdateValidator
+
+	newCompositeDeclarationType(declaration *ast.CompositeDeclaration) *sema.CompositeType
 }
 
 type checkConformanceFunc func(
@@ -70,6 +73,7 @@ type ContractUpdateValidator struct {
 	contractName                 string
 	oldProgram                   *ast.Program
 	newProgram                   *ast.Program
+	newElaboration               *sema.Elaboration
 	currentDecl                  ast.Declaration
 	importLocations              map[ast.Identifier]common.Location
 	accountContractNamesProvider AccountContractNamesProvider
@@ -115,6 +119,23 @@ func (validator *ContractUpdateValidator) WithTypeRemovalEnabled(enabled bool) U
 	return validator
 }
 
+// WithNewProgramElaboration sets the elaboration of the new program.
+// It is used to determine the conformances of the updated composite declarations,
+// e.g. to validate updates of structures conforming to the built-in `Hashable` interface.
+func (validator *ContractUpdateValidator) WithNewProgramElaboration(elaboration *sema.Elaboration) *ContractUpdateValidator {
+	validator.newElaboration = elaboration
+	return validator
+}
+
+func (validator *ContractUpdateValidator) newCompositeDeclarationType(
+	declaration *ast.CompositeDeclaration,
+) *sema.CompositeType {
+	if validator.newElaboration == nil {
+		return nil
+	}
+	return validator.newElaboration.CompositeDeclarationType(declaration)
+}
+
 func (validator *ContractUpdateValidator) getCurrentDeclaration() ast.Declaration {
 	return validator.currentDecl
 }
@@ -331,11 +352,108 @@ func checkDeclarationUpdatability(
 
 	if newDecl, ok := newDeclaration.(*ast.CompositeDeclaration); ok {
 		if oldDecl, ok := oldDeclaration.(*ast.CompositeDeclaration); ok {
+			checkTypeParameters(validator, oldDecl, newDecl)
 			checkConformance(oldDecl, newDecl)
+			checkHashingFunctions(validator, oldDecl, newDecl)
+		}
+	}
+}
+
+// checkTypeParameters validates updating the type parameters of a generic composite declaration.
+// Stored values of instances of the composite type have the type arguments as part of their type,
+// so the number of type parameters and their bounds must not change.
+func checkTypeParameters(
+	validator UpdateValidator,
+	oldDeclaration *ast.CompositeDeclaration,
+	newDeclaration *ast.CompositeDeclaration,
+) {
+	var oldTypeParameters, newTypeParameters []*ast.TypeParameter
+	if oldDeclaration.TypeParameterList != nil {
+		oldTypeParameters = oldDeclaration.TypeParameterList.TypeParameters
+	}
+	if newDeclaration.TypeParameterList != nil {
+		newTypeParameters = newDeclaration.TypeParameterList.TypeParameters
+	}
+
+	reportMismatch := func() {
+		validator.report(&TypeParametersMismatchError{
+			DeclName: newDeclaration.Identifier.Identifier,
+			Range:    ast.NewUnmeteredRangeFromPositioned(newDeclaration.Identifier),
+		})
+	}
+
+	if len(oldTypeParameters) != len(newTypeParameters) {
+		reportMismatch()
+		return
+	}
+
+	for i, oldTypeParameter := range oldTypeParameters {
+		newTypeParameter := newTypeParameters[i]
+
+		oldTypeBound := oldTypeParameter.TypeBound
+		newTypeBound := newTypeParameter.TypeBound
+
+		if oldTypeBound == nil || newTypeBound == nil {
+			if oldTypeBound != newTypeBound {
+				reportMismatch()
+				return
+			}
+			continue
+		}
+
+		if oldTypeBound.IsResource != newTypeBound.IsResource ||
+			oldTypeBound.Type.CheckEqual(newTypeBound.Type, validator) != nil {
+
+			reportMismatch()
+			return
 		}
 	}
 }
 
+// checkHashingFunctions validates updating a structure conforming to the built-in `Equatable` interface,
+// or the built-in `Hashable` interface, which extends it.
+// Stored dictionary keys and set elements are located by the result of the `hashValue` function,
+// and matched using the `equals` function, so changing the result of either function
+// would make existing entries unreachable.
+//
+// The functions may only read the constant fields of the structure,
+// and call the `equals` and `hashValue` functions of other structures,
+// which are validated when they are updated.
+// Still, the whole declaration of the structure must not change,
+// as the functions depend on the types of the fields.
+func checkHashingFunctions(
+	validator UpdateValidator,
+	oldDeclaration *ast.CompositeDeclaration,
+	newDeclaration *ast.CompositeDeclaration,
+) {
+	compositeType := validator.newCompositeDeclarationType(newDeclaration)
+	if compositeType == nil ||
+		!compositeType.EffectiveInterfaceConformanceSet().Contains(sema.EquatableType) {
+
+		return
+	}
+
+	oldFunctions := oldDeclaration.Members.FunctionsByIdentifier()
+	_, hadEquals := oldFunctions[sema.EquatableTypeEqualsFunctionName]
+	_, hadHashValue := oldFunctions[sema.HashableTypeHashValueFunctionName]
+	if !hadEquals && !hadHashValue {
+		// The structure did not implement the functions before,
+		// so no values could have been compared or hashed using them
+		return
+	}
+
+	// Compare the pretty-printed declarations,
+	// which ignores changes to formatting and comments
+	if ast.Prettier(oldDeclaration) == ast.Prettier(newDeclaration) {
+		return
+	}
+
+	validator.report(&HashableTypeChangeError{
+		DeclName: newDeclaration.Identifier.Identifier,
+		Range:    ast.NewUnmeteredRangeFromPositioned(newDeclaration.Identifier),
+	})
+}
+
 func checkFields(
 	validator UpdateValidator,
 	oldDeclaration ast.Declaration,
@@ -562,6 +680,9 @@ func checkNestedDeclarations(
 
 	// Check enum-cases, if there are any.
 	checkEnumCases(validator, oldDeclaration, newDeclaration)
+
+	// Check type aliases, if there are any.
+	checkTypeAliases(validator, oldDeclaration, newDeclaration)
 }
 
 func getNestedNominalTypeDecls(declaration ast.Declaration) map[string]ast.Declaration {
@@ -632,6 +753,43 @@ func checkEnumCases(
 	}
 }
 
+// checkTypeAliases validates updating type aliases.
+// Fields are compared syntactically, so the aliased type of an existing type alias must not change,
+// as it would otherwise change the types of the fields which are declared using the type alias.
+func checkTypeAliases(
+	validator UpdateValidator,
+	oldDeclaration ast.Declaration,
+	newDeclaration ast.Declaration,
+) {
+	oldTypeAliases := oldDeclaration.DeclarationMembers().TypeAliases()
+	if len(oldTypeAliases) == 0 {
+		return
+	}
+
+	oldTypeAliasesByIdentifier := make(map[string]*ast.TypeAliasDeclaration, len(oldTypeAliases))
+	for _, oldTypeAlias := range oldTypeAliases {
+		oldTypeAliasesByIdentifier[oldTypeAlias.Identifier.Identifier] = oldTypeAlias
+	}
+
+	for _, newTypeAlias := range newDeclaration.DeclarationMembers().TypeAliases() {
+		oldTypeAlias, ok := oldTypeAliasesByIdentifier[newTypeAlias.Identifier.Identifier]
+		if !ok {
+			// Then it's a new declaration
+			continue
+		}
+
+		err := oldTypeAlias.TypeAnnotation.Type.CheckEqual(newTypeAlias.TypeAnnotation.Type, validator)
+		if err != nil {
+			validator.report(&TypeAliasMismatchError{
+				DeclName:      newDeclaration.DeclarationIdentifier().Identifier,
+				TypeAliasName: newTypeAlias.Identifier.Identifier,
+				Err:           err,
+				Range:         ast.NewUnmeteredRangeFromPositioned(newTypeAlias.TypeAnnotation),
+			})
+		}
+	}
+}
+
 func (validator *ContractUpdateValidator) checkConformance(
 	oldDecl *ast.CompositeDeclaration,
 	newDecl *ast.CompositeDeclaration,
@@ -778,6 +936,48 @@ func (e *FieldMismatchError) SecondaryError() string {
 	return e.Err.Error()
 }
 
+// TypeAliasMismatchError is reported during a contract update, when the aliased type of a type alias
+// does not match the existing aliased type of the same type alias.
+type TypeAliasMismatchError struct {
+	Err           error
+	DeclName      string
+	TypeAliasName string
+	ast.Range
+}
+
+var _ errors.UserError = &TypeAliasMismatchError{}
+var _ errors.SecondaryError = &TypeAliasMismatchError{}
+
+func (*TypeAliasMismatchError) IsUserError() {}
+
+func (e *TypeAliasMismatchError) Error() string {
+	return fmt.Sprintf("mismatching type alias `%s` in `%s`",
+		e.TypeAliasName,
+		e.DeclName,
+	)
+}
+
+func (e *TypeAliasMismatchError) SecondaryError() string {
+	return e.Err.Error()
+}
+
+// TypeParametersMismatchError is reported during a contract update,
+// when the type parameters of a generic composite declaration do not match the existing type parameters.
+type TypeParametersMismatchError struct {
+	DeclName string
+	ast.Range
+}
+
+var _ errors.UserError = &TypeParametersMismatchError{}
+
+func (*TypeParametersMismatchError) IsUserError() {}
+
+func (e *TypeParametersMismatchError) Error() string {
+	return fmt.Sprintf("mismatching type parameters in `%s`",
+		e.DeclName,
+	)
+}
+
 // TypeMismatchError is reported during a contract update, when a type of the new program
 // does not match the existing type.
 type TypeMismatchError struct {
@@ -867,6 +1067,29 @@ func (e *ConformanceMismatchError) Error() string {
 	)
 }
 
+// HashableTypeChangeError is reported during a contract update,
+// when the declaration of a structure conforming to the built-in `Equatable` or `Hashable` interface changed.
+type HashableTypeChangeError struct {
+	DeclName string
+	ast.Range
+}
+
+var _ errors.UserError = &HashableTypeChangeError{}
+var _ errors.SecondaryError = &HashableTypeChangeError{}
+
+func (*HashableTypeChangeError) IsUserError() {}
+
+func (e *HashableTypeChangeError) Error() string {
+	return fmt.Sprintf(
+		"cannot change equatable or hashable structure `%s`",
+		e.DeclName,
+	)
+}
+
+func (e *HashableTypeChangeError) SecondaryError() string {
+	return "stored dictionary keys and set elements depend on the functions and fields of the structure"
+}
+
 // EnumCaseMismatchError is reported during an enum update, when an updated enum case
 // does not match the existing enum case.
 type EnumCaseMismatchError struct {
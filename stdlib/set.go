@@ -0,0 +1,113 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/errors"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+)
+
+// SetConstructorFunction
+
+const setConstructorFunctionDocString = `
+Constructs a set containing the given elements.
+
+Duplicate elements are only contained once.
+`
+
+var setConstructorFunctionTypeParameter = &sema.TypeParameter{
+	Name:      "T",
+	TypeBound: sema.HashableStructType,
+}
+
+var setConstructorFunctionType = func() *sema.FunctionType {
+	elementType := &sema.GenericType{
+		TypeParameter: setConstructorFunctionTypeParameter,
+	}
+
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		TypeParameters: []*sema.TypeParameter{
+			setConstructorFunctionTypeParameter,
+		},
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "elements",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.VariableSizedType{
+						Type: elementType,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(
+			&sema.SetType{
+				ElementType: elementType,
+			},
+		),
+	}
+}()
+
+var SetConstructorFunction = NewStandardLibraryStaticFunction(
+	sema.SetTypeName,
+	setConstructorFunctionType,
+	setConstructorFunctionDocString,
+	func(invocation interpreter.Invocation) interpreter.Value {
+		elements, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		inter := invocation.Interpreter
+		locationRange := invocation.LocationRange
+
+		elementType, ok := invocation.TypeParameterTypes.Get(setConstructorFunctionTypeParameter)
+		if !ok || elementType == nil {
+			panic(errors.NewUnreachableError())
+		}
+
+		set := interpreter.NewSetValue(
+			inter,
+			locationRange,
+			interpreter.NewSetStaticType(
+				inter,
+				interpreter.ConvertSemaToStaticType(inter, elementType),
+			),
+			common.ZeroAddress,
+		)
+
+		// Transfer the elements before they are inserted into the set.
+		const transferElements = true
+
+		elements.Iterate(
+			inter,
+			func(element interpreter.Value) (resume bool) {
+				set.Insert(inter, locationRange, element)
+				return true
+			},
+			transferElements,
+			locationRange,
+		)
+
+		return set
+	},
+)
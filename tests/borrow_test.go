@@ -0,0 +1,400 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/runtime"
+	. "github.com/onflow/cadence/tests/runtime_utils"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestRuntimeExclusiveBorrows(t *testing.T) {
+
+	t.Parallel()
+
+	signerAddress := common.MustBytesToAddress([]byte{0x42})
+
+	deployTx := DeploymentTransaction("Test", []byte(`
+      access(all) contract Test {
+
+          access(all) entitlement E
+
+          access(all) resource R {
+              access(all) var count: Int
+
+              init() {
+                  self.count = 0
+              }
+
+              access(E) fun increment() {
+                  self.count = self.count + 1
+              }
+          }
+
+          access(all) fun createR(): @R {
+              return <-create R()
+          }
+
+          access(all) fun increment(_ cap: Capability<auth(E) &R>, reenter: Bool) {
+              let r = cap.borrow()!
+              if reenter {
+                  Test.increment(cap, reenter: false)
+              }
+              r.increment()
+          }
+
+          access(all) fun incrementLogged(_ cap: Capability<auth(E) &R>, reenter: Bool) {
+              let r = cap.borrow()!
+              log("borrowed")
+              if reenter {
+                  Test.incrementLogged(cap, reenter: false)
+              }
+              r.increment()
+          }
+
+          access(all) fun borrowR(_ cap: Capability<auth(E) &R>): auth(E) &R {
+              return cap.borrow()!
+          }
+
+          access(all) fun borrowOptionalR(_ cap: Capability<auth(E) &R>): auth(E) &R? {
+              return cap.borrow()
+          }
+
+          access(all) fun count(_ cap: Capability<&R>): Int {
+              return cap.borrow()!.count
+          }
+      }
+    `))
+
+	type overlap struct {
+		address common.Address
+		path    interpreter.PathValue
+	}
+
+	execute := func(
+		t *testing.T,
+		mode interpreter.ExclusiveBorrowMode,
+		body string,
+	) (
+		[]overlap,
+		[]string,
+		error,
+	) {
+		config := DefaultTestInterpreterConfig
+		config.ExclusiveBorrowMode = mode
+		runtime := NewTestInterpreterRuntimeWithConfig(config)
+
+		accountCodes := map[Location][]byte{}
+		var overlaps []overlap
+		var logs []string
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return []Address{signerAddress}, nil
+			},
+			OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+			OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+				accountCodes[location] = code
+				return nil
+			},
+			OnGetAccountContractCode: func(location common.AddressLocation) (code []byte, err error) {
+				code = accountCodes[location]
+				return code, nil
+			},
+			OnEmitEvent: func(event cadence.Event) error {
+				return nil
+			},
+			OnProgramLog: func(message string) {
+				logs = append(logs, message)
+			},
+			OnOverlappingBorrow: func(
+				_ *interpreter.Interpreter,
+				_ interpreter.LocationRange,
+				address common.Address,
+				path interpreter.PathValue,
+			) {
+				overlaps = append(overlaps, overlap{
+					address: address,
+					path:    path,
+				})
+			},
+		}
+
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: deployTx,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+
+		tx := `
+          import Test from 0x42
+
+          transaction {
+              prepare(signer: auth(Storage, Capabilities) &Account) {
+                  signer.storage.save(<-Test.createR(), to: /storage/r)
+                  let cap = signer.capabilities.storage.issue<auth(Test.E) &Test.R>(/storage/r)
+                  let readCap = signer.capabilities.storage.issue<&Test.R>(/storage/r)
+        ` + body + `
+              }
+          }
+        `
+
+		err = runtime.ExecuteTransaction(
+			Script{
+				Source: []byte(tx),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+
+		return overlaps, logs, err
+	}
+
+	expectedOverlap := overlap{
+		address: signerAddress,
+		path: interpreter.PathValue{
+			Domain:     common.PathDomainStorage,
+			Identifier: "r",
+		},
+	}
+
+	t.Run("sequential borrows", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, mode := range []interpreter.ExclusiveBorrowMode{
+			interpreter.ExclusiveBorrowModeWarn,
+			interpreter.ExclusiveBorrowModeEnforce,
+		} {
+			overlaps, _, err := execute(t, mode, `
+              Test.increment(cap, reenter: false)
+              Test.increment(cap, reenter: false)
+              assert(Test.count(readCap) == 2)
+            `)
+			require.NoError(t, err)
+			assert.Empty(t, overlaps)
+		}
+	})
+
+	t.Run("borrows in same function", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, mode := range []interpreter.ExclusiveBorrowMode{
+			interpreter.ExclusiveBorrowModeWarn,
+			interpreter.ExclusiveBorrowModeEnforce,
+		} {
+			overlaps, _, err := execute(t, mode, `
+              let r1 = signer.storage.borrow<auth(Test.E) &Test.R>(from: /storage/r)!
+              let r2 = cap.borrow()!
+              r1.increment()
+              r2.increment()
+            `)
+			require.NoError(t, err)
+			assert.Empty(t, overlaps)
+		}
+	})
+
+	t.Run("unauthorized borrow", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, mode := range []interpreter.ExclusiveBorrowMode{
+			interpreter.ExclusiveBorrowModeWarn,
+			interpreter.ExclusiveBorrowModeEnforce,
+		} {
+			overlaps, _, err := execute(t, mode, `
+              let r = signer.storage.borrow<auth(Test.E) &Test.R>(from: /storage/r)!
+              r.increment()
+              assert(Test.count(readCap) == 1)
+            `)
+			require.NoError(t, err)
+			assert.Empty(t, overlaps)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeDisabled, `
+          Test.increment(cap, reenter: true)
+        `)
+		require.NoError(t, err)
+		assert.Empty(t, overlaps)
+	})
+
+	t.Run("warn, re-entrant capability borrow", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeWarn, `
+          Test.increment(cap, reenter: true)
+          assert(Test.count(readCap) == 2)
+        `)
+		require.NoError(t, err)
+		assert.Equal(t, []overlap{expectedOverlap}, overlaps)
+	})
+
+	t.Run("warn, storage and capability borrow", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeWarn, `
+          let r = signer.storage.borrow<auth(Test.E) &Test.R>(from: /storage/r)!
+          Test.increment(cap, reenter: false)
+          r.increment()
+        `)
+		require.NoError(t, err)
+		assert.Equal(t, []overlap{expectedOverlap}, overlaps)
+	})
+
+	t.Run("borrows returned by helper function", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, mode := range []interpreter.ExclusiveBorrowMode{
+			interpreter.ExclusiveBorrowModeWarn,
+			interpreter.ExclusiveBorrowModeEnforce,
+		} {
+			overlaps, _, err := execute(t, mode, `
+              let r1 = Test.borrowR(cap)
+              let r2 = Test.borrowOptionalR(cap)!
+              r1.increment()
+              r2.increment()
+              assert(Test.count(readCap) == 2)
+            `)
+			require.NoError(t, err)
+			assert.Empty(t, overlaps)
+		}
+	})
+
+	t.Run("warn, borrow returned by helper function", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeWarn, `
+          let r = Test.borrowR(cap)
+          Test.increment(cap, reenter: false)
+          r.increment()
+        `)
+		require.NoError(t, err)
+		assert.Equal(t, []overlap{expectedOverlap}, overlaps)
+	})
+
+	t.Run("warn, optional borrow returned by helper function", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeWarn, `
+          let r = Test.borrowOptionalR(cap)!
+          Test.increment(cap, reenter: false)
+          r.increment()
+        `)
+		require.NoError(t, err)
+		assert.Equal(t, []overlap{expectedOverlap}, overlaps)
+	})
+
+	t.Run("warn, borrow not returned by helper function", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The borrow ends when the helper function returns,
+		// because the reference does not escape through the result
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeWarn, `
+          fun incrementBorrowed() {
+              Test.borrowR(cap).increment()
+          }
+          incrementBorrowed()
+          Test.increment(cap, reenter: false)
+        `)
+		require.NoError(t, err)
+		assert.Empty(t, overlaps)
+	})
+
+	t.Run("enforce, re-entrant capability borrow", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeEnforce, `
+          Test.increment(cap, reenter: true)
+        `)
+		RequireError(t, err)
+		assert.Empty(t, overlaps)
+
+		var overlappingBorrowErr interpreter.OverlappingBorrowError
+		require.ErrorAs(t, err, &overlappingBorrowErr)
+		assert.Equal(t, expectedOverlap.address, overlappingBorrowErr.Address)
+		assert.Equal(t, expectedOverlap.path, overlappingBorrowErr.Path)
+	})
+
+	t.Run("enforce, public capability borrow", func(t *testing.T) {
+
+		t.Parallel()
+
+		overlaps, _, err := execute(t, interpreter.ExclusiveBorrowModeEnforce, `
+          signer.capabilities.publish(cap, at: /public/r)
+          let r = signer.storage.borrow<auth(Test.E) &Test.R>(from: /storage/r)!
+          fun reenter() {
+              signer.capabilities.borrow<auth(Test.E) &Test.R>(/public/r)!.increment()
+          }
+          reenter()
+        `)
+		RequireError(t, err)
+		assert.Empty(t, overlaps)
+
+		var overlappingBorrowErr interpreter.OverlappingBorrowError
+		require.ErrorAs(t, err, &overlappingBorrowErr)
+	})
+	t.Run("enforce, overlap rejected when borrowed", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The re-entered function must not be able to use the overlapping borrow
+
+		_, logs, err := execute(t, interpreter.ExclusiveBorrowModeEnforce, `
+          Test.incrementLogged(cap, reenter: true)
+        `)
+		RequireError(t, err)
+
+		var overlappingBorrowErr interpreter.OverlappingBorrowError
+		require.ErrorAs(t, err, &overlappingBorrowErr)
+
+		assert.Equal(t, []string{`"borrowed"`}, logs)
+	})
+}
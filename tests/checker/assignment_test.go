@@ -257,3 +257,212 @@ func TestCheckInvalidAssignmentTargetExpression(t *testing.T) {
 		assert.IsType(t, &sema.InvalidAssignmentTargetError{}, errs[0])
 	})
 }
+
+func TestCheckCompoundAssignment(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all operators", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x = 60
+              x += 1
+              x -= 2
+              x *= 3
+              x /= 4
+              x %= 5
+              x &= 6
+              x |= 7
+              x ^= 8
+              x <<= 9
+              x >>= 10
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("inferred value type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x: UInt8 = 1
+              x += 2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("member and index targets", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Counter {
+              var count: Int
+              var counts: [Int]
+
+              init() {
+                  self.count = 0
+                  self.counts = [0]
+              }
+
+              fun increment() {
+                  self.count += 1
+                  self.counts[0] += 1
+              }
+          }
+
+          fun test() {
+              let xs = [1, 2, 3]
+              xs[0] *= 2
+
+              let ref = &xs as auth(Mutate) &[Int]
+              ref[1] -= 1
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid, constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = 1
+              x += 1
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("invalid, unauthorized reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs = [1, 2, 3]
+              let ref = &xs as &[Int]
+              ref[0] += 1
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnauthorizedReferenceAssignmentError{}, errs[0])
+	})
+
+	t.Run("invalid, non-number operands", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x = "a"
+              x += "b"
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("invalid, mismatched operand types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x: Int8 = 1
+              let y: Int16 = 2
+              x += y
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("invalid, bitwise fixed-point", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x = 1.0
+              x &= 2.0
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("invalid, optional target", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var x: Int? = 1
+              x += 1
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandError{}, errs[0])
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[1])
+	})
+
+	t.Run("invalid, dictionary target", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs = {"a": 1}
+              xs["a"] += 1
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandError{}, errs[0])
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[1])
+	})
+
+	t.Run("invalid, view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var count = 0
+
+          view fun test() {
+              var x = 1
+              x += 1
+              count += 1
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+}
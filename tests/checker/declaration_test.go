@@ -638,6 +638,14 @@ func TestCheckBuiltinRedeclaration(t *testing.T) {
 	_ = sema.BaseValueActivation.ForEach(
 		func(name string, _ *sema.Variable) error {
 
+			// Built-ins which were introduced after programs could already declare the same name
+			// may be shadowed, see TestCheckTimestamp and TestCheckDuration
+
+			switch name {
+			case sema.TimestampTypeName, sema.DurationTypeName:
+				return nil
+			}
+
 			t.Run(name, func(t *testing.T) {
 
 				t.Run("re-declaration in function", func(t *testing.T) {
@@ -0,0 +1,294 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+)
+
+func TestCheckFunctionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun add(_ a: Int, _ b: Int = 1, c: Int = a * 2): Int {
+              return a + b + c
+          }
+
+          let x = add(1)
+          let y = add(1, 2)
+          let z = add(1, 2, c: 3)
+        `)
+		require.NoError(t, err)
+
+		addType := RequireGlobalValue(t, checker.Elaboration, "add")
+		require.IsType(t, &sema.FunctionType{}, addType)
+		assert.Equal(t,
+			&sema.Arity{Min: 1, Max: 3},
+			addType.(*sema.FunctionType).Arity,
+		)
+	})
+
+	t.Run("too few arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun add(_ a: Int, _ b: Int = 1): Int {
+              return a + b
+          }
+
+          let x = add()
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InsufficientArgumentsError{}, errs[0])
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun add(_ a: Int, _ b: Int = 1): Int {
+              return a + b
+          }
+
+          let x = add(1, 2, 3)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.ExcessiveArgumentsError{}, errs[0])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = "1") {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("impure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun impure(): Int {
+              return 1
+          }
+
+          fun test(a: Int = impure()) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.PurityError{}, errs[0])
+	})
+
+	t.Run("view", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun one(): Int {
+              return 1
+          }
+
+          fun test(a: Int = one()) {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("non-trailing", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1, b: Int) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.MissingDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("subsequent parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = b, b: Int = 1) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R = <-create R()) {
+              destroy r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidResourceDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 1) {}
+
+          let f: fun(Int, Int): Void = test
+          let g: fun(Int): Void = test
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckCompositeDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let a: Int
+              let b: Int
+
+              init(a: Int, b: Int = a + 1) {
+                  self.a = a
+                  self.b = b
+              }
+          }
+
+          let s1 = S(a: 1)
+          let s2 = S(a: 1, b: 3)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("initializer, uninitialized field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let a: Int
+              let b: Int
+
+              init(b: Int = self.a) {
+                  self.a = 1
+                  self.b = b
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.UninitializedFieldAccessError{}, errs[0])
+	})
+
+	t.Run("function, self", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let a: Int
+
+              init() {
+                  self.a = 1
+              }
+
+              fun get(b: Int = self.a): Int {
+                  return b
+              }
+          }
+
+          let x = S().get()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("interface, same required parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun get(a: Int, b: Int = 1): Int
+          }
+
+          struct S: I {
+              fun get(a: Int, b: Int = 2): Int {
+                  return a + b
+              }
+          }
+
+          let s: {I} = S()
+          let x = s.get(a: 1)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("interface, different required parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun get(a: Int, b: Int = 1): Int
+          }
+
+          struct S: I {
+              fun get(a: Int, b: Int): Int {
+                  return a + b
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
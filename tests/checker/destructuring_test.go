@@ -0,0 +1,540 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+)
+
+func TestCheckArrayDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable-sized", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              let values = [1, 2]
+              let [a, b] = values
+              return a + b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("constant-sized", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): String {
+              let values: [String; 2] = ["a", "b"]
+              let [a, _] = values
+              return a
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("constant-sized, length mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let values: [Int; 3] = [1, 2, 3]
+              let [a, b] = values
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var mismatchErr *sema.DestructuringLengthMismatchError
+		require.ErrorAs(t, errs[0], &mismatchErr)
+		assert.Equal(t, 3, mismatchErr.ExpectedLength)
+		assert.Equal(t, 2, mismatchErr.ActualLength)
+	})
+
+	t.Run("element type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, b] = ["a", "b"]
+              let c: Int = a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): UInt8 {
+              let [a, b]: [UInt8; 2] = [1, 2]
+              return a + b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, b] = {1: 2}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var invalidErr *sema.InvalidDestructuringTypeError
+		require.ErrorAs(t, errs[0], &invalidErr)
+		assert.Equal(t,
+			"cannot destructure value of type `{Int: Int}` with array pattern",
+			invalidErr.Error(),
+		)
+	})
+
+	t.Run("duplicate variable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, a] = [1, 2]
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("constant", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, b] = [1, 2]
+              a = 3
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("variable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var [a, b] = [1, 2]
+              a = 3
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckDictionaryDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int? {
+              let values = {"a": 1, "b": 2}
+              let {"a": a, "c": c} = values
+              let optional: Int? = c
+              return a
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("element type is optional", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let {"a": a} = {"a": 1}
+              let b: Int = a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid key type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let {1: a} = {"a": 1}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let {"a": a} <- {"a": <-create R()}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringTypeError{}, errs[0])
+	})
+}
+
+func TestCheckCompositeDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Pair {
+              let first: Int
+              let second: String
+
+              init(first: Int, second: String) {
+                  self.first = first
+                  self.second = second
+              }
+          }
+
+          fun test(): String {
+              let (first, second: s) = Pair(first: 1, second: "2")
+              let i: Int = first
+              return s
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test() {
+              let (x) = S()
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("function", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun f() {}
+          }
+
+          fun test() {
+              let (f) = S()
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringMemberError{}, errs[0])
+	})
+
+	t.Run("inaccessible field", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              access(self) let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          fun test() {
+              let (x) = S()
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          fun test() {
+              let r <- create R()
+              let (x) <- r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringTypeError{}, errs[0])
+	})
+}
+
+func TestCheckResourceArrayDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all moved", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R(), <-create R()]
+              let [a, b] <- rs
+              destroy a
+              destroy b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R()]
+              let [a] = rs
+              destroy a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[0])
+	})
+
+	t.Run("element lost", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R(), <-create R()]
+              let [a, b] <- rs
+              destroy a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("element discarded", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R(), <-create R()]
+              let [a, _] <- rs
+              destroy a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("element moved twice", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R()]
+              let [a] <- rs
+              destroy a
+              destroy a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("array used after destructuring", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R()]
+              let [a] <- rs
+              destroy a
+              destroy rs
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+}
+
+func TestCheckForDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("composite", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Pair {
+              let first: Int
+              let second: Int
+
+              init(first: Int, second: Int) {
+                  self.first = first
+                  self.second = second
+              }
+          }
+
+          fun test(): Int {
+              var sum = 0
+              for i, (first, second) in [Pair(first: 1, second: 2)] {
+                  sum = sum + i + first + second
+              }
+              return sum
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              var sum = 0
+              for [a, b] in [[1, 2], [3, 4]] {
+                  sum = sum + a + b
+              }
+              return sum
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let values = [[1, 2], [3, 4]]
+              for [a, b] in &values as &[[Int]] {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringTypeError{}, errs[0])
+	})
+}
+
+func TestCheckUnsupportedDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let [a, b] = [1, 2]
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnsupportedDestructuringError{}, errs[0])
+	})
+
+	t.Run("optional binding", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let values: [Int]? = [1, 2]
+              if let [a, b] = values {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UnsupportedDestructuringError{}, errs[0])
+	})
+}
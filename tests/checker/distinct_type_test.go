@@ -0,0 +1,260 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+)
+
+func TestCheckDistinctType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("declaration", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          type PersonID = distinct String
+
+          let id: PersonID = PersonID("alice")
+          let raw: String = id.toUnderlying()
+        `)
+		require.NoError(t, err)
+
+		idType := RequireGlobalType(t, checker.Elaboration, "PersonID")
+		require.IsType(t, &sema.CompositeType{}, idType)
+
+		compositeType := idType.(*sema.CompositeType)
+		assert.True(t, compositeType.IsDistinctType())
+		assert.Equal(t, sema.StringType, compositeType.DistinctUnderlyingType)
+		assert.False(t, compositeType.DistinctInheritsOperations)
+
+		assert.Equal(t,
+			idType,
+			RequireGlobalValue(t, checker.Elaboration, "id"),
+		)
+	})
+
+	t.Run("not interchangeable with underlying type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type PersonID = distinct String
+
+          let a: PersonID = "alice"
+          let b: String = PersonID("bob")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("not interchangeable with other distinct type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type PersonID = distinct String
+          type CompanyID = distinct String
+
+          let id: CompanyID = PersonID("alice")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("underlying value is not accessible", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type PersonID = distinct String
+
+          let raw = PersonID("alice").underlyingValue
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("equality and dictionary keys", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type PersonID = distinct String
+
+          let equal = PersonID("alice") == PersonID("bob")
+          let ages: {PersonID: Int} = {PersonID("alice"): 42}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("equality with underlying value", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type PersonID = distinct String
+
+          let equal = PersonID("alice") == "alice"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("operations without opt-in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type Meters = distinct Int
+
+          let sum = Meters(1) + Meters(2)
+          let less = Meters(1) < Meters(2)
+          let negated = -Meters(1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[1])
+		assert.IsType(t, &sema.InvalidUnaryOperandError{}, errs[2])
+	})
+
+	t.Run("operations with opt-in", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          type Meters = distinct Int with operations
+
+          let sum = Meters(1) + Meters(2)
+          let less = Meters(1) < Meters(2)
+          let negated = -Meters(1)
+
+          fun test() {
+              var total = Meters(0)
+              total += Meters(3)
+          }
+        `)
+		require.NoError(t, err)
+
+		metersType := RequireGlobalType(t, checker.Elaboration, "Meters")
+
+		assert.Equal(t,
+			metersType,
+			RequireGlobalValue(t, checker.Elaboration, "sum"),
+		)
+		assert.Equal(t,
+			sema.BoolType,
+			RequireGlobalValue(t, checker.Elaboration, "less"),
+		)
+		assert.Equal(t,
+			metersType,
+			RequireGlobalValue(t, checker.Elaboration, "negated"),
+		)
+	})
+
+	t.Run("operations with opt-in, mixed operands", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type Meters = distinct Int with operations
+
+          let sum = Meters(1) + 2
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("operations with opt-in, unsupported by underlying type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          type PersonID = distinct String with operations
+
+          let sum = PersonID("a") + PersonID("b")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("resource underlying type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          type Wrapper = distinct @R
+        `)
+
+		// NOTE: the synthesized members are also invalid for a resource
+
+		errs := RequireCheckerErrors(t, err, 4)
+
+		assert.IsType(t, &sema.InvalidDistinctTypeUnderlyingTypeError{}, errs[0])
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
+		assert.IsType(t, &sema.MissingMoveOperationError{}, errs[2])
+		assert.IsType(t, &sema.InvalidResourceFieldError{}, errs[3])
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              access(all) type TokenID = distinct UInt64
+
+              access(all) let ids: [TokenID]
+
+              init() {
+                  self.ids = [TokenID(1)]
+              }
+          }
+
+          let id: C.TokenID = C.TokenID(2)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("local", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              type PersonID = distinct String
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+	})
+}
@@ -0,0 +1,539 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+)
+
+func TestCheckEquatable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("conformance, equality", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S: Equatable {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if let other = other as? S {
+                      return self.id == other.id
+                  }
+                  return false
+              }
+          }
+
+          let a = S(id: 1)
+          let b = S(id: 1)
+          let eq = a == b
+          let ne = a != b
+          let contains = [a].contains(b)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing equals", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S: Equatable {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("impure equals", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S: Equatable {
+              access(all) fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R: Equatable {
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CompositeKindMismatchError{}, errs[0])
+	})
+
+	t.Run("non-conforming struct", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+          }
+
+          let eq = S() == S()
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("intersection type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: {Equatable}, b: {Equatable}): Bool {
+              return a == b
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckHashable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("dictionary key", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Key: Hashable {
+              let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if let other = other as? Key {
+                      return self.id == other.id
+                  }
+                  return false
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  return self.id
+              }
+          }
+
+          let a: {Key: String} = {Key(id: 1): "one"}
+          let b = {Key(id: 2): "two"}
+          let c: {{Hashable}: String} = {}
+          let d: HashableStruct = Key(id: 3)
+          let eq = Key(id: 1) == Key(id: 1)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing equals", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Key: Hashable {
+              access(all) view fun hashValue(): UInt64 {
+                  return 0
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("equatable only", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Key: Equatable {
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+          }
+
+          fun test(keys: {Key: String}) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDictionaryKeyTypeError{}, errs[0])
+	})
+}
+
+func TestCheckEquatableHashableShadowing(t *testing.T) {
+
+	t.Parallel()
+
+	// Programs declaring types named `Equatable` and `Hashable`
+	// existed before the built-in `Equatable` and `Hashable` interfaces
+
+	t.Run("nested in contract", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              struct interface Equatable {
+                  fun isEqual(_ other: AnyStruct): Bool
+              }
+
+              struct interface Hashable {
+                  let hash: String
+              }
+
+              struct S: Equatable, Hashable {
+                  let hash: String
+
+                  init(hash: String) {
+                      self.hash = hash
+                  }
+
+                  fun isEqual(_ other: AnyStruct): Bool {
+                      return false
+                  }
+              }
+
+              fun test(): Bool {
+                  let s: {Equatable, Hashable} = S(hash: "")
+                  return s.isEqual(s) && s.hash == ""
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("global", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Equatable {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          resource Hashable {}
+
+          let e: Equatable = Equatable(id: 1)
+
+          fun test(): @Hashable {
+              return <-create Hashable()
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("shadowed, no built-in conformance requirements", func(t *testing.T) {
+		t.Parallel()
+
+		// A struct conforming to a shadowing interface does not need to implement
+		// the functions required by the built-in interface,
+		// and is not a valid dictionary key
+
+		_, err := ParseAndCheck(t, `
+          struct interface Hashable {}
+
+          struct Key: Hashable {}
+
+          fun test(keys: {Key: String}) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDictionaryKeyTypeError{}, errs[0])
+	})
+}
+
+func TestCheckHashingFunctionAccess(t *testing.T) {
+
+	t.Parallel()
+
+	// The equals and hashValue functions of stored dictionary keys and set elements
+	// may only depend on values which cannot change after the key was stored
+
+	t.Run("constant fields, parameters, locals, built-in functions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Inner: Hashable {
+              let id: UInt8
+
+              init(id: UInt8) {
+                  self.id = id
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if let other = other as? Inner {
+                      return self.id == other.id
+                  }
+                  return false
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  return UInt64(self.id)
+              }
+          }
+
+          struct Key: Hashable {
+              let name: String
+              let inner: Inner
+
+              init(name: String, inner: Inner) {
+                  self.name = name
+                  self.inner = inner
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if !other.isInstance(self.getType()) {
+                      return false
+                  }
+                  let other = other as! Key
+                  return self.name == other.name
+                      && self.inner.equals(other.inner)
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  let length = UInt64(self.name.length)
+                  return length ^ self.inner.hashValue()
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("variable field", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Key: Hashable {
+              let id: UInt64
+              var count: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+                  self.count = 0
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if let other = other as? Key {
+                      return self.id == other.id && self.count == other.count
+                  }
+                  return false
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  return self.count
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 3)
+
+		var accessErr *sema.InvalidHashingFunctionAccessError
+		for _, err := range errs {
+			require.ErrorAs(t, err, &accessErr)
+			assert.Equal(t, "count", accessErr.Name)
+		}
+	})
+
+	t.Run("global", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var salt: UInt64 = 0
+
+          struct Key: Hashable {
+              let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  return self.id ^ salt
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var accessErr *sema.InvalidHashingFunctionAccessError
+		require.ErrorAs(t, errs[0], &accessErr)
+		assert.Equal(t, "salt", accessErr.Name)
+	})
+
+	t.Run("contract field", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              let salt: UInt64
+
+              init() {
+                  self.salt = 0
+              }
+
+              struct Key: Hashable {
+                  let id: UInt64
+
+                  init(id: UInt64) {
+                      self.id = id
+                  }
+
+                  access(all) view fun equals(_ other: AnyStruct): Bool {
+                      return true
+                  }
+
+                  access(all) view fun hashValue(): UInt64 {
+                      return self.id ^ C.salt
+                  }
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var accessErr *sema.InvalidHashingFunctionAccessError
+		require.ErrorAs(t, errs[0], &accessErr)
+		assert.Equal(t, "C", accessErr.Name)
+	})
+
+	t.Run("user-defined functions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          view fun hash(_ id: UInt64): UInt64 {
+              return id
+          }
+
+          struct Key: Equatable {
+              let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if let other = other as? Key {
+                      return self.same(other) && hash(self.id) == hash(other.id)
+                  }
+                  return false
+              }
+
+              access(all) view fun same(_ other: Key): Bool {
+                  return self.id == other.id
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 3)
+
+		var accessErr *sema.InvalidHashingFunctionAccessError
+		require.ErrorAs(t, errs[0], &accessErr)
+		assert.Equal(t, "same", accessErr.Name)
+		require.ErrorAs(t, errs[1], &accessErr)
+		assert.Equal(t, "hash", accessErr.Name)
+		require.ErrorAs(t, errs[2], &accessErr)
+		assert.Equal(t, "hash", accessErr.Name)
+	})
+
+	t.Run("interface default function", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface HasCount: Hashable {
+              var count: UInt64
+
+              access(all) view fun hashValue(): UInt64 {
+                  return self.count
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var accessErr *sema.InvalidHashingFunctionAccessError
+		require.ErrorAs(t, errs[0], &accessErr)
+		assert.Equal(t, "count", accessErr.Name)
+	})
+
+	t.Run("other functions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          var salt: UInt64 = 0
+
+          struct Key: Hashable {
+              var count: UInt64
+
+              init() {
+                  self.count = 0
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  return 0
+              }
+
+              access(all) view fun describe(): UInt64 {
+                  return self.count ^ salt
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
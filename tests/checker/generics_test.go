@@ -0,0 +1,515 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+)
+
+func TestCheckUserDefinedGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun first<T>(_ xs: [T]): T? {
+              if xs.length == 0 {
+                  return nil
+              }
+              return xs[0]
+          }
+
+          let x: Int? = first([1, 2])
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun id<T>(_ x: T): T {
+              let y: T = x
+              return y
+          }
+
+          let x = id<String>("a")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun id<T: Integer>(_ x: T): T {
+              return x
+          }
+
+          let x = id(UInt8(1))
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.UInt8Type,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("bound, mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun id<T: Integer>(_ x: T): T {
+              return x
+          }
+
+          let x = id("a")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("type parameter is opaque", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f<T>(_ x: T): Int {
+              return x
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("cast to type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f<T>(_ x: AnyStruct): T? {
+              return x as? T
+          }
+
+          let y: Int? = f<Int>(1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("nested inference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun wrap<T>(_ x: T): [T] {
+              return [x]
+          }
+
+          fun wrapTwice<U>(_ x: U): [[U]] {
+              return wrap(wrap(x))
+          }
+
+          let z: [[Int]] = wrapTwice(1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("closure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f<T>(_ x: T): fun(): T {
+              return fun (): T {
+                  return x
+              }
+          }
+
+          let g: fun(): Int = f(1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("uninferrable type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f<T>() {}
+
+          fun test() {
+              f()
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f<T: @AnyResource>(_ x: @T) {
+              destroy x
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, missing bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f<T>(_ x: @T) {
+              destroy x
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceAnnotationError{}, errs[0])
+		assert.IsType(t, &sema.InvalidDestructionError{}, errs[1])
+	})
+
+	t.Run("resource argument for struct bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun f<T: AnyStruct>(_ x: T) {}
+
+          fun test() {
+              let r <- create R()
+              f(<-r)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun get(): T {
+                  return self.value
+              }
+          }
+
+          let b = Box(value: 1)
+          let c: Box<Int> = b
+          let d = c.get()
+          let e = b.value
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			common.TypeID("S.test.Box<Int>"),
+			RequireGlobalValue(t, checker.Elaboration, "b").ID(),
+		)
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "d"),
+		)
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "e"),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          resource Vault<T: @AnyResource> {
+              var items: @[T]
+
+              init() {
+                  self.items <- []
+              }
+
+              fun deposit(_ item: @T) {
+                  self.items.append(<-item)
+              }
+          }
+
+          fun test() {
+              let vault <- create Vault<@R>()
+              vault.deposit(<-create R())
+              destroy vault
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("multiple type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Pair<A, B> {
+              let a: A
+              let b: B
+
+              init(a: A, b: B) {
+                  self.a = a
+                  self.b = b
+              }
+
+              fun swap(): Pair<B, A> {
+                  return Pair(a: self.b, b: self.a)
+              }
+          }
+
+          let p: Pair<String, Int> = Pair(a: 1, b: "x").swap()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Node<T> {
+              let value: T
+              let next: Node<T>?
+
+              init(value: T, next: Node<T>?) {
+                  self.value = value
+                  self.next = next
+              }
+          }
+
+          let n = Node<Int>(value: 1, next: Node<Int>(value: 2, next: nil))
+          let v: Int = n.next!.value
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("generic function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun map<U>(_ f: fun(T): U): Box<U> {
+                  return Box<U>(value: f(self.value))
+              }
+          }
+
+          let b: Box<String> = Box(value: 1).map(fun (x: Int): String {
+              return x.toString()
+          })
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun get(): Int
+          }
+
+          struct Box<T>: I {
+              fun get(): Int {
+                  return 1
+              }
+          }
+
+          let i: {I} = Box<String>()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let b: Box = Box(value: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingTypeArgumentError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("invalid type argument count", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t, `
+          struct Box<T> {}
+
+          let b: Box<Int, Int> = panic("")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeArgumentCountError{}, errs[0])
+	})
+
+	t.Run("type argument mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          let b: Box<String> = Box(value: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource type argument for struct bound", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T> {}
+
+          resource R {}
+
+          let b: Box<@R>? = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource field in structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Box<T: @AnyResource> {
+              let x: @T
+
+              init(x: @T) {
+                  self.x <- x
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceFieldError{}, errs[0])
+	})
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C<T> {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidTypeParameterizedCompositeError{}, errs[0])
+	})
+}
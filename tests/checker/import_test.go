@@ -823,3 +823,243 @@ func TestCheckImportContract(t *testing.T) {
 	})
 
 }
+
+func TestCheckImportHash(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          access(all) fun answer(): Int {
+              return 42
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+
+	require.NoError(t, err)
+
+	check := func(code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					ImportHandler: func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+					ImportHashHandler: func(_ *sema.Checker, location common.Location) ([]byte, error) {
+						require.Equal(t, common.StringLocation("imported"), location)
+						return []byte{0x12, 0x34}, nil
+					},
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import "imported" hash "1234"
+
+          access(all) let x = answer()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("mismatching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import "imported" hash "abcd"
+
+          access(all) let x = answer()
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var mismatchErr *sema.ImportHashMismatchError
+		require.ErrorAs(t, errs[0], &mismatchErr)
+
+		assert.Equal(t, []byte{0xab, 0xcd}, mismatchErr.ExpectedHash)
+		assert.Equal(t, []byte{0x12, 0x34}, mismatchErr.ActualHash)
+	})
+
+	t.Run("no hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import "imported"
+
+          access(all) let x = answer()
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckImportHashWithoutHandler(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          access(all) fun answer(): Int {
+              return 42
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+
+	require.NoError(t, err)
+
+	// Without an import hash handler, the hash of a pinned import cannot be verified,
+	// so the import must be rejected
+
+	_, err = ParseAndCheckWithOptions(t,
+		`
+          import "imported" hash "1234"
+
+          access(all) let x = answer()
+        `,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				ImportHandler: func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+					return sema.ElaborationImport{
+						Elaboration: importedChecker.Elaboration,
+					}, nil
+				},
+			},
+		},
+	)
+
+	errs := RequireCheckerErrors(t, err, 1)
+
+	var unverifiedErr *sema.UnverifiedImportHashError
+	require.ErrorAs(t, errs[0], &unverifiedErr)
+
+	assert.Equal(t, common.StringLocation("imported"), unverifiedErr.Location)
+}
+
+func TestCheckImportHashMultipleResolvedLocations(t *testing.T) {
+
+	t.Parallel()
+
+	importedAddress := common.MustBytesToAddress([]byte{0x1})
+
+	importedCheckers := map[string]*sema.Checker{}
+
+	for _, name := range []string{"x", "y"} {
+		importedChecker, err := ParseAndCheckWithOptions(t,
+			fmt.Sprintf(
+				`
+                  access(all) let %s = 1
+                `,
+				name,
+			),
+			ParseAndCheckOptions{
+				Location: common.AddressLocation{
+					Address: importedAddress,
+					Name:    name,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		importedCheckers[name] = importedChecker
+	}
+
+	check := func(code string) error {
+		_, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					LocationHandler: func(identifiers []ast.Identifier, location common.Location) (result []sema.ResolvedLocation, err error) {
+						for _, identifier := range identifiers {
+							result = append(result, sema.ResolvedLocation{
+								Location: common.AddressLocation{
+									Address: importedAddress,
+									Name:    identifier.Identifier,
+								},
+								Identifiers: []ast.Identifier{
+									identifier,
+								},
+							})
+						}
+						return
+					},
+					ImportHandler: func(_ *sema.Checker, importedLocation common.Location, _ ast.Range) (sema.Import, error) {
+						addressLocation := importedLocation.(common.AddressLocation)
+						return sema.ElaborationImport{
+							Elaboration: importedCheckers[addressLocation.Name].Elaboration,
+						}, nil
+					},
+					ImportHashHandler: func(_ *sema.Checker, _ common.Location) ([]byte, error) {
+						return []byte{0x12, 0x34}, nil
+					},
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("single location", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import x from 0x1 hash "1234"
+
+          access(all) let a = x
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("multiple locations", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import x, y from 0x1 hash "1234"
+
+          access(all) let a = x + y
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousImportHashError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+
+		assert.Equal(t,
+			common.AddressLocation{
+				Address: importedAddress,
+			},
+			ambiguousErr.Location,
+		)
+	})
+
+	t.Run("multiple locations, no hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := check(`
+          import x, y from 0x1
+
+          access(all) let a = x + y
+        `)
+
+		require.NoError(t, err)
+	})
+}
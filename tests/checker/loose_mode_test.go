@@ -0,0 +1,243 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/sema"
+)
+
+func parseAndCheckLoose(t *testing.T, code string) (*sema.Checker, error) {
+	return ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			ParseOptions: parser.Config{
+				LooseModeEnabled: true,
+			},
+			Config: &sema.Config{
+				LooseModeEnabled: true,
+			},
+		},
+	)
+}
+
+func TestCheckLooseMode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("parameters without type annotations", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun test(a, _ b, c d) {}
+        `)
+		require.NoError(t, err)
+		assert.Empty(t, checker.Warnings())
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+
+		require.Len(t, functionType.Parameters, 3)
+		for _, parameter := range functionType.Parameters {
+			assert.Equal(t, sema.AnyStructType, parameter.TypeAnnotation.Type)
+		}
+		assert.Equal(t, sema.VoidType, functionType.ReturnTypeAnnotation.Type)
+	})
+
+	t.Run("inferred return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun test(_ a) {
+              let f = fun () {
+                  return
+              }
+              return a
+          }
+        `)
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+		assert.Equal(t, sema.AnyStructType, functionType.ReturnTypeAnnotation.Type)
+	})
+
+	t.Run("inferred return type, nested function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun test() {
+              let f = fun (): Int {
+                  return 1
+              }
+          }
+        `)
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+		assert.Equal(t, sema.VoidType, functionType.ReturnTypeAnnotation.Type)
+	})
+
+	t.Run("argument, checked at run-time", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun double(_ x: Int): Int {
+              return x * 2
+          }
+
+          fun test(_ a): Int {
+              return double(a)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.True(t, checker.Elaboration.IsLooseModeChecked)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		require.IsType(t, &sema.RuntimeTypeCheckWarning{}, warnings[0])
+		warning := warnings[0].(*sema.RuntimeTypeCheckWarning)
+
+		assert.Equal(t, sema.IntType, warning.ExpectedType)
+		assert.Equal(t, sema.AnyStructType, warning.ActualType)
+	})
+
+	t.Run("binary operand, checked at run-time", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun test(_ a): Bool {
+              return a + 1 > 2
+          }
+        `)
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.RuntimeTypeCheckWarning{}, warnings[0])
+	})
+
+	t.Run("binary operands, both without types", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun add(a, b) {
+              return a + b
+          }
+
+          fun less(a, b): Bool {
+              return a < b
+          }
+        `)
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 2)
+
+		require.IsType(t, &sema.RuntimeOperandsCheckWarning{}, warnings[0])
+		require.IsType(t, &sema.RuntimeOperandsCheckWarning{}, warnings[1])
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "add").(*sema.FunctionType)
+		assert.Equal(t, sema.AnyStructType, functionType.ReturnTypeAnnotation.Type)
+	})
+
+	t.Run("index, without type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheckLoose(t, `
+          fun first(xs) {
+              return xs[0]
+          }
+        `)
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		require.IsType(t, &sema.RuntimeIndexingCheckWarning{}, warnings[0])
+	})
+
+	t.Run("index assignment, without type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckLoose(t, `
+          fun test(xs) {
+              xs[0] = 1
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotIndexableTypeError{}, errs[0])
+	})
+
+	t.Run("type mismatch, not checked at run-time", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckLoose(t, `
+          fun test(_ a: String): Int {
+              return a
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("loose mode disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              fun test(_ a): Int {
+                  let x: AnyStruct = 1
+                  return x
+              }
+            `,
+			ParseAndCheckOptions{
+				ParseOptions: parser.Config{
+					LooseModeEnabled: true,
+				},
+			},
+		)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.MissingTypeAnnotationError{}, errs[0])
+		require.IsType(t, &sema.TypeMismatchError{}, errs[1])
+
+		assert.False(t, checker.Elaboration.IsLooseModeChecked)
+	})
+}
@@ -519,6 +519,104 @@ func TestCheckSaturatedArithmeticFunctions(t *testing.T) {
 	}
 }
 
+func TestCheckNumericFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(ty sema.Type, call string, returnType string, expected bool) {
+
+		t.Run(fmt.Sprintf("%s %s", ty, call), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      fun test(a: %[1]s, b: %[1]s): %[3]s {
+                          return a.%[2]s
+                      }
+                    `,
+					ty,
+					call,
+					returnType,
+				),
+			)
+
+			if expected {
+				require.NoError(t, err)
+			} else {
+				errs := RequireCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+			}
+		})
+	}
+
+	for _, ty := range sema.AllNumberTypes {
+
+		if ty.(sema.IntegerRangedType).IsSuperType() {
+			continue
+		}
+
+		optionalType := fmt.Sprintf("%s?", ty)
+
+		test(ty, "checkedAdd(b)", optionalType, true)
+		test(ty, "checkedSubtract(b)", optionalType, true)
+		test(ty, "checkedMultiply(b)", optionalType, true)
+		test(ty, "checkedDivide(b)", optionalType, true)
+
+		isFixedSize := sema.IsFixedSizeIntegerType(ty)
+
+		test(ty, "wrappingAdd(b)", ty.String(), isFixedSize)
+		test(ty, "wrappingSubtract(b)", ty.String(), isFixedSize)
+		test(ty, "wrappingMultiply(b)", ty.String(), isFixedSize)
+
+		test(ty, "min(b)", ty.String(), true)
+		test(ty, "max(b)", ty.String(), true)
+		test(ty, "pow(2)", ty.String(), true)
+		test(ty, "sqrt()", ty.String(), true)
+
+		isSigned := sema.IsSubType(ty, sema.SignedNumberType)
+
+		test(ty, "abs()", ty.String(), isSigned)
+	}
+}
+
+func TestCheckInvalidNumericFunctionArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("checkedAdd, mismatched type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: Int8 = 1
+          let y: Int16 = 2
+          let z = x.checkedAdd(y)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("pow, invalid exponent type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: UFix64 = 1.5
+          let exponent: Int = 2
+          let y = x.pow(exponent)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
 func TestCheckInvalidCompositeEquality(t *testing.T) {
 
 	t.Parallel()
@@ -23,12 +23,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/sema"
 )
 
-func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
+func TestCheckCompositeInitializerOverloading(t *testing.T) {
 
 	t.Parallel()
 
@@ -76,10 +77,789 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 					),
 				)
 
-				errs := RequireCheckerErrors(t, err, 1)
+				switch {
+				case isInterface:
+					errs := RequireCheckerErrors(t, err, 1)
 
-				assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+					assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+
+				case kind == common.CompositeKindStructure,
+					kind == common.CompositeKindResource:
+
+					require.NoError(t, err)
+
+				default:
+					errs := RequireCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.UnsupportedOverloadingError{}, errs[0])
+				}
 			})
 		}
 	}
 }
+
+func TestCheckFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun double(value: Int): Int {
+              return value * 2
+          }
+
+          fun double(string: String): String {
+              return string.concat(string)
+          }
+
+          let x = double(value: 1)
+          let y = double(string: "a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("global functions, same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun double(value: Int): Int {
+              return value * 2
+          }
+
+          fun double(value: String): String {
+              return value.concat(value)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("global functions, unknown argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun double(value: Int): Int {
+              return value * 2
+          }
+
+          fun double(string: String): String {
+              return string.concat(string)
+          }
+
+          let x = double(other: 1)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("global function and variable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let double = 1
+
+          fun double(value: Int): Int {
+              return value * 2
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("composite functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+
+              fun double(string: String): String {
+                  return string.concat(string)
+              }
+          }
+
+          let s = S()
+          let x = s.double(value: 1)
+          let y = s.double(string: "a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("composite functions, same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+
+              fun double(value: String): String {
+                  return value.concat(value)
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("composite function and field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+
+              let double: Int
+
+              init() {
+                  self.double = 1
+              }
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("global functions, default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun double(string: String): String {
+              return string.concat(string)
+          }
+
+          fun double(value: Int, factor: Int = 2): Int {
+              return value * factor
+          }
+
+          let x = double(value: 1)
+          let y = double(value: 1, factor: 3)
+          let z = double(string: "a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "z"),
+		)
+	})
+
+	t.Run("global functions, ambiguous default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f(a: Int, b: Int = 1) {}
+
+          fun f(a: Int, c: Int = 1) {}
+
+          fun test() {
+              f(a: 0)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		var ambiguousErr *sema.AmbiguousFunctionOverloadError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "f", ambiguousErr.Name)
+		assert.Equal(t, []string{"a"}, ambiguousErr.ArgumentLabels)
+
+		require.ErrorAs(t, errs[1], &ambiguousErr)
+		assert.Equal(t, []string{"a"}, ambiguousErr.ArgumentLabels)
+	})
+
+	t.Run("global functions, default argument of overload with fewer parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun f(a: Int, b: Int) {}
+
+          fun f(a: Int, c: Int = 1) {}
+
+          fun g(a: Int, b: Int) {}
+
+          fun g(a: Int, b: Int, c: Int = 1) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousFunctionOverloadError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "g", ambiguousErr.Name)
+		assert.Equal(t, []string{"a", "b"}, ambiguousErr.ArgumentLabels)
+	})
+
+	t.Run("composite functions, default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+
+              fun double(string: String): String {
+                  return string.concat(string)
+              }
+
+              fun double(value: Int, factor: Int = 2): Int {
+                  return value * factor
+              }
+          }
+
+          let s = S()
+          let x = s.double(value: 1)
+          let y = s.double(string: "a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("composite functions, ambiguous default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun f(a: Int, b: Int = 1) {}
+
+              fun f(a: Int, c: Int = 1) {}
+          }
+
+          fun test() {
+              S().f(a: 0)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.AmbiguousFunctionOverloadError{}, errs[0])
+		assert.IsType(t, &sema.AmbiguousFunctionOverloadError{}, errs[1])
+	})
+}
+
+func TestCheckFunctionOverloadReference(t *testing.T) {
+
+	t.Parallel()
+
+	// Overloaded functions which are referenced without invoking them
+	// are resolved based on the expected type
+
+	t.Run("global function, no expected type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun h(a: Int): Int { return a }
+
+          fun h(b: String): String { return b }
+
+          let fn = h
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousFunctionReferenceError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "h", ambiguousErr.Name)
+	})
+
+	t.Run("global function, expected type of one overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun h(a: Int): Int { return a }
+
+          fun h(b: String): String { return b }
+
+          let fn1: fun(Int): Int = h
+          let fn2: fun(String): String = h
+        `)
+		// The type of the referenced overload must match the declared type,
+		// otherwise a type mismatch is reported
+		require.NoError(t, err)
+	})
+
+	t.Run("global function, expected type of multiple overloads", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun h(a: Int): Int { return a }
+
+          fun h(b: Int): Int { return b }
+
+          let fn: fun(Int): Int = h
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousFunctionReferenceError{}, errs[0])
+	})
+
+	t.Run("global function, expected type of no overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun h(a: Int): Int { return a }
+
+          fun h(b: String): String { return b }
+
+          let fn: fun(Bool): Bool = h
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.AmbiguousFunctionReferenceError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("global function, not overloaded", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun h(a: Int): Int { return a }
+
+          let fn = h
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("composite function, no expected type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun f(a: Int): Int { return a }
+
+              fun f(b: String): String { return b }
+          }
+
+          let fn = S().f
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousFunctionReferenceError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "f", ambiguousErr.Name)
+	})
+
+	t.Run("composite function, expected type of one overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun f(a: Int): Int { return a }
+
+              fun f(b: String): String { return b }
+          }
+
+          let fn1: fun(Int): Int = S().f
+          let fn2: fun(String): String = S().f
+        `)
+		// The type of the referenced overload must match the declared type,
+		// otherwise a type mismatch is reported
+		require.NoError(t, err)
+	})
+
+	t.Run("composite function, expected type of multiple overloads", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun f(a: Int): Int { return a }
+
+              fun f(b: String): String { return b }
+          }
+
+          let fn: AnyStruct = S().f
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousFunctionReferenceError{}, errs[0])
+	})
+}
+
+func TestCheckInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double: Int) {
+                  self.value = double * 2
+              }
+          }
+
+          let s1 = S(value: 1)
+          let s2 = S(double: 1)
+        `)
+
+		require.NoError(t, err)
+
+		sType := RequireGlobalType(t, checker.Elaboration, "S")
+
+		assert.Equal(t, sType, RequireGlobalValue(t, checker.Elaboration, "s1"))
+		assert.Equal(t, sType, RequireGlobalValue(t, checker.Elaboration, "s2"))
+	})
+
+	t.Run("overload does not initialize all fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double: Int) {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
+	})
+
+	t.Run("same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double: Int) {
+                  self.value = double * 2
+              }
+
+              init(value: Int8) {
+                  self.value = Int(value)
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              let value: Int
+
+              init(string: String) {
+                  self.value = string.length
+              }
+
+              init(value: Int, factor: Int = 1) {
+                  self.value = value * factor
+              }
+          }
+
+          let s = S(value: 1)
+        `)
+		require.NoError(t, err)
+
+		sType := RequireGlobalType(t, checker.Elaboration, "S")
+		assert.Equal(t, sType, RequireGlobalValue(t, checker.Elaboration, "s"))
+	})
+
+	t.Run("ambiguous default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let value: Int
+
+              init(value: Int, factor: Int = 1) {
+                  self.value = value * factor
+              }
+
+              init(value: Int, offset: Int = 0) {
+                  self.value = value + offset
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var ambiguousErr *sema.AmbiguousFunctionOverloadError
+		require.ErrorAs(t, errs[0], &ambiguousErr)
+		assert.Equal(t, "init", ambiguousErr.Name)
+		assert.Equal(t, []string{"value"}, ambiguousErr.ArgumentLabels)
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              resource R {
+                  let value: Int
+
+                  init(value: Int) {
+                      self.value = value
+                  }
+
+                  init(double: Int) {
+                      self.value = double * 2
+                  }
+              }
+
+              fun createR(): @R {
+                  return <-create R(double: 1)
+              }
+          }
+
+          fun test(): @C.R {
+              return <-C.createR()
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("interface requirement satisfied by overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              init(double: Int)
+          }
+
+          struct S: I {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double: Int) {
+                  self.value = double * 2
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckFunctionOverloadingConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface I {
+              fun double(value: Int): Int
+              fun double(string: String): String
+          }
+
+          struct S: I {
+
+              fun double(string: String): String {
+                  return string.concat(string)
+              }
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+          }
+
+          let s: {I} = S()
+          let x = s.double(value: 1)
+          let y = s.double(string: "a")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "y"),
+		)
+	})
+
+	t.Run("missing overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun double(value: Int): Int
+              fun double(string: String): String
+          }
+
+          struct S: I {
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("default function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun double(value: Int): Int
+
+              fun double(string: String): String {
+                  return string.concat(string)
+              }
+          }
+
+          struct S: I {
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+          }
+
+          let x = S().double(string: "a")
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("overloads in inherited interfaces", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface A {
+              fun double(value: Int): Int
+          }
+
+          struct interface B: A {
+              fun double(string: String): String
+          }
+
+          struct S: B {
+
+              fun double(value: Int): Int {
+                  return value * 2
+              }
+
+              fun double(string: String): String {
+                  return string.concat(string)
+              }
+          }
+
+          let s: {B} = S()
+          let x = s.double(value: 1)
+          let y = s.double(string: "a")
+        `)
+
+		require.NoError(t, err)
+	})
+}
@@ -163,3 +163,39 @@ func TestCheckConvertStringToPath(t *testing.T) {
 		test(domain)
 	}
 }
+
+func TestCheckExtendedPath(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let address: Address = 0x1
+          let id: UInt64 = 42
+          let x = /storage/vault/\(address)/\(id)/\(Type<Int>())
+        `)
+
+		require.NoError(t, err)
+
+		assert.IsType(t,
+			sema.StoragePathType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("invalid component type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = /storage/vault/\("foo")
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidPathComponentTypeError{}, errs[0])
+	})
+}
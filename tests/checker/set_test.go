@@ -0,0 +1,283 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+)
+
+func parseAndCheckWithSet(t *testing.T, code string) (*sema.Checker, error) {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.SetConstructorFunction)
+
+	return ParseAndCheckWithOptions(t,
+		code,
+		ParseAndCheckOptions{
+			Config: &sema.Config{
+				BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+		},
+	)
+}
+
+func TestCheckSet(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("construction, explicit type argument", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := parseAndCheckWithSet(t, `
+          let s = Set<Int>([1, 2, 3])
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+
+	t.Run("construction, inferred type argument", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := parseAndCheckWithSet(t, `
+          let s = Set(["a", "b"])
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.StringType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+
+	t.Run("type annotation", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s: Set<Address> = Set<Address>([0x1])
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("members", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := parseAndCheckWithSet(t, `
+          let s = Set<Int>([1, 2, 3])
+          let other = Set<Int>([3, 4])
+
+          let length: Int = s.length
+          let contains: Bool = s.contains(1)
+          let inserted: Bool = s.insert(4)
+          let removed: Bool = s.remove(1)
+          let union: Set<Int> = s.union(other)
+          let intersection: Set<Int> = s.intersection(other)
+          let difference: Set<Int> = s.difference(other)
+          let array: [Int] = s.toArray()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.BoolType,
+			RequireGlobalValue(t, checker.Elaboration, "inserted"),
+		)
+	})
+
+	t.Run("for-loop", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test(): Int {
+              var sum = 0
+              for element in Set<Int>([1, 2, 3]) {
+                  sum = sum + element
+              }
+              return sum
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("subtyping", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s: Set<Integer> = Set<Int>([1])
+          let t: AnyStruct = s
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid subtyping", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s: Set<Int> = Set<String>(["a"])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid element type, resource", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          resource R {}
+
+          fun test(s: Set<@R>) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid element type, not hashable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test(s: Set<[Int]>) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid element type argument, construction", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          let s = Set<[Int]>([[1]])
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test(s: Set) {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.MissingTypeArgumentError{}, errs[0])
+	})
+
+	t.Run("insert, unauthorized reference", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test(s: &Set<Int>) {
+              s.insert(1)
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("insert, authorized reference", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          fun test(s: auth(Insert) &Set<Int>) {
+              s.insert(1)
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("view functions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          view fun test(s: Set<Int>): Bool {
+              return s.contains(1) && s.union(s).length > 0
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("shadowed by nested composite", func(t *testing.T) {
+		t.Parallel()
+
+		// Programs declaring a type named `Set` existed before the built-in `Set` type
+
+		_, err := parseAndCheckWithSet(t, `
+          contract C {
+
+              struct Set {
+                  let id: UInt32
+
+                  init(id: UInt32) {
+                      self.id = id
+                  }
+              }
+
+              let sets: {UInt32: Set}
+
+              init() {
+                  self.sets = {1: Set(id: 1)}
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("shadowed by global composite", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          struct Set {}
+
+          let set: Set = Set()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("built-in, not shadowable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseAndCheckWithSet(t, `
+          struct String {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+		assert.IsType(t, &sema.RedeclarationError{}, errs[1])
+	})
+}
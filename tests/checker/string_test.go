@@ -697,3 +697,143 @@ func TestCheckStringCount(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCheckStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              fun toString(): String {
+                  return "S"
+              }
+          }
+
+          let c: Character = "c"
+          let x = "\("a") \(c) \(1) \(2.5) \(0x1) \(/storage/foo) \(S())"
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = "a \("b \(1)") c"
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid, no toString function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = "\([1])"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateInterpolationError{}, errs[0])
+	})
+
+	t.Run("invalid, optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let y: Int? = 1
+          let x = "\(y)"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateInterpolationError{}, errs[0])
+	})
+
+	t.Run("invalid, toString function with wrong type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun toString(): Int {
+                  return 1
+              }
+          }
+
+          let x = "\(S())"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateInterpolationError{}, errs[0])
+	})
+
+	t.Run("invalid, non-public toString function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              access(self) fun toString(): String {
+                  return "S"
+              }
+          }
+
+          let x = "\(S())"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateInterpolationError{}, errs[0])
+	})
+
+	t.Run("invalid, resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              fun toString(): String {
+                  return "R"
+              }
+          }
+
+          fun test() {
+              let r <- create R()
+              let x = "\(r)"
+              destroy r
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidStringTemplateInterpolationError{}, errs[0])
+	})
+
+	t.Run("invalid interpolated expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = "\(y)"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
 )
 
 func TestCheckSwitchStatementTest(t *testing.T) {
@@ -609,3 +611,451 @@ func TestCheckSwitchResourceInvalidation(t *testing.T) {
 		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
 	})
 }
+
+func TestCheckSwitchStatementTypePattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("non-equatable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+              init() {
+                  self.x = 1
+              }
+          }
+
+          fun test(_ value: AnyStruct): Int {
+              switch value {
+              case let s as S:
+                  return s.x
+              case let i as Int:
+                  return i
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource type for non-resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ value: AnyStruct) {
+              switch value {
+              case let r as @R:
+                  destroy r
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AlwaysFailingResourceCastingTypeError{}, errs[0])
+	})
+
+	t.Run("binding scope", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: AnyStruct) {
+              switch value {
+              case let i as Int:
+                  i
+              default:
+                  i
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementResourcePattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I {}
+
+          resource R: I {}
+
+          fun test(_ value: @{I}) {
+              switch <-value {
+              case let r as @R:
+                  destroy r
+              case let other as @{I}:
+                  destroy other
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ value: @R) {
+              switch value {
+              case let r as @R:
+                  destroy r
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingMoveOperationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("not exhaustive", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I {}
+
+          resource R: I {}
+
+          fun test(_ value: @{I}) {
+              switch <-value {
+              case let r as @R:
+                  destroy r
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("binding not invalidated", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ value: @R) {
+              switch <-value {
+              case let r as @R:
+                  let x = 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("invalid cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let id: Int
+              init() {
+                  self.id = 1
+              }
+          }
+
+          fun test(_ value: @R?) {
+              switch <-value {
+              case nil:
+                  return
+              case let r as @R where r.id > 0:
+                  destroy r
+              case let other as @R?:
+                  destroy other
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceSwitchCaseError{}, errs[0])
+		assert.IsType(t, &sema.InvalidResourceSwitchCaseError{}, errs[1])
+	})
+}
+
+func TestCheckSwitchStatementOptionalPattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: Int?): Int {
+              switch value {
+              case nil:
+                  return 0
+              case let x?:
+                  return x
+              }
+              return -1
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-equatable", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(_ value: S?): S? {
+              switch value {
+              case nil:
+                  return nil
+              case let s?:
+                  return s
+              }
+              return nil
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: Int) {
+              switch value {
+              case let x?:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementRangePattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+		baseValueActivation.DeclareValue(stdlib.InclusiveRangeConstructorFunction)
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              fun test(_ value: Int8): String {
+                  switch value {
+                  case in InclusiveRange<Int8>(1, 9):
+                      return "digit"
+                  case in InclusiveRange<Int8>(10, 100, step: 10):
+                      return "tens"
+                  }
+                  return "other"
+              }
+            `,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+						return baseValueActivation
+					},
+				},
+			},
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: String, range: InclusiveRange<Int>) {
+              switch value {
+              case in range:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchWithDescriptionError{}, errs[0])
+	})
+
+	t.Run("mismatched range type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: Int8, range: InclusiveRange<Int>) {
+              switch value {
+              case in range:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementGuard(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: Int?): String {
+              switch value {
+              case let x? where x > 10:
+                  return "large"
+              case 1 where true:
+                  return "one"
+              }
+              return "other"
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-boolean", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ value: Int) {
+              switch value {
+              case 1 where 2:
+                  return
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementEnumExhaustiveness(t *testing.T) {
+
+	t.Parallel()
+
+	const enumDeclaration = `
+      enum E: UInt8 {
+          case a
+          case b
+          case c
+      }
+    `
+
+	t.Run("all cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, enumDeclaration+`
+          fun test(_ e: E) {
+              switch e {
+              case E.a:
+                  return
+              case E.b:
+                  return
+              case E.c:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+		assert.Empty(t, checker.Warnings())
+	})
+
+	t.Run("default case", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, enumDeclaration+`
+          fun test(_ e: E) {
+              switch e {
+              case E.a:
+                  return
+              default:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+		assert.Empty(t, checker.Warnings())
+	})
+
+	t.Run("missing cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, enumDeclaration+`
+          fun test(_ e: E) {
+              switch e {
+              case E.a:
+                  return
+              case E.b where false:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		require.IsType(t, &sema.NonExhaustiveSwitchWarning{}, warnings[0])
+		warning := warnings[0].(*sema.NonExhaustiveSwitchWarning)
+
+		assert.Equal(t, []string{"b", "c"}, warning.MissingCases)
+	})
+}
@@ -0,0 +1,414 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/sema"
+)
+
+func TestCheckTimestamp(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literal", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a: Timestamp = "2020-03-13T19:52:43Z"
+          let b: Timestamp = "2020-03-13T19:52:43.5"
+          let c: Timestamp? = "1970-01-01T00:00:00Z"
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.TimestampType,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+		assert.Equal(t,
+			sema.TimestampType,
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.TimestampType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "c"),
+		)
+	})
+
+	t.Run("invalid literal", func(t *testing.T) {
+		t.Parallel()
+
+		for _, literal := range []string{
+			"",
+			"2020-03-13",
+			"2020-03-13 19:52:43Z",
+			"2020-03-13T19:52:43+01:00",
+			"2020-13-13T19:52:43Z",
+			"2020-03-13T19:52:43.123456789Z",
+			"9999-12-31T23:59:59Z",
+		} {
+			t.Run(literal, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`let a: Timestamp = %q`,
+						literal,
+					),
+				)
+
+				errs := RequireCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.InvalidTimestampLiteralError{}, errs[0])
+			})
+		}
+	})
+
+	t.Run("conversion", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = Timestamp(1584129163)
+          let b = Timestamp(1584129163.5)
+
+          fun test(block: Block): Timestamp {
+              return Timestamp(block.timestamp)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.TimestampType,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+		assert.Equal(t,
+			sema.TimestampType,
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+	})
+
+	t.Run("conversion, literal out of range", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a = Timestamp(100000000000)
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidIntegerLiteralRangeError{}, errs[0])
+	})
+
+	t.Run("fromString", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = Timestamp.fromString("2020-03-13T19:52:43Z")
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: sema.TimestampType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+	})
+
+	t.Run("members", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a: Timestamp = "2020-03-13T19:52:43Z"
+          let b = a.unixSeconds
+          let c = a.toString()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.Fix64Type,
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "c"),
+		)
+	})
+
+	t.Run("dictionary key", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: {Timestamp: Duration} = {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("shadowed by composite", func(t *testing.T) {
+		t.Parallel()
+
+		// Programs declaring a type named `Timestamp` existed before the built-in `Timestamp` type
+
+		_, err := ParseAndCheck(t, `
+          struct Timestamp {
+              let value: UInt64
+
+              init(value: UInt64) {
+                  self.value = value
+              }
+          }
+
+          let a: Timestamp = Timestamp(value: 1)
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckDuration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("conversion", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = Duration(60)
+          let b = Duration(-0.5)
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.DurationType,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+		assert.Equal(t,
+			sema.DurationType,
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+	})
+
+	t.Run("constants", func(t *testing.T) {
+		t.Parallel()
+
+		for _, constant := range sema.DurationConstants {
+			t.Run(constant.Name, func(t *testing.T) {
+				t.Parallel()
+
+				checker, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`let a = Duration.%s`,
+						constant.Name,
+					),
+				)
+				require.NoError(t, err)
+
+				assert.Equal(t,
+					sema.DurationType,
+					RequireGlobalValue(t, checker.Elaboration, "a"),
+				)
+			})
+		}
+	})
+
+	t.Run("members", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = Duration.day.seconds
+          let b = Duration.day.toString()
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.Fix64Type,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+		assert.Equal(t,
+			sema.StringType,
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+	})
+
+	t.Run("negation", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = -Duration.day
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.DurationType,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+	})
+
+	t.Run("shadowed by variable", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let Duration: UInt64 = 60
+          let a = Duration
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.UInt64Type,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+	})
+
+	t.Run("invalid negation of timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: Timestamp = "2020-03-13T19:52:43Z"
+          let b = -a
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidUnaryOperandError{}, errs[0])
+	})
+}
+
+func TestCheckTimestampAndDurationOperations(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      let timestamp: Timestamp = "2020-03-13T19:52:43Z"
+      let duration = Duration.day
+      let int64: Int64 = 2
+    `
+
+	validTests := map[string]sema.Type{
+		"timestamp + duration":   sema.TimestampType,
+		"timestamp - duration":   sema.TimestampType,
+		"timestamp - timestamp":  sema.DurationType,
+		"duration + duration":    sema.DurationType,
+		"duration - duration":    sema.DurationType,
+		"duration * int64":       sema.DurationType,
+		"duration / int64":       sema.DurationType,
+		"duration * 7":           sema.DurationType,
+		"duration / 2":           sema.DurationType,
+		"timestamp < timestamp":  sema.BoolType,
+		"duration >= duration":   sema.BoolType,
+		"timestamp == timestamp": sema.BoolType,
+		"duration != duration":   sema.BoolType,
+	}
+
+	for expression, expectedType := range validTests {
+		t.Run(expression, func(t *testing.T) {
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      %s
+                      let result = %s
+                    `,
+					declarations,
+					expression,
+				),
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				expectedType,
+				RequireGlobalValue(t, checker.Elaboration, "result"),
+			)
+		})
+	}
+
+	invalidTests := []string{
+		"timestamp + timestamp",
+		"duration + timestamp",
+		"duration - timestamp",
+		"timestamp * int64",
+		"timestamp / int64",
+		"duration * duration",
+		"duration / duration",
+		"duration % duration",
+		"duration + int64",
+		"int64 * duration",
+		"duration & duration",
+		"timestamp < duration",
+	}
+
+	for _, expression := range invalidTests {
+		t.Run(expression, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      %s
+                      let result = %s
+                    `,
+					declarations,
+					expression,
+				),
+			)
+
+			errs := RequireCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+		})
+	}
+
+	t.Run("compound assignment", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var timestamp: Timestamp = "2020-03-13T19:52:43Z"
+              timestamp += Duration.hour
+              timestamp -= Duration.minute
+
+              var duration = Duration.day
+              duration *= 7
+              duration -= Duration.hour
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("compound assignment, invalid result type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var timestamp: Timestamp = "2020-03-13T19:52:43Z"
+              timestamp -= timestamp
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
@@ -0,0 +1,503 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/tests/utils"
+)
+
+func TestCheckTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("program scope", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Numbers = [Int]
+
+          let numbers: Numbers = [1, 2, 3]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalType(t, checker.Elaboration, "Numbers"),
+		)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "numbers"),
+		)
+	})
+
+	t.Run("transparent", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Amount = Int
+
+          let a: Amount = 1
+          let b: Int = a
+          let c: Amount = b
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Amount = Int
+
+          let a: Amount = "1"
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("forward reference", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: A = {"a": [1]}
+
+          typealias A = {String: B}
+
+          typealias B = [Int]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.DictionaryType{
+				KeyType: sema.StringType,
+				ValueType: &sema.VariableSizedType{
+					Type: sema.IntType,
+				},
+			},
+			RequireGlobalType(t, checker.Elaboration, "A"),
+		)
+	})
+
+	t.Run("composite", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Things = [S]
+
+          struct S {}
+
+          fun test(): Things {
+              return [S()]
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("authorized reference to intersection", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+
+          struct interface I {
+              access(E) fun foo()
+          }
+
+          struct interface J {}
+
+          struct S: I, J {
+              access(E) fun foo() {}
+          }
+
+          typealias Ref = auth(E) &{I, J}
+
+          fun test(ref: Ref) {
+              ref.foo()
+          }
+
+          fun main() {
+              let s = S()
+              test(ref: &s as auth(E) &{I, J})
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Rs = @[R]
+
+          fun test(): @Rs {
+              return <-[<-create R()]
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, missing annotation", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          typealias Rs = [R]
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingResourceAnnotationError{}, errs[0])
+	})
+
+	t.Run("undeclared type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = B
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          typealias S = Int
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("cycle, self", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = [A]
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var cyclicTypeAliasErr *sema.CyclicTypeAliasError
+		require.ErrorAs(t, errs[0], &cyclicTypeAliasErr)
+		assert.Equal(t, "A", cyclicTypeAliasErr.Name)
+	})
+
+	t.Run("cycle, indirect", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = B?
+          typealias B = {String: C}
+          typealias C = fun(A): Void
+
+          let a: A = nil
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var cyclicTypeAliasErr *sema.CyclicTypeAliasError
+		require.ErrorAs(t, errs[0], &cyclicTypeAliasErr)
+		assert.Equal(t, "A", cyclicTypeAliasErr.Name)
+	})
+
+	t.Run("contract scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              typealias Balances = {Address: Vault}
+
+              struct Vault {}
+
+              access(all) let balances: Balances
+
+              init() {
+                  self.balances = {}
+              }
+
+              access(all) fun balance(_ address: Address): Vault? {
+                  let balances: Balances = self.balances
+                  return balances[address]
+              }
+
+              struct S {
+                  access(all) let balances: Balances
+
+                  init() {
+                      self.balances = {}
+                  }
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract scope, not visible outside", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              typealias Amount = Int
+          }
+
+          let x: Amount = 1
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("contract scope, cycle", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              typealias A = B
+              typealias B = A
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("contract scope, nested type", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          contract C {
+              access(all) typealias Amount = UFix64
+
+              access(all) struct Vault {}
+
+              access(all) typealias Vaults = [Vault]
+          }
+
+          let amount: C.Amount = 1.0
+          let vaults: C.Vaults = [C.Vault()]
+        `)
+		require.NoError(t, err)
+
+		// Type aliases are not nested types
+
+		contractType := RequireGlobalType(t, checker.Elaboration, "C").(*sema.CompositeType)
+
+		assert.Equal(t, 1, contractType.NestedTypes.Len())
+		assert.True(t, contractType.NestedTypes.Contains("Vault"))
+		assert.False(t, contractType.NestedTypes.Contains("Amount"))
+
+		aliasedType, ok := contractType.GetNestedTypeAlias("Amount")
+		require.True(t, ok)
+		assert.Equal(t, sema.UFix64Type, aliasedType)
+	})
+
+	t.Run("contract scope, nested type, imported", func(t *testing.T) {
+		t.Parallel()
+
+		importedChecker, err := ParseAndCheckWithOptions(t,
+			`
+              access(all) contract C {
+
+                  access(all) typealias Balance = UFix64
+
+                  access(all) resource Vault {
+                      access(all) let balance: Balance
+
+                      init(balance: Balance) {
+                          self.balance = balance
+                      }
+                  }
+
+                  access(all) typealias Balances = {Address: Balance}
+
+                  access(all) fun createVault(balance: Balance): @Vault {
+                      return <-create Vault(balance: balance)
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Location: utils.ImportedLocation,
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = ParseAndCheckWithOptions(t,
+			`
+              import C from "imported"
+
+              access(all) fun test(): C.Balances {
+                  let balance: C.Balance = 1.5
+                  let vault <- C.createVault(balance: balance)
+                  let balances: C.Balances = {0x1: vault.balance}
+                  destroy vault
+                  return balances
+              }
+            `,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					ImportHandler: func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract interface scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+              typealias Numbers = [Int]
+
+              access(all) fun numbers(): Numbers
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("contract interface scope, nested type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+              access(all) typealias Numbers = [Int]
+          }
+
+          let numbers: CI.Numbers = [1, 2]
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("struct scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              typealias Amount = Int
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+	})
+
+	t.Run("function scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              typealias Numbers = [Int]
+              let numbers: Numbers = [1, 2]
+              return numbers[0]
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("function scope, not visible outside", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              typealias Amount = Int
+          }
+
+          let x: Amount = 1
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("function scope, access modifier", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              access(all) typealias Amount = Int
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+	})
+
+	t.Run("function scope, cycle", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              typealias A = [A]
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("invalid access modifier", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              access(self) typealias Amount = Int
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
+	})
+}
@@ -2521,6 +2521,458 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
 		require.NoError(t, err)
 	})
+
+	// NOTE: not using testWithValidators,
+	// as the old program of a Cadence 1.0 upgrade can not contain type aliases
+
+	t.Run("change type alias", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultTestInterpreterConfig
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) typealias Amount = UFix64
+
+                access(all) var a: Amount
+
+                init() {
+                    self.a = 1.0
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) typealias Amount = String
+
+                access(all) var a: Amount
+
+                init() {
+                    self.a = "1.0"
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		RequireError(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		var typeAliasMismatchError *stdlib.TypeAliasMismatchError
+		require.ErrorAs(t, cause, &typeAliasMismatchError)
+
+		assert.Equal(t, "Test", typeAliasMismatchError.DeclName)
+		assert.Equal(t, "Amount", typeAliasMismatchError.TypeAliasName)
+	})
+
+	testWithValidators(t, "add type alias", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) var a: UFix64
+
+                init() {
+                    self.a = 1.0
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) typealias Amount = UFix64
+
+                access(all) var a: UFix64
+
+                init() {
+                    self.a = 1.0
+                }
+
+                access(all) fun amount(): Amount {
+                    return self.a
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		require.NoError(t, err)
+	})
+
+	// NOTE: not using testWithValidators,
+	// as the old program of a Cadence 1.0 upgrade can not contain distinct types
+
+	t.Run("change distinct type underlying type", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultTestInterpreterConfig
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) type Amount = distinct UFix64
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) type Amount = distinct String
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		RequireError(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		var fieldMismatchError *stdlib.FieldMismatchError
+		require.ErrorAs(t, cause, &fieldMismatchError)
+
+		assert.Equal(t, "Amount", fieldMismatchError.DeclName)
+		assert.Equal(t, ast.DistinctTypeUnderlyingValueFieldName, fieldMismatchError.FieldName)
+	})
+
+	// NOTE: not using testWithValidators,
+	// as the old program of a Cadence 1.0 upgrade can not contain generic composite types
+
+	t.Run("keep type parameters", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultTestInterpreterConfig
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) struct Box<T: AnyStruct> {
+                    access(all) let value: T
+
+                    init(value: T) {
+                        self.value = value
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box<T: AnyStruct> {
+                    access(all) let value: T
+
+                    init(value: T) {
+                        self.value = value
+                    }
+
+                    access(all) fun get(): T {
+                        return self.value
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		require.NoError(t, err)
+	})
+
+	t.Run("change type parameters", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultTestInterpreterConfig
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) struct Box<T> {}
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box<T, U> {}
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		RequireError(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		var typeParametersMismatchError *stdlib.TypeParametersMismatchError
+		require.ErrorAs(t, cause, &typeParametersMismatchError)
+
+		assert.Equal(t, "Box", typeParametersMismatchError.DeclName)
+	})
+
+	t.Run("change type parameter bound", func(t *testing.T) {
+		t.Parallel()
+
+		config := DefaultTestInterpreterConfig
+
+		const oldCode = `
+            access(all) contract Test {
+                access(all) struct Box<T: Integer> {}
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+                access(all) struct Box<T: Number> {}
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		RequireError(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		var typeParametersMismatchError *stdlib.TypeParametersMismatchError
+		require.ErrorAs(t, cause, &typeParametersMismatchError)
+
+		assert.Equal(t, "Box", typeParametersMismatchError.DeclName)
+	})
+
+	testWithValidators(t, "add function and initializer overloads", func(t *testing.T, config Config) {
+
+		const oldCode = `
+            access(all) contract Test {
+
+                access(all) struct S {
+                    access(all) let value: Int
+
+                    init(value: Int) {
+                        self.value = value
+                    }
+
+                    access(all) fun describe(value: Int): String {
+                        return "value"
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            access(all) contract Test {
+
+                access(all) struct S {
+                    access(all) let value: Int
+
+                    init(value: Int) {
+                        self.value = value
+                    }
+
+                    init(double: Int) {
+                        self.value = double * 2
+                    }
+
+                    access(all) fun describe(value: Int): String {
+                        return "value"
+                    }
+
+                    access(all) fun describe(double: Int): String {
+                        return "double"
+                    }
+                }
+
+                access(all) fun double(): S {
+                    return S(double: 1)
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, "Test", oldCode, newCode, config)
+		require.NoError(t, err)
+	})
+
+	t.Run("hashable structure", func(t *testing.T) {
+		t.Parallel()
+
+		// newHashableCode returns a contract with a structure
+		// which has the given conformance and functions
+		newHashableCode := func(conformance string, declarations string, functions string) string {
+			return fmt.Sprintf(
+				`
+                  access(all) contract Test {
+
+                      %[2]s
+
+                      access(all) struct Key: %[1]s {
+                          access(all) let id: Int
+
+                          init(id: Int) {
+                              self.id = id
+                          }
+
+                          %[3]s
+                      }
+
+                      access(all) let keys: [Key]
+
+                      init() {
+                          self.keys = [Key(id: 1)]
+                      }
+                  }
+                `,
+				conformance,
+				declarations,
+				functions,
+			)
+		}
+
+		const hashValueFunction = `
+          access(all) view fun hashValue(): UInt64 {
+              return UInt64(self.id)
+          }
+        `
+
+		const equalsFunction = `
+          access(all) view fun equals(_ other: AnyStruct): Bool {
+              if let other = other as? Key {
+                  return self.id == other.id
+              }
+              return false
+          }
+        `
+
+		type testCase struct {
+			name         string
+			conformance  string
+			declarations string
+			oldFunctions string
+			newFunctions string
+			changed      bool
+		}
+
+		testCases := []testCase{
+			{
+				name:         "unchanged",
+				conformance:  "Hashable",
+				oldFunctions: hashValueFunction + equalsFunction,
+				newFunctions: hashValueFunction + equalsFunction,
+			},
+			{
+				name:         "formatting and comments changed",
+				conformance:  "Hashable",
+				oldFunctions: hashValueFunction + equalsFunction,
+				newFunctions: `
+                  /// Returns the hash value
+                  access(all) view fun hashValue(): UInt64 { return UInt64(self.id) }
+                ` + equalsFunction,
+			},
+			{
+				name:         "function added",
+				conformance:  "Hashable",
+				oldFunctions: hashValueFunction + equalsFunction,
+				newFunctions: `
+                  access(all) view fun describe(): String {
+                      return self.id.toString()
+                  }
+                ` + hashValueFunction + equalsFunction,
+				changed: true,
+			},
+			{
+				name:         "hashValue changed",
+				conformance:  "Hashable",
+				oldFunctions: hashValueFunction + equalsFunction,
+				newFunctions: `
+                  access(all) view fun hashValue(): UInt64 {
+                      return UInt64(self.id) + 1
+                  }
+                ` + equalsFunction,
+				changed: true,
+			},
+			{
+				name:         "equals changed",
+				conformance:  "Hashable",
+				oldFunctions: hashValueFunction + equalsFunction,
+				newFunctions: hashValueFunction + `
+                  access(all) view fun equals(_ other: AnyStruct): Bool {
+                      return (other as? Key) != nil
+                  }
+                `,
+				changed: true,
+			},
+			{
+				// Hashable structures may compare equatable fields using their equals function
+				name:         "equatable structure, equals changed",
+				conformance:  "Equatable",
+				oldFunctions: equalsFunction,
+				newFunctions: `
+                  access(all) view fun equals(_ other: AnyStruct): Bool {
+                      return true
+                  }
+                `,
+				changed: true,
+			},
+			{
+				name:         "equatable structure, unchanged",
+				conformance:  "Equatable",
+				oldFunctions: equalsFunction,
+				newFunctions: equalsFunction,
+			},
+			{
+				name:        "both changed, conformance through interface",
+				conformance: "I",
+				declarations: `
+                  access(all) struct interface I: Hashable {}
+                `,
+				oldFunctions: hashValueFunction + equalsFunction,
+				newFunctions: `
+                  access(all) view fun hashValue(): UInt64 {
+                      return 0
+                  }
+
+                  access(all) view fun equals(_ other: AnyStruct): Bool {
+                      return true
+                  }
+                `,
+				changed: true,
+			},
+			{
+				name:        "shadowed Hashable interface",
+				conformance: "Hashable",
+				declarations: `
+                  access(all) struct interface Hashable {}
+                `,
+				oldFunctions: hashValueFunction,
+				newFunctions: `
+                  access(all) view fun hashValue(): UInt64 {
+                      return 0
+                  }
+                `,
+			},
+		}
+
+		for _, testCase := range testCases {
+
+			t.Run(testCase.name, func(t *testing.T) {
+				t.Parallel()
+
+				oldCode := newHashableCode(
+					testCase.conformance,
+					testCase.declarations,
+					testCase.oldFunctions,
+				)
+
+				newCode := newHashableCode(
+					testCase.conformance,
+					testCase.declarations,
+					testCase.newFunctions,
+				)
+
+				err := testDeployAndUpdate(t, "Test", oldCode, newCode, DefaultTestInterpreterConfig)
+
+				if !testCase.changed {
+					require.NoError(t, err)
+					return
+				}
+
+				RequireError(t, err)
+
+				updateErr := getContractUpdateError(t, err, "Test")
+				require.Len(t, updateErr.Errors, 1)
+
+				var hashableTypeChangeError *stdlib.HashableTypeChangeError
+				require.ErrorAs(t, updateErr.Errors[0], &hashableTypeChangeError)
+
+				assert.Equal(t, "Key", hashableTypeChangeError.DeclName)
+			})
+		}
+	})
 }
 
 func assertContractRemovalError(t *testing.T, err error, name string) {
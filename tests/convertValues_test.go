@@ -21,6 +21,7 @@ package tests
 import (
 	_ "embed"
 	"fmt"
+	"math/big"
 	"testing"
 	"unicode/utf8"
 
@@ -417,6 +418,16 @@ func TestRuntimeExportValue(t *testing.T) {
 			value:    interpreter.NewUnmeteredUFix64Value(123000000),
 			expected: cadence.UFix64(123000000),
 		},
+		{
+			label:    "Timestamp",
+			value:    interpreter.NewUnmeteredTimestampValue(158412916300000000),
+			expected: cadence.Timestamp(158412916300000000),
+		},
+		{
+			label:    "Duration",
+			value:    interpreter.NewUnmeteredDurationValue(-8640000000000),
+			expected: cadence.Duration(-8640000000000),
+		},
 		{
 			label: "Path",
 			value: interpreter.PathValue{
@@ -428,6 +439,23 @@ func TestRuntimeExportValue(t *testing.T) {
 				Identifier: "foo",
 			},
 		},
+		{
+			label: "Extended Path",
+			value: interpreter.NewUnmeteredExtendedPathValue(
+				common.PathDomainStorage,
+				"foo",
+				common.NewPathComponents(
+					common.NewIntegerPathComponent(big.NewInt(42)),
+				),
+			),
+			expected: cadence.Path{
+				Domain:     common.PathDomainStorage,
+				Identifier: "foo",
+				Components: common.NewPathComponents(
+					common.NewIntegerPathComponent(big.NewInt(42)),
+				),
+			},
+		},
 		{
 			label: "Interpreted Function",
 			value: testFunction,
@@ -882,6 +910,16 @@ func TestRuntimeImportValue(t *testing.T) {
 			value:    cadence.UFix64(123000000),
 			expected: interpreter.NewUnmeteredUFix64Value(123000000),
 		},
+		{
+			label:    "Timestamp",
+			value:    cadence.Timestamp(158412916300000000),
+			expected: interpreter.NewUnmeteredTimestampValue(158412916300000000),
+		},
+		{
+			label:    "Duration",
+			value:    cadence.Duration(-8640000000000),
+			expected: interpreter.NewUnmeteredDurationValue(-8640000000000),
+		},
 		{
 			label: "Path",
 			value: cadence.Path{
@@ -893,6 +931,23 @@ func TestRuntimeImportValue(t *testing.T) {
 				Identifier: "foo",
 			},
 		},
+		{
+			label: "Extended Path",
+			value: cadence.Path{
+				Domain:     common.PathDomainStorage,
+				Identifier: "foo",
+				Components: common.NewPathComponents(
+					common.NewIntegerPathComponent(big.NewInt(42)),
+				),
+			},
+			expected: interpreter.NewUnmeteredExtendedPathValue(
+				common.PathDomainStorage,
+				"foo",
+				common.NewPathComponents(
+					common.NewIntegerPathComponent(big.NewInt(42)),
+				),
+			),
+		},
 		{
 			label: "ID Capability (invalid)",
 			value: cadence.NewCapability(
@@ -2686,6 +2741,16 @@ func TestRuntimeArgumentPassing(t *testing.T) {
 			typeSignature: "UFix64",
 			exportedValue: cadence.UFix64(123000000),
 		},
+		{
+			label:         "Timestamp",
+			typeSignature: "Timestamp",
+			exportedValue: cadence.Timestamp(158412916300000000),
+		},
+		{
+			label:         "Duration",
+			typeSignature: "Duration",
+			exportedValue: cadence.Duration(-8640000000000),
+		},
 		{
 			label:         "StoragePath",
 			typeSignature: "StoragePath",
@@ -3969,6 +4034,130 @@ func TestRuntimeImportExportDictionaryValue(t *testing.T) {
 	})
 }
 
+func TestRuntimeImportExportSetValue(t *testing.T) {
+
+	t.Parallel()
+
+	setStaticType := &interpreter.SetStaticType{
+		ElementType: interpreter.PrimitiveStaticTypeInt,
+	}
+
+	t.Run("export", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := NewTestInterpreter(t)
+
+		value := interpreter.NewSetValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			setStaticType,
+			common.ZeroAddress,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		)
+
+		actual, err := ExportValue(
+			value,
+			inter,
+			interpreter.EmptyLocationRange,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewSet([]cadence.Value{
+				cadence.NewInt(1),
+			}).WithType(cadence.NewSetType(cadence.IntType)),
+			actual,
+		)
+	})
+
+	t.Run("import", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := NewTestInterpreter(t)
+
+		actual, err := ImportValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			nil,
+			cadence.NewSet([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewInt(2),
+			}),
+			&sema.SetType{
+				ElementType: sema.IntType,
+			},
+		)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewSetValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				setStaticType,
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			actual,
+		)
+	})
+
+	t.Run("import, invalid element", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := NewTestInterpreter(t)
+
+		_, err := ImportValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			nil,
+			cadence.NewSet([]cadence.Value{
+				cadence.NewArray([]cadence.Value{}),
+			}),
+			nil,
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            access(all) fun main(s: Set<String>): Set<String> {
+                s.insert("b")
+                return s
+            }
+        `
+
+		actual, err := executeTestScript(
+			t,
+			script,
+			cadence.NewSet([]cadence.Value{
+				cadence.String("a"),
+			}).WithType(cadence.NewSetType(cadence.StringType)),
+		)
+		require.NoError(t, err)
+
+		set, ok := actual.(cadence.Set)
+		require.True(t, ok)
+
+		assert.Equal(t, cadence.NewSetType(cadence.StringType), set.SetType)
+		assert.ElementsMatch(t,
+			[]cadence.Value{
+				cadence.String("a"),
+				cadence.String("b"),
+			},
+			set.Values,
+		)
+	})
+}
+
 func TestRuntimeStringValueImport(t *testing.T) {
 
 	t.Parallel()
@@ -39,14 +39,21 @@ func TestRuntimeDeployedContracts(t *testing.T) {
 			access(all) resource B {}
 			access(all) event C()
 
+			// type aliases are not type declarations, so they are not public types
+			access(all) typealias D = A
+
 			init() {}
 		}
 	`
 
 	script :=
 		`
+		import Test from 0x2a00000000000000
+
 		transaction {
 			prepare(signer: &Account) {
+				let a: Test.D = Test.A()
+
 				let deployedContract = signer.contracts.get(name: "Test")
 				assert(deployedContract!.name == "Test")
 
@@ -75,6 +82,7 @@ func TestRuntimeDeployedContracts(t *testing.T) {
 		OnGetSigningAccounts: func() ([]Address, error) {
 			return []Address{{42}}, nil
 		},
+		OnResolveLocation: NewSingleIdentifierLocationResolver(t),
 		OnGetAccountContractCode: func(location common.AddressLocation) ([]byte, error) {
 			return accountCodes[location], nil
 		},
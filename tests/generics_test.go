@@ -0,0 +1,215 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	. "github.com/onflow/cadence/runtime"
+	. "github.com/onflow/cadence/tests/runtime_utils"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestRuntimeGenericCompositeStorage(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	signerAddress := common.MustBytesToAddress([]byte{0x42})
+
+	deployTx := DeploymentTransaction("Test", []byte(`
+      access(all) contract Test {
+
+          access(all) resource R {
+              access(all) let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          access(all) resource Vault<T: @AnyResource> {
+              access(all) var items: @[T]
+
+              init() {
+                  self.items <- []
+              }
+
+              access(all) fun deposit(_ item: @T) {
+                  self.items.append(<-item)
+              }
+
+              access(all) fun withdraw(): @T {
+                  return <-self.items.removeFirst()
+              }
+          }
+
+          access(all) struct Box<T> {
+              access(all) let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          access(all) fun createR(id: Int): @R {
+              return <-create R(id: id)
+          }
+
+          access(all) fun createVault<T: @AnyResource>(): @Vault<@T> {
+              return <-create Vault<@T>()
+          }
+      }
+    `))
+
+	accountCodes := map[Location][]byte{}
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{signerAddress}, nil
+		},
+		OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+		OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+			accountCodes[location] = code
+			return nil
+		},
+		OnGetAccountContractCode: func(location common.AddressLocation) (code []byte, err error) {
+			code = accountCodes[location]
+			return code, nil
+		},
+		OnEmitEvent: func(event cadence.Event) error {
+			return nil
+		},
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	// Deploy contract
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deployTx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Store instances of the generic composite types
+
+	const storeTx = `
+      import Test from 0x42
+
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              let vault <- Test.createVault<@Test.R>()
+              vault.deposit(<-Test.createR(id: 1))
+              vault.deposit(<-Test.createR(id: 2))
+              signer.storage.save(<-vault, to: /storage/vault)
+
+              signer.storage.save(Test.Box(value: "hello"), to: /storage/box)
+          }
+      }
+    `
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(storeTx),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Load the stored instances, with their instantiated types
+
+	const loadTx = `
+      import Test from 0x42
+
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              assert(signer.storage.type(at: /storage/vault) == Type<@Test.Vault<@Test.R>>())
+              assert(signer.storage.type(at: /storage/box) == Type<Test.Box<String>>())
+
+              assert(!signer.storage.check<Test.Box<Int>>(from: /storage/box))
+              assert(signer.storage.borrow<&Test.Box<String>>(from: /storage/box)!.value == "hello")
+
+              let vault = signer.storage.borrow<&Test.Vault<@Test.R>>(from: /storage/vault)!
+              let r <- vault.withdraw()
+              assert(r.id == 1)
+              destroy r
+          }
+      }
+    `
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(loadTx),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Export an instance of a generic composite type
+
+	const script = `
+      import Test from 0x42
+
+      access(all) fun main(): Test.Box<Int> {
+          return Test.Box(value: 42)
+      }
+    `
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: []byte(script),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	require.IsType(t, cadence.Struct{}, result)
+	box := result.(cadence.Struct)
+
+	assert.Equal(t,
+		"A.0000000000000042.Test.Box<Int>",
+		box.StructType.ID(),
+	)
+	assert.Equal(t,
+		cadence.NewInt(42),
+		box.SearchFieldByName("value"),
+	)
+}
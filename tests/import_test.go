@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/common"
@@ -499,3 +500,150 @@ func TestRuntimeContractImport(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestRuntimeImportHash(t *testing.T) {
+
+	t.Parallel()
+
+	imported := []byte(`
+      access(all) fun answer(): Int {
+          return 42
+      }
+    `)
+
+	importedHash := sha3.Sum256(imported)
+
+	execute := func(hash []byte) (cadence.Value, error) {
+
+		runtime := NewTestInterpreterRuntime()
+
+		script := []byte(fmt.Sprintf(
+			`
+              import answer from imported hash "%x"
+
+              access(all) fun main(): Int {
+                  return answer()
+              }
+            `,
+			hash,
+		))
+
+		runtimeInterface := &TestRuntimeInterface{
+			OnGetCode: func(location Location) (bytes []byte, err error) {
+				switch location {
+				case common.IdentifierLocation("imported"):
+					return imported, nil
+				default:
+					return nil, fmt.Errorf("unknown import location: %s", location)
+				}
+			},
+			OnHash: func(data []byte, _ string, hashAlgorithm HashAlgorithm) ([]byte, error) {
+				require.Equal(t, sema.HashAlgorithmSHA3_256, hashAlgorithm)
+				hash := sha3.Sum256(data)
+				return hash[:], nil
+			},
+		}
+
+		return runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, err := execute(importedHash[:])
+		require.NoError(t, err)
+
+		require.Equal(t, cadence.NewInt(42), result)
+	})
+
+	t.Run("mismatching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := execute([]byte{0x1, 0x2})
+		RequireError(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+
+		errs := checker.RequireCheckerErrors(t, checkerErr, 1)
+
+		var mismatchErr *sema.ImportHashMismatchError
+		require.ErrorAs(t, errs[0], &mismatchErr)
+
+		require.Equal(t, []byte{0x1, 0x2}, mismatchErr.ExpectedHash)
+		require.Equal(t, importedHash[:], mismatchErr.ActualHash)
+	})
+}
+
+func TestRuntimeImportFunctionOverloads(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	imported := []byte(`
+      access(all) fun describe(value: Int): String {
+          return "value "
+      }
+
+      access(all) fun describe(double: Int): String {
+          return "double "
+      }
+
+      access(all) struct S {
+          access(all) let value: Int
+
+          init(value: Int) {
+              self.value = value
+          }
+
+          init(double: Int) {
+              self.value = double * 2
+          }
+      }
+    `)
+
+	script := []byte(`
+      import describe, S from "imported"
+
+      access(all) fun main(): String {
+          return describe(double: 2)
+              .concat(describe(value: 1))
+              .concat(S(double: 2).value.toString())
+      }
+    `)
+
+	runtimeInterface := &TestRuntimeInterface{
+		OnGetCode: func(location Location) (bytes []byte, err error) {
+			switch location {
+			case common.StringLocation("imported"):
+				return imported, nil
+			default:
+				return nil, fmt.Errorf("unknown import location: %s", location)
+			}
+		},
+	}
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, cadence.String("double value 4"), result)
+}
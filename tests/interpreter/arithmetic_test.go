@@ -21,6 +21,7 @@ package interpreter_test
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -838,3 +839,174 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 		test(ty, "Divide", testCase.divide)
 	}
 }
+
+func TestInterpretNumericFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(expression string, expected interpreter.Value) {
+
+		t.Run(expression, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let x = %s
+                    `,
+					expression,
+				),
+			)
+
+			AssertValuesEqual(
+				t,
+				inter,
+				expected,
+				inter.Globals.Get("x").GetValue(inter),
+			)
+		})
+	}
+
+	some := func(value interpreter.Value) interpreter.Value {
+		return interpreter.NewUnmeteredSomeValueNonCopying(value)
+	}
+
+	t.Run("checked", func(t *testing.T) {
+
+		t.Parallel()
+
+		test("Int8(100).checkedAdd(27)", some(interpreter.NewUnmeteredInt8Value(127)))
+		test("Int8(100).checkedAdd(28)", interpreter.Nil)
+		test("Int8(-100).checkedSubtract(29)", interpreter.Nil)
+		test("Int8(-128).checkedDivide(-1)", interpreter.Nil)
+		test("Int8(-7).checkedDivide(2)", some(interpreter.NewUnmeteredInt8Value(-3)))
+		test("UInt8(1).checkedSubtract(2)", interpreter.Nil)
+		test("UInt8(16).checkedMultiply(16)", interpreter.Nil)
+		test("UInt8(15).checkedMultiply(17)", some(interpreter.NewUnmeteredUInt8Value(255)))
+		test("UInt8(1).checkedDivide(0)", interpreter.Nil)
+		test("UInt(1).checkedSubtract(2)", interpreter.Nil)
+		test("Int(1).checkedDivide(0)", interpreter.Nil)
+		test("Int(-1).checkedSubtract(1)", some(interpreter.NewUnmeteredIntValueFromInt64(-2)))
+		test("Word8(255).checkedAdd(1)", interpreter.Nil)
+		test("Word8(0).checkedSubtract(1)", interpreter.Nil)
+		test("Word8(254).checkedAdd(1)", some(interpreter.NewUnmeteredWord8Value(255)))
+		test("UInt256.max.checkedAdd(1)", interpreter.Nil)
+		test("Int128.min.checkedSubtract(1)", interpreter.Nil)
+		test("UFix64.max.checkedAdd(0.00000001)", interpreter.Nil)
+		test("UFix64(1.5).checkedSubtract(2.0)", interpreter.Nil)
+		test("Fix64(1.5).checkedMultiply(2.0)", some(interpreter.NewUnmeteredFix64Value(300000000)))
+		test("Fix64(1.0).checkedDivide(0.0)", interpreter.Nil)
+	})
+
+	t.Run("wrapping", func(t *testing.T) {
+
+		t.Parallel()
+
+		test("Int8(127).wrappingAdd(2)", interpreter.NewUnmeteredInt8Value(-127))
+		test("Int8(-128).wrappingSubtract(1)", interpreter.NewUnmeteredInt8Value(127))
+		test("Int8(64).wrappingMultiply(4)", interpreter.NewUnmeteredInt8Value(0))
+		test("Int8(-3).wrappingMultiply(50)", interpreter.NewUnmeteredInt8Value(106))
+		test("UInt8(255).wrappingAdd(2)", interpreter.NewUnmeteredUInt8Value(1))
+		test("UInt8(0).wrappingSubtract(1)", interpreter.NewUnmeteredUInt8Value(255))
+		test("UInt16(300).wrappingMultiply(300)", interpreter.NewUnmeteredUInt16Value(24464))
+		test("Word8(0).wrappingSubtract(1)", interpreter.NewUnmeteredWord8Value(255))
+		test("UInt128.max.wrappingAdd(1)", interpreter.NewUnmeteredUInt128ValueFromUint64(0))
+		test("Int256.min.wrappingSubtract(1)", interpreter.NewUnmeteredInt256ValueFromBigInt(sema.Int256TypeMaxIntBig))
+	})
+
+	t.Run("pow", func(t *testing.T) {
+
+		t.Parallel()
+
+		test("Int(2).pow(100)", interpreter.NewUnmeteredIntValueFromBigInt(
+			new(big.Int).Lsh(big.NewInt(1), 100),
+		))
+		test("Int(-3).pow(3)", interpreter.NewUnmeteredIntValueFromInt64(-27))
+		test("Int(5).pow(0)", interpreter.NewUnmeteredIntValueFromInt64(1))
+		test("UInt8(2).pow(7)", interpreter.NewUnmeteredUInt8Value(128))
+		test("Word8(2).pow(9)", interpreter.NewUnmeteredWord8Value(0))
+		test("Int8(-2).pow(7)", interpreter.NewUnmeteredInt8Value(-128))
+		test("UFix64(1.5).pow(2)", interpreter.NewUnmeteredUFix64Value(225000000))
+		test("Fix64(-0.5).pow(3)", interpreter.NewUnmeteredFix64Value(-12500000))
+		// Computed by squaring, i.e. 1.50000001 * 1.50000001 = 2.25000003,
+		// and 2.25000003 * 2.25000003 = 5.06250013.
+		// Repeated multiplication would result in 5.06250012
+		test("UFix64(1.50000001).pow(4)", interpreter.NewUnmeteredUFix64Value(506250013))
+	})
+
+	t.Run("abs", func(t *testing.T) {
+
+		t.Parallel()
+
+		test("Int(-5).abs()", interpreter.NewUnmeteredIntValueFromInt64(5))
+		test("Int8(-127).abs()", interpreter.NewUnmeteredInt8Value(127))
+		test("Int64(3).abs()", interpreter.NewUnmeteredInt64Value(3))
+		test("Fix64(-1.25).abs()", interpreter.NewUnmeteredFix64Value(125000000))
+	})
+
+	t.Run("min, max", func(t *testing.T) {
+
+		t.Parallel()
+
+		test("Int(-5).min(3)", interpreter.NewUnmeteredIntValueFromInt64(-5))
+		test("Int(-5).max(3)", interpreter.NewUnmeteredIntValueFromInt64(3))
+		test("UInt64(7).min(2)", interpreter.NewUnmeteredUInt64Value(2))
+		test("Word16(7).max(9)", interpreter.NewUnmeteredWord16Value(9))
+		test("UFix64(1.5).min(2.5)", interpreter.NewUnmeteredUFix64Value(150000000))
+		test("Fix64(-1.5).max(-2.5)", interpreter.NewUnmeteredFix64Value(-150000000))
+	})
+
+	t.Run("sqrt", func(t *testing.T) {
+
+		t.Parallel()
+
+		test("Int(99).sqrt()", interpreter.NewUnmeteredIntValueFromInt64(9))
+		test("UInt8(255).sqrt()", interpreter.NewUnmeteredUInt8Value(15))
+		test("Int8(0).sqrt()", interpreter.NewUnmeteredInt8Value(0))
+		test("UInt256.max.sqrt()", interpreter.NewUnmeteredUInt256ValueFromBigInt(
+			new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)),
+		))
+		test("UFix64(2.0).sqrt()", interpreter.NewUnmeteredUFix64Value(141421356))
+		test("Fix64(0.25).sqrt()", interpreter.NewUnmeteredFix64Value(50000000))
+	})
+}
+
+func TestInterpretInvalidNumericFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(expression string, expectedError error) {
+
+		t.Run(expression, func(t *testing.T) {
+
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      fun test(): AnyStruct {
+                          return %s
+                      }
+                    `,
+					expression,
+				),
+			)
+
+			_, err := inter.Invoke("test")
+			RequireError(t, err)
+
+			require.ErrorAs(t, err, expectedError)
+		})
+	}
+
+	// The absolute value of the minimum value of each fixed-size signed type overflows
+	for _, ty := range []string{"Int8", "Int16", "Int32", "Int64", "Int128", "Int256", "Fix64"} {
+		test(fmt.Sprintf("%s.min.abs()", ty), &interpreter.OverflowError{})
+	}
+	test("Int8(-128).abs()", &interpreter.OverflowError{})
+	test("Int8(2).pow(7)", &interpreter.OverflowError{})
+	test("UFix64.max.pow(2)", &interpreter.OverflowError{})
+	test("Int(-1).sqrt()", &interpreter.NegativeSquareRootError{})
+	test("Fix64(-0.5).sqrt()", &interpreter.NegativeSquareRootError{})
+}
@@ -0,0 +1,206 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretCompoundAssignment(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all operators", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              var a = 1
+              a += 2
+              var b = 5
+              b -= 2
+              var c = 2
+              c *= 3
+              var d = 7
+              d /= 2
+              var e = 7
+              e %= 4
+              var f = 6
+              f &= 3
+              var g = 6
+              g |= 3
+              var h = 6
+              h ^= 3
+              var i = 1
+              i <<= 4
+              var j = 32
+              j >>= 2
+              return [a, b, c, d, e, f, g, h, i, j]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(6),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(7),
+				interpreter.NewUnmeteredIntValueFromInt64(5),
+				interpreter.NewUnmeteredIntValueFromInt64(16),
+				interpreter.NewUnmeteredIntValueFromInt64(8),
+			),
+			value,
+		)
+	})
+
+	t.Run("member", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Counter {
+              var count: UInt64
+
+              init() {
+                  self.count = 10
+              }
+
+              fun add(_ amount: UInt64) {
+                  self.count += amount
+              }
+          }
+
+          fun test(): UInt64 {
+              let counter = Counter()
+              counter.add(5)
+              counter.add(7)
+              return counter.count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUInt64Value(22),
+			value,
+		)
+	})
+
+	t.Run("target is evaluated once", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xss = [[1, 2, 3], [4, 5, 6]]
+          var calls = 0
+
+          fun outer(): Int {
+              calls += 1
+              return 0
+          }
+
+          fun inner(): Int {
+              calls += 1
+              return 1
+          }
+
+          fun test(): [Int] {
+              xss[outer()][inner()] += 10
+              return [xss[0][1], calls]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(12),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			value,
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              var x: UInt8 = 255
+              x += 1
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              var x = 1
+              x /= 0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+}
@@ -0,0 +1,223 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretFunctionDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("omitted and provided", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun add(_ a: Int, _ b: Int = 10, c: Int = a * 100): Int {
+              return a + b + c
+          }
+
+          fun test(): [Int] {
+              return [add(1), add(1, 2), add(1, 2, c: 3)]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(111),
+				interpreter.NewUnmeteredIntValueFromInt64(103),
+				interpreter.NewUnmeteredIntValueFromInt64(6),
+			),
+			result,
+		)
+	})
+
+	t.Run("evaluated on each invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun count(_ values: [Int] = []): Int {
+              values.append(1)
+              return values.length
+          }
+
+          fun test(): Int {
+              return count() + count()
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			result,
+		)
+	})
+
+	t.Run("converted to parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ value: Int? = 1): Int? {
+              return value
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			result,
+		)
+	})
+}
+
+func TestInterpretCompositeDefaultArguments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let a: Int
+              let b: Int
+
+              init(a: Int, b: Int = a + 1) {
+                  self.a = a
+                  self.b = b
+              }
+          }
+
+          fun test(): Int {
+              let s = S(a: 1)
+              return s.a + s.b
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			result,
+		)
+	})
+
+	t.Run("function, self", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let a: Int
+
+              init() {
+                  self.a = 42
+              }
+
+              fun get(_ b: Int = self.a): Int {
+                  return b
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              return s.get() + s.get(1)
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(43),
+			result,
+		)
+	})
+
+	t.Run("interface with conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              fun get(_ a: Int, _ b: Int = 2): Int {
+                  pre { b == 2: "invalid default argument" }
+              }
+          }
+
+          struct S: I {
+              fun get(_ a: Int, _ b: Int = 2): Int {
+                  return a + b
+              }
+          }
+
+          fun test(): Int {
+              let s: {I} = S()
+              return s.get(1)
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			result,
+		)
+	})
+}
@@ -0,0 +1,326 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretArrayDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable-sized", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let values = [1, 2]
+              let [a, b] = values
+              return a * 10 + b
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(12),
+			result,
+		)
+	})
+
+	t.Run("discard", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let values: [String; 3] = ["a", "b", "c"]
+              let [_, b, _] = values
+              return b
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("b"),
+			result,
+		)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let values = [[1]]
+              let [inner] = values
+              inner.append(2)
+              return [values.length, values[0].length, inner.length]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              let values = [1, 2, 3]
+              let [a, b] = values
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		var mismatchErr interpreter.DestructuringLengthMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+		require.Equal(t, 2, mismatchErr.ExpectedLength)
+		require.Equal(t, 3, mismatchErr.ActualLength)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(): [Int] {
+              let rs <- [<-create R(id: 1), <-create R(id: 2)]
+              let [a, b] <- rs
+              let ids = [a.id, b.id]
+              destroy a
+              destroy b
+              return ids
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+	})
+
+	t.Run("resources, length mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [<-create R(), <-create R(), <-create R()]
+              let [a, b] <- rs
+              destroy a
+              destroy b
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DestructuringLengthMismatchError{})
+	})
+}
+
+func TestInterpretDictionaryDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Int {
+          let values = {"a": 1, "b": 2}
+          let {"a": a, "c": c} = values
+          return a! + (c ?? 10)
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(11),
+		result,
+	)
+}
+
+func TestInterpretCompositeDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct Pair {
+          let first: Int
+          let second: [Int]
+
+          init(first: Int, second: [Int]) {
+              self.first = first
+              self.second = second
+          }
+      }
+
+      fun test(): [Int] {
+          let pair = Pair(first: 1, second: [2])
+          var (first, second: rest) = pair
+          rest.append(3)
+          return [first, rest.length, pair.second.length]
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		),
+		result,
+	)
+}
+
+func TestInterpretForDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("composite", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Pair {
+              let first: Int
+              let second: Int
+
+              init(first: Int, second: Int) {
+                  self.first = first
+                  self.second = second
+              }
+          }
+
+          fun test(): Int {
+              var sum = 0
+              let pairs = [
+                  Pair(first: 1, second: 2),
+                  Pair(first: 3, second: 4)
+              ]
+              for i, (first, second) in pairs {
+                  sum = sum + i * 100 + first * 10 + second
+              }
+              return sum
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(146),
+			result,
+		)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var sum = 0
+              for [a, b] in [[1, 2], [3, 4]] {
+                  sum = sum + a * b
+              }
+              return sum
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(14),
+			result,
+		)
+	})
+}
@@ -0,0 +1,167 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretDistinctType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("conversion", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          type PersonID = distinct String
+
+          let id = PersonID("alice")
+          let raw = id.toUnderlying()
+        `)
+
+		id := inter.Globals.Get("id").GetValue(inter)
+		require.IsType(t, &interpreter.CompositeValue{}, id)
+
+		assert.Equal(t,
+			interpreter.NewCompositeStaticTypeComputeTypeID(
+				nil,
+				TestLocation,
+				"PersonID",
+			),
+			id.StaticType(inter),
+		)
+
+		RequireValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("alice"),
+			inter.Globals.Get("raw").GetValue(inter),
+		)
+	})
+
+	t.Run("equality", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          type PersonID = distinct String
+
+          let equal = PersonID("alice") == PersonID("alice")
+          let notEqual = PersonID("alice") != PersonID("bob")
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			inter.Globals.Get("equal").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			inter.Globals.Get("notEqual").GetValue(inter),
+		)
+	})
+
+	t.Run("dictionary key", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          type PersonID = distinct String
+
+          fun test(): Int? {
+              let ages: {PersonID: Int} = {}
+              ages[PersonID("alice")] = 42
+              ages[PersonID("bob")] = 23
+              return ages[PersonID("alice")]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+			),
+			result,
+		)
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          type Meters = distinct Int with operations
+
+          fun test(): Int {
+              var total = Meters(1) + Meters(2) * Meters(3)
+              total -= Meters(2)
+              return (-total).toUnderlying()
+          }
+
+          let less = Meters(1) < Meters(2)
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(-5),
+			result,
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			inter.Globals.Get("less").GetValue(inter),
+		)
+	})
+
+	t.Run("operations, result keeps distinct type", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          type Meters = distinct Int with operations
+
+          let sum = Meters(1) + Meters(2)
+          let isMeters = sum.getType() == Type<Meters>()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			inter.Globals.Get("isMeters").GetValue(inter),
+		)
+	})
+}
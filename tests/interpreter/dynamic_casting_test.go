@@ -182,6 +182,281 @@ func TestInterpretDynamicCastingNumber(t *testing.T) {
 	}
 }
 
+func TestInterpretDynamicCastingNumberConversion(t *testing.T) {
+
+	t.Parallel()
+
+	parseCheckAndInterpretWithNumberConversionCasts := func(t *testing.T, code string) *interpreter.Interpreter {
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				CheckerConfig: &sema.Config{
+					NumberConversionCastsEnabled: true,
+				},
+			},
+		)
+		require.NoError(t, err)
+		return inter
+	}
+
+	type outOfRange int
+
+	const (
+		inRange outOfRange = iota
+		overflow
+		underflow
+	)
+
+	type test struct {
+		valueType  string
+		value      string
+		targetType sema.Type
+		expected   interpreter.Value
+		outOfRange outOfRange
+	}
+
+	tests := []test{
+		{"Int", "200", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(200), inRange},
+		{"Int", "300", sema.UInt8Type, nil, overflow},
+		{"Int", "-1", sema.UIntType, nil, underflow},
+		{"Int", "-129", sema.Int8Type, nil, underflow},
+		{"Int", "255", sema.Word8Type, interpreter.NewUnmeteredWord8Value(255), inRange},
+		{"Int", "256", sema.Word8Type, nil, overflow},
+		{"Int", "-1", sema.Word8Type, nil, underflow},
+		{"Int?", "42", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(42), inRange},
+		{"UInt64", "42", sema.IntType, interpreter.NewUnmeteredIntValueFromInt64(42), inRange},
+		{"Int", "1", sema.UFix64Type, interpreter.NewUnmeteredUFix64Value(100000000), inRange},
+		{"Int", "92233720369", sema.Fix64Type, nil, overflow},
+		{"UFix64", "1.5", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(1), inRange},
+		{"UFix64", "1.5", sema.Fix64Type, interpreter.NewUnmeteredFix64Value(150000000), inRange},
+		{"UFix64", "92233720368.9", sema.Fix64Type, nil, overflow},
+		{"Fix64", "-0.5", sema.UFix64Type, nil, underflow},
+		{"Fix64", "-0.5", sema.Int8Type, interpreter.NewUnmeteredInt8Value(0), inRange},
+		// Values with an abstract static type are converted like values with a number static type
+		{"AnyStruct", "200", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(200), inRange},
+		{"AnyStruct", "300", sema.UInt8Type, nil, overflow},
+		{"AnyStruct?", "42", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(42), inRange},
+		{"AnyStruct", "UInt8(42)", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(42), inRange},
+		{"Integer", "UInt8(5)", sema.IntType, interpreter.NewUnmeteredIntValueFromInt64(5), inRange},
+		{"Integer", "-1", sema.UIntType, nil, underflow},
+		{"Number", "1.5", sema.UInt8Type, interpreter.NewUnmeteredUInt8Value(1), inRange},
+		{"Number", "-0.5", sema.UFix64Type, nil, underflow},
+	}
+
+	for operation, returnsOptional := range dynamicCastingOperations {
+
+		t.Run(operation.Symbol(), func(t *testing.T) {
+
+			t.Parallel()
+
+			for _, test := range tests {
+
+				t.Run(fmt.Sprintf("%s %s to %s", test.valueType, test.value, test.targetType), func(t *testing.T) {
+
+					t.Parallel()
+
+					inter := parseCheckAndInterpretWithNumberConversionCasts(t,
+						fmt.Sprintf(
+							`
+                              fun test(): %[3]s? {
+                                  let x: %[1]s = %[2]s
+                                  return x %[4]s %[3]s
+                              }
+                            `,
+							test.valueType,
+							test.value,
+							test.targetType,
+							operation.Symbol(),
+						),
+					)
+
+					result, err := inter.Invoke("test")
+
+					if test.expected != nil {
+						require.NoError(t, err)
+
+						AssertValuesEqual(
+							t,
+							inter,
+							interpreter.NewUnmeteredSomeValueNonCopying(test.expected),
+							result,
+						)
+						return
+					}
+
+					if returnsOptional {
+						require.NoError(t, err)
+
+						AssertValuesEqual(
+							t,
+							inter,
+							interpreter.Nil,
+							result,
+						)
+						return
+					}
+
+					RequireError(t, err)
+
+					switch test.outOfRange {
+					case overflow:
+						require.ErrorAs(t, err, &interpreter.OverflowError{})
+					case underflow:
+						require.ErrorAs(t, err, &interpreter.UnderflowError{})
+					default:
+						t.Fatalf("missing expected value")
+					}
+				})
+			}
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Int = 42
+          let y: AnyStruct = 42
+
+          let a = x as? UInt8
+          let b = y as? UInt8
+          let c = y as? Int
+
+          fun test(): UInt8 {
+              return x as! UInt8
+          }
+        `)
+
+		for name, expected := range map[string]interpreter.Value{
+			"a": interpreter.Nil,
+			"b": interpreter.Nil,
+			"c": interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+			),
+		} {
+			AssertValuesEqual(
+				t,
+				inter,
+				expected,
+				inter.Globals.Get(name).GetValue(inter),
+			)
+		}
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+	})
+
+	t.Run("non-number value of abstract static type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithNumberConversionCasts(t, `
+          let x: AnyStruct = "42"
+          let y = x as? UInt8
+
+          fun test(): UInt8 {
+              return x as! UInt8
+          }
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.Nil,
+			inter.Globals.Get("y").GetValue(inter),
+		)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+	})
+
+	t.Run("nil", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithNumberConversionCasts(t, `
+          let x: Int? = nil
+          let y = x as? UInt8
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.Nil,
+			inter.Globals.Get("y").GetValue(inter),
+		)
+	})
+}
+
+func TestInterpretDynamicCastingOptionalAndReferenceConversion(t *testing.T) {
+
+	t.Parallel()
+
+	// Optional unwrapping and reference conversions are performed by all failable and force casts,
+	// they are not specific to number conversions
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Int? = 42
+          let y = x as? Int
+          let z = x as! Int
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+			),
+			inter.Globals.Get("y").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			inter.Globals.Get("z").GetValue(inter),
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          entitlement E
+
+          struct S {}
+
+          fun test(): Bool {
+              let s = S()
+              let ref: auth(E) &AnyStruct = &s
+              let unauthorized = ref as? &S
+              let authorized = ref as? auth(E) &S
+              return unauthorized != nil && authorized != nil
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			result,
+		)
+	})
+}
+
 func TestInterpretDynamicCastingVoid(t *testing.T) {
 
 	t.Parallel()
@@ -0,0 +1,453 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+const equatableHashableKeyDeclaration = `
+  struct Key: Hashable {
+      let id: UInt64
+      let label: String
+
+      init(id: UInt64, label: String) {
+          self.id = id
+          self.label = label
+      }
+
+      // Only the ID is relevant for equality, the label is ignored
+      access(all) view fun equals(_ other: AnyStruct): Bool {
+          if let other = other as? Key {
+              return self.id == other.id
+          }
+          return false
+      }
+
+      access(all) view fun hashValue(): UInt64 {
+          return self.id % 2
+      }
+  }
+`
+
+func TestInterpretEquatable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("equality operators", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, equatableHashableKeyDeclaration+`
+          fun test(): [Bool] {
+              let a = Key(id: 1, label: "a")
+              let b = Key(id: 1, label: "b")
+              let c = Key(id: 2, label: "a")
+              return [a == b, a != b, a == c, a != c]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+			),
+			result,
+		)
+	})
+
+	t.Run("array contains", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, equatableHashableKeyDeclaration+`
+          fun test(): Bool {
+              let keys = [Key(id: 1, label: "a"), Key(id: 2, label: "b")]
+              return keys.contains(Key(id: 2, label: "c"))
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.TrueValue, result)
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct A: Equatable {
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+          }
+
+          struct B: Equatable {
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  return true
+              }
+          }
+
+          fun test(): Bool {
+              let a: {Equatable} = A()
+              let b: {Equatable} = B()
+              return a == b
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("recursive, bounded by computation metering", func(t *testing.T) {
+		t.Parallel()
+
+		const invocationLimit = 100
+
+		computationLimitExceededError := goerrors.New("computation limit exceeded")
+
+		var invocations uint
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              struct S: Equatable {
+                  access(all) view fun equals(_ other: AnyStruct): Bool {
+                      return self == (other as! S)
+                  }
+              }
+
+              fun test(): Bool {
+                  return S() == S()
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnMeterComputation: func(compKind common.ComputationKind, intensity uint) {
+						if compKind != common.ComputationKindFunctionInvocation {
+							return
+						}
+						invocations += intensity
+						if invocations > invocationLimit {
+							panic(computationLimitExceededError)
+						}
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		invocations = 0
+
+		_, err = inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorIs(t, err, computationLimitExceededError)
+	})
+}
+
+func TestInterpretHashable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("dictionary keys", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, equatableHashableKeyDeclaration+`
+          fun test(): [String?] {
+              let dict: {Key: String} = {
+                  Key(id: 1, label: "a"): "one",
+                  Key(id: 2, label: "b"): "two",
+                  Key(id: 3, label: "c"): "three"
+              }
+
+              // Overwrites the entry with the equal key
+              dict[Key(id: 3, label: "d")] = "THREE"
+
+              return [
+                  dict[Key(id: 1, label: "x")],
+                  dict[Key(id: 2, label: "y")],
+                  dict[Key(id: 3, label: "z")],
+                  dict[Key(id: 4, label: "a")],
+                  dict.length == 3 ? "3" : nil
+              ]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: &interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeString,
+					},
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("one")),
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("two")),
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("THREE")),
+				interpreter.Nil,
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("3")),
+			),
+			result,
+		)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, equatableHashableKeyDeclaration+`
+          fun test(): Int {
+              let dict = {Key(id: 1, label: "a"): 1, Key(id: 2, label: "b"): 2}
+              dict.remove(key: Key(id: 1, label: "c"))
+              return dict.length
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(1), result)
+	})
+
+	t.Run("mutated key", func(t *testing.T) {
+		t.Parallel()
+
+		// The equals and hashValue functions may only access constant fields,
+		// so mutating the variable fields of a key does not affect the lookup
+
+		inter := parseCheckAndInterpret(t, `
+          struct Key: Hashable {
+              let id: UInt64
+              var note: String
+
+              init(id: UInt64, note: String) {
+                  self.id = id
+                  self.note = note
+              }
+
+              fun setNote(_ note: String) {
+                  self.note = note
+              }
+
+              access(all) view fun equals(_ other: AnyStruct): Bool {
+                  if let other = other as? Key {
+                      return self.id == other.id
+                  }
+                  return false
+              }
+
+              access(all) view fun hashValue(): UInt64 {
+                  return self.id
+              }
+          }
+
+          fun test(): [String?] {
+              let key = Key(id: 1, note: "a")
+              let dict = {key: "one"}
+
+              key.setNote("b")
+
+              let storedKey = dict.keys[0]
+              storedKey.setNote("c")
+
+              return [dict[key], dict[storedKey], dict.keys[0].note]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: &interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeString,
+					},
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("one")),
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("one")),
+				interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("a")),
+			),
+			result,
+		)
+	})
+
+	t.Run("metered", func(t *testing.T) {
+		t.Parallel()
+
+		var invocations int
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			equatableHashableKeyDeclaration+`
+              fun test() {
+                  let dict = {Key(id: 1, label: "a"): 1}
+                  dict[Key(id: 1, label: "b")]
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					OnFunctionInvocation: func(_ *interpreter.Interpreter) {
+						invocations++
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		invocations = 0
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		// In addition to the two constructor calls,
+		// the calls of the hashValue and equals functions are reported
+		assert.Greater(t, invocations, 2)
+	})
+}
+
+func TestInterpretHashableLoadingFailure(t *testing.T) {
+
+	t.Parallel()
+
+	unknownLocation := common.StringLocation("unknown")
+
+	hashInputPanic := func(inter *interpreter.Interpreter, value *interpreter.CompositeValue) (recovered any) {
+		defer func() {
+			recovered = recover()
+		}()
+
+		value.HashInput(inter, interpreter.EmptyLocationRange, nil)
+
+		return nil
+	}
+
+	newKey := func(inter *interpreter.Interpreter) *interpreter.CompositeValue {
+		return interpreter.NewCompositeValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			unknownLocation,
+			"Key",
+			common.CompositeKindStructure,
+			nil,
+			common.ZeroAddress,
+		)
+	}
+
+	t.Run("type not loadable", func(t *testing.T) {
+		t.Parallel()
+
+		// The program of the location can be loaded, but it does not declare the type
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			``,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					ImportLocationHandler: func(_ *interpreter.Interpreter, _ common.Location) interpreter.Import {
+						return interpreter.VirtualImport{
+							Elaboration: sema.NewElaboration(nil),
+						}
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		recovered := hashInputPanic(inter, newKey(inter))
+
+		require.Equal(t,
+			interpreter.TypeLoadingError{
+				TypeID: unknownLocation.TypeID(nil, "Key"),
+			},
+			recovered,
+		)
+	})
+
+	t.Run("functions not loadable", func(t *testing.T) {
+		t.Parallel()
+
+		// The type can be loaded, but the functions of the type are not available
+
+		keyType := &sema.CompositeType{
+			Location:   unknownLocation,
+			Identifier: "Key",
+			Kind:       common.CompositeKindStructure,
+			ExplicitInterfaceConformances: []*sema.InterfaceType{
+				sema.HashableType,
+			},
+		}
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			``,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					CompositeTypeHandler: func(location common.Location, typeID interpreter.TypeID) *sema.CompositeType {
+						if location == unknownLocation {
+							return keyType
+						}
+						return nil
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		recovered := hashInputPanic(inter, newKey(inter))
+
+		require.Equal(t,
+			interpreter.TypeLoadingError{
+				TypeID: unknownLocation.TypeID(nil, "Key"),
+			},
+			recovered,
+		)
+	})
+}
@@ -0,0 +1,413 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun first<T>(_ xs: [T]): T? {
+              if xs.length == 0 {
+                  return nil
+              }
+              return xs[0]
+          }
+
+          fun test(): Int? {
+              return first([1, 2, 3])
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun wrap<T>(_ x: T): [T] {
+              let xs: [T] = [x]
+              return xs
+          }
+
+          fun test(): Bool {
+              return wrap<Int8>(1).getType() == Type<[Int8]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+
+	t.Run("optional type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun first<T>(_ xs: [T]): T? {
+              if xs.length == 0 {
+                  return nil
+              }
+              return xs[0]
+          }
+
+          fun test(): Int?? {
+              return first<Int?>([1])
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+			),
+			value,
+		)
+	})
+
+	t.Run("cast to type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun cast<T>(_ x: AnyStruct): T? {
+              return x as? T
+          }
+
+          fun test(): [Bool] {
+              return [
+                  cast<Int>(1) == 1,
+                  cast<String>(1) == nil
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+			),
+			value,
+		)
+	})
+
+	t.Run("run-time type of type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun typeOf<T>(): Type {
+              return Type<T>()
+          }
+
+          fun test(): Bool {
+              return typeOf<[String]>() == Type<[String]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+
+	t.Run("nested generic invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun wrap<T>(_ x: T): [T] {
+              return [x]
+          }
+
+          fun wrapTwice<U>(_ x: U): [[U]] {
+              return wrap(wrap(x))
+          }
+
+          fun test(): Bool {
+              return wrapTwice<UInt8>(1).getType() == Type<[[UInt8]]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+
+	t.Run("closure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun makeWrapper<T>(_ x: T): fun(): [T] {
+              return fun (): [T] {
+                  return [x]
+              }
+          }
+
+          fun test(): Bool {
+              let wrapper = makeWrapper<UInt8>(1)
+              return wrapper().getType() == Type<[UInt8]>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          fun collect<T: @AnyResource>(_ x: @T): @[T] {
+              return <-[<-x]
+          }
+
+          fun test(): Bool {
+              let rs <- collect(<-create R())
+              let result = rs.getType() == Type<@[R]>()
+              destroy rs
+              return result
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+}
+
+func TestInterpretGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun get(): T {
+                  return self.value
+              }
+
+              fun list(): [T] {
+                  return [self.value]
+              }
+          }
+
+          fun test(): [Bool] {
+              let box = Box(value: 1)
+              return [
+                  box.get() == 1,
+                  box.getType() == Type<Box<Int>>(),
+                  box.list().getType() == Type<[Int]>(),
+                  !box.isInstance(Type<Box<String>>())
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+			),
+			value,
+		)
+	})
+
+	t.Run("generic function of generic structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+
+              fun map<U>(_ f: fun(T): U): Box<U> {
+                  return Box<U>(value: f(self.value))
+              }
+          }
+
+          fun test(): Bool {
+              let box = Box(value: 1).map(fun (x: Int): String {
+                  return x.toString()
+              })
+              return box.value == "1" && box.getType() == Type<Box<String>>()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {}
+
+          resource Vault<T: @AnyResource> {
+              var items: @[T]
+
+              init() {
+                  self.items <- []
+              }
+
+              fun deposit(_ item: @T) {
+                  self.items.append(<-item)
+              }
+          }
+
+          fun test(): Bool {
+              let vault <- create Vault<@R>()
+              vault.deposit(<-create R())
+              let result = vault.items.length == 1 &&
+                  vault.getType() == Type<@Vault<@R>>()
+              destroy vault
+              return result
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.TrueValue, value)
+	})
+
+	t.Run("dynamic cast", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Box<T> {
+              let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          fun test(): [Bool] {
+              let box: AnyStruct = Box(value: 1)
+              return [
+                  (box as? Box<Int>) != nil,
+                  (box as? Box<String>) == nil
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+			),
+			value,
+		)
+	})
+}
@@ -46,6 +46,7 @@ import (
 type ParseCheckAndInterpretOptions struct {
 	Config             *interpreter.Config
 	CheckerConfig      *sema.Config
+	ParseOptions       parser.Config
 	HandleCheckerError func(error)
 }
 
@@ -176,7 +177,8 @@ func parseCheckAndInterpretWithOptionsAndMemoryMetering(
 	checker, err := checker.ParseAndCheckWithOptionsAndMemoryMetering(t,
 		code,
 		checker.ParseAndCheckOptions{
-			Config: options.CheckerConfig,
+			Config:       options.CheckerConfig,
+			ParseOptions: options.ParseOptions,
 		},
 		memoryGauge,
 	)
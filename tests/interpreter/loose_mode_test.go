@@ -0,0 +1,284 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func parseCheckAndInterpretLoose(t *testing.T, code string) *interpreter.Interpreter {
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			ParseOptions: parser.Config{
+				LooseModeEnabled: true,
+			},
+			CheckerConfig: &sema.Config{
+				LooseModeEnabled: true,
+			},
+		},
+	)
+	require.NoError(t, err)
+	return inter
+}
+
+func TestInterpretLooseMode(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("parameters and return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun double(_ x) {
+              return x * 2
+          }
+
+          fun test(): Int {
+              return double(21)
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			result,
+		)
+	})
+
+	t.Run("argument type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun double(_ x: Int): Int {
+              return x * 2
+          }
+
+          fun test(_ a) {
+              double(a)
+          }
+        `)
+
+		_, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue("a"))
+		RequireError(t, err)
+
+		var typeMismatchErr interpreter.TypeMismatchError
+		require.ErrorAs(t, err, &typeMismatchErr)
+		require.Equal(t, sema.IntType, typeMismatchErr.ExpectedType)
+		require.Equal(t, sema.StringType, typeMismatchErr.ActualType)
+	})
+
+	t.Run("binary operand type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun test(_ a): Bool {
+              return a > 1
+          }
+        `)
+
+		_, err := inter.Invoke("test", interpreter.TrueValue)
+		RequireError(t, err)
+
+		var typeMismatchErr interpreter.TypeMismatchError
+		require.ErrorAs(t, err, &typeMismatchErr)
+	})
+
+	t.Run("binary operands, both without types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun add(_ a, _ b) {
+              return a + b
+          }
+
+          fun test(): [AnyStruct] {
+              return [add(1, 2), add(1, 2) < add(2, 2)]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.TrueValue,
+			),
+			result,
+		)
+	})
+
+	t.Run("binary operands, both without types, mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun add(_ a, _ b) {
+              return a + b
+          }
+        `)
+
+		_, err := inter.Invoke(
+			"add",
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.NewUnmeteredStringValue("2"),
+		)
+		RequireError(t, err)
+
+		var invalidOperandsErr interpreter.InvalidOperandsError
+		require.ErrorAs(t, err, &invalidOperandsErr)
+	})
+
+	t.Run("index", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun first(_ xs) {
+              return xs[0]
+          }
+
+          fun test(): [AnyStruct] {
+              return [first([1, 2]), first({0: "a"}), first("abc")]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredStringValue("a"),
+				),
+				interpreter.NewUnmeteredCharacterValue("a"),
+			),
+			result,
+		)
+	})
+
+	t.Run("index, not indexable", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun first(_ xs) {
+              return xs[0]
+          }
+        `)
+
+		_, err := inter.Invoke("first", interpreter.TrueValue)
+		RequireError(t, err)
+
+		var notIndexableErr interpreter.NotIndexableError
+		require.ErrorAs(t, err, &notIndexableErr)
+		require.Equal(t, sema.BoolType, notIndexableErr.Type)
+	})
+
+	t.Run("index, indexing type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun get(_ xs, _ i) {
+              return xs[i]
+          }
+
+          fun test() {
+              get([1, 2], "a")
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		var typeMismatchErr interpreter.TypeMismatchError
+		require.ErrorAs(t, err, &typeMismatchErr)
+		require.Equal(t, sema.IntegerType, typeMismatchErr.ExpectedType)
+		require.Equal(t, sema.StringType, typeMismatchErr.ActualType)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretLoose(t, `
+          fun test(_ a): Int? {
+              let x: Int? = a
+              return x
+          }
+        `)
+
+		result, err := inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(1))
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			result,
+		)
+
+		result, err = inter.Invoke("test", interpreter.Nil)
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.Nil,
+			result,
+		)
+	})
+}
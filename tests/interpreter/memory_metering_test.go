@@ -1890,6 +1890,53 @@ func TestInterpretUInt8Metering(t *testing.T) {
 		assert.Equal(t, uint64(3), meter.getMemory(common.MemoryKindNumberValue))
 	})
 
+	t.Run("checked addition", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = (1 as UInt8).checkedAdd(2 as UInt8)
+          }
+        `
+
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// creation: 1 + 1
+		// result: 1
+		assert.Equal(t, uint64(3), meter.getMemory(common.MemoryKindNumberValue))
+		assert.Equal(t, uint64(88), meter.getMemory(common.MemoryKindBigInt))
+		// result: 1
+		// transfer: 1
+		assert.Equal(t, uint64(2), meter.getMemory(common.MemoryKindOptionalValue))
+	})
+
+	t.Run("wrapping addition", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = (255 as UInt8).wrappingAdd(2 as UInt8)
+          }
+        `
+
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// creation: 1 + 1
+		// result: 1
+		assert.Equal(t, uint64(3), meter.getMemory(common.MemoryKindNumberValue))
+		assert.Equal(t, uint64(88), meter.getMemory(common.MemoryKindBigInt))
+	})
+
 	t.Run("subtraction", func(t *testing.T) {
 
 		t.Parallel()
@@ -6789,6 +6836,26 @@ func TestInterpretStringMetering(t *testing.T) {
 		assert.Equal(t, uint64(0), meter.getMemory(common.MemoryKindStringValue))
 	})
 
+	t.Run("template", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+          fun main() {
+              let x = "abc \("de") f"
+          }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), meter.getMemory(common.MemoryKindStringTemplateExpression))
+		// 1 + 8 (abc de f)
+		assert.Equal(t, uint64(9), meter.getMemory(common.MemoryKindStringValue))
+	})
+
 	t.Run("toLower, ASCII", func(t *testing.T) {
 
 		t.Parallel()
@@ -0,0 +1,403 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun describe(value: Int): String {
+              return "value "
+          }
+
+          fun describe(double: Int): String {
+              return "double "
+          }
+
+          fun test(): String {
+              return describe(double: 2).concat(describe(value: 1))
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("double value "),
+			result,
+		)
+	})
+
+	t.Run("composite functions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+
+              fun describe(value: Int): String {
+                  return "value "
+              }
+
+              fun describe(double: Int): String {
+                  return "double "
+              }
+          }
+
+          fun test(): String {
+              let s = S()
+              return s.describe(double: 2).concat(s.describe(value: 1))
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("double value "),
+			result,
+		)
+	})
+
+	t.Run("references", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun describe(value: Int): String {
+              return "value "
+          }
+
+          fun describe(string: String): String {
+              return string
+          }
+
+          struct S {
+
+              fun describe(value: Int): String {
+                  return "member value "
+              }
+
+              fun describe(string: String): String {
+                  return string
+              }
+          }
+
+          fun test(): String {
+              let f: fun(String): String = describe
+              let g: fun(String): String = S().describe
+              return f("global ").concat(g("member "))
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("global member "),
+			result,
+		)
+	})
+
+	t.Run("default arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun describe(value: Int): String {
+              return "value "
+          }
+
+          fun describe(double: Int, suffix: String = "double "): String {
+              return suffix
+          }
+
+          struct S {
+
+              fun describe(value: Int): String {
+                  return "value "
+              }
+
+              fun describe(double: Int, suffix: String = "double "): String {
+                  return suffix
+              }
+          }
+
+          fun test(): String {
+              let s = S()
+              return describe(double: 2).concat(s.describe(double: 2))
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("double double "),
+			result,
+		)
+	})
+
+	t.Run("through interface, declared in different order", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              fun describe(value: Int): String
+              fun describe(double: Int): String
+          }
+
+          struct S: I {
+
+              fun describe(double: Int): String {
+                  return "double "
+              }
+
+              fun describe(value: Int): String {
+                  return "value "
+              }
+          }
+
+          fun test(): String {
+              let s: {I} = S()
+              return s.describe(double: 2).concat(s.describe(value: 1))
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("double value "),
+			result,
+		)
+	})
+
+	t.Run("default function and conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+
+              fun describe(value: Int): String {
+                  pre { value > 0 }
+              }
+
+              fun describe(double: Int): String {
+                  return "default double "
+              }
+          }
+
+          struct S: I {
+
+              fun describe(value: Int): String {
+                  return "value "
+              }
+          }
+
+          fun test(): String {
+              let s = S()
+              return s.describe(double: 2).concat(s.describe(value: 1))
+          }
+
+          fun testCondition(): String {
+              return S().describe(value: 0)
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("default double value "),
+			result,
+		)
+
+		_, err = inter.Invoke("testCondition")
+		RequireError(t, err)
+
+		var conditionErr interpreter.ConditionError
+		require.ErrorAs(t, err, &conditionErr)
+	})
+}
+
+func TestInterpretInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double: Int) {
+                  self.value = double * 2
+              }
+          }
+
+          fun test(): Int {
+              return S(value: 1).value + S(double: 10).value
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(21),
+			result,
+		)
+	})
+
+	t.Run("nested in contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              contract C {
+
+                  struct S {
+                      let value: Int
+
+                      init(value: Int) {
+                          self.value = value
+                      }
+
+                      init(double: Int) {
+                          self.value = double * 2
+                      }
+                  }
+
+                  let value: Int
+
+                  init() {
+                      self.value = S(double: 1).value
+                  }
+              }
+
+              fun test(): Int {
+                  return C.value + C.S(double: 10).value
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					ContractValueHandler: makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(22),
+			result,
+		)
+	})
+
+	t.Run("interface requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct interface I {
+              init(double: Int) {
+                  pre { double > 0 }
+              }
+          }
+
+          struct S: I {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double: Int) {
+                  self.value = double * 2
+              }
+          }
+
+          fun test(): Int {
+              return S(value: 0).value + S(double: 10).value
+          }
+
+          fun testCondition(): Int {
+              return S(double: 0).value
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(20),
+			result,
+		)
+
+		_, err = inter.Invoke("testCondition")
+		RequireError(t, err)
+
+		var conditionErr interpreter.ConditionError
+		require.ErrorAs(t, err, &conditionErr)
+	})
+}
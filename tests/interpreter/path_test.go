@@ -20,9 +20,11 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/interpreter"
@@ -148,3 +150,177 @@ func TestInterpretConvertStringToPath(t *testing.T) {
 		test(domain)
 	}
 }
+
+func TestInterpretExtendedPath(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("components", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let address: Address = 0x1
+          let id: UInt64 = 42
+          let x = /public/vault/\(address)/\(id)/\(Type<Int>())
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredExtendedPathValue(
+				common.PathDomainPublic,
+				"vault",
+				common.NewPathComponents(
+					common.NewAddressPathComponent(common.MustBytesToAddress([]byte{0x1})),
+					common.NewIntegerPathComponent(big.NewInt(42)),
+					common.NewTypePathComponent("Int"),
+				),
+			),
+			inter.Globals.Get("x").GetValue(inter),
+		)
+	})
+
+	t.Run("toString", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              return /storage/vault/\(-1).toString()
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue(`/storage/vault/\(-1)`),
+			result,
+		)
+	})
+
+	t.Run("identifier with separator", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x = StoragePath(identifier: "vault/1")!
+          let y = /storage/vault/\(1)
+          let equal = x == y
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredPathValue(common.PathDomainStorage, "vault/1"),
+			inter.Globals.Get("x").GetValue(inter),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.FalseValue,
+			inter.Globals.Get("equal").GetValue(inter),
+		)
+	})
+
+	t.Run("storage", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, getAccountValues := testAccount(t, address, true, nil, `
+          fun test(): [Int] {
+              let legacyPath = StoragePath(identifier: "vault/1")!
+              let extendedPath = /storage/vault/\(1)
+
+              account.storage.save(1, to: legacyPath)
+              account.storage.save(2, to: extendedPath)
+
+              return [
+                  account.storage.load<Int>(from: legacyPath)!,
+                  account.storage.load<Int>(from: extendedPath)!
+              ]
+          }
+
+          fun save() {
+              account.storage.save(3, to: /storage/vault/\(1))
+          }
+
+          fun paths(): [StoragePath] {
+              let paths: [StoragePath] = []
+              account.storage.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                  paths.append(path)
+                  return true
+              })
+              return paths
+          }
+        `, sema.Config{})
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+
+		_, err = inter.Invoke("save")
+		require.NoError(t, err)
+
+		components := common.NewPathComponents(
+			common.NewIntegerPathComponent(big.NewInt(1)),
+		)
+
+		assert.Equal(t,
+			map[storageKey]interpreter.Value{
+				{
+					address: address.ToAddress(),
+					domain:  common.PathDomainStorage.Identifier(),
+					key: interpreter.ExtendedPathAtreeValue{
+						Identifier: "vault",
+						Components: components,
+					},
+				}: interpreter.NewUnmeteredIntValueFromInt64(3),
+			},
+			getAccountValues(),
+		)
+
+		result, err = inter.Invoke("paths")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeStoragePath,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredExtendedPathValue(
+					common.PathDomainStorage,
+					"vault",
+					components,
+				),
+			),
+			result,
+		)
+	})
+}
@@ -0,0 +1,220 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretProfiler(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      struct S {
+          fun count(_ n: Int): Int {
+              var i = 0
+              while i < n {
+                  i = i + 1
+              }
+              return i
+          }
+      }
+
+      fun test(): Int {
+          let s = S()
+          return s.count(3)
+      }
+    `
+
+	newProfiledInterpreter := func(t *testing.T) (*interpreter.Interpreter, *interpreter.Profiler) {
+		profiler := interpreter.NewProfiler()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			code,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					Profiler: profiler,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		// Only profile the invocation, not the declarations of the program
+		profiler.Reset()
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		return inter, profiler
+	}
+
+	t.Run("computation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, profiler := newProfiledInterpreter(t)
+
+		computation := map[string]uint64{}
+
+		for _, sample := range profiler.Samples() {
+			if sample.Computation == 0 {
+				continue
+			}
+
+			k := sample.Kind
+			for _, frame := range sample.Frames {
+				assert.Equal(t, utils.TestLocation, frame.Location)
+				k += "|" + frame.Function
+			}
+			computation[k] += sample.Computation
+		}
+
+		assert.Equal(t,
+			map[string]uint64{
+				"Statement|test":              2,
+				"FunctionInvocation|test":     2,
+				"CreateCompositeValue|test":   1,
+				"TransferCompositeValue|test": 1,
+				"Statement|S.count|test":      3 + 3,
+				"Loop|S.count|test":           3,
+			},
+			computation,
+		)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, profiler := newProfiledInterpreter(t)
+
+		var found bool
+
+		for _, sample := range profiler.Samples() {
+			if sample.Kind != common.MemoryKindCompositeValueBase.String() {
+				continue
+			}
+
+			assert.Equal(t,
+				[]interpreter.ProfileFrame{
+					{
+						Location: utils.TestLocation,
+						Function: "test",
+						Line:     13,
+					},
+				},
+				sample.Frames,
+			)
+			assert.NotZero(t, sample.Memory)
+			found = true
+		}
+
+		assert.True(t, found)
+	})
+
+	t.Run("pprof", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, profiler := newProfiledInterpreter(t)
+
+		var buffer bytes.Buffer
+		err := profiler.WritePprof(&buffer)
+		require.NoError(t, err)
+
+		// NOTE: profile.Parse decompresses the gzip-compressed profile
+		decoded, err := profile.Parse(&buffer)
+		require.NoError(t, err)
+
+		require.NoError(t, decoded.CheckValid())
+
+		assert.Equal(t,
+			[]*profile.ValueType{
+				{Type: "computation", Unit: "count"},
+				{Type: "memory", Unit: "count"},
+			},
+			decoded.SampleType,
+		)
+		assert.Equal(t, "computation", decoded.DefaultSampleType)
+
+		computation := map[string]int64{}
+		memory := map[string]int64{}
+
+		for _, sample := range decoded.Sample {
+			require.Len(t, sample.Value, 2)
+
+			kinds := sample.Label["kind"]
+			require.Len(t, kinds, 1)
+
+			k := kinds[0]
+			for _, location := range sample.Location {
+				require.Len(t, location.Line, 1)
+				line := location.Line[0]
+
+				assert.Equal(t, utils.TestLocation.String(), line.Function.Filename)
+				assert.Equal(t, line.Function.Name, line.Function.SystemName)
+
+				k += fmt.Sprintf("|%s:%d", line.Function.Name, line.Line)
+			}
+
+			computation[k] += sample.Value[0]
+			memory[k] += sample.Value[1]
+		}
+
+		// Drop the kinds which only have memory samples
+		for k, value := range computation { //nolint:maprange
+			if value == 0 {
+				delete(computation, k)
+			}
+		}
+
+		assert.Equal(t,
+			map[string]int64{
+				"Statement|S.test.test:13":                  1,
+				"Statement|S.test.test:14":                  1,
+				"FunctionInvocation|S.test.test:13":         1,
+				"FunctionInvocation|S.test.test:14":         1,
+				"CreateCompositeValue|S.test.test:13":       1,
+				"TransferCompositeValue|S.test.test:13":     1,
+				"Statement|S.test.S.count:4|S.test.test:14": 1,
+				"Statement|S.test.S.count:5|S.test.test:14": 1,
+				"Statement|S.test.S.count:6|S.test.test:14": 3,
+				"Statement|S.test.S.count:8|S.test.test:14": 1,
+				"Loop|S.test.S.count:5|S.test.test:14":      1,
+				"Loop|S.test.S.count:6|S.test.test:14":      2,
+			},
+			computation,
+		)
+
+		assert.NotZero(t,
+			memory[common.MemoryKindCompositeValueBase.String()+"|S.test.test:13"],
+		)
+	})
+}
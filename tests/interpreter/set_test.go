@@ -0,0 +1,332 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func parseCheckAndInterpretWithSet(t *testing.T, code string) *interpreter.Interpreter {
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.SetConstructorFunction)
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	interpreter.Declare(baseActivation, stdlib.SetConstructorFunction)
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+			Config: &interpreter.Config{
+				BaseActivationHandler: func(common.Location) *interpreter.VariableActivation {
+					return baseActivation
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	return inter
+}
+
+func TestInterpretSet(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("construction", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          let s = Set<Int>([1, 2, 2, 3, 1])
+          let empty = Set<String>([])
+        `)
+
+		set := inter.Globals.Get("s").GetValue(inter).(*interpreter.SetValue)
+		assert.Equal(t, 3, set.Count())
+		assert.Equal(t,
+			interpreter.NewSetStaticType(nil, interpreter.PrimitiveStaticTypeInt),
+			set.StaticType(inter),
+		)
+
+		empty := inter.Globals.Get("empty").GetValue(inter).(*interpreter.SetValue)
+		assert.Equal(t, 0, empty.Count())
+	})
+
+	t.Run("insert, remove, contains", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          fun test(): [Bool] {
+              let s = Set<String>(["a"])
+              return [
+                  s.insert("b"),
+                  s.insert("b"),
+                  s.contains("a"),
+                  s.contains("b"),
+                  s.contains("c"),
+                  s.remove("a"),
+                  s.remove("a"),
+                  s.contains("a"),
+                  s.length == 1
+              ]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+				interpreter.FalseValue,
+				interpreter.FalseValue,
+				interpreter.TrueValue,
+			),
+			result,
+		)
+	})
+
+	t.Run("union, intersection, difference", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          let a = Set<Int>([1, 2, 3])
+          let b = Set<Int>([3, 4])
+
+          let union = a.union(b)
+          let intersection = a.intersection(b)
+          let difference = a.difference(b)
+
+          let unionIsCorrect = union == Set<Int>([1, 2, 3, 4])
+          let intersectionIsCorrect = intersection == Set<Int>([3])
+          let differenceIsCorrect = difference == Set<Int>([1, 2])
+
+          // operations do not mutate the operands
+          let operandsUnchanged = a.length == 3 && b.length == 2
+        `)
+
+		for _, name := range []string{
+			"unionIsCorrect",
+			"intersectionIsCorrect",
+			"differenceIsCorrect",
+			"operandsUnchanged",
+		} {
+			assert.Equal(t,
+				interpreter.TrueValue,
+				inter.Globals.Get(name).GetValue(inter),
+				name,
+			)
+		}
+	})
+
+	t.Run("equality", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          let equal = Set<Int>([1, 2]) == Set<Int>([2, 1])
+          let notEqual = Set<Int>([1, 2]) == Set<Int>([1, 3])
+          let differentLength = Set<Int>([1, 2]) == Set<Int>([1])
+        `)
+
+		assert.Equal(t, interpreter.TrueValue, inter.Globals.Get("equal").GetValue(inter))
+		assert.Equal(t, interpreter.FalseValue, inter.Globals.Get("notEqual").GetValue(inter))
+		assert.Equal(t, interpreter.FalseValue, inter.Globals.Get("differentLength").GetValue(inter))
+	})
+
+	t.Run("for-loop", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          fun test(): Int {
+              var sum = 0
+              for element in Set<Int>([1, 2, 3, 2]) {
+                  sum = sum + element
+              }
+              return sum
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(6), result)
+	})
+
+	t.Run("for-loop, reference", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          fun test(): Int {
+              let s = Set<Int>([1, 2, 3])
+              var sum = 0
+              for element in &s as &Set<Int> {
+                  sum = sum + element
+              }
+              return sum
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(6), result)
+	})
+
+	t.Run("mutation while iterating", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          fun test() {
+              let s = Set<Int>([1, 2, 3])
+              for element in &s as auth(Insert) &Set<Int> {
+                  s.insert(element + 10)
+              }
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		var mutationErr interpreter.ContainerMutatedDuringIterationError
+		require.ErrorAs(t, err, &mutationErr)
+	})
+
+	t.Run("copy semantics", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          fun test(): [Int] {
+              let a = Set<Int>([1])
+              let b = a
+              b.insert(2)
+              return [a.length, b.length]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+	})
+
+	t.Run("toArray", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          let array = Set<Int>([1, 1, 1]).toArray()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			inter.Globals.Get("array").GetValue(inter),
+		)
+	})
+
+	t.Run("enum elements", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          enum Color: UInt8 {
+              case red
+              case green
+          }
+
+          let s = Set<Color>([Color.red, Color.red, Color.green])
+          let length = s.length
+          let containsRed = s.contains(Color.red)
+        `)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(2), inter.Globals.Get("length").GetValue(inter))
+		assert.Equal(t, interpreter.TrueValue, inter.Globals.Get("containsRed").GetValue(inter))
+	})
+
+	t.Run("dynamic casting", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          let s: AnyStruct = Set<Int>([1])
+          let valid = s as? Set<Int>
+          let invalid = s as? Set<String>
+        `)
+
+		require.IsType(t, &interpreter.SomeValue{}, inter.Globals.Get("valid").GetValue(inter))
+		assert.Equal(t, interpreter.Nil, inter.Globals.Get("invalid").GetValue(inter))
+	})
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithSet(t, `
+          let s = Set<Int>([1])
+        `)
+
+		assert.Equal(t, "Set([1])", inter.Globals.Get("s").GetValue(inter).String())
+	})
+}
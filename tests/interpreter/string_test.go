@@ -936,3 +936,136 @@ func TestInterpretStringCount(t *testing.T) {
 		runTest(test)
 	}
 }
+
+func TestInterpretStringTemplate(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literals", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let c: Character = "c"
+              return "\("a") \(c) \(-1) \(2.5) \(Address(0x1)) \(/storage/foo)"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("a c -1 2.50000000 0x0000000000000001 /storage/foo"),
+			value,
+		)
+	})
+
+	t.Run("expressions", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let balance = 42
+              let name = "Alice"
+              return "balance of \(name): \(balance + 1)!\n"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("balance of Alice: 43!\n"),
+			value,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              return "a \("b \((1 + 2) * 3)") c"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("a b 9 c"),
+			value,
+		)
+	})
+
+	t.Run("toString function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+
+              fun toString(): String {
+                  return "S(\(self.id))"
+              }
+          }
+
+          fun test(): String {
+              return "value: \(S(id: 1))"
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("value: S(1)"),
+			value,
+		)
+	})
+
+	t.Run("evaluation order", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          var log = ""
+
+          fun next(_ s: String): String {
+              log = log.concat(s)
+              return s
+          }
+
+          fun test(): String {
+              let result = "\(next("a"))\(next("b"))\(next("c"))"
+              return log.concat(result)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("abcabc"),
+			value,
+		)
+	})
+}
@@ -26,8 +26,11 @@ import (
 
 	. "github.com/onflow/cadence/tests/utils"
 
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/interpreter"
 	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
 	"github.com/onflow/cadence/tests/checker"
 )
 
@@ -272,3 +275,288 @@ func TestInterpretSwitchStatement(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpretSwitchStatementPatterns(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("type pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let x: Int
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun match(_ value: AnyStruct): String {
+              switch value {
+              case let s as S:
+                  return "S ".concat(s.x.toString())
+              case let i as Int:
+                  return "Int ".concat(i.toString())
+              default:
+                  return "other"
+              }
+          }
+
+          fun test(): [String] {
+              return [
+                  match(S(x: 1)),
+                  match(2),
+                  match(true)
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredStringValue("S 1"),
+				interpreter.NewUnmeteredStringValue("Int 2"),
+				interpreter.NewUnmeteredStringValue("other"),
+			),
+			actual,
+		)
+	})
+
+	t.Run("resource type pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource interface I {}
+
+          resource R: I {
+              let id: Int
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          resource S: I {}
+
+          fun match(_ value: @{I}): Int {
+              switch <-value {
+              case let r as @R:
+                  let id = r.id
+                  destroy r
+                  return id
+              case let other as @{I}:
+                  destroy other
+                  return 0
+              }
+              return -1
+          }
+
+          fun test(): [Int] {
+              return [
+                  match(<-create R(id: 42)),
+                  match(<-create S())
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(42),
+				interpreter.NewUnmeteredIntValueFromInt64(0),
+			),
+			actual,
+		)
+	})
+
+	t.Run("optional pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let x: Int
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(_ value: Int?): Int {
+              let s: S? = value.map(fun (x: Int): S { return S(x: x) })
+              switch s {
+              case nil:
+                  return 0
+              case let s?:
+                  return s.x
+              }
+              return -1
+          }
+        `)
+
+		for argument, expected := range map[interpreter.Value]interpreter.Value{
+			interpreter.Nil: interpreter.NewUnmeteredIntValueFromInt64(0),
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+			): interpreter.NewUnmeteredIntValueFromInt64(3),
+		} {
+			actual, err := inter.Invoke("test", argument)
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, expected, actual)
+		}
+	})
+
+	t.Run("range pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+		baseValueActivation.DeclareValue(stdlib.InclusiveRangeConstructorFunction)
+
+		baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+		interpreter.Declare(baseActivation, stdlib.InclusiveRangeConstructorFunction)
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              fun test(_ value: Int): String {
+                  switch value {
+                  case in InclusiveRange(0, 9):
+                      return "digit"
+                  case in InclusiveRange(10, 100, step: 10):
+                      return "tens"
+                  }
+                  return "other"
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				CheckerConfig: &sema.Config{
+					BaseValueActivationHandler: func(common.Location) *sema.VariableActivation {
+						return baseValueActivation
+					},
+				},
+				Config: &interpreter.Config{
+					BaseActivationHandler: func(common.Location) *interpreter.VariableActivation {
+						return baseActivation
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		for argument, expected := range map[int64]string{
+			5:   "digit",
+			30:  "tens",
+			35:  "other",
+			100: "tens",
+			-1:  "other",
+		} {
+			actual, err := inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(argument))
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue(expected), actual)
+		}
+	})
+
+	t.Run("guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ value: Int?): String {
+              switch value {
+              case let x? where x > 10:
+                  return "large"
+              case let x? where x < 0:
+                  return "negative"
+              case let x?:
+                  return "small"
+              default:
+                  return "none"
+              }
+          }
+        `)
+
+		for argument, expected := range map[interpreter.Value]string{
+			interpreter.Nil: "none",
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(11),
+			): "large",
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(-1),
+			): "negative",
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(5),
+			): "small",
+		} {
+			actual, err := inter.Invoke("test", argument)
+			require.NoError(t, err)
+
+			AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue(expected), actual)
+		}
+	})
+
+	t.Run("enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(): [Int] {
+              let results: [Int] = []
+              for e in [E.a, E.b] {
+                  switch e {
+                  case E.a:
+                      results.append(1)
+                  case E.b:
+                      results.append(2)
+                  }
+              }
+              return results
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			actual,
+		)
+	})
+}
@@ -0,0 +1,385 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+// 2020-03-13T19:52:43Z
+const testUnixSeconds = 1584129163
+
+func TestInterpretTimestamp(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literal", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: Timestamp = "2020-03-13T19:52:43Z"
+          let b: Timestamp = "2020-03-13T19:52:43.25"
+          let c: Timestamp = "1969-12-31T23:59:59.5Z"
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(testUnixSeconds*sema.Fix64Factor),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(testUnixSeconds*sema.Fix64Factor+25000000),
+			inter.Globals.Get("b").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(-50000000),
+			inter.Globals.Get("c").GetValue(inter),
+		)
+	})
+
+	t.Run("conversion", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = Timestamp(1584129163)
+          let b = Timestamp(UInt64(1584129163))
+          let c = Timestamp(1584129163.5)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(testUnixSeconds*sema.Fix64Factor),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(testUnixSeconds*sema.Fix64Factor),
+			inter.Globals.Get("b").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(testUnixSeconds*sema.Fix64Factor+50000000),
+			inter.Globals.Get("c").GetValue(inter),
+		)
+	})
+
+	t.Run("conversion, overflow", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Timestamp {
+              return Timestamp(UInt64.max)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("fromString", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = Timestamp.fromString("2020-03-13T19:52:43Z")
+          let b = Timestamp.fromString("2020-03-13T19:52:43+01:00")
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredTimestampValue(testUnixSeconds*sema.Fix64Factor),
+			),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.Nil,
+			inter.Globals.Get("b").GetValue(inter),
+		)
+	})
+
+	t.Run("members", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let timestamp: Timestamp = "2020-03-13T19:52:43.5Z"
+          let a = timestamp.unixSeconds
+          let b = timestamp.toString()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix64Value(testUnixSeconds*sema.Fix64Factor+50000000),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("2020-03-13T19:52:43.5Z"),
+			inter.Globals.Get("b").GetValue(inter),
+		)
+	})
+
+	t.Run("comparison", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a: Timestamp = "2020-03-13T19:52:43Z"
+          let b: Timestamp = "2020-03-13T19:52:44Z"
+          let c = a < b
+          let d = a == Timestamp(1584129163)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			inter.Globals.Get("c").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TrueValue,
+			inter.Globals.Get("d").GetValue(inter),
+		)
+	})
+
+	t.Run("dictionary key", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let a: Timestamp = "2020-03-13T19:52:43Z"
+              let dict: {Timestamp: Int} = {a: 1}
+              return dict[Timestamp(1584129163)]!
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			result,
+		)
+	})
+}
+
+func TestInterpretDuration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("constants", func(t *testing.T) {
+		t.Parallel()
+
+		for _, constant := range sema.DurationConstants {
+			t.Run(constant.Name, func(t *testing.T) {
+				t.Parallel()
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`let a = Duration.%s`,
+						constant.Name,
+					),
+				)
+
+				AssertValuesEqual(
+					t,
+					inter,
+					interpreter.NewUnmeteredDurationValue(constant.Seconds*sema.Fix64Factor),
+					inter.Globals.Get("a").GetValue(inter),
+				)
+			})
+		}
+	})
+
+	t.Run("members", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = Duration.hour.seconds
+          let b = Duration(-1.5).toString()
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix64Value(3600*sema.Fix64Factor),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("-1.50000000"),
+			inter.Globals.Get("b").GetValue(inter),
+		)
+	})
+
+	t.Run("negation", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = -Duration.minute
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredDurationValue(-60*sema.Fix64Factor),
+			inter.Globals.Get("a").GetValue(inter),
+		)
+	})
+}
+
+func TestInterpretTimestampAndDurationArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      let timestamp: Timestamp = "2020-03-13T19:52:43Z"
+      let other: Timestamp = "2020-03-14T19:52:43Z"
+    `
+
+	tests := map[string]interpreter.Value{
+		"timestamp + Duration.day": interpreter.NewUnmeteredTimestampValue(
+			(testUnixSeconds + 86400) * sema.Fix64Factor,
+		),
+		"timestamp - Duration(0.5)": interpreter.NewUnmeteredTimestampValue(
+			testUnixSeconds*sema.Fix64Factor - 50000000,
+		),
+		"other - timestamp": interpreter.NewUnmeteredDurationValue(
+			86400 * sema.Fix64Factor,
+		),
+		"timestamp - other": interpreter.NewUnmeteredDurationValue(
+			-86400 * sema.Fix64Factor,
+		),
+		"Duration.hour + Duration.minute": interpreter.NewUnmeteredDurationValue(
+			3660 * sema.Fix64Factor,
+		),
+		"Duration.hour - Duration.minute": interpreter.NewUnmeteredDurationValue(
+			3540 * sema.Fix64Factor,
+		),
+		"Duration.day * 7": interpreter.NewUnmeteredDurationValue(
+			7 * 86400 * sema.Fix64Factor,
+		),
+		"Duration.minute / 4": interpreter.NewUnmeteredDurationValue(
+			15 * sema.Fix64Factor,
+		),
+	}
+
+	for expression, expected := range tests {
+		t.Run(expression, func(t *testing.T) {
+			t.Parallel()
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      %s
+                      let result = %s
+                    `,
+					declarations,
+					expression,
+				),
+			)
+
+			AssertValuesEqual(
+				t,
+				inter,
+				expected,
+				inter.Globals.Get("result").GetValue(inter),
+			)
+		})
+	}
+
+	t.Run("compound assignment", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Timestamp {
+              var timestamp: Timestamp = "2020-03-13T19:52:43Z"
+              var duration = Duration.hour
+              duration *= 2
+              timestamp += duration
+              return timestamp
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue((testUnixSeconds+7200)*sema.Fix64Factor),
+			result,
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Duration {
+              return Duration.year * Int64.max
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Duration {
+              return Duration.day / 0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+}
@@ -0,0 +1,586 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/activations"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/stdlib"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func parseCheckAndInterpretWithPanic(
+	t *testing.T,
+	code string,
+	config *interpreter.Config,
+) *interpreter.Interpreter {
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.PanicFunction)
+
+	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
+	interpreter.Declare(baseActivation, stdlib.PanicFunction)
+
+	if config == nil {
+		config = &interpreter.Config{}
+	}
+	config.BaseActivationHandler = func(_ common.Location) *interpreter.VariableActivation {
+		return baseActivation
+	}
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerConfig: &sema.Config{
+				BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+					return baseValueActivation
+				},
+			},
+			Config: config,
+		},
+	)
+	require.NoError(t, err)
+
+	return inter
+}
+
+func TestInterpretTryStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [Int] {
+              var x = 1
+              var caught = 0
+              try {
+                  x = 2
+              } catch {
+                  caught = 1
+              }
+              return [x, caught]
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(0),
+			),
+			value,
+		)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [Int] {
+              var x = 1
+              var caught = 0
+              try {
+                  x = 2
+                  panic("failed")
+              } catch {
+                  caught = x
+              }
+              return [x, caught]
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("failed pre-condition in function call", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          var x = 1
+
+          fun update(_ newX: Int) {
+              pre { newX > 0 }
+              x = newX
+          }
+
+          fun test(): Int {
+              try {
+                  update(2)
+                  update(0)
+              } catch {}
+              return x
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("return", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): Int {
+              try {
+                  return 1
+              } catch {
+                  return 2
+              }
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("failure in catch block", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test() {
+              try {
+                  panic("first")
+              } catch {
+                  panic("second")
+              }
+          }
+        `, nil)
+
+		_, err := inter.Invoke("test")
+		RequireError(t, err)
+
+		var panicErr stdlib.PanicError
+		require.ErrorAs(t, err, &panicErr)
+		assert.Equal(t, "second", panicErr.Message)
+	})
+}
+
+func TestInterpretTryStatementContainers(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [Int] {
+              let xs = [1, 2, 3]
+              try {
+                  xs.append(4)
+                  xs.insert(at: 0, 0)
+                  xs.remove(at: 2)
+                  xs[1] = 10
+                  panic("failed")
+              } catch {}
+              return xs
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+			),
+			value,
+		)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [Int?] {
+              let xs = {"a": 1, "b": 2}
+              try {
+                  xs["a"] = 10
+                  xs["c"] = 3
+                  xs.remove(key: "b")
+                  panic("failed")
+              } catch {}
+              return [xs["a"], xs["b"], xs["c"]]
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: &interpreter.OptionalStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(2),
+				),
+				interpreter.Nil,
+			),
+			value,
+		)
+	})
+
+	t.Run("nested containers", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [[Int]] {
+              let xs = {"a": [1]}
+              try {
+                  xs["a"]!.append(2)
+                  xs.remove(key: "a")
+                  panic("failed")
+              } catch {}
+              return xs.values
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: &interpreter.VariableSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+				},
+				common.ZeroAddress,
+				interpreter.NewArrayValue(
+					inter,
+					interpreter.EmptyLocationRange,
+					&interpreter.VariableSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+					common.ZeroAddress,
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+			),
+			value,
+		)
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          struct S {
+              var x: Int
+              init() {
+                  self.x = 1
+              }
+              fun setX(_ x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(): Int {
+              let s = S()
+              try {
+                  s.setX(2)
+                  panic("failed")
+              } catch {}
+              return s.x
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+}
+
+func TestInterpretTryStatementResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("moved and destroyed", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          resource R {
+              let id: Int
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(): [Int] {
+              let rs <- [<-create R(id: 1), <-create R(id: 2)]
+              let ref = &rs[0] as &R
+              try {
+                  let r <- rs.removeFirst()
+                  destroy r
+                  panic("failed")
+              } catch {}
+              let ids = [rs.length, rs[0].id, ref.id]
+              destroy rs
+              return ids
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("moved in both blocks", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          resource R {}
+
+          fun test(): Int {
+              let r <- create R()
+              let rs: @[R] <- []
+              var result = 0
+              try {
+                  rs.append(<-r)
+                  panic("failed")
+              } catch {
+                  destroy r
+                  result = rs.length
+              }
+              destroy rs
+              return result
+          }
+        `, nil)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(0),
+			value,
+		)
+	})
+}
+
+func TestInterpretNestedTryStatements(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpretWithPanic(t, `
+      fun test(): [Int] {
+          let xs: [Int] = []
+          try {
+              xs.append(1)
+              try {
+                  xs.append(2)
+                  panic("inner")
+              } catch {
+                  xs.append(3)
+              }
+              try {
+                  xs.append(4)
+              } catch {}
+              panic("outer")
+          } catch {
+              xs.append(5)
+          }
+          try {
+              xs.append(6)
+              try {
+                  xs.append(7)
+              } catch {}
+          } catch {}
+          return xs
+      }
+    `, nil)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.ZeroAddress,
+			interpreter.NewUnmeteredIntValueFromInt64(5),
+			interpreter.NewUnmeteredIntValueFromInt64(6),
+			interpreter.NewUnmeteredIntValueFromInt64(7),
+		),
+		value,
+	)
+}
+
+func TestInterpretTryStatementEvents(t *testing.T) {
+
+	t.Parallel()
+
+	var events []*interpreter.CompositeValue
+
+	inter := parseCheckAndInterpretWithPanic(t, `
+      event E(x: Int)
+
+      fun test() {
+          emit E(x: 1)
+          try {
+              emit E(x: 2)
+              panic("failed")
+          } catch {
+              emit E(x: 3)
+          }
+          try {
+              emit E(x: 4)
+          } catch {}
+      }
+    `, &interpreter.Config{
+		OnEventEmitted: func(
+			_ *interpreter.Interpreter,
+			_ interpreter.LocationRange,
+			event *interpreter.CompositeValue,
+			_ *sema.CompositeType,
+		) error {
+			events = append(events, event)
+			return nil
+		},
+	})
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	var xs []interpreter.Value
+	for _, event := range events {
+		xs = append(xs, event.GetField(inter, interpreter.EmptyLocationRange, "x"))
+	}
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+		},
+		xs,
+	)
+}
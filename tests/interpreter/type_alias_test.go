@@ -0,0 +1,163 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestInterpretTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("program scope", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          typealias Numbers = [Int]
+
+          fun test(): Numbers {
+              let numbers: Numbers = [1, 2]
+              return numbers
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+	})
+
+	t.Run("run-time types", func(t *testing.T) {
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          typealias Balances = {String: UFix64}
+
+          fun test(): [Bool] {
+              typealias Amount = UFix64
+
+              let balances: AnyStruct = {"a": 1.0}
+              let amount: AnyStruct = 2.0
+
+              return [
+                  Type<Balances>() == Type<{String: UFix64}>(),
+                  balances.getType() == Type<Balances>(),
+                  (balances as? Balances) != nil,
+                  (amount as? Amount) != nil,
+                  amount.isInstance(Type<Amount>())
+              ]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				&interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.ZeroAddress,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+				interpreter.TrueValue,
+			),
+			result,
+		)
+	})
+
+	t.Run("contract scope", func(t *testing.T) {
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              contract C {
+
+                  typealias Ref = &R
+
+                  resource R {
+                      access(all) let id: Int
+
+                      init(id: Int) {
+                          self.id = id
+                      }
+                  }
+
+                  access(all) fun createR(id: Int): @R {
+                      return <-create R(id: id)
+                  }
+
+                  access(all) fun id(_ ref: Ref): Int {
+                      return ref.id
+                  }
+              }
+
+              fun test(): Int {
+                  let r <- C.createR(id: 42)
+                  let id = C.id(&r as &C.R)
+                  destroy r
+                  return id
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Config: &interpreter.Config{
+					ContractValueHandler: makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			result,
+		)
+	})
+}
@@ -2113,6 +2113,48 @@ func TestRuntimeParseAndCheckProgram(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("Warnings", func(t *testing.T) {
+		runtime := NewTestInterpreterRuntime()
+
+		script := []byte(`
+          access(all) contract C {
+
+              access(all) enum E: UInt8 {
+                  access(all) case a
+                  access(all) case b
+              }
+
+              access(all) fun test(e: E): Int {
+                  switch e {
+                  case E.a:
+                      return 1
+                  }
+                  return 0
+              }
+          }
+        `)
+		runtimeInterface := &TestRuntimeInterface{}
+
+		program, err := runtime.ParseAndCheckProgram(
+			script,
+			Context{
+				Interface: runtimeInterface,
+				Location: common.AddressLocation{
+					Address: common.MustBytesToAddress([]byte{0x1}),
+					Name:    "C",
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		warnings := program.Elaboration.Warnings
+		require.Len(t, warnings, 1)
+
+		var switchWarning *sema.NonExhaustiveSwitchWarning
+		require.ErrorAs(t, warnings[0], &switchWarning)
+		assert.Equal(t, []string{"b"}, switchWarning.MissingCases)
+	})
+
 	t.Run("InvalidSemantics", func(t *testing.T) {
 		runtime := NewTestInterpreterRuntime()
 
@@ -4887,6 +4929,81 @@ func TestRuntimeTransactionTopLevelDeclarations(t *testing.T) {
 
 		assert.IsType(t, &sema.InvalidTopLevelDeclarationError{}, errs[0])
 	})
+
+	t.Run("transaction with type alias", func(t *testing.T) {
+		runtime := NewTestInterpreterRuntime()
+
+		script := []byte(`
+          access(all) typealias Numbers = [Int]
+
+          transaction(numbers: Numbers) {}
+        `)
+
+		runtimeInterface := &TestRuntimeInterface{
+			OnGetSigningAccounts: func() ([]Address, error) {
+				return nil, nil
+			},
+			OnDecodeArgument: func(b []byte, t cadence.Type) (value cadence.Value, err error) {
+				return json.Decode(nil, b)
+			},
+		}
+
+		nextTransactionLocation := NewTransactionLocationGenerator()
+
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: script,
+				Arguments: encodeArgs([]cadence.Value{
+					cadence.NewArray([]cadence.Value{
+						cadence.NewInt(1),
+					}),
+				}),
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	})
+}
+
+func TestRuntimeScriptTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	script := []byte(`
+      access(all) typealias Numbers = [Int]
+
+      access(all) fun main(): Numbers {
+          return [1, 2]
+      }
+    `)
+
+	runtimeInterface := &TestRuntimeInterface{}
+
+	value, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	// Type aliases are transparent, i.e. the exported type is the aliased type
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+		}).WithType(cadence.NewVariableSizedArrayType(cadence.IntType)),
+		value,
+	)
 }
 
 func TestRuntimeStoreIntegerTypes(t *testing.T) {
@@ -9073,12 +9190,25 @@ func TestRuntimeTypesAndConversions(t *testing.T) {
 	}
 
 	for name, ty := range checker.AllBaseSemaTypes() {
-		// Inclusive range is a dynamically created type.
-		if _, isInclusiveRange := ty.(*sema.InclusiveRangeType); isInclusiveRange {
+		// Inclusive range and set are dynamically created types.
+		// The base types have no member/element type, so they cannot be converted.
+		// Instantiations of the set type are tested below.
+		switch ty.(type) {
+		case *sema.InclusiveRangeType, *sema.SetType:
 			continue
 		}
 		test(name, ty)
 	}
+
+	for _, elementType := range []sema.Type{
+		sema.IntType,
+		sema.StringType,
+		sema.TheAddressType,
+		sema.PathType,
+	} {
+		ty := sema.NewSetType(nil, elementType)
+		test(ty.QualifiedString(), ty)
+	}
 }
 
 func TestRuntimeEventEmission(t *testing.T) {
@@ -78,6 +78,12 @@ type TestRuntimeInterface struct {
 		oldAddress common.Address,
 		newAddress common.Address,
 	)
+	OnOverlappingBorrow func(
+		interpreter *interpreter.Interpreter,
+		locationRange interpreter.LocationRange,
+		address common.Address,
+		path interpreter.PathValue,
+	)
 	OnGenerateUUID       func() (uint64, error)
 	OnMeterComputation   func(compKind common.ComputationKind, intensity uint) error
 	OnDecodeArgument     func(b []byte, t cadence.Type) (cadence.Value, error)
@@ -364,6 +370,22 @@ func (i *TestRuntimeInterface) ResourceOwnerChanged(
 	}
 }
 
+func (i *TestRuntimeInterface) OverlappingBorrow(
+	interpreter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	address common.Address,
+	path interpreter.PathValue,
+) {
+	if i.OnOverlappingBorrow != nil {
+		i.OnOverlappingBorrow(
+			interpreter,
+			locationRange,
+			address,
+			path,
+		)
+	}
+}
+
 func (i *TestRuntimeInterface) GenerateUUID() (uint64, error) {
 	if i.OnGenerateUUID == nil {
 		i.lastUUID++
@@ -2959,6 +2959,125 @@ func TestRuntimeStorageEnumCase(t *testing.T) {
 	)
 }
 
+// TestRuntimeStorageDistinctType tests the writing of values of distinct types to storage,
+// and reading them back from storage, keeping their distinct type.
+func TestRuntimeStorageDistinctType(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	accountCodes := map[Location][]byte{}
+	var loggedMessages []string
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+		OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+			accountCodes[location] = code
+			return nil
+		},
+		OnGetAccountContractCode: func(location common.AddressLocation) (code []byte, err error) {
+			code = accountCodes[location]
+			return code, nil
+		},
+		OnEmitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		OnProgramLog: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	// Deploy contract
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: DeploymentTransaction(
+				"C",
+				[]byte(`
+                  access(all) contract C {
+
+                    access(all) type PersonID = distinct String
+
+                    access(all) type Score = distinct UInt64 with operations
+                  }
+                `),
+			),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Store values of distinct types
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      let scores: {C.PersonID: C.Score} = {
+                          C.PersonID("alice"): C.Score(1) + C.Score(2)
+                      }
+                      signer.storage.save(scores, to: /storage/scores)
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Load values of distinct types
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: auth(Storage) &Account) {
+                      let scores = signer.storage.load<{C.PersonID: C.Score}>(from: /storage/scores)!
+                      let score = scores[C.PersonID("alice")]!
+                      log(score.getType().identifier)
+                      log(score.toUnderlying())
+                      log(scores.keys[0].getType().identifier)
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{
+			`"A.0000000000000001.C.Score"`,
+			"3",
+			`"A.0000000000000001.C.PersonID"`,
+		},
+		loggedMessages,
+	)
+}
+
 func TestRuntimeStorageReadNoImplicitWrite(t *testing.T) {
 
 	t.Parallel()
@@ -0,0 +1,242 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	. "github.com/onflow/cadence/runtime"
+	. "github.com/onflow/cadence/tests/runtime_utils"
+	. "github.com/onflow/cadence/tests/utils"
+)
+
+func TestRuntimeTryStatementStorage(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	signerAddress := common.MustBytesToAddress([]byte{0x42})
+
+	deployTx := DeploymentTransaction("Test", []byte(`
+      access(all) contract Test {
+
+          access(all) event Stored(id: Int)
+
+          access(all) resource R {
+              access(all) let id: Int
+              access(all) let data: [String]
+
+              init(id: Int) {
+                  self.id = id
+                  self.data = ["a", "b", "c"]
+              }
+          }
+
+          access(all) fun createR(id: Int): @R {
+              return <-create R(id: id)
+          }
+
+          access(all) fun emitStored(id: Int) {
+              emit Stored(id: id)
+          }
+      }
+    `))
+
+	accountCodes := map[Location][]byte{}
+	var events []cadence.Event
+	var loggedMessages []string
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{signerAddress}, nil
+		},
+		OnResolveLocation: NewSingleIdentifierLocationResolver(t),
+		OnUpdateAccountContractCode: func(location common.AddressLocation, code []byte) error {
+			accountCodes[location] = code
+			return nil
+		},
+		OnGetAccountContractCode: func(location common.AddressLocation) (code []byte, err error) {
+			code = accountCodes[location]
+			return code, nil
+		},
+		OnEmitEvent: func(event cadence.Event) error {
+			events = append(events, event)
+			return nil
+		},
+		OnProgramLog: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := NewTransactionLocationGenerator()
+
+	// Deploy contract
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deployTx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Store resources, some of them in failing try blocks
+
+	const storeTx = `
+      import Test from 0x42
+
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              signer.storage.save(<-[<-Test.createR(id: 1)], to: /storage/rs)
+
+              let rs = signer.storage.borrow<auth(Mutate) &[Test.R]>(from: /storage/rs)!
+
+              for id in [2, 3, 4] {
+                  try {
+                      rs.append(<-Test.createR(id: id))
+                      signer.storage.save(<-Test.createR(id: id), to: /storage/r)
+                      Test.emitStored(id: id)
+                      if id == 3 {
+                          panic("failed")
+                      }
+                      destroy signer.storage.load<@Test.R>(from: /storage/r)
+                  } catch {
+                      log(id)
+                  }
+              }
+
+              try {
+                  destroy signer.storage.load<@[Test.R]>(from: /storage/rs)
+                  panic("failed")
+              } catch {}
+          }
+      }
+    `
+
+	events = nil
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(storeTx),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"3"}, loggedMessages)
+
+	require.Len(t, events, 2)
+	assert.Equal(t,
+		[]cadence.Value{cadence.NewInt(2), cadence.NewInt(4)},
+		[]cadence.Value{
+			events[0].SearchFieldByName("id"),
+			events[1].SearchFieldByName("id"),
+		},
+	)
+
+	// Check the stored resources
+
+	const checkTx = `
+      import Test from 0x42
+
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              assert(signer.storage.type(at: /storage/r) == nil)
+
+              let rs <- signer.storage.load<@[Test.R]>(from: /storage/rs)!
+              assert(rs.length == 3)
+              assert(rs[0].id == 1)
+              assert(rs[1].id == 2)
+              assert(rs[2].id == 4)
+              assert(rs[2].data.length == 3)
+              destroy rs
+          }
+      }
+    `
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(checkTx),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestRuntimeTryStatementNonRevertibleOperation(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewTestInterpreterRuntime()
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+		OnGetSigningAccounts: func() ([]Address, error) {
+			return []Address{{0x1}}, nil
+		},
+		OnCreateAccount: func(payer Address) (address Address, err error) {
+			return Address{0x2}, nil
+		},
+		OnEmitEvent: func(event cadence.Event) error {
+			return nil
+		},
+	}
+
+	const tx = `
+      transaction {
+          prepare(signer: auth(Storage) &Account) {
+              try {
+                  Account(payer: signer)
+              } catch {}
+          }
+      }
+    `
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(tx),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.TransactionLocation{},
+		},
+	)
+	RequireError(t, err)
+
+	var nonRevertibleErr interpreter.NonRevertibleOperationError
+	require.ErrorAs(t, err, &nonRevertibleErr)
+	assert.Equal(t, "create account", nonRevertibleErr.Operation)
+}
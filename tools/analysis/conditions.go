@@ -0,0 +1,525 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/tools/analysis/lia"
+)
+
+// ConditionsAnalyzer statically verifies pre- and post-conditions of functions.
+//
+// Functions are executed symbolically: integer and boolean values are translated
+// into terms and formulas of linear integer arithmetic, and the verification conditions
+// are decided by the decision procedure of the lia package.
+//
+// Post-conditions are verified on all paths through a function.
+// Pre-conditions of functions declared in the same program are verified where the functions are invoked.
+// View functions declared in the same program are inlined,
+// `before` expressions are evaluated using the post-condition rewrite produced by sema.BeforeExtractor,
+// and the fields of `self` are tracked.
+//
+// Unsupported expressions are abstracted to unconstrained values.
+// A condition is only reported if it may fail with a counterexample
+// which does not depend on such an abstraction.
+// Functions with unsupported statements, e.g. loops, are not verified.
+//
+// The analyzer requires the programs to be loaded with NeedTypes and NeedExtendedElaboration.
+var ConditionsAnalyzer = &Analyzer{
+	Description: "Statically verifies pre- and post-conditions of functions",
+	Run: func(pass *Pass) interface{} {
+		if pass.Program.Checker == nil {
+			return nil
+		}
+
+		newConditionsVerifier(pass).verifyProgram()
+
+		return nil
+	},
+}
+
+const (
+	ConditionsCategory = "verification"
+
+	PreConditionMayFailCode  = "pre-condition-may-fail"
+	PostConditionMayFailCode = "post-condition-may-fail"
+)
+
+const (
+	// conditionsMaxPaths bounds the number of paths through a function
+	conditionsMaxPaths = 64
+	// conditionsMaxInlineDepth bounds the depth of inlined invocations
+	conditionsMaxInlineDepth = 4
+)
+
+// conditionsInput is an input of the verified function,
+// i.e. a parameter or the initial value of a field of `self`
+type conditionsInput struct {
+	name    string
+	boolean bool
+}
+
+type conditionsVerifier struct {
+	pass            *Pass
+	elaboration     *sema.Elaboration
+	globalFunctions map[string][]*ast.FunctionDeclaration
+
+	// The state of the verification of the current function
+
+	function    *ast.FunctionDeclaration
+	composite   *ast.CompositeDeclaration
+	inputs      []conditionsInput
+	inputValues map[string]symbolicValue
+	// domains are the formulas which constrain variables to the values of their types
+	domains             []lia.Formula
+	abstractVariables   map[string]struct{}
+	variableCount       int
+	inlined             []*ast.FunctionDeclaration
+	reportedInvocations map[*ast.InvocationExpression]struct{}
+	diagnostics         []Diagnostic
+}
+
+func newConditionsVerifier(pass *Pass) *conditionsVerifier {
+	globalFunctions := map[string][]*ast.FunctionDeclaration{}
+	for _, declaration := range pass.Program.Program.FunctionDeclarations() {
+		name := declaration.Identifier.Identifier
+		globalFunctions[name] = append(globalFunctions[name], declaration)
+	}
+
+	return &conditionsVerifier{
+		pass:            pass,
+		elaboration:     pass.Program.Checker.Elaboration,
+		globalFunctions: globalFunctions,
+	}
+}
+
+func (v *conditionsVerifier) verifyProgram() {
+	program := v.pass.Program.Program
+
+	for _, declaration := range program.FunctionDeclarations() {
+		v.verifyFunction(declaration, nil)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		v.verifyComposite(declaration)
+	}
+}
+
+func (v *conditionsVerifier) verifyComposite(declaration *ast.CompositeDeclaration) {
+	for _, function := range declaration.Members.Functions() {
+		v.verifyFunction(function, declaration)
+	}
+
+	for _, nestedDeclaration := range declaration.Members.Composites() {
+		v.verifyComposite(nestedDeclaration)
+	}
+}
+
+func (v *conditionsVerifier) verifyFunction(
+	declaration *ast.FunctionDeclaration,
+	composite *ast.CompositeDeclaration,
+) {
+	functionBlock := declaration.FunctionBlock
+	if functionBlock == nil || declaration.ParameterList == nil {
+		return
+	}
+
+	functionType := v.elaboration.FunctionDeclarationFunctionType(declaration)
+	if functionType == nil ||
+		len(functionType.Parameters) != len(declaration.ParameterList.Parameters) {
+
+		return
+	}
+
+	v.function = declaration
+	v.composite = composite
+	v.inputs = nil
+	v.inputValues = map[string]symbolicValue{}
+	v.domains = nil
+	v.abstractVariables = map[string]struct{}{}
+	v.variableCount = 0
+	v.inlined = nil
+	v.reportedInvocations = map[*ast.InvocationExpression]struct{}{}
+	v.diagnostics = nil
+
+	state := newSymbolicState()
+
+	for i, parameter := range declaration.ParameterList.Parameters {
+		name := parameter.Identifier.Identifier
+		parameterType := functionType.Parameters[i].TypeAnnotation.Type
+		state.declare(name, v.input(name, parameterType))
+	}
+
+	// The pre-conditions of the function can be assumed
+
+	if functionBlock.PreConditions != nil {
+		for _, condition := range functionBlock.PreConditions.Conditions {
+			testCondition, ok := condition.(*ast.TestCondition)
+			if !ok {
+				continue
+			}
+			state.assume(v.evaluateCondition(state, testCondition.Test))
+		}
+	}
+
+	// Evaluate the `before` expressions of the post-conditions in the initial state
+
+	states := []*symbolicState{state}
+
+	var rewrittenPostConditions []ast.Condition
+
+	if !functionBlock.PostConditions.IsEmpty() {
+		rewrite := v.elaboration.PostConditionsRewrite(functionBlock.PostConditions)
+
+		var ok bool
+		states, ok = v.executeStatements(states, rewrite.BeforeStatements)
+		if !ok || len(states) != 1 {
+			return
+		}
+
+		rewrittenPostConditions = rewrite.RewrittenPostConditions
+	}
+
+	// The post-conditions may only refer to the parameters, the `before` expressions, and the result
+
+	initialScope := states[0].copy().scopes[0]
+
+	states, ok := v.executeBlock(states, functionBlock.Block)
+	if !ok {
+		return
+	}
+
+	returnType := functionType.ReturnTypeAnnotation.Type
+
+	for i, condition := range rewrittenPostConditions {
+		testCondition, ok := condition.(*ast.TestCondition)
+		if !ok {
+			continue
+		}
+
+		originalCondition, ok := functionBlock.PostConditions.Conditions[i].(*ast.TestCondition)
+		if !ok {
+			continue
+		}
+
+		for _, finalState := range states {
+			postState := finalState.copy()
+			postState.scopes = []map[string]symbolicValue{initialScope, {}}
+
+			if returnType != nil && returnType != sema.VoidType {
+				result := finalState.returnValue
+				if result == nil {
+					result = symbolicUnknown{}
+				}
+				postState.declare(sema.ResultIdentifier, result)
+			}
+
+			formula := v.evaluateCondition(postState, testCondition.Test)
+
+			counterexample, ok := v.counterexample(postState.assumptions, formula)
+			if !ok {
+				continue
+			}
+
+			v.diagnostics = append(
+				v.diagnostics,
+				Diagnostic{
+					Location:         v.pass.Program.Location,
+					Category:         ConditionsCategory,
+					Message:          "post-condition may fail",
+					SecondaryMessage: counterexample,
+					Code:             PostConditionMayFailCode,
+					Range:            ast.NewRangeFromPositioned(nil, originalCondition.Test),
+				},
+			)
+
+			break
+		}
+	}
+
+	for _, diagnostic := range v.diagnostics {
+		v.pass.Report(diagnostic)
+	}
+}
+
+// verifyPreCondition verifies that the given pre-condition of the given invoked function
+// holds under the given assumptions
+func (v *conditionsVerifier) verifyPreCondition(
+	assumptions []lia.Formula,
+	formula lia.Formula,
+	invocation *ast.InvocationExpression,
+	function *ast.FunctionDeclaration,
+) {
+	// Only report failing pre-conditions of invocations in the verified function itself,
+	// not of invocations in inlined functions
+
+	if len(v.inlined) > 0 {
+		return
+	}
+
+	if _, ok := v.reportedInvocations[invocation]; ok {
+		return
+	}
+
+	counterexample, ok := v.counterexample(assumptions, formula)
+	if !ok {
+		return
+	}
+
+	v.reportedInvocations[invocation] = struct{}{}
+
+	v.diagnostics = append(
+		v.diagnostics,
+		Diagnostic{
+			Location: v.pass.Program.Location,
+			Category: ConditionsCategory,
+			Message: fmt.Sprintf(
+				"pre-condition of function `%s` may fail",
+				function.Identifier.Identifier,
+			),
+			SecondaryMessage: counterexample,
+			Code:             PreConditionMayFailCode,
+			Range:            ast.NewRangeFromPositioned(nil, invocation),
+		},
+	)
+}
+
+// counterexample decides if the given condition may fail under the given assumptions.
+// If it may, a description of the inputs for which it fails is returned.
+func (v *conditionsVerifier) counterexample(assumptions []lia.Formula, condition lia.Formula) (string, bool) {
+	verificationCondition := make(lia.And, 0, len(v.domains)+len(assumptions)+1)
+	verificationCondition = append(verificationCondition, v.domains...)
+	verificationCondition = append(verificationCondition, assumptions...)
+	verificationCondition = append(verificationCondition, lia.Not{Formula: condition})
+
+	result, model := lia.Solve(verificationCondition)
+	if result != lia.ResultSatisfiable {
+		return "", false
+	}
+
+	if !verificationCondition.Evaluate(model) {
+		return "", false
+	}
+
+	// The counterexample might be spurious if it depends on abstracted values
+
+	variables := relevantVariables(assumptions, condition)
+	for name := range variables { //nolint:maprange
+		if _, ok := v.abstractVariables[name]; ok {
+			return "", false
+		}
+	}
+
+	var values []string
+	for _, input := range v.inputs {
+		if _, ok := variables[input.name]; !ok {
+			continue
+		}
+
+		value := model.Value(input.name)
+
+		var formattedValue string
+		if input.boolean {
+			formattedValue = strconv.FormatBool(value.Sign() != 0)
+		} else {
+			formattedValue = value.String()
+		}
+
+		values = append(values, fmt.Sprintf("%s = %s", input.name, formattedValue))
+	}
+
+	if len(values) == 0 {
+		return "the condition fails for all inputs", true
+	}
+
+	return "counterexample: " + strings.Join(values, ", "), true
+}
+
+// relevantVariables returns the variables of the given condition,
+// and the variables of the assumptions which are transitively related to them
+func relevantVariables(assumptions []lia.Formula, condition lia.Formula) map[string]struct{} {
+	variables := map[string]struct{}{}
+	for _, name := range condition.Variables() {
+		variables[name] = struct{}{}
+	}
+
+	related := make([]bool, len(assumptions))
+
+	for changed := true; changed; {
+		changed = false
+
+		for i, assumption := range assumptions {
+			if related[i] {
+				continue
+			}
+
+			assumptionVariables := assumption.Variables()
+
+			for _, name := range assumptionVariables {
+				if _, ok := variables[name]; ok {
+					related[i] = true
+					break
+				}
+			}
+
+			if !related[i] {
+				continue
+			}
+
+			for _, name := range assumptionVariables {
+				variables[name] = struct{}{}
+			}
+			changed = true
+		}
+	}
+
+	return variables
+}
+
+// freshVariable returns the name of a new variable.
+// The names cannot clash with the names of inputs, which are identifiers.
+func (v *conditionsVerifier) freshVariable() string {
+	v.variableCount++
+	return "%" + strconv.Itoa(v.variableCount)
+}
+
+// input returns the value of the input with the given name and type
+func (v *conditionsVerifier) input(name string, ty sema.Type) symbolicValue {
+	if value, ok := v.inputValues[name]; ok {
+		return value
+	}
+
+	var value symbolicValue
+	var boolean bool
+
+	switch {
+	case isIntegerType(ty):
+		term := lia.NewVariable(name)
+		v.domains = append(v.domains, integerDomain(term, ty))
+		value = symbolicInteger{term: term}
+
+	case ty == sema.BoolType:
+		value = symbolicBoolean{formula: v.booleanVariable(name)}
+		boolean = true
+
+	default:
+		value = symbolicUnknown{}
+	}
+
+	v.inputValues[name] = value
+	v.inputs = append(
+		v.inputs,
+		conditionsInput{
+			name:    name,
+			boolean: boolean,
+		},
+	)
+
+	return value
+}
+
+// booleanVariable returns the formula for a boolean variable with the given name,
+// which is represented by an integer variable with the values 0 (false) and 1 (true)
+func (v *conditionsVerifier) booleanVariable(name string) lia.Formula {
+	variable := lia.NewVariable(name)
+
+	v.domains = append(
+		v.domains,
+		lia.GreaterEqual(variable, lia.NewConstantFromInt64(0)),
+		lia.LessEqual(variable, lia.NewConstantFromInt64(1)),
+	)
+
+	return lia.Atom{
+		Term: variable.Subtract(lia.NewConstantFromInt64(1)),
+	}
+}
+
+// abstractValue returns an unconstrained value of the given type
+func (v *conditionsVerifier) abstractValue(state *symbolicState, ty sema.Type) symbolicValue {
+	switch {
+	case ty == sema.NeverType:
+		// The evaluation of an expression of type `Never` aborts the execution
+		state.assume(lia.False)
+		return symbolicUnknown{}
+
+	case isIntegerType(ty):
+		name := v.freshVariable()
+		v.abstractVariables[name] = struct{}{}
+		term := lia.NewVariable(name)
+		v.domains = append(v.domains, integerDomain(term, ty))
+		return symbolicInteger{term: term}
+
+	case ty == sema.BoolType:
+		name := v.freshVariable()
+		v.abstractVariables[name] = struct{}{}
+		return symbolicBoolean{formula: v.booleanVariable(name)}
+
+	default:
+		return symbolicUnknown{}
+	}
+}
+
+func isIntegerType(ty sema.Type) bool {
+	return ty != nil &&
+		ty != sema.NeverType &&
+		!ty.IsInvalidType() &&
+		sema.IsSubType(ty, sema.IntegerType)
+}
+
+// isWordType returns true if the given type is a Word type,
+// i.e. arithmetic wraps around instead of aborting on overflow and underflow
+func isWordType(ty sema.Type) bool {
+	switch ty {
+	case sema.Word8Type,
+		sema.Word16Type,
+		sema.Word32Type,
+		sema.Word64Type,
+		sema.Word128Type,
+		sema.Word256Type:
+
+		return true
+	}
+
+	return false
+}
+
+// integerDomain returns the formula which constrains the given term to the range of the given integer type
+func integerDomain(term lia.Term, ty sema.Type) lia.Formula {
+	rangedType, ok := ty.(sema.IntegerRangedType)
+	if !ok {
+		return lia.True
+	}
+
+	var formulas lia.And
+
+	if minInt := rangedType.MinInt(); minInt != nil {
+		formulas = append(formulas, lia.GreaterEqual(term, lia.NewConstant(minInt)))
+	}
+
+	if maxInt := rangedType.MaxInt(); maxInt != nil {
+		formulas = append(formulas, lia.LessEqual(term, lia.NewConstant(maxInt)))
+	}
+
+	return formulas
+}
@@ -0,0 +1,897 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/sema"
+	"github.com/onflow/cadence/tools/analysis/lia"
+)
+
+// symbolicValue is the value of an expression during symbolic execution
+type symbolicValue interface {
+	isSymbolicValue()
+}
+
+// symbolicInteger is an integer value, represented by a linear term
+type symbolicInteger struct {
+	term lia.Term
+}
+
+func (symbolicInteger) isSymbolicValue() {}
+
+// symbolicBoolean is a boolean value, represented by a formula
+type symbolicBoolean struct {
+	formula lia.Formula
+}
+
+func (symbolicBoolean) isSymbolicValue() {}
+
+// symbolicUnknown is a value which is not tracked, e.g. a string or a resource
+type symbolicUnknown struct{}
+
+func (symbolicUnknown) isSymbolicValue() {}
+
+// symbolicState is the state of a path through a function
+type symbolicState struct {
+	returnValue symbolicValue
+	// fields are the current values of the fields of `self`
+	fields map[string]symbolicValue
+	// assumptions are the formulas which hold on the path
+	assumptions []lia.Formula
+	scopes      []map[string]symbolicValue
+	// fieldsUnknown is true if the fields of `self` may have been modified in an unknown way,
+	// e.g. by an impure function
+	fieldsUnknown bool
+	returned      bool
+}
+
+func newSymbolicState() *symbolicState {
+	return &symbolicState{
+		fields: map[string]symbolicValue{},
+		scopes: []map[string]symbolicValue{{}},
+	}
+}
+
+func (s *symbolicState) copy() *symbolicState {
+	scopes := make([]map[string]symbolicValue, len(s.scopes))
+	for i, scope := range s.scopes {
+		scopes[i] = copySymbolicValues(scope)
+	}
+
+	return &symbolicState{
+		returnValue:   s.returnValue,
+		fields:        copySymbolicValues(s.fields),
+		assumptions:   append([]lia.Formula(nil), s.assumptions...),
+		scopes:        scopes,
+		fieldsUnknown: s.fieldsUnknown,
+		returned:      s.returned,
+	}
+}
+
+func copySymbolicValues(values map[string]symbolicValue) map[string]symbolicValue {
+	result := make(map[string]symbolicValue, len(values))
+	for name, value := range values { //nolint:maprange
+		result[name] = value
+	}
+	return result
+}
+
+func (s *symbolicState) assume(formula lia.Formula) {
+	if formula == lia.True {
+		return
+	}
+	s.assumptions = append(s.assumptions, formula)
+}
+
+// assumptionsSince returns the conjunction of the assumptions added after the given number of assumptions
+func (s *symbolicState) assumptionsSince(count int) lia.Formula {
+	return append(lia.And(nil), s.assumptions[count:]...)
+}
+
+func (s *symbolicState) lookup(name string) (symbolicValue, bool) {
+	for i := len(s.scopes) - 1; i >= 0; i-- {
+		if value, ok := s.scopes[i][name]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func (s *symbolicState) declare(name string, value symbolicValue) {
+	s.scopes[len(s.scopes)-1][name] = value
+}
+
+func (s *symbolicState) assign(name string, value symbolicValue) {
+	for i := len(s.scopes) - 1; i >= 0; i-- {
+		if _, ok := s.scopes[i][name]; ok {
+			s.scopes[i][name] = value
+			return
+		}
+	}
+}
+
+func (s *symbolicState) pushScope() {
+	s.scopes = append(s.scopes, map[string]symbolicValue{})
+}
+
+func (s *symbolicState) popScope() {
+	s.scopes = s.scopes[:len(s.scopes)-1]
+}
+
+// havocFields forgets the values of the fields of `self`
+func (s *symbolicState) havocFields() {
+	s.fields = map[string]symbolicValue{}
+	s.fieldsUnknown = true
+}
+
+// executeBlock executes the given block in each of the given states.
+// It returns the resulting states, and false if the block is not supported.
+func (v *conditionsVerifier) executeBlock(
+	states []*symbolicState,
+	block *ast.Block,
+) ([]*symbolicState, bool) {
+	if block == nil {
+		return states, true
+	}
+
+	for _, state := range states {
+		state.pushScope()
+	}
+
+	states, ok := v.executeStatements(states, block.Statements)
+	if !ok {
+		return nil, false
+	}
+
+	for _, state := range states {
+		state.popScope()
+	}
+
+	return states, true
+}
+
+func (v *conditionsVerifier) executeStatements(
+	states []*symbolicState,
+	statements []ast.Statement,
+) ([]*symbolicState, bool) {
+	for _, statement := range statements {
+		var nextStates []*symbolicState
+
+		for _, state := range states {
+			if state.returned {
+				nextStates = append(nextStates, state)
+				continue
+			}
+
+			resultStates, ok := v.executeStatement(state, statement)
+			if !ok {
+				return nil, false
+			}
+
+			nextStates = append(nextStates, resultStates...)
+		}
+
+		if len(nextStates) > conditionsMaxPaths {
+			return nil, false
+		}
+
+		states = nextStates
+	}
+
+	return states, true
+}
+
+func (v *conditionsVerifier) executeStatement(
+	state *symbolicState,
+	statement ast.Statement,
+) ([]*symbolicState, bool) {
+	switch statement := statement.(type) {
+	case *ast.ExpressionStatement:
+		v.evaluateExpression(state, statement.Expression)
+		return []*symbolicState{state}, true
+
+	case *ast.EmitStatement:
+		return []*symbolicState{state}, true
+
+	case *ast.ReturnStatement:
+		if statement.Expression != nil {
+			state.returnValue = v.evaluateExpression(state, statement.Expression)
+		}
+		state.returned = true
+		return []*symbolicState{state}, true
+
+	case *ast.VariableDeclaration:
+		if statement.SecondValue != nil || statement.Pattern != nil {
+			return nil, false
+		}
+
+		value := v.evaluateExpression(state, statement.Value)
+		state.declare(statement.Identifier.Identifier, value)
+		return []*symbolicState{state}, true
+
+	case *ast.AssignmentStatement:
+		return v.executeAssignment(state, statement)
+
+	case *ast.IfStatement:
+		return v.executeIf(state, statement)
+
+	default:
+		return nil, false
+	}
+}
+
+func (v *conditionsVerifier) executeAssignment(
+	state *symbolicState,
+	assignment *ast.AssignmentStatement,
+) ([]*symbolicState, bool) {
+
+	var value symbolicValue
+
+	if assignment.Operation != ast.OperationUnknown {
+		targetType := v.elaboration.AssignmentStatementTypes(assignment).TargetType
+		left := v.evaluateExpression(state, assignment.Target)
+		right := v.evaluateExpression(state, assignment.Value)
+		value = v.evaluateOperation(state, assignment.Operation, left, right, targetType)
+	} else {
+		value = v.evaluateExpression(state, assignment.Value)
+	}
+
+	switch target := assignment.Target.(type) {
+	case *ast.IdentifierExpression:
+		state.assign(target.Identifier.Identifier, value)
+
+	case *ast.MemberExpression:
+		if fieldName, ok := v.selfField(target); ok {
+			state.fields[fieldName] = value
+		} else {
+			// The assignment might modify a field of `self` through a reference
+			v.evaluateExpression(state, target.Expression)
+			state.havocFields()
+		}
+
+	default:
+		// e.g. an index expression
+		v.abstractExpression(state, assignment.Target)
+		state.havocFields()
+	}
+
+	return []*symbolicState{state}, true
+}
+
+func (v *conditionsVerifier) executeIf(
+	state *symbolicState,
+	statement *ast.IfStatement,
+) ([]*symbolicState, bool) {
+
+	thenState := state
+	var elseState *symbolicState
+
+	switch test := statement.Test.(type) {
+	case ast.Expression:
+		condition := v.evaluateCondition(state, test)
+		elseState = state.copy()
+		thenState.assume(condition)
+		elseState.assume(lia.Not{Formula: condition})
+		thenState.pushScope()
+
+	case *ast.VariableDeclaration:
+		// Optional binding: whether the value is present is not tracked
+
+		if test.SecondValue != nil {
+			return nil, false
+		}
+
+		v.evaluateExpression(state, test.Value)
+		elseState = state.copy()
+
+		condition := v.abstractValue(state, sema.BoolType).(symbolicBoolean).formula
+		thenState.assume(condition)
+		elseState.assume(lia.Not{Formula: condition})
+
+		targetType := v.elaboration.VariableDeclarationTypes(test).TargetType
+		thenState.pushScope()
+		thenState.declare(test.Identifier.Identifier, v.abstractValue(thenState, targetType))
+
+	default:
+		return nil, false
+	}
+
+	thenStates, ok := v.executeBlock([]*symbolicState{thenState}, statement.Then)
+	if !ok {
+		return nil, false
+	}
+	for _, state := range thenStates {
+		state.popScope()
+	}
+
+	elseStates, ok := v.executeBlock([]*symbolicState{elseState}, statement.Else)
+	if !ok {
+		return nil, false
+	}
+
+	return append(thenStates, elseStates...), true
+}
+
+// evaluateCondition evaluates the given boolean expression to a formula
+func (v *conditionsVerifier) evaluateCondition(state *symbolicState, expression ast.Expression) lia.Formula {
+	value := v.evaluateExpression(state, expression)
+
+	boolean, ok := value.(symbolicBoolean)
+	if !ok {
+		return v.abstractValue(state, sema.BoolType).(symbolicBoolean).formula
+	}
+
+	return boolean.formula
+}
+
+func (v *conditionsVerifier) expressionType(expression ast.Expression) sema.Type {
+	return v.elaboration.ExpressionTypes(expression).ActualType
+}
+
+func (v *conditionsVerifier) evaluateExpression(state *symbolicState, expression ast.Expression) symbolicValue {
+	switch expression := expression.(type) {
+	case *ast.BoolExpression:
+		if expression.Value {
+			return symbolicBoolean{formula: lia.True}
+		}
+		return symbolicBoolean{formula: lia.False}
+
+	case *ast.IntegerExpression:
+		if !isIntegerType(v.elaboration.IntegerExpressionType(expression)) {
+			return symbolicUnknown{}
+		}
+		return symbolicInteger{term: lia.NewConstant(expression.Value)}
+
+	case *ast.IdentifierExpression:
+		if value, ok := state.lookup(expression.Identifier.Identifier); ok {
+			return value
+		}
+		return v.abstractExpression(state, expression)
+
+	case *ast.MemberExpression:
+		if fieldName, ok := v.selfField(expression); ok {
+			return v.readField(state, fieldName, v.expressionType(expression))
+		}
+		return v.abstractExpression(state, expression)
+
+	case *ast.UnaryExpression:
+		return v.evaluateUnaryExpression(state, expression)
+
+	case *ast.BinaryExpression:
+		return v.evaluateBinaryExpression(state, expression)
+
+	case *ast.ConditionalExpression:
+		return v.evaluateConditionalExpression(state, expression)
+
+	case *ast.CastingExpression:
+		if expression.Operation != ast.OperationCast {
+			return v.abstractExpression(state, expression)
+		}
+
+		value := v.evaluateExpression(state, expression.Expression)
+
+		// The static cast may widen the type of an integer, e.g. `x as Integer`
+		targetType := v.elaboration.CastingExpressionTypes(expression).TargetType
+		if _, ok := value.(symbolicInteger); ok && !isIntegerType(targetType) {
+			return symbolicUnknown{}
+		}
+
+		return value
+
+	case *ast.InvocationExpression:
+		return v.evaluateInvocation(state, expression)
+
+	default:
+		return v.abstractExpression(state, expression)
+	}
+}
+
+// selfField returns the name of the field, if the given member expression accesses a field of `self`
+func (v *conditionsVerifier) selfField(expression *ast.MemberExpression) (string, bool) {
+	if v.composite == nil || expression.Optional {
+		return "", false
+	}
+
+	identifierExpression, ok := expression.Expression.(*ast.IdentifierExpression)
+	if !ok || identifierExpression.Identifier.Identifier != sema.SelfIdentifier {
+		return "", false
+	}
+
+	memberInfo, ok := v.elaboration.MemberExpressionMemberAccessInfo(expression)
+	if !ok ||
+		memberInfo.Member == nil ||
+		memberInfo.Member.DeclarationKind != common.DeclarationKindField {
+
+		return "", false
+	}
+
+	return expression.Identifier.Identifier, true
+}
+
+func (v *conditionsVerifier) readField(state *symbolicState, name string, ty sema.Type) symbolicValue {
+	if value, ok := state.fields[name]; ok {
+		return value
+	}
+
+	var value symbolicValue
+	if state.fieldsUnknown {
+		value = v.abstractValue(state, ty)
+	} else {
+		value = v.input(sema.SelfIdentifier+"."+name, ty)
+	}
+
+	state.fields[name] = value
+
+	return value
+}
+
+// abstractExpression evaluates an unsupported expression to an unconstrained value of its type
+func (v *conditionsVerifier) abstractExpression(state *symbolicState, expression ast.Expression) symbolicValue {
+	if v.mayModifyFields(expression) {
+		state.havocFields()
+	}
+
+	return v.abstractValue(state, v.expressionType(expression))
+}
+
+// mayModifyFields returns true if the evaluation of the given expression
+// may modify the fields of `self`, i.e. if it contains an invocation which is not a view
+func (v *conditionsVerifier) mayModifyFields(expression ast.Expression) bool {
+	var result bool
+
+	ast.Inspect(expression, func(element ast.Element) bool {
+		if result {
+			return false
+		}
+
+		switch element := element.(type) {
+		case *ast.InvocationExpression:
+			if !v.isViewInvocation(element) {
+				result = true
+				return false
+			}
+
+		case *ast.FunctionExpression:
+			// Function expressions are not invoked when they are evaluated
+			return false
+		}
+
+		return true
+	})
+
+	return result
+}
+
+func (v *conditionsVerifier) isViewInvocation(invocation *ast.InvocationExpression) bool {
+	functionType, ok := v.expressionType(invocation.InvokedExpression).(*sema.FunctionType)
+	return ok && functionType.Purity == sema.FunctionPurityView
+}
+
+func (v *conditionsVerifier) evaluateUnaryExpression(
+	state *symbolicState,
+	expression *ast.UnaryExpression,
+) symbolicValue {
+	value := v.evaluateExpression(state, expression.Expression)
+
+	switch expression.Operation {
+	case ast.OperationNegate:
+		if boolean, ok := value.(symbolicBoolean); ok {
+			return symbolicBoolean{formula: lia.Not{Formula: boolean.formula}}
+		}
+
+	case ast.OperationMinus:
+		if integer, ok := value.(symbolicInteger); ok {
+			ty := v.expressionType(expression)
+			result := integer.term.Negate()
+			state.assume(integerDomain(result, ty))
+			return symbolicInteger{term: result}
+		}
+	}
+
+	return v.abstractValue(state, v.expressionType(expression))
+}
+
+func (v *conditionsVerifier) evaluateBinaryExpression(
+	state *symbolicState,
+	expression *ast.BinaryExpression,
+) symbolicValue {
+
+	switch expression.Operation {
+	case ast.OperationAnd, ast.OperationOr:
+		return v.evaluateShortCircuitExpression(state, expression)
+	}
+
+	left := v.evaluateExpression(state, expression.Left)
+	right := v.evaluateExpression(state, expression.Right)
+
+	resultType := v.elaboration.BinaryExpressionTypes(expression).ResultType
+	if resultType == nil {
+		resultType = v.expressionType(expression)
+	}
+
+	return v.evaluateOperation(state, expression.Operation, left, right, resultType)
+}
+
+// evaluateOperation evaluates the given binary operation.
+// Integer arithmetic aborts on overflow, so the result is assumed to be in the range of the result type.
+func (v *conditionsVerifier) evaluateOperation(
+	state *symbolicState,
+	operation ast.Operation,
+	left, right symbolicValue,
+	resultType sema.Type,
+) symbolicValue {
+
+	leftInteger, leftIsInteger := left.(symbolicInteger)
+	rightInteger, rightIsInteger := right.(symbolicInteger)
+	leftBoolean, leftIsBoolean := left.(symbolicBoolean)
+	rightBoolean, rightIsBoolean := right.(symbolicBoolean)
+
+	if leftIsInteger && rightIsInteger {
+		leftTerm := leftInteger.term
+		rightTerm := rightInteger.term
+
+		switch operation {
+		case ast.OperationEqual:
+			return symbolicBoolean{formula: lia.Equal(leftTerm, rightTerm)}
+		case ast.OperationNotEqual:
+			return symbolicBoolean{formula: lia.NotEqual(leftTerm, rightTerm)}
+		case ast.OperationLess:
+			return symbolicBoolean{formula: lia.Less(leftTerm, rightTerm)}
+		case ast.OperationLessEqual:
+			return symbolicBoolean{formula: lia.LessEqual(leftTerm, rightTerm)}
+		case ast.OperationGreater:
+			return symbolicBoolean{formula: lia.Greater(leftTerm, rightTerm)}
+		case ast.OperationGreaterEqual:
+			return symbolicBoolean{formula: lia.GreaterEqual(leftTerm, rightTerm)}
+		}
+
+		if isIntegerType(resultType) && !isWordType(resultType) {
+			var result lia.Term
+			var ok bool
+
+			switch operation {
+			case ast.OperationPlus:
+				result, ok = leftTerm.Add(rightTerm), true
+
+			case ast.OperationMinus:
+				result, ok = leftTerm.Subtract(rightTerm), true
+
+			case ast.OperationMul:
+				// Only multiplication by a constant is linear
+				switch {
+				case leftTerm.IsConstant():
+					result, ok = rightTerm.Multiply(leftTerm.Constant()), true
+				case rightTerm.IsConstant():
+					result, ok = leftTerm.Multiply(rightTerm.Constant()), true
+				}
+			}
+
+			if ok {
+				state.assume(integerDomain(result, resultType))
+				return symbolicInteger{term: result}
+			}
+		}
+	}
+
+	if leftIsBoolean && rightIsBoolean {
+		switch operation {
+		case ast.OperationEqual:
+			return symbolicBoolean{
+				formula: lia.Iff(leftBoolean.formula, rightBoolean.formula),
+			}
+		case ast.OperationNotEqual:
+			return symbolicBoolean{
+				formula: lia.Not{Formula: lia.Iff(leftBoolean.formula, rightBoolean.formula)},
+			}
+		}
+	}
+
+	return v.abstractValue(state, resultType)
+}
+
+// evaluateShortCircuitExpression evaluates a logical conjunction or disjunction.
+// The right-hand side is only evaluated if the left-hand side does not determine the result,
+// so the assumptions made during its evaluation are guarded.
+func (v *conditionsVerifier) evaluateShortCircuitExpression(
+	state *symbolicState,
+	expression *ast.BinaryExpression,
+) symbolicValue {
+
+	left := v.evaluateCondition(state, expression.Left)
+
+	rightState := state.copy()
+	right := v.evaluateCondition(rightState, expression.Right)
+
+	guard := left
+	if expression.Operation == ast.OperationOr {
+		guard = lia.Not{Formula: left}
+	}
+
+	rightAssumptions := rightState.assumptionsSince(len(state.assumptions))
+	if len(rightAssumptions.(lia.And)) > 0 {
+		state.assume(lia.Implies(guard, rightAssumptions))
+	}
+
+	if rightState.fieldsUnknown && !state.fieldsUnknown {
+		state.havocFields()
+	}
+
+	if expression.Operation == ast.OperationOr {
+		return symbolicBoolean{formula: lia.Or{left, right}}
+	}
+
+	return symbolicBoolean{formula: lia.And{left, right}}
+}
+
+func (v *conditionsVerifier) evaluateConditionalExpression(
+	state *symbolicState,
+	expression *ast.ConditionalExpression,
+) symbolicValue {
+
+	test := v.evaluateCondition(state, expression.Test)
+	notTest := lia.Not{Formula: test}
+
+	thenState := state.copy()
+	thenValue := v.evaluateExpression(thenState, expression.Then)
+	thenAssumptions := thenState.assumptionsSince(len(state.assumptions))
+
+	elseState := state.copy()
+	elseValue := v.evaluateExpression(elseState, expression.Else)
+	elseAssumptions := elseState.assumptionsSince(len(state.assumptions))
+
+	if thenState.fieldsUnknown || elseState.fieldsUnknown {
+		state.havocFields()
+	}
+
+	thenInteger, thenIsInteger := thenValue.(symbolicInteger)
+	elseInteger, elseIsInteger := elseValue.(symbolicInteger)
+
+	if thenIsInteger && elseIsInteger {
+		result := lia.NewVariable(v.freshVariable())
+
+		state.assume(lia.Or{
+			lia.And{test, thenAssumptions, lia.Equal(result, thenInteger.term)},
+			lia.And{notTest, elseAssumptions, lia.Equal(result, elseInteger.term)},
+		})
+
+		return symbolicInteger{term: result}
+	}
+
+	state.assume(lia.Or{
+		lia.And{test, thenAssumptions},
+		lia.And{notTest, elseAssumptions},
+	})
+
+	thenBoolean, thenIsBoolean := thenValue.(symbolicBoolean)
+	elseBoolean, elseIsBoolean := elseValue.(symbolicBoolean)
+
+	if thenIsBoolean && elseIsBoolean {
+		return symbolicBoolean{
+			formula: lia.Or{
+				lia.And{test, thenBoolean.formula},
+				lia.And{notTest, elseBoolean.formula},
+			},
+		}
+	}
+
+	return v.abstractValue(state, v.expressionType(expression))
+}
+
+func (v *conditionsVerifier) evaluateInvocation(
+	state *symbolicState,
+	invocation *ast.InvocationExpression,
+) symbolicValue {
+
+	returnType := v.elaboration.InvocationExpressionTypes(invocation).ReturnType
+
+	function := v.invokedFunction(state, invocation)
+	if function == nil {
+		if memberExpression, ok := invocation.InvokedExpression.(*ast.MemberExpression); ok {
+			v.evaluateExpression(state, memberExpression.Expression)
+		}
+
+		for _, argument := range invocation.Arguments {
+			v.evaluateExpression(state, argument.Expression)
+		}
+
+		if !v.isViewInvocation(invocation) {
+			state.havocFields()
+		}
+
+		return v.abstractValue(state, returnType)
+	}
+
+	// Bind the arguments to the parameters of the invoked function
+
+	arguments := make([]symbolicValue, 0, len(invocation.Arguments))
+	for _, argument := range invocation.Arguments {
+		arguments = append(arguments, v.evaluateExpression(state, argument.Expression))
+	}
+
+	functionState := state.copy()
+	functionState.scopes = []map[string]symbolicValue{{}}
+	functionState.returnValue = nil
+	functionState.returned = false
+
+	for i, parameter := range function.ParameterList.Parameters {
+		functionState.declare(parameter.Identifier.Identifier, arguments[i])
+	}
+
+	// The pre-conditions of the invoked function must hold
+
+	functionBlock := function.FunctionBlock
+	if functionBlock.PreConditions != nil {
+		for _, condition := range functionBlock.PreConditions.Conditions {
+			testCondition, ok := condition.(*ast.TestCondition)
+			if !ok {
+				continue
+			}
+
+			formula := v.evaluateCondition(functionState, testCondition.Test)
+			v.verifyPreCondition(functionState.assumptions, formula, invocation, function)
+			functionState.assume(formula)
+		}
+	}
+
+	count := len(state.assumptions)
+	state.assume(functionState.assumptionsSince(count))
+
+	if !v.inlinable(function) {
+		if function.Purity != ast.FunctionPurityView {
+			state.havocFields()
+		}
+		return v.abstractValue(state, returnType)
+	}
+
+	// Inline the invoked view function
+
+	count = len(functionState.assumptions)
+
+	v.inlined = append(v.inlined, function)
+	finalStates, ok := v.executeBlock([]*symbolicState{functionState}, functionBlock.Block)
+	v.inlined = v.inlined[:len(v.inlined)-1]
+
+	if !ok {
+		return v.abstractValue(state, returnType)
+	}
+
+	paths := make(lia.Or, 0, len(finalStates))
+	for _, finalState := range finalStates {
+		paths = append(paths, finalState.assumptionsSince(count))
+	}
+
+	switch {
+	case isIntegerType(returnType):
+		result := lia.NewVariable(v.freshVariable())
+
+		for i, finalState := range finalStates {
+			if integer, ok := finalState.returnValue.(symbolicInteger); ok {
+				paths[i] = lia.And{paths[i], lia.Equal(result, integer.term)}
+			} else {
+				return v.abstractValue(state, returnType)
+			}
+		}
+
+		state.assume(paths)
+		return symbolicInteger{term: result}
+
+	case returnType == sema.BoolType:
+		results := make(lia.Or, 0, len(finalStates))
+
+		for i, finalState := range finalStates {
+			boolean, ok := finalState.returnValue.(symbolicBoolean)
+			if !ok {
+				return v.abstractValue(state, returnType)
+			}
+			results = append(results, lia.And{paths[i], boolean.formula})
+		}
+
+		state.assume(paths)
+		return symbolicBoolean{formula: results}
+
+	default:
+		state.assume(paths)
+		return v.abstractValue(state, returnType)
+	}
+}
+
+// invokedFunction returns the declaration of the invoked function,
+// if it is declared in the program and can be determined statically
+func (v *conditionsVerifier) invokedFunction(
+	state *symbolicState,
+	invocation *ast.InvocationExpression,
+) *ast.FunctionDeclaration {
+
+	var candidates []*ast.FunctionDeclaration
+
+	switch invokedExpression := invocation.InvokedExpression.(type) {
+	case *ast.IdentifierExpression:
+		name := invokedExpression.Identifier.Identifier
+		if _, ok := state.lookup(name); ok {
+			return nil
+		}
+		if _, ok := v.elaboration.IdentifierExpressionOverloadName(invokedExpression); ok {
+			return nil
+		}
+		candidates = v.globalFunctions[name]
+
+	case *ast.MemberExpression:
+		if v.composite == nil || invokedExpression.Optional {
+			return nil
+		}
+
+		identifierExpression, ok := invokedExpression.Expression.(*ast.IdentifierExpression)
+		if !ok || identifierExpression.Identifier.Identifier != sema.SelfIdentifier {
+			return nil
+		}
+
+		memberInfo, ok := v.elaboration.MemberExpressionMemberAccessInfo(invokedExpression)
+		if !ok ||
+			memberInfo.OverloadName != "" ||
+			memberInfo.Member == nil ||
+			memberInfo.Member.DeclarationKind != common.DeclarationKindFunction {
+
+			return nil
+		}
+
+		name := invokedExpression.Identifier.Identifier
+		for _, function := range v.composite.Members.Functions() {
+			if function.Identifier.Identifier == name {
+				candidates = append(candidates, function)
+			}
+		}
+	}
+
+	if len(candidates) != 1 {
+		return nil
+	}
+
+	function := candidates[0]
+
+	if function.FunctionBlock == nil ||
+		function.ParameterList == nil ||
+		len(function.ParameterList.Parameters) != len(invocation.Arguments) ||
+		(function.TypeParameterList != nil && !function.TypeParameterList.IsEmpty()) {
+
+		return nil
+	}
+
+	return function
+}
+
+// inlinable returns true if the given invoked function can be inlined,
+// i.e. it is a view function which is not invoked recursively
+func (v *conditionsVerifier) inlinable(function *ast.FunctionDeclaration) bool {
+	if function.Purity != ast.FunctionPurityView ||
+		function == v.function ||
+		len(v.inlined) >= conditionsMaxInlineDepth {
+
+		return false
+	}
+
+	for _, inlined := range v.inlined {
+		if inlined == function {
+			return false
+		}
+	}
+
+	return true
+}
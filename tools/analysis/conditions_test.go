@@ -0,0 +1,338 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/tools/analysis"
+)
+
+func verifyConditions(t *testing.T, code string) []analysis.Diagnostic {
+
+	location := common.StringLocation("test")
+
+	config := &analysis.Config{
+		Mode: analysis.NeedTypes | analysis.NeedExtendedElaboration,
+		ResolveCode: func(
+			location common.Location,
+			importingLocation common.Location,
+			importRange ast.Range,
+		) ([]byte, error) {
+			return []byte(code), nil
+		},
+	}
+
+	programs, err := analysis.Load(config, location)
+	require.NoError(t, err)
+
+	program := programs[location]
+	require.NotNil(t, program)
+	require.NoError(t, program.LoadError)
+
+	var diagnostics []analysis.Diagnostic
+
+	program.Run(
+		[]*analysis.Analyzer{
+			analysis.ConditionsAnalyzer,
+		},
+		func(diagnostic analysis.Diagnostic) {
+			diagnostics = append(diagnostics, diagnostic)
+		},
+	)
+
+	return diagnostics
+}
+
+func TestConditionsAnalyzer(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("verified post-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun max(_ a: Int, _ b: Int): Int {
+              post {
+                  result >= a
+                  result >= b
+                  result == a || result == b
+              }
+              if a > b {
+                  return a
+              }
+              return b
+          }
+        `)
+
+		require.Empty(t, diagnostics)
+	})
+
+	t.Run("failing post-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun abs(_ x: Int): Int {
+              post {
+                  result > 0
+              }
+              if x < 0 {
+                  return -x
+              }
+              return x
+          }
+        `)
+
+		require.Equal(t,
+			[]analysis.Diagnostic{
+				{
+					Location:         common.StringLocation("test"),
+					Category:         analysis.ConditionsCategory,
+					Message:          "post-condition may fail",
+					SecondaryMessage: "counterexample: x = 0",
+					Code:             analysis.PostConditionMayFailCode,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 87, Line: 4, Column: 18},
+						EndPos:   ast.Position{Offset: 96, Line: 4, Column: 27},
+					},
+				},
+			},
+			diagnostics,
+		)
+	})
+
+	t.Run("post-condition failing for all inputs", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun one(): Int {
+              post {
+                  result == 2
+              }
+              return 1
+          }
+        `)
+
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, "the condition fails for all inputs", diagnostics[0].SecondaryMessage)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The addition aborts if the result is out of range,
+		// so the post-condition holds
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun increment(_ x: UInt8): UInt8 {
+              post {
+                  result > x
+                  result <= 255
+              }
+              return x + 1
+          }
+        `)
+
+		require.Empty(t, diagnostics)
+	})
+
+	t.Run("pre-condition", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun decrement(_ x: Int): Int {
+              pre {
+                  x > 0
+              }
+              post {
+                  result >= 0
+              }
+              return x - 1
+          }
+        `)
+
+		require.Empty(t, diagnostics)
+	})
+
+	t.Run("fields and before", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) struct Counter {
+
+              access(all) var count: Int
+
+              init() {
+                  self.count = 0
+              }
+
+              access(all) fun increment(_ amount: Int) {
+                  pre {
+                      amount > 0
+                  }
+                  post {
+                      self.count > before(self.count)
+                      self.count == before(self.count) + amount
+                  }
+                  self.count = self.count + amount
+              }
+
+              access(all) fun decrement() {
+                  post {
+                      self.count < before(self.count)
+                      self.count >= 0
+                  }
+                  self.count = self.count - 1
+              }
+          }
+        `)
+
+		require.Len(t, diagnostics, 1)
+
+		diagnostic := diagnostics[0]
+		require.Equal(t, analysis.PostConditionMayFailCode, diagnostic.Code)
+		require.Equal(t, "counterexample: self.count = 0", diagnostic.SecondaryMessage)
+		require.Equal(t, 24, diagnostic.StartPos.Line)
+	})
+
+	t.Run("failing pre-condition of invoked function", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun half(_ x: Int): Int {
+              pre {
+                  x >= 0
+                  x <= 100
+              }
+              return x / 2
+          }
+
+          access(all) fun test(_ y: Int) {
+              if y > 10 {
+                  half(y)
+              }
+              half(-2)
+          }
+        `)
+
+		require.Len(t, diagnostics, 2)
+
+		require.Equal(t, analysis.PreConditionMayFailCode, diagnostics[0].Code)
+		require.Equal(t, "pre-condition of function `half` may fail", diagnostics[0].Message)
+		require.Equal(t, "counterexample: y = 101", diagnostics[0].SecondaryMessage)
+		require.Equal(t, 12, diagnostics[0].StartPos.Line)
+
+		require.Equal(t, analysis.PreConditionMayFailCode, diagnostics[1].Code)
+		require.Equal(t, "the condition fails for all inputs", diagnostics[1].SecondaryMessage)
+		require.Equal(t, 14, diagnostics[1].StartPos.Line)
+	})
+
+	t.Run("inlined view function", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) view fun double(_ x: Int): Int {
+              return x * 2
+          }
+
+          access(all) fun isEven(_ x: Int): Int {
+              pre {
+                  x >= 0
+              }
+              post {
+                  result >= x
+              }
+              return double(x)
+          }
+        `)
+
+		require.Empty(t, diagnostics)
+	})
+
+	t.Run("abstraction", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Division is not supported, so the result is unknown
+		// and the post-condition is not reported
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun third(_ x: Int): Int {
+              post {
+                  result == 0
+              }
+              return x / 3
+          }
+        `)
+
+		require.Empty(t, diagnostics)
+	})
+
+	t.Run("unrelated abstraction", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun test(_ x: Int, _ y: Int): Int {
+              post {
+                  result > 0
+              }
+              let z = y / 3
+              return x
+          }
+        `)
+
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, "counterexample: x = 0", diagnostics[0].SecondaryMessage)
+	})
+
+	t.Run("unsupported statement", func(t *testing.T) {
+
+		t.Parallel()
+
+		diagnostics := verifyConditions(t, `
+          access(all) fun sum(_ n: Int): Int {
+              post {
+                  result < 0
+              }
+              var i = 0
+              var s = 0
+              while i < n {
+                  s = s + i
+                  i = i + 1
+              }
+              return s
+          }
+        `)
+
+		require.Empty(t, diagnostics)
+	})
+}
@@ -0,0 +1,242 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lia
+
+import (
+	"strings"
+)
+
+// Formula is a quantifier-free formula of linear integer arithmetic
+type Formula interface {
+	isFormula()
+	// Variables returns the names of the variables which occur in the formula.
+	// The names may contain duplicates.
+	Variables() []string
+	// Evaluate returns the truth value of the formula for the given model.
+	// Variables which have no value in the model are zero.
+	Evaluate(model Model) bool
+	String() string
+}
+
+// Truth is a constant formula, i.e. `true` or `false`
+type Truth bool
+
+const (
+	True  Truth = true
+	False Truth = false
+)
+
+var _ Formula = True
+
+func (Truth) isFormula() {}
+
+func (Truth) Variables() []string {
+	return nil
+}
+
+func (t Truth) Evaluate(_ Model) bool {
+	return bool(t)
+}
+
+func (t Truth) String() string {
+	if t {
+		return "true"
+	}
+	return "false"
+}
+
+// Atom is the atomic formula `Term >= 0`, or `Term == 0` if Equality is true
+type Atom struct {
+	Term     Term
+	Equality bool
+}
+
+var _ Formula = Atom{}
+
+func (Atom) isFormula() {}
+
+func (a Atom) Variables() []string {
+	return a.Term.Variables()
+}
+
+func (a Atom) Evaluate(model Model) bool {
+	value := a.Term.Evaluate(model)
+	if a.Equality {
+		return value.Sign() == 0
+	}
+	return value.Sign() >= 0
+}
+
+func (a Atom) String() string {
+	if a.Equality {
+		return a.Term.String() + " == 0"
+	}
+	return a.Term.String() + " >= 0"
+}
+
+// Not is the negation of a formula
+type Not struct {
+	Formula Formula
+}
+
+var _ Formula = Not{}
+
+func (Not) isFormula() {}
+
+func (n Not) Variables() []string {
+	return n.Formula.Variables()
+}
+
+func (n Not) Evaluate(model Model) bool {
+	return !n.Formula.Evaluate(model)
+}
+
+func (n Not) String() string {
+	return "!(" + n.Formula.String() + ")"
+}
+
+// And is the conjunction of formulas.
+// The empty conjunction is true.
+type And []Formula
+
+var _ Formula = And{}
+
+func (And) isFormula() {}
+
+func (a And) Variables() []string {
+	return formulasVariables(a)
+}
+
+func (a And) Evaluate(model Model) bool {
+	for _, formula := range a {
+		if !formula.Evaluate(model) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a And) String() string {
+	return formulasString(a, " && ", True)
+}
+
+// Or is the disjunction of formulas.
+// The empty disjunction is false.
+type Or []Formula
+
+var _ Formula = Or{}
+
+func (Or) isFormula() {}
+
+func (o Or) Variables() []string {
+	return formulasVariables(o)
+}
+
+func (o Or) Evaluate(model Model) bool {
+	for _, formula := range o {
+		if formula.Evaluate(model) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o Or) String() string {
+	return formulasString(o, " || ", False)
+}
+
+func formulasVariables(formulas []Formula) []string {
+	var names []string
+	for _, formula := range formulas {
+		names = append(names, formula.Variables()...)
+	}
+	return names
+}
+
+func formulasString(formulas []Formula, separator string, empty Truth) string {
+	if len(formulas) == 0 {
+		return empty.String()
+	}
+
+	var builder strings.Builder
+	for i, formula := range formulas {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString("(")
+		builder.WriteString(formula.String())
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
+
+// Equal returns the formula `left == right`
+func Equal(left, right Term) Formula {
+	return Atom{
+		Term:     left.Subtract(right),
+		Equality: true,
+	}
+}
+
+// NotEqual returns the formula `left != right`
+func NotEqual(left, right Term) Formula {
+	return Not{
+		Formula: Equal(left, right),
+	}
+}
+
+// LessEqual returns the formula `left <= right`
+func LessEqual(left, right Term) Formula {
+	return Atom{
+		Term: right.Subtract(left),
+	}
+}
+
+// Less returns the formula `left < right`
+func Less(left, right Term) Formula {
+	return Atom{
+		Term: right.Subtract(left).Subtract(NewConstantFromInt64(1)),
+	}
+}
+
+// GreaterEqual returns the formula `left >= right`
+func GreaterEqual(left, right Term) Formula {
+	return LessEqual(right, left)
+}
+
+// Greater returns the formula `left > right`
+func Greater(left, right Term) Formula {
+	return Less(right, left)
+}
+
+// Implies returns the formula `premise ==> conclusion`
+func Implies(premise, conclusion Formula) Formula {
+	return Or{
+		Not{Formula: premise},
+		conclusion,
+	}
+}
+
+// Iff returns the formula `left <==> right`
+func Iff(left, right Formula) Formula {
+	return Or{
+		And{left, right},
+		And{Not{Formula: left}, Not{Formula: right}},
+	}
+}
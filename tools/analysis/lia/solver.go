@@ -0,0 +1,659 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lia
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/onflow/cadence/errors"
+)
+
+// Result is the result of deciding the satisfiability of a formula
+type Result uint8
+
+const (
+	// ResultUnknown indicates that the satisfiability could not be decided,
+	// because the solver exhausted its budget
+	ResultUnknown Result = iota
+	ResultSatisfiable
+	ResultUnsatisfiable
+)
+
+// maxSteps bounds the number of systems of constraints the solver considers
+const maxSteps = 100_000
+
+// freshVariablePrefix is the prefix of the names of variables introduced by the solver.
+// Variable names with this prefix are reserved.
+const freshVariablePrefix = "#"
+
+var one = big.NewInt(1)
+
+// Solve decides if the given formula is satisfiable over the integers,
+// and returns a model of the formula if it is.
+//
+// The formula is brought into negation normal form,
+// and the disjuncts of its disjunctive normal form are enumerated lazily.
+// The satisfiability of each conjunction of linear constraints is decided using the Omega test:
+// Equalities are eliminated by substitution, and inequalities by Fourier-Motzkin elimination,
+// using the dark shadow and splinters when the elimination is not exact over the integers.
+func Solve(formula Formula) (Result, Model) {
+	s := &solver{}
+
+	result, model := s.search(
+		[]Formula{negationNormalForm(formula, false)},
+		nil,
+	)
+	if result != ResultSatisfiable {
+		return result, nil
+	}
+
+	for name := range model { //nolint:maprange
+		if s.isFreshVariable(name) {
+			delete(model, name)
+		}
+	}
+
+	return result, model
+}
+
+// negationNormalForm returns the formula, or its negation if negated is true, in negation normal form.
+// The result only consists of truths, atoms, conjunctions, and disjunctions.
+func negationNormalForm(formula Formula, negated bool) Formula {
+	switch formula := formula.(type) {
+	case Truth:
+		if negated {
+			return !formula
+		}
+		return formula
+
+	case Atom:
+		if !negated {
+			return formula
+		}
+
+		if formula.Equality {
+			// t != 0  <==>  t - 1 >= 0 || -t - 1 >= 0
+			return Or{
+				Atom{Term: formula.Term.Subtract(NewConstant(one))},
+				Atom{Term: formula.Term.Negate().Subtract(NewConstant(one))},
+			}
+		}
+
+		// !(t >= 0)  <==>  -t - 1 >= 0
+		return Atom{Term: formula.Term.Negate().Subtract(NewConstant(one))}
+
+	case Not:
+		return negationNormalForm(formula.Formula, !negated)
+
+	case And:
+		formulas := make([]Formula, len(formula))
+		for i, child := range formula {
+			formulas[i] = negationNormalForm(child, negated)
+		}
+		if negated {
+			return Or(formulas)
+		}
+		return And(formulas)
+
+	case Or:
+		formulas := make([]Formula, len(formula))
+		for i, child := range formula {
+			formulas[i] = negationNormalForm(child, negated)
+		}
+		if negated {
+			return And(formulas)
+		}
+		return Or(formulas)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// constraint is the linear constraint `term >= 0`, or `term == 0` if equality is true
+type constraint struct {
+	term     Term
+	equality bool
+}
+
+func (c constraint) substitute(name string, replacement Term) constraint {
+	return constraint{
+		term:     c.term.Substitute(name, replacement),
+		equality: c.equality,
+	}
+}
+
+// normalize divides the coefficients of the constraint by their greatest common divisor.
+// The constant of an inequality is rounded down, which tightens the constraint.
+// It returns false if the constraint is unsatisfiable.
+func normalize(c constraint) (constraint, bool) {
+	constant := c.term.constantValue()
+
+	if c.term.IsConstant() {
+		if c.equality {
+			return c, constant.Sign() == 0
+		}
+		return c, constant.Sign() >= 0
+	}
+
+	gcd := new(big.Int)
+	for _, coefficient := range c.term.coefficients { //nolint:maprange
+		gcd.GCD(nil, nil, gcd, new(big.Int).Abs(coefficient))
+	}
+
+	if gcd.Cmp(one) == 0 {
+		return c, true
+	}
+
+	if c.equality && new(big.Int).Mod(constant, gcd).Sign() != 0 {
+		return c, false
+	}
+
+	coefficients := make(map[string]*big.Int, len(c.term.coefficients))
+	for name, coefficient := range c.term.coefficients { //nolint:maprange
+		coefficients[name] = new(big.Int).Quo(coefficient, gcd)
+	}
+
+	// NOTE: Div is the Euclidean division, i.e. it rounds down for positive divisors
+	return constraint{
+		term: Term{
+			coefficients: coefficients,
+			constant:     new(big.Int).Div(constant, gcd),
+		},
+		equality: c.equality,
+	}, true
+}
+
+type solver struct {
+	freshVariableCount int
+	steps              int
+}
+
+func (s *solver) freshVariable() string {
+	s.freshVariableCount++
+	return freshVariablePrefix + strconv.Itoa(s.freshVariableCount)
+}
+
+func (s *solver) isFreshVariable(name string) bool {
+	return len(name) > 0 && name[:1] == freshVariablePrefix
+}
+
+// search enumerates the conjunctions of the disjunctive normal form
+// of the conjunction of the pending formulas, which must be in negation normal form,
+// and the given constraints, until it finds a satisfiable one
+func (s *solver) search(pending []Formula, conjunction []constraint) (Result, Model) {
+	for len(pending) > 0 {
+		last := len(pending) - 1
+		formula := pending[last]
+		pending = pending[:last]
+
+		switch formula := formula.(type) {
+		case Truth:
+			if !formula {
+				return ResultUnsatisfiable, nil
+			}
+
+		case Atom:
+			conjunction = append(
+				slices.Clip(conjunction),
+				constraint{
+					term:     formula.Term,
+					equality: formula.Equality,
+				},
+			)
+
+		case And:
+			pending = append(slices.Clip(pending), formula...)
+
+		case Or:
+			// Prune the search if the constraints are already unsatisfiable
+
+			result, _ := s.omega(conjunction)
+			if result == ResultUnsatisfiable {
+				return result, nil
+			}
+
+			result = ResultUnsatisfiable
+
+			for _, disjunct := range formula {
+				disjunctResult, model := s.search(
+					append(slices.Clip(pending), disjunct),
+					conjunction,
+				)
+
+				switch disjunctResult {
+				case ResultSatisfiable:
+					return disjunctResult, model
+
+				case ResultUnknown:
+					result = ResultUnknown
+				}
+			}
+
+			return result, nil
+
+		default:
+			panic(errors.NewUnreachableError())
+		}
+	}
+
+	return s.omega(conjunction)
+}
+
+// omega decides the satisfiability of the given conjunction of constraints
+func (s *solver) omega(constraints []constraint) (Result, Model) {
+	s.steps++
+	if s.steps > maxSteps {
+		return ResultUnknown, nil
+	}
+
+	normalized := make([]constraint, 0, len(constraints))
+	for _, c := range constraints {
+		c, ok := normalize(c)
+		if !ok {
+			return ResultUnsatisfiable, nil
+		}
+		if c.term.IsConstant() {
+			continue
+		}
+		normalized = append(normalized, c)
+	}
+
+	if len(normalized) == 0 {
+		return ResultSatisfiable, Model{}
+	}
+
+	for i, c := range normalized {
+		if c.equality {
+			return s.eliminateEquality(normalized, i)
+		}
+	}
+
+	return s.eliminateInequality(normalized)
+}
+
+// eliminateEquality eliminates a variable using the equality at the given index
+func (s *solver) eliminateEquality(constraints []constraint, index int) (Result, Model) {
+	equality := constraints[index]
+	names := equality.term.Variables()
+
+	// If a variable has a unit coefficient,
+	// solve the equality for it, and substitute it in all other constraints:
+	// a * x + r == 0, where a = ±1  ==>  x == -a * r
+
+	for _, name := range names {
+		coefficient := equality.term.coefficients[name]
+		if coefficient.CmpAbs(one) != 0 {
+			continue
+		}
+
+		replacement := equality.term.
+			withoutVariable(name).
+			Multiply(new(big.Int).Neg(coefficient))
+
+		others := make([]constraint, 0, len(constraints)-1)
+		for i, other := range constraints {
+			if i == index {
+				continue
+			}
+			others = append(others, other.substitute(name, replacement))
+		}
+
+		result, model := s.omega(others)
+		if result == ResultSatisfiable {
+			model[name] = replacement.Evaluate(model)
+		}
+		return result, model
+	}
+
+	// Otherwise, reduce the coefficients of the equality using a fresh variable σ:
+	// Let a_k be the coefficient with the smallest absolute value, and m = |a_k| + 1.
+	// Then m * σ == sum_i (a_i mod^ m) * x_i + (c mod^ m) for some integer σ,
+	// where a mod^ m = a - m * floor(a / m + 1/2) is the symmetric modulo.
+	// As a_k mod^ m == -sign(a_k), the new equality can be solved for x_k:
+	// x_k == sign(a_k) * (-m * σ + sum_{i != k} (a_i mod^ m) * x_i + (c mod^ m))
+
+	name := names[0]
+	for _, other := range names[1:] {
+		if equality.term.coefficients[other].CmpAbs(equality.term.coefficients[name]) < 0 {
+			name = other
+		}
+	}
+
+	coefficient := equality.term.coefficients[name]
+	m := new(big.Int).Abs(coefficient)
+	m.Add(m, one)
+
+	sigma := s.freshVariable()
+
+	replacement := NewVariable(sigma).Multiply(new(big.Int).Neg(m))
+	for _, other := range names {
+		if other == name {
+			continue
+		}
+		otherCoefficient := equality.term.coefficients[other]
+		replacement = replacement.Add(
+			NewVariable(other).Multiply(symmetricModulo(otherCoefficient, m)),
+		)
+	}
+	replacement = replacement.
+		Add(NewConstant(symmetricModulo(equality.term.constantValue(), m))).
+		Multiply(big.NewInt(int64(coefficient.Sign())))
+
+	substituted := make([]constraint, len(constraints))
+	for i, c := range constraints {
+		substituted[i] = c.substitute(name, replacement)
+	}
+
+	result, model := s.omega(substituted)
+	if result == ResultSatisfiable {
+		model[name] = replacement.Evaluate(model)
+	}
+	return result, model
+}
+
+// symmetricModulo returns a mod^ m = a - m * floor(a / m + 1/2)
+func symmetricModulo(a, m *big.Int) *big.Int {
+	twiceM := new(big.Int).Lsh(m, 1)
+
+	quotient := new(big.Int).Lsh(a, 1)
+	quotient.Add(quotient, m)
+	quotient.Div(quotient, twiceM)
+	quotient.Mul(quotient, m)
+
+	return quotient.Sub(a, quotient)
+}
+
+// eliminateInequality eliminates a variable from the given inequalities
+func (s *solver) eliminateInequality(constraints []constraint) (Result, Model) {
+	names := constraintsVariables(constraints)
+
+	// If a variable is only bounded from one side,
+	// the constraints involving it can always be satisfied
+
+	for _, name := range names {
+		lowerCount, upperCount := boundCounts(constraints, name)
+		if lowerCount > 0 && upperCount > 0 {
+			continue
+		}
+
+		related, others := partitionConstraints(constraints, name)
+
+		result, model := s.omega(others)
+		if result == ResultSatisfiable {
+			return extendModel(model, name, related)
+		}
+		return result, model
+	}
+
+	name, exact := chooseVariable(constraints, names)
+
+	related, others := partitionConstraints(constraints, name)
+
+	var lowers, uppers []constraint
+	for _, c := range related {
+		if c.term.coefficients[name].Sign() > 0 {
+			lowers = append(lowers, c)
+		} else {
+			uppers = append(uppers, c)
+		}
+	}
+
+	// If the elimination is exact, the real shadow has an integer solution
+	// if and only if the constraints have an integer solution
+
+	realShadow := shadow(lowers, uppers, others, name, false)
+
+	if exact {
+		result, model := s.omega(realShadow)
+		if result == ResultSatisfiable {
+			return extendModel(model, name, related)
+		}
+		return result, model
+	}
+
+	// If the real shadow has no integer solution, the constraints have no integer solution
+
+	result, _ := s.omega(realShadow)
+	if result == ResultUnsatisfiable {
+		return result, nil
+	}
+
+	// If the dark shadow has an integer solution, the constraints have an integer solution
+
+	darkShadow := shadow(lowers, uppers, others, name, true)
+
+	result, model := s.omega(darkShadow)
+	if result == ResultSatisfiable {
+		return extendModel(model, name, related)
+	}
+
+	unknown := result == ResultUnknown
+
+	// Otherwise, an integer solution must lie close to a lower bound a * x >= -l.
+	// Search the splinters a * x == -l + i, for 0 <= i <= (m * a - m - a) / m,
+	// where m is the largest coefficient of the upper bounds
+
+	largestUpperCoefficient := new(big.Int)
+	for _, upper := range uppers {
+		coefficient := new(big.Int).Neg(upper.term.coefficients[name])
+		if coefficient.Cmp(largestUpperCoefficient) > 0 {
+			largestUpperCoefficient = coefficient
+		}
+	}
+
+	for _, lower := range lowers {
+		coefficient := lower.term.coefficients[name]
+
+		limit := new(big.Int).Mul(largestUpperCoefficient, coefficient)
+		limit.Sub(limit, largestUpperCoefficient)
+		limit.Sub(limit, coefficient)
+		limit.Div(limit, largestUpperCoefficient)
+
+		for i := new(big.Int); i.Cmp(limit) <= 0; i.Add(i, one) {
+			splinter := append(
+				slices.Clip(constraints),
+				constraint{
+					term:     lower.term.Subtract(NewConstant(i)),
+					equality: true,
+				},
+			)
+
+			result, model := s.omega(splinter)
+			switch result {
+			case ResultSatisfiable:
+				return result, model
+
+			case ResultUnknown:
+				if s.steps > maxSteps {
+					return result, nil
+				}
+				unknown = true
+			}
+		}
+	}
+
+	if unknown {
+		return ResultUnknown, nil
+	}
+
+	return ResultUnsatisfiable, nil
+}
+
+// constraintsVariables returns the names of the variables which occur in the given constraints,
+// in sorted order
+func constraintsVariables(constraints []constraint) []string {
+	nameSet := map[string]struct{}{}
+	for _, c := range constraints {
+		for name := range c.term.coefficients { //nolint:maprange
+			nameSet[name] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(nameSet))
+	for name := range nameSet { //nolint:maprange
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// boundCounts returns the number of lower and upper bounds of the given variable
+func boundCounts(constraints []constraint, name string) (lowerCount, upperCount int) {
+	for _, c := range constraints {
+		coefficient, ok := c.term.coefficients[name]
+		if !ok {
+			continue
+		}
+		if coefficient.Sign() > 0 {
+			lowerCount++
+		} else {
+			upperCount++
+		}
+	}
+	return
+}
+
+// partitionConstraints splits the given constraints into the constraints
+// which involve the given variable and the ones which do not
+func partitionConstraints(constraints []constraint, name string) (related, others []constraint) {
+	for _, c := range constraints {
+		if _, ok := c.term.coefficients[name]; ok {
+			related = append(related, c)
+		} else {
+			others = append(others, c)
+		}
+	}
+	return
+}
+
+// chooseVariable chooses the variable to eliminate from the given inequalities.
+// Variables which can be eliminated exactly are preferred,
+// i.e. variables which have only unit coefficients in their lower bounds or in their upper bounds.
+// Among those, the variable which produces the fewest new constraints is chosen.
+func chooseVariable(constraints []constraint, names []string) (name string, exact bool) {
+	bestCost := -1
+
+	for _, candidate := range names {
+		lowerCount, upperCount := boundCounts(constraints, candidate)
+		cost := lowerCount * upperCount
+
+		unitLowers := true
+		unitUppers := true
+		for _, c := range constraints {
+			coefficient, ok := c.term.coefficients[candidate]
+			if !ok || coefficient.CmpAbs(one) == 0 {
+				continue
+			}
+			if coefficient.Sign() > 0 {
+				unitLowers = false
+			} else {
+				unitUppers = false
+			}
+		}
+		candidateExact := unitLowers || unitUppers
+
+		if bestCost < 0 ||
+			(candidateExact && !exact) ||
+			(candidateExact == exact && cost < bestCost) {
+
+			name = candidate
+			exact = candidateExact
+			bestCost = cost
+		}
+	}
+
+	return
+}
+
+// shadow eliminates the given variable by combining each lower bound a * x + l >= 0
+// with each upper bound -b * x + u >= 0 into b * l + a * u >= 0 (the real shadow),
+// or b * l + a * u >= (a - 1) * (b - 1) (the dark shadow)
+func shadow(lowers, uppers, others []constraint, name string, dark bool) []constraint {
+	result := slices.Clip(others)
+
+	for _, lower := range lowers {
+		a := lower.term.coefficients[name]
+
+		for _, upper := range uppers {
+			b := new(big.Int).Neg(upper.term.coefficients[name])
+
+			term := lower.term.Multiply(b).Add(upper.term.Multiply(a))
+
+			if dark {
+				product := new(big.Int).Sub(a, one)
+				product.Mul(product, new(big.Int).Sub(b, one))
+				term = term.Subtract(NewConstant(product))
+			}
+
+			result = append(result, constraint{term: term})
+		}
+	}
+
+	return result
+}
+
+// extendModel extends the given model of the remaining constraints
+// with a value for the given variable which satisfies the given related constraints.
+// The value closest to zero is chosen.
+func extendModel(model Model, name string, related []constraint) (Result, Model) {
+	var lowerBound, upperBound *big.Int
+
+	for _, c := range related {
+		coefficient := c.term.coefficients[name]
+		rest := c.term.withoutVariable(name).Evaluate(model)
+
+		if coefficient.Sign() > 0 {
+			// a * x + r >= 0  ==>  x >= ceil(-r / a)
+			bound := new(big.Int).Div(rest, coefficient)
+			bound.Neg(bound)
+			if lowerBound == nil || bound.Cmp(lowerBound) > 0 {
+				lowerBound = bound
+			}
+		} else {
+			// -b * x + r >= 0  ==>  x <= floor(r / b)
+			bound := new(big.Int).Div(rest, new(big.Int).Neg(coefficient))
+			if upperBound == nil || bound.Cmp(upperBound) < 0 {
+				upperBound = bound
+			}
+		}
+	}
+
+	value := new(big.Int)
+	if lowerBound != nil && value.Cmp(lowerBound) < 0 {
+		value = lowerBound
+	}
+	if upperBound != nil && value.Cmp(upperBound) > 0 {
+		value = upperBound
+	}
+	if lowerBound != nil && value.Cmp(lowerBound) < 0 {
+		// The bounds are inconsistent, which cannot happen
+		// if the remaining constraints are a shadow of the related constraints
+		return ResultUnknown, nil
+	}
+
+	model[name] = value
+
+	return ResultSatisfiable, model
+}
@@ -0,0 +1,241 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lia
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func variable(name string, coefficient int64) Term {
+	return NewVariable(name).Multiply(big.NewInt(coefficient))
+}
+
+func constant(value int64) Term {
+	return NewConstantFromInt64(value)
+}
+
+func sum(terms ...Term) Term {
+	result := constant(0)
+	for _, term := range terms {
+		result = result.Add(term)
+	}
+	return result
+}
+
+func TestSolve(t *testing.T) {
+
+	t.Parallel()
+
+	x := NewVariable("x")
+	y := NewVariable("y")
+
+	type test struct {
+		name     string
+		formula  Formula
+		expected Result
+	}
+
+	tests := []test{
+		{
+			name:     "true",
+			formula:  True,
+			expected: ResultSatisfiable,
+		},
+		{
+			name:     "false",
+			formula:  False,
+			expected: ResultUnsatisfiable,
+		},
+		{
+			name: "contradicting bounds",
+			formula: And{
+				GreaterEqual(x, constant(1)),
+				LessEqual(x, constant(0)),
+			},
+			expected: ResultUnsatisfiable,
+		},
+		{
+			name: "bounds",
+			formula: And{
+				Greater(x, constant(3)),
+				Less(x, y),
+				LessEqual(y, constant(5)),
+			},
+			expected: ResultSatisfiable,
+		},
+		{
+			name:     "no integer solution of equality",
+			formula:  Equal(variable("x", 2), constant(1)),
+			expected: ResultUnsatisfiable,
+		},
+		{
+			name:     "equality without unit coefficients",
+			formula:  Equal(sum(variable("x", 3), variable("y", 5)), constant(1)),
+			expected: ResultSatisfiable,
+		},
+		{
+			name: "bounded equality without unit coefficients",
+			formula: And{
+				Equal(sum(variable("x", 3), variable("y", 5)), constant(1)),
+				GreaterEqual(x, constant(0)),
+				GreaterEqual(y, constant(0)),
+			},
+			expected: ResultUnsatisfiable,
+		},
+		{
+			// The real shadow has a solution, but there is no integer solution
+			name: "inexact elimination",
+			formula: And{
+				LessEqual(constant(27), sum(variable("x", 11), variable("y", 13))),
+				LessEqual(sum(variable("x", 11), variable("y", 13)), constant(45)),
+				LessEqual(constant(-10), sum(variable("x", 7), variable("y", -9))),
+				LessEqual(sum(variable("x", 7), variable("y", -9)), constant(4)),
+			},
+			expected: ResultUnsatisfiable,
+		},
+		{
+			name: "disequality",
+			formula: And{
+				GreaterEqual(x, constant(0)),
+				LessEqual(x, constant(1)),
+				NotEqual(x, constant(0)),
+				NotEqual(x, constant(1)),
+			},
+			expected: ResultUnsatisfiable,
+		},
+		{
+			name: "disjunction",
+			formula: And{
+				Or{
+					Less(x, constant(0)),
+					Greater(x, constant(10)),
+				},
+				Not{Formula: Greater(x, constant(-5))},
+			},
+			expected: ResultSatisfiable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			result, model := Solve(test.formula)
+			require.Equal(t, test.expected, result)
+
+			if result == ResultSatisfiable {
+				assert.True(t, test.formula.Evaluate(model))
+			}
+		})
+	}
+}
+
+func TestSolveFreshVariables(t *testing.T) {
+
+	t.Parallel()
+
+	formula := Equal(
+		sum(variable("x", 4), variable("y", 6), variable("z", 9)),
+		constant(7),
+	)
+
+	result, model := Solve(formula)
+	require.Equal(t, ResultSatisfiable, result)
+
+	assert.True(t, formula.Evaluate(model))
+	for name := range model {
+		assert.NotEqual(t, freshVariablePrefix, name[:1])
+	}
+}
+
+// TestSolveRandom compares the results of the solver
+// with an exhaustive search for random bounded systems of constraints
+func TestSolveRandom(t *testing.T) {
+
+	t.Parallel()
+
+	const bound = 5
+	names := []string{"x", "y", "z"}
+
+	random := rand.New(rand.NewSource(42))
+
+	randomTerm := func() Term {
+		term := constant(random.Int63n(21) - 10)
+		for _, name := range names {
+			term = term.Add(variable(name, random.Int63n(9)-4))
+		}
+		return term
+	}
+
+	var exhaustive func(formula Formula, model Model, index int) bool
+	exhaustive = func(formula Formula, model Model, index int) bool {
+		if index == len(names) {
+			return formula.Evaluate(model)
+		}
+		for value := int64(-bound); value <= bound; value++ {
+			model[names[index]] = big.NewInt(value)
+			if exhaustive(formula, model, index+1) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < 300; i++ {
+
+		formula := And{}
+		for _, name := range names {
+			formula = append(formula,
+				GreaterEqual(NewVariable(name), constant(-bound)),
+				LessEqual(NewVariable(name), constant(bound)),
+			)
+		}
+
+		constraintCount := 1 + random.Intn(4)
+		for j := 0; j < constraintCount; j++ {
+			var atom Formula
+			switch random.Intn(3) {
+			case 0:
+				atom = Atom{Term: randomTerm()}
+			case 1:
+				atom = Atom{Term: randomTerm(), Equality: true}
+			case 2:
+				atom = Not{Formula: Atom{Term: randomTerm(), Equality: true}}
+			}
+			formula = append(formula, atom)
+		}
+
+		expected := exhaustive(formula, Model{}, 0)
+
+		result, model := Solve(formula)
+
+		if expected {
+			require.Equal(t, ResultSatisfiable, result, formula.String())
+			require.True(t, formula.Evaluate(model), formula.String())
+		} else {
+			require.Equal(t, ResultUnsatisfiable, result, formula.String())
+		}
+	}
+}
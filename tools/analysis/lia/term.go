@@ -0,0 +1,219 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lia
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Term is a linear combination of integer variables with integer coefficients,
+// plus an integer constant, i.e. `c_1 * x_1 + ... + c_n * x_n + c`.
+//
+// Terms are immutable: all operations return new terms.
+type Term struct {
+	coefficients map[string]*big.Int
+	constant     *big.Int
+}
+
+// NewConstant returns the term which only consists of the given constant
+func NewConstant(value *big.Int) Term {
+	return Term{
+		constant: new(big.Int).Set(value),
+	}
+}
+
+// NewConstantFromInt64 returns the term which only consists of the given constant
+func NewConstantFromInt64(value int64) Term {
+	return Term{
+		constant: big.NewInt(value),
+	}
+}
+
+// NewVariable returns the term which only consists of the variable with the given name
+func NewVariable(name string) Term {
+	return Term{
+		coefficients: map[string]*big.Int{
+			name: big.NewInt(1),
+		},
+	}
+}
+
+func (t Term) constantValue() *big.Int {
+	if t.constant == nil {
+		return new(big.Int)
+	}
+	return t.constant
+}
+
+// Constant returns the constant of the term
+func (t Term) Constant() *big.Int {
+	return new(big.Int).Set(t.constantValue())
+}
+
+// Coefficient returns the coefficient of the variable with the given name.
+// The coefficient is zero if the variable does not occur in the term.
+func (t Term) Coefficient(name string) *big.Int {
+	coefficient, ok := t.coefficients[name]
+	if !ok {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(coefficient)
+}
+
+// Variables returns the names of the variables which occur in the term, in sorted order
+func (t Term) Variables() []string {
+	names := make([]string, 0, len(t.coefficients))
+	for name := range t.coefficients { //nolint:maprange
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsConstant returns true if no variables occur in the term
+func (t Term) IsConstant() bool {
+	return len(t.coefficients) == 0
+}
+
+// Add returns the sum of the term and the given term
+func (t Term) Add(other Term) Term {
+	return t.addMultiple(other, big.NewInt(1))
+}
+
+// Subtract returns the difference of the term and the given term
+func (t Term) Subtract(other Term) Term {
+	return t.addMultiple(other, big.NewInt(-1))
+}
+
+// Negate returns the negation of the term
+func (t Term) Negate() Term {
+	return t.Multiply(big.NewInt(-1))
+}
+
+// Multiply returns the term multiplied by the given factor
+func (t Term) Multiply(factor *big.Int) Term {
+	return Term{}.addMultiple(t, factor)
+}
+
+// addMultiple returns the sum of the term and the given term multiplied by the given factor
+func (t Term) addMultiple(other Term, factor *big.Int) Term {
+	coefficients := make(map[string]*big.Int, len(t.coefficients)+len(other.coefficients))
+
+	for name, coefficient := range t.coefficients { //nolint:maprange
+		coefficients[name] = new(big.Int).Set(coefficient)
+	}
+
+	for name, coefficient := range other.coefficients { //nolint:maprange
+		product := new(big.Int).Mul(coefficient, factor)
+		if existing, ok := coefficients[name]; ok {
+			product.Add(product, existing)
+		}
+		if product.Sign() == 0 {
+			delete(coefficients, name)
+		} else {
+			coefficients[name] = product
+		}
+	}
+
+	constant := new(big.Int).Mul(other.constantValue(), factor)
+	constant.Add(constant, t.constantValue())
+
+	return Term{
+		coefficients: coefficients,
+		constant:     constant,
+	}
+}
+
+// Substitute returns the term where the variable with the given name
+// is replaced with the given replacement term
+func (t Term) Substitute(name string, replacement Term) Term {
+	coefficient, ok := t.coefficients[name]
+	if !ok {
+		return t
+	}
+
+	return t.withoutVariable(name).addMultiple(replacement, coefficient)
+}
+
+// withoutVariable returns the term without the variable with the given name
+func (t Term) withoutVariable(name string) Term {
+	coefficients := make(map[string]*big.Int, len(t.coefficients))
+	for otherName, coefficient := range t.coefficients { //nolint:maprange
+		if otherName == name {
+			continue
+		}
+		coefficients[otherName] = coefficient
+	}
+	return Term{
+		coefficients: coefficients,
+		constant:     t.constant,
+	}
+}
+
+// Evaluate returns the value of the term for the given model.
+// Variables which have no value in the model are zero.
+func (t Term) Evaluate(model Model) *big.Int {
+	result := new(big.Int).Set(t.constantValue())
+	for _, name := range t.Variables() {
+		product := new(big.Int).Mul(t.coefficients[name], model.Value(name))
+		result.Add(result, product)
+	}
+	return result
+}
+
+func (t Term) String() string {
+	var builder strings.Builder
+
+	for i, name := range t.Variables() {
+		coefficient := t.coefficients[name]
+		if i > 0 {
+			builder.WriteString(" + ")
+		}
+		if coefficient.Cmp(big.NewInt(1)) != 0 {
+			builder.WriteString(coefficient.String())
+			builder.WriteString("*")
+		}
+		builder.WriteString(name)
+	}
+
+	constant := t.constantValue()
+	if constant.Sign() != 0 || t.IsConstant() {
+		if !t.IsConstant() {
+			builder.WriteString(" + ")
+		}
+		builder.WriteString(constant.String())
+	}
+
+	return builder.String()
+}
+
+// Model is an assignment of values to variables
+type Model map[string]*big.Int
+
+// Value returns the value of the variable with the given name,
+// or zero if the variable has no value in the model
+func (m Model) Value(name string) *big.Int {
+	value, ok := m[name]
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
@@ -0,0 +1,291 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+)
+
+// Key is the key of a register.
+type Key struct {
+	Owner string
+	Key   string
+}
+
+// Registers is a snapshot of registers, e.g. read from a state dump.
+//
+// Registers implements atree.Ledger,
+// so it can be used as the ledger of a runtime.Storage.
+type Registers struct {
+	values map[Key][]byte
+	// slabIndices are the last allocated slab indices, by owner
+	slabIndices map[string]uint64
+}
+
+var _ atree.Ledger = &Registers{}
+
+func New() *Registers {
+	return &Registers{
+		values:      map[Key][]byte{},
+		slabIndices: map[string]uint64{},
+	}
+}
+
+func (r *Registers) GetValue(owner, key []byte) ([]byte, error) {
+	return r.values[Key{
+		Owner: string(owner),
+		Key:   string(key),
+	}], nil
+}
+
+func (r *Registers) SetValue(owner, key, value []byte) error {
+	registerKey := Key{
+		Owner: string(owner),
+		Key:   string(key),
+	}
+
+	// An empty value removes the register
+	if len(value) == 0 {
+		delete(r.values, registerKey)
+		return nil
+	}
+
+	r.values[registerKey] = value
+	return nil
+}
+
+func (r *Registers) ValueExists(owner, key []byte) (bool, error) {
+	value, err := r.GetValue(owner, key)
+	if err != nil {
+		return false, err
+	}
+	return len(value) > 0, nil
+}
+
+// AllocateSlabIndex allocates a slab index which is greater than
+// the indices of all existing slab registers of the owner.
+func (r *Registers) AllocateSlabIndex(owner []byte) (atree.SlabIndex, error) {
+	lastIndex, ok := r.slabIndices[string(owner)]
+	if !ok {
+		for key := range r.values { //nolint:maprange
+			if key.Owner != string(owner) || !IsSlabKey(key.Key) {
+				continue
+			}
+
+			index := binary.BigEndian.Uint64([]byte(key.Key[1:]))
+			if index > lastIndex {
+				lastIndex = index
+			}
+		}
+	}
+
+	index := lastIndex + 1
+	r.slabIndices[string(owner)] = index
+
+	var result atree.SlabIndex
+	binary.BigEndian.PutUint64(result[:], index)
+	return result, nil
+}
+
+// Count returns the number of registers.
+func (r *Registers) Count() int {
+	return len(r.values)
+}
+
+// Owners returns the addresses of all owners of registers, in ascending order.
+func (r *Registers) Owners() []common.Address {
+	owners := map[string]struct{}{}
+	for key := range r.values { //nolint:maprange
+		owners[key.Owner] = struct{}{}
+	}
+
+	addresses := make([]common.Address, 0, len(owners))
+	for owner := range owners { //nolint:maprange
+		address, err := common.BytesToAddress([]byte(owner))
+		if err != nil {
+			// Ignore registers which are not owned by accounts
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+
+	return addresses
+}
+
+// ForEach calls the given function for each register, in ascending order of the keys.
+func (r *Registers) ForEach(f func(key Key, value []byte) error) error {
+	keys := make([]Key, 0, len(r.values))
+	for key := range r.values { //nolint:maprange
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		a := keys[i]
+		b := keys[j]
+		if a.Owner != b.Owner {
+			return a.Owner < b.Owner
+		}
+		return a.Key < b.Key
+	})
+
+	for _, key := range keys {
+		err := f(key, r.values[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// '$' + 8 byte index
+const slabKeyLength = 9
+
+// IsSlabKey returns true if the given register key is the key of an atree slab.
+func IsSlabKey(key string) bool {
+	return len(key) == slabKeyLength && key[0] == '$'
+}
+
+// SlabKey returns the register key of the atree slab with the given ID.
+func SlabKey(id atree.SlabID) Key {
+	address := id.Address()
+	index := id.Index()
+
+	return Key{
+		Owner: string(address[:]),
+		Key:   "$" + string(index[:]),
+	}
+}
+
+// StorageMapKeyString returns the string representation of the given storage map key,
+// i.e. the string of a string key, and the decimal representation of an integer key.
+func StorageMapKeyString(key atree.Value) string {
+	switch key := key.(type) {
+	case interpreter.StringAtreeValue:
+		return string(key)
+	case interpreter.Uint64AtreeValue:
+		return strconv.FormatUint(uint64(key), 10)
+	default:
+		return fmt.Sprint(key)
+	}
+}
+
+// State dumps are in JSON Lines format.
+// Each line is a register, with hex-encoded key parts and value.
+// Key parts are the owner, optionally followed by the (empty) controller, and the key.
+
+type encodedKeyPart struct {
+	Value string
+}
+
+type encodedKey struct {
+	KeyParts []encodedKeyPart
+}
+
+type encodedEntry struct {
+	Value string
+	Key   encodedKey
+}
+
+// Read reads registers from a state dump in JSON Lines format.
+// Entries with empty values are skipped.
+func Read(reader io.Reader) (*Registers, error) {
+	registers := New()
+
+	decoder := json.NewDecoder(bufio.NewReader(reader))
+
+	for line := 1; ; line++ {
+		var entry encodedEntry
+
+		err := decoder.Decode(&entry)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode entry on line %d: %w", line, err)
+		}
+
+		keyParts := entry.Key.KeyParts
+		keyPartCount := len(keyParts)
+		if keyPartCount == 0 {
+			continue
+		}
+		if keyPartCount < 2 || keyPartCount > 3 {
+			return nil, fmt.Errorf("invalid register key on line %d: %d key parts", line, keyPartCount)
+		}
+
+		owner, err := hex.DecodeString(keyParts[0].Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid register owner on line %d: %w", line, err)
+		}
+
+		key, err := hex.DecodeString(keyParts[keyPartCount-1].Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid register key on line %d: %w", line, err)
+		}
+
+		value, err := hex.DecodeString(entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid register value on line %d: %w", line, err)
+		}
+
+		err = registers.SetValue(owner, key, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return registers, nil
+}
+
+// Write writes the registers as a state dump in JSON Lines format,
+// in ascending order of the keys.
+func (r *Registers) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+
+	return r.ForEach(func(key Key, value []byte) error {
+		return encoder.Encode(encodedEntry{
+			Value: hex.EncodeToString(value),
+			Key: encodedKey{
+				KeyParts: []encodedKeyPart{
+					{Value: hex.EncodeToString([]byte(key.Owner))},
+					{Value: ""},
+					{Value: hex.EncodeToString([]byte(key.Key))},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,182 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/onflow/atree"
+	"github.com/onflow/flow-go/cmd/util/ledger/util/registers"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+var testAddress = common.MustBytesToAddress([]byte{0x1})
+
+var testLocation = common.AddressLocation{
+	Address: testAddress,
+	Name:    "Test",
+}
+
+// testLedger is a ledger which stores the registers in a registers.ByAccount
+type testLedger struct {
+	registersByAccount *registers.ByAccount
+	slabIndex          uint64
+}
+
+var _ atree.Ledger = &testLedger{}
+
+func (l *testLedger) GetValue(owner, key []byte) ([]byte, error) {
+	return l.registersByAccount.Get(string(owner), string(key))
+}
+
+func (l *testLedger) SetValue(owner, key, value []byte) error {
+	return l.registersByAccount.Set(string(owner), string(key), value)
+}
+
+func (l *testLedger) ValueExists(owner, key []byte) (bool, error) {
+	value, err := l.GetValue(owner, key)
+	if err != nil {
+		return false, err
+	}
+	return len(value) > 0, nil
+}
+
+func (l *testLedger) AllocateSlabIndex(_ []byte) (atree.SlabIndex, error) {
+	l.slabIndex++
+
+	var result atree.SlabIndex
+	binary.BigEndian.PutUint64(result[:], l.slabIndex)
+	return result, nil
+}
+
+// newTestStorage returns a storage and an interpreter
+// for the given registers.
+func newTestStorage(t *testing.T, registersByAccount *registers.ByAccount) (*runtime.Storage, *interpreter.Interpreter) {
+	storage := runtime.NewStorage(
+		&testLedger{
+			registersByAccount: registersByAccount,
+		},
+		nil,
+	)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		testLocation,
+		&interpreter.Config{
+			Storage: storage,
+		},
+	)
+	require.NoError(t, err)
+
+	return storage, inter
+}
+
+// newTestRegisters returns the registers of a small snapshot,
+// which has the following values in the storage domain of the test account:
+//
+//   - `answer`: the integer 42
+//   - `vault`: a `Test.Vault` structure with a balance of 10 and the IDs 1 and 2
+//   - `names`: a dictionary with the key "a" and a `Test.Vault` with a balance of 3 and no IDs
+func newTestRegisters(t *testing.T) *registers.ByAccount {
+	registersByAccount := registers.NewByAccount()
+
+	storage, inter := newTestStorage(t, registersByAccount)
+
+	storageMap := storage.GetStorageMap(
+		testAddress,
+		common.PathDomainStorage.Identifier(),
+		true,
+	)
+
+	storageMap.WriteValue(
+		inter,
+		interpreter.StringStorageMapKey("answer"),
+		interpreter.NewUnmeteredIntValueFromInt64(42),
+	)
+
+	storageMap.WriteValue(
+		inter,
+		interpreter.StringStorageMapKey("vault"),
+		newTestVault(inter, 10, 1, 2),
+	)
+
+	storageMap.WriteValue(
+		inter,
+		interpreter.StringStorageMapKey("names"),
+		interpreter.NewDictionaryValueWithAddress(
+			inter,
+			interpreter.EmptyLocationRange,
+			&interpreter.DictionaryStaticType{
+				KeyType: interpreter.PrimitiveStaticTypeString,
+				ValueType: interpreter.NewCompositeStaticTypeComputeTypeID(
+					nil,
+					testLocation,
+					"Test.Vault",
+				),
+			},
+			testAddress,
+			interpreter.NewUnmeteredStringValue("a"),
+			newTestVault(inter, 3),
+		),
+	)
+
+	err := storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	return registersByAccount
+}
+
+func newTestVault(inter *interpreter.Interpreter, balance int64, ids ...int64) *interpreter.CompositeValue {
+	idValues := make([]interpreter.Value, 0, len(ids))
+	for _, id := range ids {
+		idValues = append(idValues, interpreter.NewUnmeteredIntValueFromInt64(id))
+	}
+
+	return interpreter.NewCompositeValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		testLocation,
+		"Test.Vault",
+		common.CompositeKindStructure,
+		[]interpreter.CompositeField{
+			{
+				Name:  "balance",
+				Value: interpreter.NewUnmeteredIntValueFromInt64(balance),
+			},
+			{
+				Name: "ids",
+				Value: interpreter.NewArrayValue(
+					inter,
+					interpreter.EmptyLocationRange,
+					&interpreter.VariableSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+					testAddress,
+					idValues...,
+				),
+			},
+		},
+		testAddress,
+	)
+}
@@ -47,6 +47,11 @@ func main() {
 	portFlag := flag.Int("port", 3000, "port")
 	payloadsFlag := flag.String("payloads", "", "payloads file")
 	chainIDFlag := flag.String("chain-id", "", "chain ID")
+	queryComputationLimitFlag := flag.Uint64(
+		"query-computation-limit",
+		DefaultQueryComputationLimit,
+		"computation limit of query scripts",
+	)
 
 	flag.Parse()
 
@@ -104,6 +109,21 @@ func main() {
 		NewKnownStorageMapsHandler(log),
 	)
 
+	r.HandleFunc(
+		"/query",
+		NewQueryHandler(registersByAccount, *queryComputationLimitFlag, log),
+	).Methods(http.MethodPost)
+
+	r.HandleFunc(
+		"/search",
+		NewSearchHandler(registersByAccount, mr.Storage, mr.Interpreter, log),
+	).Methods(http.MethodPost)
+
+	r.HandleFunc(
+		"/type_statistics",
+		NewTypeStatisticsHandler(registersByAccount, mr.Storage, mr.Interpreter, log),
+	)
+
 	const accountDomainPattern = "/accounts/{address:[0-9A-Fa-f]{16}}/{domain:.+}"
 
 	r.PathPrefix(accountDomainPattern + "/{identifier:.+}").
@@ -277,6 +297,11 @@ func getNested(inter *interpreter.Interpreter, value interpreter.Value, nested [
 	decoder := &jsoncdc.Decoder{}
 
 	for index, n := range nested {
+		// Optionals are transparent in paths (see StoredValueLocation)
+		if someValue, ok := value.(*interpreter.SomeValue); ok {
+			value = someValue.InnerValue(inter, interpreter.EmptyLocationRange)
+		}
+
 		switch n := n.(type) {
 		case string:
 			memberAccessibleValue, ok := value.(interpreter.MemberAccessibleValue)
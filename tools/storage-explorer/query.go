@@ -0,0 +1,318 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/onflow/atree"
+	"github.com/onflow/flow-go/cmd/util/ledger/util/registers"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+type QueryRequest struct {
+	Script    string            `json:"script"`
+	Arguments []json.RawMessage `json:"arguments"`
+}
+
+type QueryResponse struct {
+	Value json.RawMessage `json:"value"`
+	Logs  []string        `json:"logs"`
+}
+
+// DefaultQueryComputationLimit is the default computation limit of scripts executed by the query handler.
+const DefaultQueryComputationLimit = 1_000_000
+
+// NewQueryHandler returns a handler which executes a script against the loaded snapshot.
+//
+// The request body is a QueryRequest, i.e. the script and its JSON-Cadence encoded arguments.
+// The response is a QueryResponse, i.e. the JSON-Cadence encoded result and the logs of the script.
+//
+// The execution of the script is aborted when it exceeds the given computation limit,
+// or when the request is cancelled, e.g. because the client disconnected.
+func NewQueryHandler(
+	registersByAccount *registers.ByAccount,
+	computationLimit uint64,
+	log zerolog.Logger,
+) func(w http.ResponseWriter, r *http.Request) {
+
+	config := runtime.Config{
+		AttachmentsEnabled: true,
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request QueryRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		arguments := make([][]byte, 0, len(request.Arguments))
+		for _, argument := range request.Arguments {
+			arguments = append(arguments, argument)
+		}
+
+		runtimeInterface := newQueryInterface(
+			r.Context(),
+			registersByAccount,
+			computationLimit,
+		)
+
+		value, err := runtime.NewInterpreterRuntime(config).ExecuteScript(
+			runtime.Script{
+				Source:    []byte(request.Script),
+				Arguments: arguments,
+			},
+			runtime.Context{
+				Interface:   runtimeInterface,
+				Location:    common.ScriptLocation{},
+				Environment: runtime.NewScriptInterpreterEnvironment(config),
+			},
+		)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		encodedValue, err := jsoncdc.Encode(value)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		response := QueryResponse{
+			Value: encodedValue,
+			Logs:  runtimeInterface.logs,
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(response)
+		if err != nil {
+			log.Fatal().Err(err)
+		}
+	}
+}
+
+// queryInterface is the runtime interface for scripts executed against the loaded snapshot.
+//
+// Writes are only recorded for the duration of the script execution,
+// i.e. the snapshot is never modified.
+type queryInterface struct {
+	runtime.EmptyRuntimeInterface
+	ctx                context.Context
+	registersByAccount *registers.ByAccount
+	computationLimit   uint64
+	computationUsed    uint64
+	writes             map[registerKey][]byte
+	slabIndices        map[string]uint64
+	uuid               uint64
+	logs               []string
+}
+
+var _ runtime.Interface = &queryInterface{}
+
+type registerKey struct {
+	owner string
+	key   string
+}
+
+// firstQuerySlabIndex is the first slab index allocated for writes of scripts.
+// The indices of the slabs of the snapshot are unknown,
+// so slab indices are allocated from a range which is not used in practice.
+const firstQuerySlabIndex = 1 << 63
+
+func newQueryInterface(
+	ctx context.Context,
+	registersByAccount *registers.ByAccount,
+	computationLimit uint64,
+) *queryInterface {
+	return &queryInterface{
+		ctx:                ctx,
+		registersByAccount: registersByAccount,
+		computationLimit:   computationLimit,
+		writes:             map[registerKey][]byte{},
+		slabIndices:        map[string]uint64{},
+		logs:               []string{},
+	}
+}
+
+// ComputationLimitExceededError is returned when a script exceeds the computation limit of the query handler.
+type ComputationLimitExceededError struct {
+	Limit uint64
+}
+
+func (e ComputationLimitExceededError) Error() string {
+	return fmt.Sprintf("computation limit exceeded: %d", e.Limit)
+}
+
+func (i *queryInterface) MeterComputation(_ common.ComputationKind, intensity uint) error {
+	// Abort the script when the request got cancelled,
+	// e.g. because the client disconnected
+	err := i.ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	i.computationUsed += uint64(intensity)
+	if i.computationUsed > i.computationLimit {
+		return ComputationLimitExceededError{
+			Limit: i.computationLimit,
+		}
+	}
+
+	return nil
+}
+
+func (i *queryInterface) GetValue(owner, key []byte) ([]byte, error) {
+	value, ok := i.writes[registerKey{
+		owner: string(owner),
+		key:   string(key),
+	}]
+	if ok {
+		return value, nil
+	}
+
+	return i.registersByAccount.Get(string(owner), string(key))
+}
+
+func (i *queryInterface) SetValue(owner, key, value []byte) error {
+	i.writes[registerKey{
+		owner: string(owner),
+		key:   string(key),
+	}] = value
+	return nil
+}
+
+func (i *queryInterface) ValueExists(owner, key []byte) (bool, error) {
+	value, err := i.GetValue(owner, key)
+	if err != nil {
+		return false, err
+	}
+	return len(value) > 0, nil
+}
+
+func (i *queryInterface) AllocateSlabIndex(owner []byte) (atree.SlabIndex, error) {
+	index, ok := i.slabIndices[string(owner)]
+	if !ok {
+		index = firstQuerySlabIndex
+	}
+	i.slabIndices[string(owner)] = index + 1
+
+	var result atree.SlabIndex
+	binary.BigEndian.PutUint64(result[:], index)
+	return result, nil
+}
+
+func (i *queryInterface) ResolveLocation(
+	identifiers []runtime.Identifier,
+	location runtime.Location,
+) ([]runtime.ResolvedLocation, error) {
+
+	addressLocation, ok := location.(common.AddressLocation)
+
+	// Imports of address locations without identifiers are not supported,
+	// as the names of the contracts of the account are not known
+	if !ok || len(identifiers) == 0 {
+		return []runtime.ResolvedLocation{
+			{
+				Location:    location,
+				Identifiers: identifiers,
+			},
+		}, nil
+	}
+
+	resolvedLocations := make([]runtime.ResolvedLocation, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		resolvedLocations = append(
+			resolvedLocations,
+			runtime.ResolvedLocation{
+				Location: common.AddressLocation{
+					Address: addressLocation.Address,
+					Name:    identifier.Identifier,
+				},
+				Identifiers: []runtime.Identifier{identifier},
+			},
+		)
+	}
+
+	return resolvedLocations, nil
+}
+
+func (i *queryInterface) GetAccountContractCode(location common.AddressLocation) ([]byte, error) {
+	return i.registersByAccount.Get(
+		string(location.Address[:]),
+		contractCodeRegisterKey(location.Name),
+	)
+}
+
+func (i *queryInterface) GetCode(location runtime.Location) ([]byte, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return nil, fmt.Errorf("cannot get code of location %s", location)
+	}
+
+	return i.GetAccountContractCode(addressLocation)
+}
+
+func (i *queryInterface) GetOrLoadProgram(
+	_ runtime.Location,
+	load func() (*interpreter.Program, error),
+) (*interpreter.Program, error) {
+	return load()
+}
+
+func (i *queryInterface) SetInterpreterSharedState(_ *interpreter.SharedState) {
+	// NO-OP
+}
+
+func (i *queryInterface) GetInterpreterSharedState() *interpreter.SharedState {
+	return nil
+}
+
+func (i *queryInterface) GenerateUUID() (uint64, error) {
+	i.uuid++
+	return i.uuid, nil
+}
+
+func (i *queryInterface) DecodeArgument(argument []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(nil, argument)
+}
+
+func (i *queryInterface) ProgramLog(message string) error {
+	i.logs = append(i.logs, message)
+	return nil
+}
+
+const contractCodeRegisterKeyPrefix = "code."
+
+func contractCodeRegisterKey(name string) string {
+	return contractCodeRegisterKeyPrefix + name
+}
@@ -0,0 +1,247 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/util/registers"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func registersSnapshot(t *testing.T, registersByAccount *registers.ByAccount) map[registerKey]string {
+	snapshot := map[registerKey]string{}
+	err := registersByAccount.ForEach(func(owner string, key string, value []byte) error {
+		snapshot[registerKey{
+			owner: owner,
+			key:   key,
+		}] = string(value)
+		return nil
+	})
+	require.NoError(t, err)
+	return snapshot
+}
+
+func TestQueryHandler(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		request QueryRequest
+		status  int
+		value   string
+		logs    []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "constant",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main(): Int {
+                      return 1 + 2
+                  }
+                `,
+			},
+			status: http.StatusOK,
+			value:  `{"value":"3","type":"Int"}`,
+			logs:   []string{},
+		},
+		{
+			name: "arguments",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main(x: Int, y: String): String {
+                      return y.concat((x * 2).toString())
+                  }
+                `,
+				Arguments: []json.RawMessage{
+					json.RawMessage(`{"value":"21","type":"Int"}`),
+					json.RawMessage(`{"value":"answer: ","type":"String"}`),
+				},
+			},
+			status: http.StatusOK,
+			value:  `{"value":"answer: 42","type":"String"}`,
+			logs:   []string{},
+		},
+		{
+			name: "logs",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main() {
+                      log("a")
+                      log(1)
+                  }
+                `,
+			},
+			status: http.StatusOK,
+			value:  `{"type":"Void"}`,
+			logs:   []string{`"a"`, `1`},
+		},
+		{
+			name: "storage read",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main(): Int {
+                      return getAuthAccount<auth(Storage) &Account>(0x1)
+                          .storage.copy<Int>(from: /storage/answer)!
+                  }
+                `,
+			},
+			status: http.StatusOK,
+			value:  `{"value":"42","type":"Int"}`,
+			logs:   []string{},
+		},
+		{
+			name: "storage write",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main(): Int {
+                      let account = getAuthAccount<auth(Storage) &Account>(0x1)
+                      let answer = account.storage.load<Int>(from: /storage/answer)!
+                      account.storage.save(answer + 1, to: /storage/answer)
+                      account.storage.save("new", to: /storage/new)
+                      return account.storage.copy<Int>(from: /storage/answer)!
+                  }
+                `,
+			},
+			status: http.StatusOK,
+			value:  `{"value":"43","type":"Int"}`,
+			logs:   []string{},
+		},
+		{
+			name: "invalid script",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main(): Int {
+                      return "a"
+                  }
+                `,
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "run-time error",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main(): Int {
+                      return [1][1]
+                  }
+                `,
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "computation limit exceeded",
+			request: QueryRequest{
+				Script: `
+                  access(all) fun main() {
+                      while true {}
+                  }
+                `,
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			registersByAccount := newTestRegisters(t)
+			expectedRegisters := registersSnapshot(t, registersByAccount)
+
+			handler := NewQueryHandler(registersByAccount, DefaultQueryComputationLimit, zerolog.Nop())
+
+			encodedRequest, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(
+				http.MethodPost,
+				"/query",
+				strings.NewReader(string(encodedRequest)),
+			)
+			recorder := httptest.NewRecorder()
+
+			handler(recorder, request)
+
+			require.Equal(t, testCase.status, recorder.Code, recorder.Body.String())
+
+			// Queries must never modify the registers
+			assert.Equal(t, expectedRegisters, registersSnapshot(t, registersByAccount))
+
+			if testCase.status != http.StatusOK {
+				return
+			}
+
+			var response QueryResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, testCase.value, string(response.Value))
+			assert.Equal(t, testCase.logs, response.Logs)
+		})
+	}
+}
+
+func TestQueryHandlerInvalidRequest(t *testing.T) {
+
+	t.Parallel()
+
+	handler := NewQueryHandler(newTestRegisters(t), DefaultQueryComputationLimit, zerolog.Nop())
+
+	request := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{`))
+	recorder := httptest.NewRecorder()
+
+	handler(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestQueryHandlerCancelledRequest(t *testing.T) {
+
+	t.Parallel()
+
+	handler := NewQueryHandler(newTestRegisters(t), DefaultQueryComputationLimit, zerolog.Nop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/query",
+		strings.NewReader(`{"script":"access(all) fun main() { while true {} }"}`),
+	).WithContext(ctx)
+	recorder := httptest.NewRecorder()
+
+	handler(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), context.Canceled.Error())
+}
@@ -0,0 +1,82 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/util/registers"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+type SearchRequest struct {
+	// Type is the ID of the type of the values to find, e.g. `A.1654653399040a61.FlowToken.Vault`
+	Type string `json:"type"`
+}
+
+// NewSearchHandler returns a handler which finds all stored values of a type, across all accounts.
+//
+// The request body is a SearchRequest.
+// The response is the list of the locations of all values of the type (see StoredValueLocation),
+// including values nested in other stored values.
+func NewSearchHandler(
+	registersByAccount *registers.ByAccount,
+	storage *runtime.Storage,
+	inter *interpreter.Interpreter,
+	log zerolog.Logger,
+) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request SearchRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		locations := make([]StoredValueLocation, 0)
+
+		err = walkStoredValues(
+			registersByAccount,
+			storage,
+			inter,
+			func(location StoredValueLocation, value interpreter.Value) {
+				if string(value.StaticType(inter).ID()) != request.Type {
+					return
+				}
+
+				locations = append(locations, location)
+			},
+		)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(locations)
+		if err != nil {
+			log.Fatal().Err(err)
+		}
+	}
+}
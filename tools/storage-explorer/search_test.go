@@ -0,0 +1,129 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchHandler(t *testing.T) {
+
+	t.Parallel()
+
+	registersByAccount := newTestRegisters(t)
+	storage, inter := newTestStorage(t, registersByAccount)
+
+	handler := NewSearchHandler(registersByAccount, storage, inter, zerolog.Nop())
+
+	type testCase struct {
+		name     string
+		request  string
+		status   int
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			name:    "composite type",
+			request: `{"type": "A.0000000000000001.Test.Vault"}`,
+			status:  http.StatusOK,
+			expected: []string{
+				`0000000000000001/storage/names [{"type":"String","value":"a"}]`,
+				`0000000000000001/storage/vault`,
+			},
+		},
+		{
+			name:    "primitive type",
+			request: `{"type": "Int"}`,
+			status:  http.StatusOK,
+			expected: []string{
+				`0000000000000001/storage/answer`,
+				`0000000000000001/storage/names [{"type":"String","value":"a"},"balance"]`,
+				`0000000000000001/storage/vault ["balance"]`,
+				`0000000000000001/storage/vault ["ids",{"type":"Int","value":"0"}]`,
+				`0000000000000001/storage/vault ["ids",{"type":"Int","value":"1"}]`,
+			},
+		},
+		{
+			name:    "array type",
+			request: `{"type": "[Int]"}`,
+			status:  http.StatusOK,
+			expected: []string{
+				`0000000000000001/storage/names [{"type":"String","value":"a"},"ids"]`,
+				`0000000000000001/storage/vault ["ids"]`,
+			},
+		},
+		{
+			name:     "unknown type",
+			request:  `{"type": "A.0000000000000001.Test.Unknown"}`,
+			status:   http.StatusOK,
+			expected: []string{},
+		},
+		{
+			name:    "invalid request",
+			request: `{`,
+			status:  http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(
+				http.MethodPost,
+				"/search",
+				strings.NewReader(testCase.request),
+			)
+			recorder := httptest.NewRecorder()
+
+			handler(recorder, request)
+
+			require.Equal(t, testCase.status, recorder.Code)
+
+			if testCase.status != http.StatusOK {
+				return
+			}
+
+			var locations []StoredValueLocation
+			err := json.Unmarshal(recorder.Body.Bytes(), &locations)
+			require.NoError(t, err)
+
+			formattedLocations := make([]string, 0, len(locations))
+			for _, location := range locations {
+				formattedLocations = append(
+					formattedLocations,
+					formatStoredValueLocation(t, location),
+				)
+			}
+			sort.Strings(formattedLocations)
+
+			assert.Equal(t, testCase.expected, formattedLocations)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/onflow/atree"
+	"github.com/onflow/flow-go/cmd/util/ledger/util/registers"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// TypeStatistics are the aggregate statistics of all stored values of a type.
+type TypeStatistics struct {
+	Type  string `json:"type"`
+	Count uint64 `json:"count"`
+	// StorageBytes is the total size of the encodings of the values.
+	// Values stored in separate slabs are measured by the size of their root slab,
+	// values inlined into their parent are also included in the size of their parent
+	StorageBytes uint64 `json:"storageBytes"`
+}
+
+// NewTypeStatisticsHandler returns a handler which reports aggregate statistics
+// of all stored values per type, across all accounts.
+//
+// The statistics are computed on the first request.
+// The response is the list of TypeStatistics, ordered by type ID.
+func NewTypeStatisticsHandler(
+	registersByAccount *registers.ByAccount,
+	storage *runtime.Storage,
+	inter *interpreter.Interpreter,
+	log zerolog.Logger,
+) func(w http.ResponseWriter, r *http.Request) {
+
+	var once sync.Once
+	var statisticsJSON []byte
+	var statisticsErr error
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			log.Info().Msg("computing type statistics ...")
+
+			var statistics []TypeStatistics
+			statistics, statisticsErr = typeStatistics(registersByAccount, storage, inter)
+			if statisticsErr != nil {
+				return
+			}
+
+			statisticsJSON, statisticsErr = json.Marshal(statistics)
+		})
+
+		if statisticsErr != nil {
+			http.Error(w, statisticsErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+
+		_, err := w.Write(statisticsJSON)
+		if err != nil {
+			log.Fatal().Err(err)
+		}
+	}
+}
+
+func typeStatistics(
+	registersByAccount *registers.ByAccount,
+	storage *runtime.Storage,
+	inter *interpreter.Interpreter,
+) ([]TypeStatistics, error) {
+
+	statisticsByType := map[string]*TypeStatistics{}
+
+	var sizeErr error
+
+	err := walkStoredValues(
+		registersByAccount,
+		storage,
+		inter,
+		func(location StoredValueLocation, value interpreter.Value) {
+			if sizeErr != nil {
+				return
+			}
+
+			// Optionals are transparent, their inner values are visited separately
+			if _, ok := value.(*interpreter.SomeValue); ok {
+				return
+			}
+
+			typeID := string(value.StaticType(inter).ID())
+
+			statistics, ok := statisticsByType[typeID]
+			if !ok {
+				statistics = &TypeStatistics{
+					Type: typeID,
+				}
+				statisticsByType[typeID] = statistics
+			}
+
+			address, err := common.HexToAddress(location.Address)
+			if err != nil {
+				sizeErr = err
+				return
+			}
+
+			size, err := storageSize(storage, address, value)
+			if err != nil {
+				sizeErr = err
+				return
+			}
+
+			statistics.Count++
+			statistics.StorageBytes += size
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if sizeErr != nil {
+		return nil, sizeErr
+	}
+
+	result := make([]TypeStatistics, 0, len(statisticsByType))
+	for _, statistics := range statisticsByType { //nolint:maprange
+		result = append(result, *statistics)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Type < result[j].Type
+	})
+
+	return result, nil
+}
+
+// storageSize returns the size of the encoding of the given value.
+//
+// Atree-backed values (composites, arrays, and dictionaries) are measured by the size of their root slab.
+// If the value is inlined into its parent, it has no slab of its own,
+// so it is measured by the size of its inlined encoding, like all other values.
+func storageSize(storage *runtime.Storage, address common.Address, value interpreter.Value) (uint64, error) {
+
+	if container, ok := value.(interface{ SlabID() atree.SlabID }); ok {
+		// NOTE: do not get the storable of the container,
+		// as that might inline the container
+		slab, found, err := storage.Retrieve(container.SlabID())
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			size, err := interpreter.StorableSize(slab)
+			return uint64(size), err
+		}
+
+		// The container is inlined into its parent,
+		// so its storable is the inlined container itself
+	}
+
+	storable, err := value.Storable(storage, atree.Address(address), math.MaxUint64)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := interpreter.StorableSize(storable)
+	return uint64(size), err
+}
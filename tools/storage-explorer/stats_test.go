@@ -0,0 +1,110 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeStatistics(t *testing.T) {
+
+	t.Parallel()
+
+	registersByAccount := newTestRegisters(t)
+	storage, inter := newTestStorage(t, registersByAccount)
+
+	statistics, err := typeStatistics(registersByAccount, storage, inter)
+	require.NoError(t, err)
+
+	type testCase struct {
+		typeID string
+		count  uint64
+	}
+
+	testCases := []testCase{
+		{
+			typeID: "A.0000000000000001.Test.Vault",
+			count:  2,
+		},
+		{
+			typeID: "Int",
+			count:  5,
+		},
+		{
+			typeID: "[Int]",
+			count:  2,
+		},
+		{
+			typeID: "{String:A.0000000000000001.Test.Vault}",
+			count:  1,
+		},
+	}
+
+	// The statistics are ordered by type ID
+	require.Len(t, statistics, len(testCases))
+
+	for i, testCase := range testCases {
+
+		t.Run(testCase.typeID, func(t *testing.T) {
+
+			typeStatistics := statistics[i]
+
+			assert.Equal(t, testCase.typeID, typeStatistics.Type)
+			assert.Equal(t, testCase.count, typeStatistics.Count)
+			assert.NotZero(t, typeStatistics.StorageBytes)
+		})
+	}
+}
+
+func TestTypeStatisticsHandler(t *testing.T) {
+
+	t.Parallel()
+
+	registersByAccount := newTestRegisters(t)
+	storage, inter := newTestStorage(t, registersByAccount)
+
+	expected, err := typeStatistics(registersByAccount, storage, inter)
+	require.NoError(t, err)
+
+	handler := NewTypeStatisticsHandler(registersByAccount, storage, inter, zerolog.Nop())
+
+	// The statistics are computed on the first request,
+	// and the following requests return the same result
+
+	for i := 0; i < 2; i++ {
+		recorder := httptest.NewRecorder()
+
+		handler(recorder, httptest.NewRequest(http.MethodGet, "/type_statistics", nil))
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var actual []TypeStatistics
+		err = json.Unmarshal(recorder.Body.Bytes(), &actual)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, actual)
+	}
+}
@@ -0,0 +1,179 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"sort"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/util/registers"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// StoredValueLocation is the location of a value in the storage of an account.
+type StoredValueLocation struct {
+	Address string `json:"address"`
+	Domain  string `json:"domain"`
+	Key     string `json:"key"`
+	// Nested is the path of the value within the stored value,
+	// in the format accepted by the value handler (see getNested):
+	// Field names are strings, array indices and dictionary keys are JSON-Cadence values
+	Nested []any `json:"nested"`
+}
+
+type storedValueVisitor func(location StoredValueLocation, value interpreter.Value)
+
+// walkStoredValues calls the given function for each value stored in any account,
+// including all values nested in stored values.
+func walkStoredValues(
+	registersByAccount *registers.ByAccount,
+	storage *runtime.Storage,
+	inter *interpreter.Interpreter,
+	f storedValueVisitor,
+) error {
+	var addresses []common.Address
+
+	err := registersByAccount.ForEachAccount(func(accountRegisters *registers.AccountRegisters) error {
+		owner := accountRegisters.Owner()
+		if len(owner) == 0 {
+			return nil
+		}
+
+		addresses = append(addresses, common.Address([]byte(owner)))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+
+	domains := make([]string, 0, len(knownStorageMaps))
+	for domain := range knownStorageMaps { //nolint:maprange
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	for _, address := range addresses {
+		for _, domain := range domains {
+			storageMap := storage.GetStorageMap(address, domain, false)
+			if storageMap == nil {
+				continue
+			}
+
+			knownStorageMap := knownStorageMaps[domain]
+
+			iterator := storageMap.Iterator(nil)
+			for {
+				key, value := iterator.Next()
+				if key == nil {
+					break
+				}
+
+				location := StoredValueLocation{
+					Address: address.Hex(),
+					Domain:  domain,
+					Key:     knownStorageMap.KeyAsString(key),
+					Nested:  []any{},
+				}
+
+				err := walkValue(inter, location, value, f)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func walkValue(
+	inter *interpreter.Interpreter,
+	location StoredValueLocation,
+	value interpreter.Value,
+	f storedValueVisitor,
+) (err error) {
+	f(location, value)
+
+	nested := func(element any) StoredValueLocation {
+		nestedLocation := location
+		nestedLocation.Nested = make([]any, len(location.Nested), len(location.Nested)+1)
+		copy(nestedLocation.Nested, location.Nested)
+		nestedLocation.Nested = append(nestedLocation.Nested, element)
+		return nestedLocation
+	}
+
+	switch value := value.(type) {
+	case *interpreter.CompositeValue:
+		value.ForEachField(
+			inter,
+			func(name string, fieldValue interpreter.Value) (resume bool) {
+				err = walkValue(inter, nested(name), fieldValue, f)
+				return err == nil
+			},
+			interpreter.EmptyLocationRange,
+		)
+
+	case *interpreter.ArrayValue:
+		count := value.Count()
+		for index := 0; index < count; index++ {
+			element := value.Get(inter, interpreter.EmptyLocationRange, index)
+
+			err = walkValue(
+				inter,
+				nested(jsoncdc.Prepare(cadence.NewInt(index))),
+				element,
+				f,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+	case *interpreter.DictionaryValue:
+		value.Iterate(
+			inter,
+			interpreter.EmptyLocationRange,
+			func(key, element interpreter.Value) (resume bool) {
+				var exportedKey cadence.Value
+				exportedKey, err = runtime.ExportValue(key, inter, interpreter.EmptyLocationRange)
+				if err != nil {
+					return false
+				}
+
+				err = walkValue(inter, nested(jsoncdc.Prepare(exportedKey)), element, f)
+				return err == nil
+			},
+		)
+
+	case *interpreter.SomeValue:
+		// NOTE: optionals are transparent in paths
+		innerValue := value.InnerValue(inter, interpreter.EmptyLocationRange)
+		err = walkValue(inter, location, innerValue, f)
+	}
+
+	return err
+}
@@ -0,0 +1,91 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// formatStoredValueLocation formats the given location as
+// `<address>/<domain>/<key>` followed by the JSON-encoded nested path, if any
+func formatStoredValueLocation(t *testing.T, location StoredValueLocation) string {
+	result := fmt.Sprintf("%s/%s/%s", location.Address, location.Domain, location.Key)
+	if len(location.Nested) == 0 {
+		return result
+	}
+
+	encodedNested, err := json.Marshal(location.Nested)
+	require.NoError(t, err)
+
+	return result + " " + string(encodedNested)
+}
+
+func TestWalkStoredValues(t *testing.T) {
+
+	t.Parallel()
+
+	registersByAccount := newTestRegisters(t)
+	storage, inter := newTestStorage(t, registersByAccount)
+
+	var visits []string
+
+	err := walkStoredValues(
+		registersByAccount,
+		storage,
+		inter,
+		func(location StoredValueLocation, value interpreter.Value) {
+			visits = append(
+				visits,
+				fmt.Sprintf(
+					"%s: %s",
+					formatStoredValueLocation(t, location),
+					value.StaticType(inter).ID(),
+				),
+			)
+		},
+	)
+	require.NoError(t, err)
+
+	// NOTE: storage maps, composites, and dictionaries are visited in an unspecified order
+	sort.Strings(visits)
+
+	assert.Equal(t,
+		[]string{
+			`0000000000000001/storage/answer: Int`,
+			`0000000000000001/storage/names [{"value":"a","type":"String"},"balance"]: Int`,
+			`0000000000000001/storage/names [{"value":"a","type":"String"},"ids"]: [Int]`,
+			`0000000000000001/storage/names [{"value":"a","type":"String"}]: A.0000000000000001.Test.Vault`,
+			`0000000000000001/storage/names: {String:A.0000000000000001.Test.Vault}`,
+			`0000000000000001/storage/vault ["balance"]: Int`,
+			`0000000000000001/storage/vault ["ids",{"value":"0","type":"Int"}]: Int`,
+			`0000000000000001/storage/vault ["ids",{"value":"1","type":"Int"}]: Int`,
+			`0000000000000001/storage/vault ["ids"]: [Int]`,
+			`0000000000000001/storage/vault: A.0000000000000001.Test.Vault`,
+		},
+		visits,
+	)
+}
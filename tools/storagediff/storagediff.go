@@ -0,0 +1,394 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package storagediff computes semantic differences between the account storage of two ledger snapshots.
+package storagediff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/tools/registers"
+)
+
+// Kind is the kind of difference.
+type Kind uint8
+
+const (
+	KindUnknown Kind = iota
+	// KindAdded indicates that the value only exists in the new snapshot
+	KindAdded
+	// KindRemoved indicates that the value only exists in the old snapshot
+	KindRemoved
+	// KindChanged indicates that the value exists in both snapshots, but differs
+	KindChanged
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindAdded:
+		return "added"
+	case KindRemoved:
+		return "removed"
+	case KindChanged:
+		return "changed"
+	}
+	return "unknown"
+}
+
+// Difference is a difference of a stored value, or a value nested in a stored value.
+type Difference struct {
+	Address common.Address
+	Domain  string
+	// Key is the key of the stored value in the storage map of the domain
+	Key string
+	// Path is the path of the differing value within the stored value,
+	// e.g. `.balance`, `[0]`, or `["key"]`.
+	// The path is empty if the stored value itself differs
+	Path string
+	Kind Kind
+	// OldValue is the string representation of the old value, if any
+	OldValue string
+	// NewValue is the string representation of the new value, if any
+	NewValue string
+}
+
+func (d Difference) String() string {
+	location := fmt.Sprintf("%s /%s/%s%s", d.Address.HexWithPrefix(), d.Domain, d.Key, d.Path)
+
+	switch d.Kind {
+	case KindAdded:
+		return fmt.Sprintf("%s: added: %s", location, d.NewValue)
+	case KindRemoved:
+		return fmt.Sprintf("%s: removed: %s", location, d.OldValue)
+	default:
+		return fmt.Sprintf("%s: %s: %s -> %s", location, d.Kind, d.OldValue, d.NewValue)
+	}
+}
+
+// Snapshot is the account storage of a ledger snapshot.
+type Snapshot struct {
+	storage     *runtime.Storage
+	interpreter *interpreter.Interpreter
+}
+
+// NewSnapshot returns the account storage of the given ledger,
+// loaded through runtime.NewStorage.
+func NewSnapshot(ledger atree.Ledger) (*Snapshot, error) {
+	storage := runtime.NewStorage(ledger, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		nil,
+		&interpreter.Config{
+			Storage: storage,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		storage:     storage,
+		interpreter: inter,
+	}, nil
+}
+
+// Compare returns the differences between the storage of the given accounts
+// in the old and the new snapshot.
+//
+// The differences are reported per account, domain, and storage map key,
+// in that order. Differing composites, arrays, and dictionaries of the same type
+// are compared element-wise, i.e. the differences of their fields and elements are reported.
+func Compare(
+	oldSnapshot *Snapshot,
+	newSnapshot *Snapshot,
+	addresses []common.Address,
+) (
+	differences []Difference,
+	err error,
+) {
+	c := &comparer{
+		oldInterpreter: oldSnapshot.interpreter,
+		newInterpreter: newSnapshot.interpreter,
+	}
+
+	for _, address := range addresses {
+		for _, domain := range runtime.StorageDomains {
+			err = c.compareStorageMaps(
+				address,
+				domain,
+				oldSnapshot.storage.GetStorageMap(address, domain, false),
+				newSnapshot.storage.GetStorageMap(address, domain, false),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c.differences, nil
+}
+
+type comparer struct {
+	oldInterpreter *interpreter.Interpreter
+	newInterpreter *interpreter.Interpreter
+	differences    []Difference
+	// current is the template for reported differences,
+	// i.e. the address, domain, and key of the currently compared stored value
+	current Difference
+}
+
+func (c *comparer) compareStorageMaps(
+	address common.Address,
+	domain string,
+	oldStorageMap *interpreter.StorageMap,
+	newStorageMap *interpreter.StorageMap,
+) (err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf(
+				"failed to compare storage map %s of account %s: %v",
+				domain,
+				address.HexWithPrefix(),
+				r,
+			)
+		}
+	}()
+
+	oldValues := storageMapValues(oldStorageMap)
+	newValues := storageMapValues(newStorageMap)
+
+	for _, key := range sortedKeys(oldValues, newValues) {
+		c.current = Difference{
+			Address: address,
+			Domain:  domain,
+			Key:     key,
+		}
+
+		c.compareOptionalValues(
+			"",
+			oldValues[key],
+			newValues[key],
+		)
+	}
+
+	return nil
+}
+
+func storageMapValues(storageMap *interpreter.StorageMap) map[string]interpreter.Value {
+	values := map[string]interpreter.Value{}
+
+	if storageMap == nil {
+		return values
+	}
+
+	iterator := storageMap.Iterator(nil)
+	for {
+		key, value := iterator.Next()
+		if key == nil {
+			break
+		}
+
+		values[registers.StorageMapKeyString(key)] = value
+	}
+
+	return values
+}
+
+func sortedKeys[T any](oldValues, newValues map[string]T) []string {
+	keySet := map[string]struct{}{}
+	for key := range oldValues { //nolint:maprange
+		keySet[key] = struct{}{}
+	}
+	for key := range newValues { //nolint:maprange
+		keySet[key] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet { //nolint:maprange
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (c *comparer) report(path string, kind Kind, oldValue, newValue interpreter.Value) {
+	difference := c.current
+	difference.Path = path
+	difference.Kind = kind
+	if oldValue != nil {
+		difference.OldValue = oldValue.String()
+	}
+	if newValue != nil {
+		difference.NewValue = newValue.String()
+	}
+
+	c.differences = append(c.differences, difference)
+}
+
+// compareOptionalValues compares the values at the given path,
+// where either value may be absent (nil).
+func (c *comparer) compareOptionalValues(path string, oldValue, newValue interpreter.Value) {
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+	case oldValue == nil:
+		c.report(path, KindAdded, nil, newValue)
+	case newValue == nil:
+		c.report(path, KindRemoved, oldValue, nil)
+	default:
+		c.compareValues(path, oldValue, newValue)
+	}
+}
+
+func (c *comparer) compareValues(path string, oldValue, newValue interpreter.Value) {
+	locationRange := interpreter.EmptyLocationRange
+
+	// Values of different types are reported as a change of the whole value
+
+	oldType := oldValue.StaticType(c.oldInterpreter)
+	newType := newValue.StaticType(c.newInterpreter)
+	if !oldType.Equal(newType) {
+		c.report(path, KindChanged, oldValue, newValue)
+		return
+	}
+
+	switch oldValue := oldValue.(type) {
+	case *interpreter.CompositeValue:
+		newComposite := newValue.(*interpreter.CompositeValue)
+
+		oldFields := compositeFields(c.oldInterpreter, oldValue)
+		newFields := compositeFields(c.newInterpreter, newComposite)
+
+		for _, name := range sortedKeys(oldFields, newFields) {
+			c.compareOptionalValues(
+				path+"."+name,
+				oldFields[name],
+				newFields[name],
+			)
+		}
+
+	case *interpreter.ArrayValue:
+		newArray := newValue.(*interpreter.ArrayValue)
+
+		oldCount := oldValue.Count()
+		newCount := newArray.Count()
+
+		for index := 0; index < oldCount || index < newCount; index++ {
+			var oldElement, newElement interpreter.Value
+			if index < oldCount {
+				oldElement = oldValue.Get(c.oldInterpreter, locationRange, index)
+			}
+			if index < newCount {
+				newElement = newArray.Get(c.newInterpreter, locationRange, index)
+			}
+
+			c.compareOptionalValues(
+				fmt.Sprintf("%s[%d]", path, index),
+				oldElement,
+				newElement,
+			)
+		}
+
+	case *interpreter.DictionaryValue:
+		newDictionary := newValue.(*interpreter.DictionaryValue)
+
+		oldEntries := dictionaryEntries(c.oldInterpreter, oldValue)
+		newEntries := dictionaryEntries(c.newInterpreter, newDictionary)
+
+		for _, key := range sortedKeys(oldEntries, newEntries) {
+			c.compareOptionalValues(
+				fmt.Sprintf("%s[%s]", path, key),
+				oldEntries[key],
+				newEntries[key],
+			)
+		}
+
+	case *interpreter.SomeValue:
+		newSome := newValue.(*interpreter.SomeValue)
+
+		c.compareValues(
+			path,
+			oldValue.InnerValue(c.oldInterpreter, locationRange),
+			newSome.InnerValue(c.newInterpreter, locationRange),
+		)
+
+	default:
+		if !leafValuesEqual(c.oldInterpreter, oldValue, newValue) {
+			c.report(path, KindChanged, oldValue, newValue)
+		}
+	}
+}
+
+func compositeFields(
+	inter *interpreter.Interpreter,
+	composite *interpreter.CompositeValue,
+) map[string]interpreter.Value {
+	fields := map[string]interpreter.Value{}
+
+	composite.ForEachField(
+		inter,
+		func(name string, value interpreter.Value) (resume bool) {
+			fields[name] = value
+			return true
+		},
+		interpreter.EmptyLocationRange,
+	)
+
+	return fields
+}
+
+// dictionaryEntries returns the entries of the given dictionary,
+// keyed by the string representation of the keys.
+func dictionaryEntries(
+	inter *interpreter.Interpreter,
+	dictionary *interpreter.DictionaryValue,
+) map[string]interpreter.Value {
+	entries := map[string]interpreter.Value{}
+
+	dictionary.Iterate(
+		inter,
+		interpreter.EmptyLocationRange,
+		func(key, value interpreter.Value) (resume bool) {
+			entries[key.String()] = value
+			return true
+		},
+	)
+
+	return entries
+}
+
+func leafValuesEqual(
+	inter *interpreter.Interpreter,
+	oldValue interpreter.Value,
+	newValue interpreter.Value,
+) bool {
+	if equatableValue, ok := oldValue.(interpreter.EquatableValue); ok {
+		return equatableValue.Equal(inter, interpreter.EmptyLocationRange, newValue)
+	}
+
+	return oldValue.String() == newValue.String()
+}
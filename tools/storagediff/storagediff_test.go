@@ -0,0 +1,304 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storagediff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/tools/registers"
+)
+
+var testAddress = common.MustBytesToAddress([]byte{0x1})
+
+var testLocation = common.AddressLocation{
+	Address: testAddress,
+	Name:    "Test",
+}
+
+type testValues func(inter *interpreter.Interpreter) map[string]interpreter.Value
+
+// newTestRegisters stores the given values in the storage domain of the test account
+func newTestRegisters(t *testing.T, values testValues) *registers.Registers {
+	ledger := registers.New()
+
+	storage := runtime.NewStorage(ledger, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		testLocation,
+		&interpreter.Config{
+			Storage: storage,
+		},
+	)
+	require.NoError(t, err)
+
+	storageMap := storage.GetStorageMap(
+		testAddress,
+		common.PathDomainStorage.Identifier(),
+		true,
+	)
+
+	for key, value := range values(inter) { //nolint:maprange
+		storageMap.WriteValue(
+			inter,
+			interpreter.StringStorageMapKey(key),
+			value,
+		)
+	}
+
+	err = storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	return ledger
+}
+
+func newTestVault(inter *interpreter.Interpreter, balance int64, ids ...int64) *interpreter.CompositeValue {
+	idValues := make([]interpreter.Value, 0, len(ids))
+	for _, id := range ids {
+		idValues = append(idValues, interpreter.NewUnmeteredIntValueFromInt64(id))
+	}
+
+	return interpreter.NewCompositeValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		testLocation,
+		"Vault",
+		common.CompositeKindStructure,
+		[]interpreter.CompositeField{
+			{
+				Name:  "balance",
+				Value: interpreter.NewUnmeteredIntValueFromInt64(balance),
+			},
+			{
+				Name: "ids",
+				Value: interpreter.NewArrayValue(
+					inter,
+					interpreter.EmptyLocationRange,
+					&interpreter.VariableSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeInt,
+					},
+					testAddress,
+					idValues...,
+				),
+			},
+		},
+		testAddress,
+	)
+}
+
+func newTestDictionary(inter *interpreter.Interpreter, keysAndValues ...interpreter.Value) *interpreter.DictionaryValue {
+	return interpreter.NewDictionaryValueWithAddress(
+		inter,
+		interpreter.EmptyLocationRange,
+		&interpreter.DictionaryStaticType{
+			KeyType:   interpreter.PrimitiveStaticTypeString,
+			ValueType: interpreter.PrimitiveStaticTypeInt,
+		},
+		testAddress,
+		keysAndValues...,
+	)
+}
+
+func compare(t *testing.T, oldValues, newValues testValues) []Difference {
+	oldSnapshot, err := NewSnapshot(newTestRegisters(t, oldValues))
+	require.NoError(t, err)
+
+	newSnapshot, err := NewSnapshot(newTestRegisters(t, newValues))
+	require.NoError(t, err)
+
+	differences, err := Compare(
+		oldSnapshot,
+		newSnapshot,
+		[]common.Address{testAddress},
+	)
+	require.NoError(t, err)
+
+	return differences
+}
+
+func TestCompare(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("equal", func(t *testing.T) {
+
+		t.Parallel()
+
+		values := func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+			return map[string]interpreter.Value{
+				"vault": newTestVault(inter, 10, 1, 2),
+				"flag":  interpreter.TrueValue,
+			}
+		}
+
+		differences := compare(t, values, values)
+		assert.Empty(t, differences)
+	})
+
+	t.Run("added and removed", func(t *testing.T) {
+
+		t.Parallel()
+
+		differences := compare(t,
+			func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+				return map[string]interpreter.Value{
+					"a": interpreter.TrueValue,
+				}
+			},
+			func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+				return map[string]interpreter.Value{
+					"b": interpreter.FalseValue,
+				}
+			},
+		)
+
+		assert.Equal(t,
+			[]Difference{
+				{
+					Address:  testAddress,
+					Domain:   "storage",
+					Key:      "a",
+					Kind:     KindRemoved,
+					OldValue: "true",
+				},
+				{
+					Address:  testAddress,
+					Domain:   "storage",
+					Key:      "b",
+					Kind:     KindAdded,
+					NewValue: "false",
+				},
+			},
+			differences,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		differences := compare(t,
+			func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+				return map[string]interpreter.Value{
+					"vault": newTestVault(inter, 10, 1, 2),
+					"dict": newTestDictionary(inter,
+						interpreter.NewUnmeteredStringValue("a"),
+						interpreter.NewUnmeteredIntValueFromInt64(1),
+						interpreter.NewUnmeteredStringValue("b"),
+						interpreter.NewUnmeteredIntValueFromInt64(2),
+					),
+				}
+			},
+			func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+				return map[string]interpreter.Value{
+					"vault": newTestVault(inter, 20, 1),
+					"dict": newTestDictionary(inter,
+						interpreter.NewUnmeteredStringValue("a"),
+						interpreter.NewUnmeteredIntValueFromInt64(3),
+					),
+				}
+			},
+		)
+
+		assert.Equal(t,
+			[]string{
+				`0x0000000000000001 /storage/dict["a"]: changed: 1 -> 3`,
+				`0x0000000000000001 /storage/dict["b"]: removed: 2`,
+				`0x0000000000000001 /storage/vault.balance: changed: 10 -> 20`,
+				`0x0000000000000001 /storage/vault.ids[1]: removed: 2`,
+			},
+			differenceStrings(differences),
+		)
+	})
+
+	t.Run("type change", func(t *testing.T) {
+
+		t.Parallel()
+
+		differences := compare(t,
+			func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+				return map[string]interpreter.Value{
+					"value": interpreter.NewUnmeteredIntValueFromInt64(1),
+				}
+			},
+			func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+				return map[string]interpreter.Value{
+					"value": interpreter.NewUnmeteredStringValue("1"),
+				}
+			},
+		)
+
+		assert.Equal(t,
+			[]string{
+				`0x0000000000000001 /storage/value: changed: 1 -> "1"`,
+			},
+			differenceStrings(differences),
+		)
+	})
+}
+
+func differenceStrings(differences []Difference) []string {
+	result := make([]string, 0, len(differences))
+	for _, difference := range differences {
+		result = append(result, difference.String())
+	}
+	return result
+}
+
+func TestRegistersReadWrite(t *testing.T) {
+
+	t.Parallel()
+
+	ledger := newTestRegisters(t, func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+		return map[string]interpreter.Value{
+			"vault": newTestVault(inter, 10, 1, 2),
+		}
+	})
+
+	var buffer bytes.Buffer
+	err := ledger.Write(&buffer)
+	require.NoError(t, err)
+
+	readLedger, err := registers.Read(&buffer)
+	require.NoError(t, err)
+
+	assert.Equal(t, ledger.Count(), readLedger.Count())
+	assert.Equal(t, []common.Address{testAddress}, readLedger.Owners())
+
+	oldSnapshot, err := NewSnapshot(ledger)
+	require.NoError(t, err)
+
+	newSnapshot, err := NewSnapshot(readLedger)
+	require.NoError(t, err)
+
+	differences, err := Compare(
+		oldSnapshot,
+		newSnapshot,
+		[]common.Address{testAddress},
+	)
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+}
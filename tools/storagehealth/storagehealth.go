@@ -0,0 +1,626 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package storagehealth checks the health of the atree slabs and Cadence values
+// of the account storage of a ledger snapshot, and repairs orphaned slabs.
+package storagehealth
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/tools/registers"
+)
+
+// ProblemKind is the kind of storage problem.
+type ProblemKind uint8
+
+const (
+	ProblemKindUnknown ProblemKind = iota
+	// ProblemKindOrphanedSlab indicates that a slab is not referenced by any other slab or storage map
+	ProblemKindOrphanedSlab
+	// ProblemKindDanglingReference indicates that a slab or storage map references a non-existing slab
+	ProblemKindDanglingReference
+	// ProblemKindTypeMismatch indicates that the type of a value does not conform to the type of its container
+	ProblemKindTypeMismatch
+	// ProblemKindNonDeterministicEncoding indicates that the re-encoding of a slab differs from the stored encoding
+	ProblemKindNonDeterministicEncoding
+	// ProblemKindInvalidSlab indicates that a slab cannot be decoded, or has an invalid structure
+	ProblemKindInvalidSlab
+	// ProblemKindInvalidValue indicates that a value failed the atree and Cadence value validation
+	ProblemKindInvalidValue
+)
+
+func (k ProblemKind) String() string {
+	switch k {
+	case ProblemKindOrphanedSlab:
+		return "orphaned slab"
+	case ProblemKindDanglingReference:
+		return "dangling reference"
+	case ProblemKindTypeMismatch:
+		return "type mismatch"
+	case ProblemKindNonDeterministicEncoding:
+		return "non-deterministic encoding"
+	case ProblemKindInvalidSlab:
+		return "invalid slab"
+	case ProblemKindInvalidValue:
+		return "invalid value"
+	}
+	return "unknown"
+}
+
+// Problem is a problem in the storage of an account.
+type Problem struct {
+	Address common.Address
+	Kind    ProblemKind
+	// SlabID is the ID of the affected slab, if any
+	SlabID atree.SlabID
+	// Domain and Key are the domain and storage map key of the affected stored value, if any
+	Domain string
+	Key    string
+	// Path is the path of the affected value within the stored value, if any,
+	// e.g. `.balance`, `[0]`, or `[key]`
+	Path string
+	// Descendants are the IDs of all slabs reachable from an orphaned slab.
+	// They are removed together with the orphaned slab when repairing
+	Descendants []atree.SlabID
+	Message     string
+}
+
+func (p Problem) String() string {
+	location := p.Address.HexWithPrefix()
+	if p.Domain != "" {
+		location = fmt.Sprintf("%s /%s/%s%s", location, p.Domain, p.Key, p.Path)
+	}
+	if p.SlabID != atree.SlabIDUndefined {
+		location = fmt.Sprintf("%s %s", location, p.SlabID)
+	}
+	return fmt.Sprintf("%s: %s: %s", location, p.Kind, p.Message)
+}
+
+// Check checks the storage of the given accounts, and returns all found problems,
+// ordered by account.
+//
+// All slabs of an account are decoded, re-encoded, and their references are checked.
+// The containers of the account are validated using atree and Cadence value validation,
+// and the types of all stored values are checked against the types of their containers.
+func Check(ledger *registers.Registers, addresses []common.Address) ([]Problem, error) {
+
+	slabsByOwner := map[common.Address]map[atree.SlabID][]byte{}
+
+	err := ledger.ForEach(func(key registers.Key, value []byte) error {
+		if !registers.IsSlabKey(key.Key) {
+			return nil
+		}
+
+		address, err := common.BytesToAddress([]byte(key.Owner))
+		if err != nil {
+			// Ignore registers which are not owned by accounts
+			return nil
+		}
+
+		var index atree.SlabIndex
+		copy(index[:], key.Key[1:])
+
+		slabs, ok := slabsByOwner[address]
+		if !ok {
+			slabs = map[atree.SlabID][]byte{}
+			slabsByOwner[address] = slabs
+		}
+		slabs[atree.NewSlabID(atree.Address(address), index)] = value
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storage := runtime.NewStorage(ledger, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		nil,
+		&interpreter.Config{
+			Storage: storage,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []Problem
+
+	for _, address := range addresses {
+		checker := &accountChecker{
+			address:      address,
+			ledger:       ledger,
+			storage:      storage,
+			interpreter:  inter,
+			encodedSlabs: slabsByOwner[address],
+			slabs:        map[atree.SlabID]atree.Slab{},
+			parents:      map[atree.SlabID]atree.SlabID{},
+			children:     map[atree.SlabID][]atree.SlabID{},
+			storageMaps:  map[string]atree.SlabID{},
+		}
+		checker.check()
+
+		problems = append(problems, checker.problems...)
+	}
+
+	return problems, nil
+}
+
+// RemoveOrphanedSlabs removes the orphaned slabs of the given problems,
+// including all their descendants, and returns the number of removed slabs.
+func RemoveOrphanedSlabs(ledger *registers.Registers, problems []Problem) (int, error) {
+	var count int
+
+	for _, problem := range problems {
+		if problem.Kind != ProblemKindOrphanedSlab {
+			continue
+		}
+
+		slabIDs := append([]atree.SlabID{problem.SlabID}, problem.Descendants...)
+
+		for _, slabID := range slabIDs {
+			key := registers.SlabKey(slabID)
+
+			err := ledger.SetValue([]byte(key.Owner), []byte(key.Key), nil)
+			if err != nil {
+				return count, err
+			}
+
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+type accountChecker struct {
+	address      common.Address
+	ledger       *registers.Registers
+	storage      *runtime.Storage
+	interpreter  *interpreter.Interpreter
+	encodedSlabs map[atree.SlabID][]byte
+	// slabs are the successfully decoded slabs
+	slabs map[atree.SlabID]atree.Slab
+	// parents and children are the references between slabs
+	parents  map[atree.SlabID]atree.SlabID
+	children map[atree.SlabID][]atree.SlabID
+	// storageMaps are the root slab IDs of the storage maps, by domain
+	storageMaps map[string]atree.SlabID
+	problems    []Problem
+}
+
+func (c *accountChecker) report(problem Problem) {
+	problem.Address = c.address
+	c.problems = append(c.problems, problem)
+}
+
+func (c *accountChecker) check() {
+	slabIDs := make([]atree.SlabID, 0, len(c.encodedSlabs))
+	for slabID := range c.encodedSlabs { //nolint:maprange
+		slabIDs = append(slabIDs, slabID)
+	}
+	sort.Slice(slabIDs, func(i, j int) bool {
+		return slabIDs[i].Compare(slabIDs[j]) < 0
+	})
+
+	c.decodeSlabs(slabIDs)
+	c.checkReferences(slabIDs)
+	c.checkStorageMaps()
+
+	// If a slab could not be decoded, its references are unknown,
+	// so the slabs it references would be falsely reported as orphaned
+	if len(c.slabs) == len(c.encodedSlabs) {
+		c.checkOrphans(slabIDs)
+	}
+
+	c.validateContainers(slabIDs)
+	c.checkTypes()
+}
+
+// decodeSlabs decodes all slabs, and checks that their re-encoding is identical
+func (c *accountChecker) decodeSlabs(slabIDs []atree.SlabID) {
+	for _, slabID := range slabIDs {
+		data := c.encodedSlabs[slabID]
+
+		slab, err := decodeSlab(slabID, data)
+		if err != nil {
+			c.report(Problem{
+				Kind:    ProblemKindInvalidSlab,
+				SlabID:  slabID,
+				Message: fmt.Sprintf("failed to decode slab: %s", err),
+			})
+			continue
+		}
+
+		c.slabs[slabID] = slab
+
+		encoded, err := atree.EncodeSlab(slab, interpreter.CBOREncMode)
+		if err != nil {
+			c.report(Problem{
+				Kind:    ProblemKindInvalidSlab,
+				SlabID:  slabID,
+				Message: fmt.Sprintf("failed to re-encode slab: %s", err),
+			})
+			continue
+		}
+
+		if !bytes.Equal(encoded, data) {
+			c.report(Problem{
+				Kind:    ProblemKindNonDeterministicEncoding,
+				SlabID:  slabID,
+				Message: fmt.Sprintf("re-encoded slab differs: stored %x, re-encoded %x", data, encoded),
+			})
+		}
+	}
+}
+
+// checkReferences checks that all slabs referenced by slabs exist,
+// are owned by the account, and are only referenced once
+func (c *accountChecker) checkReferences(slabIDs []atree.SlabID) {
+	for _, slabID := range slabIDs {
+		slab, ok := c.slabs[slabID]
+		if !ok {
+			continue
+		}
+
+		for _, childID := range referencedSlabIDs(slab) {
+
+			if childID.Address() != atree.Address(c.address) {
+				c.report(Problem{
+					Kind:    ProblemKindInvalidSlab,
+					SlabID:  slabID,
+					Message: fmt.Sprintf("references slab %s of another account", childID),
+				})
+				continue
+			}
+
+			if _, ok := c.encodedSlabs[childID]; !ok {
+				c.report(Problem{
+					Kind:    ProblemKindDanglingReference,
+					SlabID:  slabID,
+					Message: fmt.Sprintf("references non-existing slab %s", childID),
+				})
+				continue
+			}
+
+			if parentID, ok := c.parents[childID]; ok {
+				c.report(Problem{
+					Kind:    ProblemKindInvalidSlab,
+					SlabID:  childID,
+					Message: fmt.Sprintf("slab is referenced by multiple slabs: %s and %s", parentID, slabID),
+				})
+				continue
+			}
+
+			c.parents[childID] = slabID
+			c.children[slabID] = append(c.children[slabID], childID)
+		}
+	}
+}
+
+// checkStorageMaps checks that the storage map registers of all domains
+// reference existing slabs
+func (c *accountChecker) checkStorageMaps() {
+	for _, domain := range runtime.StorageDomains {
+		data, err := c.ledger.GetValue(c.address[:], []byte(domain))
+		if err != nil || len(data) == 0 {
+			continue
+		}
+
+		var index atree.SlabIndex
+		if len(data) != len(index) {
+			c.report(Problem{
+				Kind:    ProblemKindInvalidValue,
+				Domain:  domain,
+				Message: fmt.Sprintf("invalid storage map slab index: %x", data),
+			})
+			continue
+		}
+		copy(index[:], data)
+
+		slabID := atree.NewSlabID(atree.Address(c.address), index)
+
+		if _, ok := c.encodedSlabs[slabID]; !ok {
+			c.report(Problem{
+				Kind:    ProblemKindDanglingReference,
+				Domain:  domain,
+				Message: fmt.Sprintf("storage map references non-existing slab %s", slabID),
+			})
+			continue
+		}
+
+		c.storageMaps[domain] = slabID
+	}
+}
+
+// checkOrphans reports all slabs which are neither referenced by another slab,
+// nor the root of a storage map
+func (c *accountChecker) checkOrphans(slabIDs []atree.SlabID) {
+	storageMapSlabIDs := map[atree.SlabID]struct{}{}
+	for _, slabID := range c.storageMaps { //nolint:maprange
+		storageMapSlabIDs[slabID] = struct{}{}
+	}
+
+	for _, slabID := range slabIDs {
+		if _, ok := c.parents[slabID]; ok {
+			continue
+		}
+		if _, ok := storageMapSlabIDs[slabID]; ok {
+			continue
+		}
+
+		descendants := c.descendants(slabID, nil)
+		sort.Slice(descendants, func(i, j int) bool {
+			return descendants[i].Compare(descendants[j]) < 0
+		})
+
+		c.report(Problem{
+			Kind:        ProblemKindOrphanedSlab,
+			SlabID:      slabID,
+			Descendants: descendants,
+			Message: fmt.Sprintf(
+				"slab is not referenced (%d descendant slabs)",
+				len(descendants),
+			),
+		})
+	}
+}
+
+func (c *accountChecker) descendants(slabID atree.SlabID, result []atree.SlabID) []atree.SlabID {
+	for _, childID := range c.children[slabID] {
+		result = append(result, childID)
+		result = c.descendants(childID, result)
+	}
+	return result
+}
+
+// validateContainers runs the atree and Cadence value validation
+// for all containers which are stored in separate slabs
+func (c *accountChecker) validateContainers(slabIDs []atree.SlabID) {
+	for _, slabID := range slabIDs {
+		slab, ok := c.slabs[slabID]
+		if !ok {
+			continue
+		}
+
+		var container atree.Value
+		var err error
+
+		// Only the root slab of a container has extra data
+
+		switch slab := slab.(type) {
+		case atree.ArraySlab:
+			if slab.ExtraData() == nil {
+				continue
+			}
+			container, err = atree.NewArrayWithRootID(c.storage, slabID)
+
+		case atree.MapSlab:
+			if slab.ExtraData() == nil {
+				continue
+			}
+			container, err = atree.NewMapWithRootID(c.storage, slabID, atree.NewDefaultDigesterBuilder())
+
+		default:
+			continue
+		}
+
+		if err == nil {
+			err = c.recover(func() {
+				c.interpreter.ValidateAtreeValue(container)
+			})
+		}
+
+		if err != nil {
+			c.report(Problem{
+				Kind:    ProblemKindInvalidValue,
+				SlabID:  slabID,
+				Message: err.Error(),
+			})
+		}
+	}
+}
+
+// checkTypes checks that the types of all stored values
+// conform to the types of their containers
+func (c *accountChecker) checkTypes() {
+	for _, domain := range runtime.StorageDomains {
+		slabID, ok := c.storageMaps[domain]
+		if !ok {
+			continue
+		}
+
+		err := c.recover(func() {
+			storageMap := interpreter.NewStorageMapWithRootID(c.storage, slabID)
+
+			iterator := storageMap.Iterator(nil)
+			for {
+				key, value := iterator.Next()
+				if key == nil {
+					break
+				}
+
+				location := Problem{
+					Domain: domain,
+					Key:    registers.StorageMapKeyString(key),
+				}
+
+				c.checkValueTypes(location, value)
+			}
+		})
+		if err != nil {
+			c.report(Problem{
+				Kind:    ProblemKindInvalidValue,
+				Domain:  domain,
+				Message: fmt.Sprintf("failed to load stored values: %s", err),
+			})
+		}
+	}
+}
+
+func (c *accountChecker) checkValueTypes(location Problem, value interpreter.Value) {
+	inter := c.interpreter
+
+	checkElement := func(path string, element interpreter.Value, expectedType interpreter.StaticType) {
+		elementLocation := location
+		elementLocation.Path += path
+
+		elementType := element.StaticType(inter)
+		if !c.isSubType(elementType, expectedType) {
+			elementLocation.Kind = ProblemKindTypeMismatch
+			elementLocation.Message = fmt.Sprintf(
+				"expected type `%s`, got `%s`",
+				expectedType,
+				elementType,
+			)
+			c.report(elementLocation)
+		}
+
+		c.checkValueTypes(elementLocation, element)
+	}
+
+	switch value := value.(type) {
+	case *interpreter.SomeValue:
+		innerValue := value.InnerValue(inter, interpreter.EmptyLocationRange)
+		c.checkValueTypes(location, innerValue)
+
+	case *interpreter.ArrayValue:
+		elementType := value.Type.ElementType()
+
+		index := 0
+		value.Iterate(
+			inter,
+			func(element interpreter.Value) (resume bool) {
+				checkElement(fmt.Sprintf("[%d]", index), element, elementType)
+				index++
+				return true
+			},
+			false,
+			interpreter.EmptyLocationRange,
+		)
+
+	case *interpreter.DictionaryValue:
+		dictionaryType := value.Type
+
+		value.Iterate(
+			inter,
+			interpreter.EmptyLocationRange,
+			func(key, element interpreter.Value) (resume bool) {
+				path := fmt.Sprintf("[%s]", key)
+				checkElement(path, key, dictionaryType.KeyType)
+				checkElement(path, element, dictionaryType.ValueType)
+				return true
+			},
+		)
+
+	case *interpreter.CompositeValue:
+		// NOTE: the types of fields are not checked,
+		// as they are only known from the program of the composite type
+		value.ForEachField(
+			inter,
+			func(name string, fieldValue interpreter.Value) (resume bool) {
+				fieldLocation := location
+				fieldLocation.Path += "." + name
+				c.checkValueTypes(fieldLocation, fieldValue)
+				return true
+			},
+			interpreter.EmptyLocationRange,
+		)
+	}
+}
+
+// isSubType returns true if the given type is a subtype of the given expected type,
+// or if subtyping cannot be determined, e.g. because it involves a user-defined type,
+// for which the program is not available
+func (c *accountChecker) isSubType(subType, superType interpreter.StaticType) (result bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = true
+		}
+	}()
+
+	return c.interpreter.IsSubType(subType, superType)
+}
+
+// recover calls the given function, and returns the error of a panic, if any
+func (c *accountChecker) recover(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var ok bool
+			err, ok = r.(error)
+			if !ok {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	f()
+	return nil
+}
+
+// referencedSlabIDs returns the IDs of all slabs referenced by the given slab,
+// including the slabs referenced by inlined containers
+func referencedSlabIDs(slab atree.Slab) []atree.SlabID {
+	var result []atree.SlabID
+
+	storables := slab.ChildStorables()
+	for len(storables) > 0 {
+		var next []atree.Storable
+
+		for _, storable := range storables {
+			if slabIDStorable, ok := storable.(atree.SlabIDStorable); ok {
+				result = append(result, atree.SlabID(slabIDStorable))
+			}
+
+			next = append(next, storable.ChildStorables()...)
+		}
+
+		storables = next
+	}
+
+	return result
+}
+
+func decodeSlab(id atree.SlabID, data []byte) (atree.Slab, error) {
+	return atree.DecodeSlab(
+		id,
+		data,
+		interpreter.CBORDecMode,
+		func(
+			decoder *cbor.StreamDecoder,
+			slabID atree.SlabID,
+			inlinedExtraData []atree.ExtraData,
+		) (atree.Storable, error) {
+			return interpreter.DecodeStorable(decoder, slabID, inlinedExtraData, nil)
+		},
+		func(decoder *cbor.StreamDecoder) (atree.TypeInfo, error) {
+			return interpreter.DecodeTypeInfo(decoder, nil)
+		},
+	)
+}
@@ -0,0 +1,282 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storagehealth
+
+import (
+	"testing"
+
+	"github.com/onflow/atree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/interpreter"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/tools/registers"
+)
+
+var testAddress = common.MustBytesToAddress([]byte{0x1})
+
+var testLocation = common.AddressLocation{
+	Address: testAddress,
+	Name:    "Test",
+}
+
+// newTestRegisters stores the given values in the storage domain of the test account.
+// Values which are not stored in the storage map are still committed, if they are owned by the test account.
+// Values owned by the zero address are temporary, and are not committed
+func newTestRegisters(
+	t *testing.T,
+	values func(inter *interpreter.Interpreter) map[string]interpreter.Value,
+) *registers.Registers {
+	ledger := registers.New()
+
+	storage := runtime.NewStorage(ledger, nil)
+
+	inter, err := interpreter.NewInterpreter(
+		nil,
+		testLocation,
+		&interpreter.Config{
+			Storage: storage,
+		},
+	)
+	require.NoError(t, err)
+
+	storageMap := storage.GetStorageMap(
+		testAddress,
+		common.PathDomainStorage.Identifier(),
+		true,
+	)
+
+	for key, value := range values(inter) { //nolint:maprange
+		storageMap.WriteValue(
+			inter,
+			interpreter.StringStorageMapKey(key),
+			value,
+		)
+	}
+
+	err = storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	return ledger
+}
+
+func newTestIntArray(
+	inter *interpreter.Interpreter,
+	address common.Address,
+	elementType interpreter.StaticType,
+	count int,
+) *interpreter.ArrayValue {
+	elements := make([]interpreter.Value, 0, count)
+	for i := 0; i < count; i++ {
+		elements = append(elements, interpreter.NewUnmeteredIntValueFromInt64(int64(i)))
+	}
+
+	return interpreter.NewArrayValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		&interpreter.VariableSizedStaticType{
+			Type: elementType,
+		},
+		address,
+		elements...,
+	)
+}
+
+func newTestComposite(inter *interpreter.Interpreter, fields ...interpreter.CompositeField) *interpreter.CompositeValue {
+	return interpreter.NewCompositeValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		testLocation,
+		"S",
+		common.CompositeKindStructure,
+		fields,
+		testAddress,
+	)
+}
+
+func check(t *testing.T, ledger *registers.Registers) []Problem {
+	problems, err := Check(ledger, []common.Address{testAddress})
+	require.NoError(t, err)
+	return problems
+}
+
+func problemKinds(problems []Problem) []ProblemKind {
+	kinds := make([]ProblemKind, 0, len(problems))
+	for _, problem := range problems {
+		kinds = append(kinds, problem.Kind)
+	}
+	return kinds
+}
+
+func TestCheck(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("healthy", func(t *testing.T) {
+		t.Parallel()
+
+		ledger := newTestRegisters(t, func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+			return map[string]interpreter.Value{
+				"small": newTestIntArray(inter, testAddress, interpreter.PrimitiveStaticTypeInt, 2),
+				"large": newTestComposite(
+					inter,
+					interpreter.CompositeField{
+						Name:  "ids",
+						Value: newTestIntArray(inter, common.ZeroAddress, interpreter.PrimitiveStaticTypeInt, 500),
+					},
+				),
+			}
+		})
+
+		assert.Empty(t, check(t, ledger))
+	})
+
+	t.Run("orphaned slab", func(t *testing.T) {
+		t.Parallel()
+
+		var orphanSlabID atree.SlabID
+
+		ledger := newTestRegisters(t, func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+			// The array is committed, but never stored
+			orphan := newTestIntArray(inter, testAddress, interpreter.PrimitiveStaticTypeInt, 500)
+			orphanSlabID = orphan.SlabID()
+
+			return map[string]interpreter.Value{
+				"value": newTestIntArray(inter, testAddress, interpreter.PrimitiveStaticTypeInt, 2),
+			}
+		})
+
+		problems := check(t, ledger)
+		require.Len(t, problems, 1)
+
+		problem := problems[0]
+		assert.Equal(t, ProblemKindOrphanedSlab, problem.Kind)
+		assert.Equal(t, testAddress, problem.Address)
+		assert.Equal(t, orphanSlabID, problem.SlabID)
+		assert.NotEmpty(t, problem.Descendants)
+
+		registerCount := ledger.Count()
+
+		removed, err := RemoveOrphanedSlabs(ledger, problems)
+		require.NoError(t, err)
+		assert.Equal(t, 1+len(problem.Descendants), removed)
+		assert.Equal(t, registerCount-removed, ledger.Count())
+
+		assert.Empty(t, check(t, ledger))
+	})
+
+	t.Run("dangling reference", func(t *testing.T) {
+		t.Parallel()
+
+		var nestedSlabID atree.SlabID
+
+		ledger := newTestRegisters(t, func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+			composite := newTestComposite(
+				inter,
+				interpreter.CompositeField{
+					Name:  "ids",
+					Value: newTestIntArray(inter, common.ZeroAddress, interpreter.PrimitiveStaticTypeInt, 500),
+				},
+			)
+
+			nested := composite.GetField(inter, interpreter.EmptyLocationRange, "ids").(*interpreter.ArrayValue)
+			nestedSlabID = nested.SlabID()
+
+			return map[string]interpreter.Value{
+				"value": composite,
+			}
+		})
+
+		key := registers.SlabKey(nestedSlabID)
+		err := ledger.SetValue([]byte(key.Owner), []byte(key.Key), nil)
+		require.NoError(t, err)
+
+		kinds := problemKinds(check(t, ledger))
+
+		// The composite references the removed root slab of the array,
+		// and the data slabs of the array are no longer referenced
+		assert.Contains(t, kinds, ProblemKindDanglingReference)
+		assert.Contains(t, kinds, ProblemKindOrphanedSlab)
+		assert.Contains(t, kinds, ProblemKindInvalidValue)
+	})
+
+	t.Run("dangling storage map", func(t *testing.T) {
+		t.Parallel()
+
+		ledger := registers.New()
+		err := ledger.SetValue(
+			testAddress[:],
+			[]byte(common.PathDomainStorage.Identifier()),
+			[]byte{0, 0, 0, 0, 0, 0, 0, 1},
+		)
+		require.NoError(t, err)
+
+		problems := check(t, ledger)
+		require.Len(t, problems, 1)
+
+		problem := problems[0]
+		assert.Equal(t, ProblemKindDanglingReference, problem.Kind)
+		assert.Equal(t, common.PathDomainStorage.Identifier(), problem.Domain)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		ledger := newTestRegisters(t, func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+			return map[string]interpreter.Value{
+				"value": newTestIntArray(inter, testAddress, interpreter.PrimitiveStaticTypeString, 2),
+			}
+		})
+
+		problems := check(t, ledger)
+		require.Len(t, problems, 2)
+
+		for i, problem := range problems {
+			assert.Equal(t, ProblemKindTypeMismatch, problem.Kind)
+			assert.Equal(t, "storage", problem.Domain)
+			assert.Equal(t, "value", problem.Key)
+			assert.Equal(t, []string{"[0]", "[1]"}[i], problem.Path)
+		}
+
+		assert.Equal(
+			t,
+			"0x0000000000000001 /storage/value[0]: type mismatch: expected type `String`, got `Int`",
+			problems[0].String(),
+		)
+	})
+
+	t.Run("invalid slab", func(t *testing.T) {
+		t.Parallel()
+
+		ledger := newTestRegisters(t, func(inter *interpreter.Interpreter) map[string]interpreter.Value {
+			return map[string]interpreter.Value{
+				"value": newTestIntArray(inter, testAddress, interpreter.PrimitiveStaticTypeInt, 2),
+			}
+		})
+
+		err := ledger.SetValue(testAddress[:], []byte("$\x00\x00\x00\x00\x00\x00\x00\x09"), []byte{0xff})
+		require.NoError(t, err)
+
+		problems := check(t, ledger)
+		require.Len(t, problems, 1)
+		assert.Equal(t, ProblemKindInvalidSlab, problems[0].Kind)
+	})
+}
@@ -130,6 +130,8 @@ var BoolType = PrimitiveType(interpreter.PrimitiveStaticTypeBool)
 var AddressType = PrimitiveType(interpreter.PrimitiveStaticTypeAddress)
 var StringType = PrimitiveType(interpreter.PrimitiveStaticTypeString)
 var CharacterType = PrimitiveType(interpreter.PrimitiveStaticTypeCharacter)
+var TimestampType = PrimitiveType(interpreter.PrimitiveStaticTypeTimestamp)
+var DurationType = PrimitiveType(interpreter.PrimitiveStaticTypeDuration)
 var MetaType = PrimitiveType(interpreter.PrimitiveStaticTypeMetaType)
 var BlockType = PrimitiveType(interpreter.PrimitiveStaticTypeBlock)
 
@@ -410,6 +412,52 @@ func (t *InclusiveRangeType) Equal(other Type) bool {
 	return t.ElementType.Equal(otherType.ElementType)
 }
 
+// SetType
+
+type SetType struct {
+	ElementType Type
+	typeID      string
+}
+
+var _ Type = &SetType{}
+
+func NewSetType(
+	elementType Type,
+) *SetType {
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func NewMeteredSetType(
+	gauge common.MemoryGauge,
+	elementType Type,
+) *SetType {
+	common.UseMemory(gauge, common.CadenceSetTypeMemoryUsage)
+	return NewSetType(elementType)
+}
+
+func (*SetType) isType() {}
+
+func (t *SetType) ID() string {
+	if t.typeID == "" {
+		t.typeID = fmt.Sprintf(
+			"Set<%s>",
+			t.ElementType.ID(),
+		)
+	}
+	return t.typeID
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherType, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherType.ElementType)
+}
+
 // Field
 
 type Field struct {
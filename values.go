@@ -1383,6 +1383,102 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Timestamp
+
+// Timestamp represents a point in time as the number of seconds
+// since the Unix epoch (00:00:00 UTC on 1 January 1970).
+// It is a fixed-point number with the scale of Fix64
+type Timestamp int64
+
+var _ Value = Timestamp(0)
+
+var timestampMemoryUsage = common.NewCadenceNumberMemoryUsage(int(unsafe.Sizeof(Timestamp(0))))
+
+// NewTimestamp parses the given ISO-8601 string in the UTC timezone,
+// e.g. `2020-03-13T19:52:43Z`
+func NewTimestamp(s string) (Timestamp, error) {
+	v, ok := sema.ParseTimestamp(s)
+	if !ok {
+		return 0, errors.NewDefaultUserError("invalid timestamp: %s", s)
+	}
+	return Timestamp(v), nil
+}
+
+func NewMeteredTimestamp(gauge common.MemoryGauge, constructor func() (string, error)) (Timestamp, error) {
+	common.UseMemory(gauge, timestampMemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return 0, err
+	}
+	return NewTimestamp(value)
+}
+
+func NewMeteredTimestampFromRawFixedPointNumber(gauge common.MemoryGauge, n int64) Timestamp {
+	common.UseMemory(gauge, timestampMemoryUsage)
+	return Timestamp(n)
+}
+
+func (Timestamp) isValue() {}
+
+func (Timestamp) Type() Type {
+	return TimestampType
+}
+
+func (v Timestamp) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Timestamp) String() string {
+	return format.Timestamp(int64(v))
+}
+
+// Duration
+
+// Duration represents a duration as a number of seconds.
+// It is a fixed-point number with the scale of Fix64
+type Duration int64
+
+var _ Value = Duration(0)
+
+var durationMemoryUsage = common.NewCadenceNumberMemoryUsage(int(unsafe.Sizeof(Duration(0))))
+
+// NewDuration parses the given number of seconds, e.g. `86400.0`
+func NewDuration(s string) (Duration, error) {
+	v, err := fixedpoint.ParseFix64(s)
+	if err != nil {
+		return 0, err
+	}
+	return Duration(v.Int64()), nil
+}
+
+func NewMeteredDuration(gauge common.MemoryGauge, constructor func() (string, error)) (Duration, error) {
+	common.UseMemory(gauge, durationMemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return 0, err
+	}
+	return NewDuration(value)
+}
+
+func NewMeteredDurationFromRawFixedPointNumber(gauge common.MemoryGauge, n int64) Duration {
+	common.UseMemory(gauge, durationMemoryUsage)
+	return Duration(n)
+}
+
+func (Duration) isValue() {}
+
+func (Duration) Type() Type {
+	return DurationType
+}
+
+func (v Duration) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Duration) String() string {
+	return format.Fix64(int64(v))
+}
+
 // Array
 
 type Array struct {
@@ -1436,6 +1532,64 @@ func (v Array) String() string {
 	return format.Array(values)
 }
 
+// Set
+
+type Set struct {
+	SetType *SetType
+	Values  []Value
+}
+
+var _ Value = Set{}
+
+func NewSet(values []Value) Set {
+	return Set{Values: values}
+}
+
+func NewMeteredSet(
+	gauge common.MemoryGauge,
+	length int,
+	constructor func() ([]Value, error),
+) (Set, error) {
+	common.UseMemory(gauge, common.CadenceSetValueMemoryUsage)
+	_, lengthUse := common.NewCadenceArrayMemoryUsages(length)
+	common.UseMemory(gauge, lengthUse)
+
+	values, err := constructor()
+	if err != nil {
+		return Set{}, err
+	}
+
+	return NewSet(values), nil
+}
+
+func (Set) isValue() {}
+
+func (v Set) Type() Type {
+	if v.SetType == nil {
+		// Return nil Type instead of Type referencing nil *SetType,
+		// so caller can check if v's type is nil and also prevent nil pointer dereference.
+		return nil
+	}
+	return v.SetType
+}
+
+func (v Set) MeteredType(common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Set) WithType(setType *SetType) Set {
+	v.SetType = setType
+	return v
+}
+
+func (v Set) String() string {
+	values := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		values[i] = value.String()
+	}
+	return format.Set(values)
+}
+
 // Dictionary
 
 type Dictionary struct {
@@ -2106,6 +2260,8 @@ func (v *InclusiveRange) String() string {
 type Path struct {
 	Domain     common.PathDomain
 	Identifier string
+	// Components are the embedded components of an extended path, if any
+	Components common.PathComponents
 }
 
 var _ Value = Path{}
@@ -2134,6 +2290,94 @@ func NewMeteredPath(gauge common.MemoryGauge, domain common.PathDomain, identifi
 	return NewPath(domain, identifier)
 }
 
+// NewExtendedPath returns the extended path with the given identifier and embedded components,
+// e.g. the path `/storage/vault/\(42)`.
+// Only addresses, integers, and types can be embedded in paths.
+func NewExtendedPath(domain common.PathDomain, identifier string, components ...Value) (Path, error) {
+	pathComponents := make([]common.PathComponent, 0, len(components))
+	for _, component := range components {
+		pathComponent, err := newPathComponent(component)
+		if err != nil {
+			return Path{}, err
+		}
+		pathComponents = append(pathComponents, pathComponent)
+	}
+
+	path, err := NewPath(domain, identifier)
+	if err != nil {
+		return Path{}, err
+	}
+
+	path.Components = common.NewPathComponents(pathComponents...)
+
+	return path, nil
+}
+
+func newPathComponent(value Value) (common.PathComponent, error) {
+	var integer *big.Int
+
+	switch value := value.(type) {
+	case Address:
+		return common.NewAddressPathComponent(common.Address(value)), nil
+
+	case TypeValue:
+		var typeID common.TypeID
+		if value.StaticType != nil {
+			typeID = common.TypeID(value.StaticType.ID())
+		}
+		return common.NewTypePathComponent(typeID), nil
+
+	case Int:
+		integer = value.Value
+	case Int8:
+		integer = big.NewInt(int64(value))
+	case Int16:
+		integer = big.NewInt(int64(value))
+	case Int32:
+		integer = big.NewInt(int64(value))
+	case Int64:
+		integer = big.NewInt(int64(value))
+	case Int128:
+		integer = value.Value
+	case Int256:
+		integer = value.Value
+	case UInt:
+		integer = value.Value
+	case UInt8:
+		integer = big.NewInt(int64(value))
+	case UInt16:
+		integer = big.NewInt(int64(value))
+	case UInt32:
+		integer = big.NewInt(int64(value))
+	case UInt64:
+		integer = new(big.Int).SetUint64(uint64(value))
+	case UInt128:
+		integer = value.Value
+	case UInt256:
+		integer = value.Value
+	case Word8:
+		integer = big.NewInt(int64(value))
+	case Word16:
+		integer = big.NewInt(int64(value))
+	case Word32:
+		integer = big.NewInt(int64(value))
+	case Word64:
+		integer = new(big.Int).SetUint64(uint64(value))
+	case Word128:
+		integer = value.Value
+	case Word256:
+		integer = value.Value
+
+	default:
+		return common.PathComponent{}, errors.NewDefaultUserError(
+			"cannot embed value of type `%s` in path",
+			value.Type().ID(),
+		)
+	}
+
+	return common.NewIntegerPathComponent(integer), nil
+}
+
 func (Path) isValue() {}
 
 func (v Path) Type() Type {
@@ -2154,10 +2398,14 @@ func (v Path) MeteredType(common.MemoryGauge) Type {
 }
 
 func (v Path) String() string {
-	return format.Path(
+	path := format.Path(
 		v.Domain.Identifier(),
 		v.Identifier,
 	)
+	if v.Components.IsEmpty() {
+		return path
+	}
+	return path + v.Components.String()
 }
 
 // TypeValue
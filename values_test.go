@@ -739,6 +739,52 @@ func TestNonUTF8String(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid UTF-8 in string")
 }
 
+func TestNewExtendedPath(t *testing.T) {
+	t.Parallel()
+
+	path, err := NewExtendedPath(
+		common.PathDomainStorage,
+		"vault",
+		BytesToAddress([]byte{0x1}),
+		NewUInt64(42),
+		NewTypeValue(IntType),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		Path{
+			Domain:     common.PathDomainStorage,
+			Identifier: "vault",
+			Components: common.NewPathComponents(
+				common.NewAddressPathComponent(common.Address{0, 0, 0, 0, 0, 0, 0, 0x1}),
+				common.NewIntegerPathComponent(big.NewInt(42)),
+				common.NewTypePathComponent("Int"),
+			),
+		},
+		path,
+	)
+
+	assert.Equal(t,
+		`/storage/vault/\(0x0000000000000001)/\(42)/\(Type<Int>())`,
+		path.String(),
+	)
+
+	// The identifier of a path without components may contain the separator,
+	// but the path is different from the extended path
+
+	assert.NotEqual(t,
+		MustNewPath(common.PathDomainStorage, "vault/0x0000000000000001/42/Type<Int>"),
+		path,
+	)
+
+	_, err = NewExtendedPath(
+		common.PathDomainStorage,
+		"vault",
+		String("foo"),
+	)
+	require.Error(t, err)
+}
+
 func TestNewInt128FromBig(t *testing.T) {
 	t.Parallel()
 